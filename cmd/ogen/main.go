@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
@@ -19,6 +20,7 @@ import (
 	"github.com/go-faster/yaml"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
+	"golang.org/x/mod/modfile"
 
 	"github.com/ogen-go/ogen"
 	"github.com/ogen-go/ogen/gen"
@@ -51,12 +53,57 @@ func cleanDir(targetDir string, files []os.DirEntry) (rerr error) {
 	return rerr
 }
 
+// packagePath returns import path of the dir, using the nearest go.mod.
+func packagePath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for modDir := dir; ; {
+		data, err := os.ReadFile(filepath.Join(modDir, "go.mod"))
+		switch {
+		case err == nil:
+			modPath := modfile.ModulePath(data)
+			if modPath == "" {
+				return "", errors.Errorf("%s: module path not found", filepath.Join(modDir, "go.mod"))
+			}
+			rel, err := filepath.Rel(modDir, dir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modPath, filepath.ToSlash(rel)), nil
+		case !os.IsNotExist(err):
+			return "", err
+		}
+
+		parent := filepath.Dir(modDir)
+		if parent == modDir {
+			return "", errors.Errorf("go.mod of %q not found", dir)
+		}
+		modDir = parent
+	}
+}
+
 func generate(data []byte, packageName, targetDir string, clean bool, opts gen.Options) error {
 	log := opts.Logger
 	if log == nil {
 		log = zap.NewNop()
 	}
 
+	features, err := opts.Generator.Features.Build()
+	if err != nil {
+		return errors.Wrap(err, "build features")
+	}
+	split := features.Has(gen.OgenSplitPackages)
+	if split && opts.Generator.PackagePath == "" {
+		p, err := packagePath(targetDir)
+		if err != nil {
+			return errors.Wrap(err, "detect package path")
+		}
+		opts.Generator.PackagePath = p
+	}
+
 	spec, err := ogen.Parse(data)
 	if err != nil {
 		// For pretty error message, we need to pass location.File.
@@ -87,6 +134,20 @@ func generate(data []byte, packageName, targetDir string, clean bool, opts gen.O
 			if err := cleanDir(targetDir, files); err != nil {
 				return errors.Wrap(err, "clean")
 			}
+			// Clean operation group packages too.
+			for _, f := range files {
+				if !split || !f.IsDir() {
+					continue
+				}
+				dir := filepath.Join(targetDir, f.Name())
+				subFiles, err := os.ReadDir(dir)
+				if err != nil {
+					return errors.Wrap(err, "clean")
+				}
+				if err := cleanDir(dir, subFiles); err != nil {
+					return errors.Wrap(err, "clean")
+				}
+			}
 		}
 	}
 
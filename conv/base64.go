@@ -0,0 +1,24 @@
+package conv
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+func ToBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(s)
+}
+
+func Base64ToString(v []byte) string {
+	return base64.StdEncoding.EncodeToString(v)
+}
+
+// ToBase64URL decodes base64url string, padding is optional.
+func ToBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// Base64URLToString encodes v as base64url string without padding.
+func Base64URLToString(v []byte) string {
+	return base64.RawURLEncoding.EncodeToString(v)
+}
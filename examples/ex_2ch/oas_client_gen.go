@@ -12,6 +12,7 @@ import (
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/otelogen"
 	"github.com/ogen-go/ogen/uri"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -185,29 +186,49 @@ func (c *Client) sendAPICaptcha2chcaptchaIDGet(ctx context.Context, params APICa
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptcha2chcaptchaIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -263,10 +284,12 @@ func (c *Client) sendAPICaptcha2chcaptchaIDGet(ctx context.Context, params APICa
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -302,29 +325,49 @@ func (c *Client) sendAPICaptcha2chcaptchaShowGet(ctx context.Context, params API
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptcha2chcaptchaShowGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -360,10 +403,12 @@ func (c *Client) sendAPICaptcha2chcaptchaShowGet(ctx context.Context, params API
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -401,29 +446,49 @@ func (c *Client) sendAPICaptchaAppIDPublicKeyGet(ctx context.Context, params API
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptchaAppIDPublicKeyGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -497,10 +562,12 @@ func (c *Client) sendAPICaptchaAppIDPublicKeyGet(ctx context.Context, params API
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -536,29 +603,49 @@ func (c *Client) sendAPICaptchaInvisibleRecaptchaIDGet(ctx context.Context, para
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptchaInvisibleRecaptchaIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -614,10 +701,12 @@ func (c *Client) sendAPICaptchaInvisibleRecaptchaIDGet(ctx context.Context, para
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -653,29 +742,49 @@ func (c *Client) sendAPICaptchaInvisibleRecaptchaMobileGet(ctx context.Context)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptchaInvisibleRecaptchaMobileGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -693,10 +802,12 @@ func (c *Client) sendAPICaptchaInvisibleRecaptchaMobileGet(ctx context.Context)
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -732,29 +843,49 @@ func (c *Client) sendAPICaptchaRecaptchaIDGet(ctx context.Context, params APICap
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptchaRecaptchaIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -810,10 +941,12 @@ func (c *Client) sendAPICaptchaRecaptchaIDGet(ctx context.Context, params APICap
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -849,29 +982,49 @@ func (c *Client) sendAPICaptchaRecaptchaMobileGet(ctx context.Context) (res *API
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APICaptchaRecaptchaMobileGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -889,10 +1042,12 @@ func (c *Client) sendAPICaptchaRecaptchaMobileGet(ctx context.Context) (res *API
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -928,29 +1083,49 @@ func (c *Client) sendAPIDislikeGet(ctx context.Context, params APIDislikeGetPara
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APIDislikeGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1000,10 +1175,12 @@ func (c *Client) sendAPIDislikeGet(ctx context.Context, params APIDislikeGetPara
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1039,29 +1216,49 @@ func (c *Client) sendAPILikeGet(ctx context.Context, params APILikeGetParams) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APILikeGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1111,10 +1308,12 @@ func (c *Client) sendAPILikeGet(ctx context.Context, params APILikeGetParams) (r
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1152,29 +1351,49 @@ func (c *Client) sendAPIMobileV2AfterBoardThreadNumGet(ctx context.Context, para
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APIMobileV2AfterBoardThreadNumGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1248,10 +1467,12 @@ func (c *Client) sendAPIMobileV2AfterBoardThreadNumGet(ctx context.Context, para
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1287,29 +1508,49 @@ func (c *Client) sendAPIMobileV2BoardsGet(ctx context.Context) (res Boards, err
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APIMobileV2BoardsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1327,10 +1568,12 @@ func (c *Client) sendAPIMobileV2BoardsGet(ctx context.Context) (res Boards, err
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1366,29 +1609,49 @@ func (c *Client) sendAPIMobileV2InfoBoardThreadGet(ctx context.Context, params A
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APIMobileV2InfoBoardThreadGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1443,10 +1706,12 @@ func (c *Client) sendAPIMobileV2InfoBoardThreadGet(ctx context.Context, params A
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1482,29 +1747,49 @@ func (c *Client) sendAPIMobileV2PostBoardNumGet(ctx context.Context, params APIM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, APIMobileV2PostBoardNumGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1559,10 +1844,12 @@ func (c *Client) sendAPIMobileV2PostBoardNumGet(ctx context.Context, params APIM
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1598,29 +1885,51 @@ func (c *Client) sendUserPassloginPost(ctx context.Context, request OptUserPassl
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UserPassloginPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1658,11 +1967,15 @@ func (c *Client) sendUserPassloginPost(ctx context.Context, request OptUserPassl
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1698,29 +2011,51 @@ func (c *Client) sendUserPostingPost(ctx context.Context, request OptUserPosting
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UserPostingPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1740,11 +2075,15 @@ func (c *Client) sendUserPostingPost(ctx context.Context, request OptUserPosting
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1780,29 +2119,51 @@ func (c *Client) sendUserReportPost(ctx context.Context, request OptUserReportPo
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UserReportPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1822,11 +2183,15 @@ func (c *Client) sendUserReportPost(ctx context.Context, request OptUserReportPo
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
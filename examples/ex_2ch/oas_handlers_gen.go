@@ -11,6 +11,7 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/otelogen"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
@@ -21,6 +22,7 @@ import (
 type codeRecorder struct {
 	http.ResponseWriter
 	status int
+	size   int64
 }
 
 func (c *codeRecorder) WriteHeader(status int) {
@@ -28,6 +30,16 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
+func (c *codeRecorder) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		// Status is sent implicitly.
+		c.status = http.StatusOK
+	}
+	n, err := c.ResponseWriter.Write(p)
+	c.size += int64(n)
+	return n, err
+}
+
 func (c *codeRecorder) Unwrap() http.ResponseWriter {
 	return c.ResponseWriter
 }
@@ -58,6 +70,11 @@ func (s *Server) handleAPICaptcha2chcaptchaIDGetRequest(args [0]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78,6 +95,9 @@ func (s *Server) handleAPICaptcha2chcaptchaIDGetRequest(args [0]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93,8 +113,9 @@ func (s *Server) handleAPICaptcha2chcaptchaIDGetRequest(args [0]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -204,6 +225,11 @@ func (s *Server) handleAPICaptcha2chcaptchaShowGetRequest(args [0]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -224,6 +250,9 @@ func (s *Server) handleAPICaptcha2chcaptchaShowGetRequest(args [0]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -239,8 +268,9 @@ func (s *Server) handleAPICaptcha2chcaptchaShowGetRequest(args [0]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -348,6 +378,11 @@ func (s *Server) handleAPICaptchaAppIDPublicKeyGetRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -368,6 +403,9 @@ func (s *Server) handleAPICaptchaAppIDPublicKeyGetRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -383,8 +421,9 @@ func (s *Server) handleAPICaptchaAppIDPublicKeyGetRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -498,6 +537,11 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaIDGetRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -518,6 +562,9 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaIDGetRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -533,8 +580,9 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaIDGetRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -644,6 +692,11 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaMobileGetRequest(args [0]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -664,6 +717,9 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaMobileGetRequest(args [0]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -679,8 +735,9 @@ func (s *Server) handleAPICaptchaInvisibleRecaptchaMobileGetRequest(args [0]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -767,6 +824,11 @@ func (s *Server) handleAPICaptchaRecaptchaIDGetRequest(args [0]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -787,6 +849,9 @@ func (s *Server) handleAPICaptchaRecaptchaIDGetRequest(args [0]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -802,8 +867,9 @@ func (s *Server) handleAPICaptchaRecaptchaIDGetRequest(args [0]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -913,6 +979,11 @@ func (s *Server) handleAPICaptchaRecaptchaMobileGetRequest(args [0]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -933,6 +1004,9 @@ func (s *Server) handleAPICaptchaRecaptchaMobileGetRequest(args [0]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -948,8 +1022,9 @@ func (s *Server) handleAPICaptchaRecaptchaMobileGetRequest(args [0]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1036,6 +1111,11 @@ func (s *Server) handleAPIDislikeGetRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1056,6 +1136,9 @@ func (s *Server) handleAPIDislikeGetRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1071,8 +1154,9 @@ func (s *Server) handleAPIDislikeGetRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1182,6 +1266,11 @@ func (s *Server) handleAPILikeGetRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1202,6 +1291,9 @@ func (s *Server) handleAPILikeGetRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1217,8 +1309,9 @@ func (s *Server) handleAPILikeGetRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1330,6 +1423,11 @@ func (s *Server) handleAPIMobileV2AfterBoardThreadNumGetRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1350,6 +1448,9 @@ func (s *Server) handleAPIMobileV2AfterBoardThreadNumGetRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1365,8 +1466,9 @@ func (s *Server) handleAPIMobileV2AfterBoardThreadNumGetRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1480,6 +1582,11 @@ func (s *Server) handleAPIMobileV2BoardsGetRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1500,6 +1607,9 @@ func (s *Server) handleAPIMobileV2BoardsGetRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1515,8 +1625,9 @@ func (s *Server) handleAPIMobileV2BoardsGetRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1603,6 +1714,11 @@ func (s *Server) handleAPIMobileV2InfoBoardThreadGetRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1623,6 +1739,9 @@ func (s *Server) handleAPIMobileV2InfoBoardThreadGetRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1638,8 +1757,9 @@ func (s *Server) handleAPIMobileV2InfoBoardThreadGetRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1749,6 +1869,11 @@ func (s *Server) handleAPIMobileV2PostBoardNumGetRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1769,6 +1894,9 @@ func (s *Server) handleAPIMobileV2PostBoardNumGetRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1784,8 +1912,9 @@ func (s *Server) handleAPIMobileV2PostBoardNumGetRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1895,6 +2024,15 @@ func (s *Server) handleUserPassloginPostRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1915,6 +2053,10 @@ func (s *Server) handleUserPassloginPostRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1930,8 +2072,9 @@ func (s *Server) handleUserPassloginPostRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2052,6 +2195,15 @@ func (s *Server) handleUserPostingPostRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2072,6 +2224,10 @@ func (s *Server) handleUserPostingPostRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2087,8 +2243,9 @@ func (s *Server) handleUserPostingPostRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2194,6 +2351,15 @@ func (s *Server) handleUserReportPostRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2214,6 +2380,10 @@ func (s *Server) handleUserReportPostRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2229,8 +2399,9 @@ func (s *Server) handleUserReportPostRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
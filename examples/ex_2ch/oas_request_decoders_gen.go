@@ -10,6 +10,7 @@ import (
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/ogen-go/ogen/uri"
 	"github.com/ogen-go/ogen/validate"
 )
@@ -35,6 +36,9 @@ func (s *Server) decodeUserPassloginPostRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -47,7 +51,7 @@ func (s *Server) decodeUserPassloginPostRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, nil
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -122,6 +126,9 @@ func (s *Server) decodeUserPostingPostRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -134,7 +141,7 @@ func (s *Server) decodeUserPostingPostRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, nil
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -524,6 +531,9 @@ func (s *Server) decodeUserReportPostRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -536,7 +546,7 @@ func (s *Server) decodeUserReportPostRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, nil
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -38,13 +38,14 @@ func encodeUserPassloginPostRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+	// Body without files can be replayed.
+	body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
 		return nil
 	})
-	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 	return nil
 }
 
@@ -310,12 +311,13 @@ func encodeUserReportPostRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+	// Body without files can be replayed.
+	body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
 		return nil
 	})
-	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 	return nil
 }
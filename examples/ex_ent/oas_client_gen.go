@@ -159,29 +159,51 @@ func (c *Client) sendCreatePet(ctx context.Context, request *CreatePetReq) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreatePetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -201,11 +223,15 @@ func (c *Client) sendCreatePet(ctx context.Context, request *CreatePetReq) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -242,29 +268,51 @@ func (c *Client) sendCreatePetCategories(ctx context.Context, request *CreatePet
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreatePetCategoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -303,11 +351,15 @@ func (c *Client) sendCreatePetCategories(ctx context.Context, request *CreatePet
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -344,29 +396,51 @@ func (c *Client) sendCreatePetFriends(ctx context.Context, request *CreatePetFri
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreatePetFriendsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -405,11 +479,15 @@ func (c *Client) sendCreatePetFriends(ctx context.Context, request *CreatePetFri
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -446,29 +524,51 @@ func (c *Client) sendCreatePetOwner(ctx context.Context, request *CreatePetOwner
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreatePetOwnerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -507,11 +607,15 @@ func (c *Client) sendCreatePetOwner(ctx context.Context, request *CreatePetOwner
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -548,29 +652,49 @@ func (c *Client) sendDeletePet(ctx context.Context, params DeletePetParams) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeletePetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -610,6 +734,8 @@ func (c *Client) sendDeletePet(ctx context.Context, params DeletePetParams) (res
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -646,29 +772,49 @@ func (c *Client) sendDeletePetOwner(ctx context.Context, params DeletePetOwnerPa
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeletePetOwnerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -709,6 +855,8 @@ func (c *Client) sendDeletePetOwner(ctx context.Context, params DeletePetOwnerPa
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -745,29 +893,49 @@ func (c *Client) sendListPet(ctx context.Context, params ListPetParams) (res Lis
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ListPetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -823,10 +991,12 @@ func (c *Client) sendListPet(ctx context.Context, params ListPetParams) (res Lis
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -863,29 +1033,49 @@ func (c *Client) sendListPetCategories(ctx context.Context, params ListPetCatego
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ListPetCategoriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -960,10 +1150,12 @@ func (c *Client) sendListPetCategories(ctx context.Context, params ListPetCatego
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1000,29 +1192,49 @@ func (c *Client) sendListPetFriends(ctx context.Context, params ListPetFriendsPa
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ListPetFriendsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1097,10 +1309,12 @@ func (c *Client) sendListPetFriends(ctx context.Context, params ListPetFriendsPa
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1137,29 +1351,49 @@ func (c *Client) sendReadPet(ctx context.Context, params ReadPetParams) (res Rea
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ReadPetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1195,10 +1429,12 @@ func (c *Client) sendReadPet(ctx context.Context, params ReadPetParams) (res Rea
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1235,29 +1471,49 @@ func (c *Client) sendReadPetOwner(ctx context.Context, params ReadPetOwnerParams
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ReadPetOwnerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1294,10 +1550,12 @@ func (c *Client) sendReadPetOwner(ctx context.Context, params ReadPetOwnerParams
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1334,29 +1592,51 @@ func (c *Client) sendUpdatePet(ctx context.Context, request *UpdatePetReq, param
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UpdatePetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1394,11 +1674,15 @@ func (c *Client) sendUpdatePet(ctx context.Context, request *UpdatePetReq, param
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
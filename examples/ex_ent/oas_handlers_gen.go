@@ -22,6 +22,7 @@ import (
 type codeRecorder struct {
 	http.ResponseWriter
 	status int
+	size   int64
 }
 
 func (c *codeRecorder) WriteHeader(status int) {
@@ -29,6 +30,16 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
+func (c *codeRecorder) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		// Status is sent implicitly.
+		c.status = http.StatusOK
+	}
+	n, err := c.ResponseWriter.Write(p)
+	c.size += int64(n)
+	return n, err
+}
+
 func (c *codeRecorder) Unwrap() http.ResponseWriter {
 	return c.ResponseWriter
 }
@@ -60,6 +71,15 @@ func (s *Server) handleCreatePetRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80,6 +100,10 @@ func (s *Server) handleCreatePetRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95,8 +119,9 @@ func (s *Server) handleCreatePetRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -203,6 +228,15 @@ func (s *Server) handleCreatePetCategoriesRequest(args [1]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -223,6 +257,10 @@ func (s *Server) handleCreatePetCategoriesRequest(args [1]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -238,8 +276,9 @@ func (s *Server) handleCreatePetCategoriesRequest(args [1]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -361,6 +400,15 @@ func (s *Server) handleCreatePetFriendsRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -381,6 +429,10 @@ func (s *Server) handleCreatePetFriendsRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -396,8 +448,9 @@ func (s *Server) handleCreatePetFriendsRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -519,6 +572,15 @@ func (s *Server) handleCreatePetOwnerRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -539,6 +601,10 @@ func (s *Server) handleCreatePetOwnerRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -554,8 +620,9 @@ func (s *Server) handleCreatePetOwnerRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -677,6 +744,11 @@ func (s *Server) handleDeletePetRequest(args [1]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -697,6 +769,9 @@ func (s *Server) handleDeletePetRequest(args [1]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -712,8 +787,9 @@ func (s *Server) handleDeletePetRequest(args [1]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -820,6 +896,11 @@ func (s *Server) handleDeletePetOwnerRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -840,6 +921,9 @@ func (s *Server) handleDeletePetOwnerRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -855,8 +939,9 @@ func (s *Server) handleDeletePetOwnerRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -963,6 +1048,11 @@ func (s *Server) handleListPetRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -983,6 +1073,9 @@ func (s *Server) handleListPetRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -998,8 +1091,9 @@ func (s *Server) handleListPetRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1110,6 +1204,11 @@ func (s *Server) handleListPetCategoriesRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1130,6 +1229,9 @@ func (s *Server) handleListPetCategoriesRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1145,8 +1247,9 @@ func (s *Server) handleListPetCategoriesRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1261,6 +1364,11 @@ func (s *Server) handleListPetFriendsRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1281,6 +1389,9 @@ func (s *Server) handleListPetFriendsRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1296,8 +1407,9 @@ func (s *Server) handleListPetFriendsRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1412,6 +1524,11 @@ func (s *Server) handleReadPetRequest(args [1]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1432,6 +1549,9 @@ func (s *Server) handleReadPetRequest(args [1]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1447,8 +1567,9 @@ func (s *Server) handleReadPetRequest(args [1]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1555,6 +1676,11 @@ func (s *Server) handleReadPetOwnerRequest(args [1]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1575,6 +1701,9 @@ func (s *Server) handleReadPetOwnerRequest(args [1]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1590,8 +1719,9 @@ func (s *Server) handleReadPetOwnerRequest(args [1]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1698,6 +1828,15 @@ func (s *Server) handleUpdatePetRequest(args [1]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1718,6 +1857,10 @@ func (s *Server) handleUpdatePetRequest(args [1]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1733,8 +1876,9 @@ func (s *Server) handleUpdatePetRequest(args [1]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35,6 +35,9 @@ func (s *Server) decodeCreatePetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -60,6 +63,9 @@ func (s *Server) decodeCreatePetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreatePetReq
@@ -106,6 +112,9 @@ func (s *Server) decodeCreatePetCategoriesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -131,6 +140,9 @@ func (s *Server) decodeCreatePetCategoriesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreatePetCategoriesReq
@@ -177,6 +189,9 @@ func (s *Server) decodeCreatePetFriendsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -202,6 +217,9 @@ func (s *Server) decodeCreatePetFriendsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreatePetFriendsReq
@@ -248,6 +266,9 @@ func (s *Server) decodeCreatePetOwnerRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -273,6 +294,9 @@ func (s *Server) decodeCreatePetOwnerRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreatePetOwnerReq
@@ -319,6 +343,9 @@ func (s *Server) decodeUpdatePetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -344,6 +371,9 @@ func (s *Server) decodeUpdatePetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UpdatePetReq
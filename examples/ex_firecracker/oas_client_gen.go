@@ -257,29 +257,51 @@ func (c *Client) sendCreateSnapshot(ctx context.Context, request *SnapshotCreate
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateSnapshotOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -299,11 +321,15 @@ func (c *Client) sendCreateSnapshot(ctx context.Context, request *SnapshotCreate
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -340,29 +366,51 @@ func (c *Client) sendCreateSyncAction(ctx context.Context, request *InstanceActi
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateSyncActionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -382,11 +430,15 @@ func (c *Client) sendCreateSyncAction(ctx context.Context, request *InstanceActi
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -423,29 +475,49 @@ func (c *Client) sendDescribeBalloonConfig(ctx context.Context) (res DescribeBal
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DescribeBalloonConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -463,10 +535,12 @@ func (c *Client) sendDescribeBalloonConfig(ctx context.Context) (res DescribeBal
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -503,29 +577,49 @@ func (c *Client) sendDescribeBalloonStats(ctx context.Context) (res DescribeBall
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DescribeBalloonStatsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -543,10 +637,12 @@ func (c *Client) sendDescribeBalloonStats(ctx context.Context) (res DescribeBall
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -583,29 +679,49 @@ func (c *Client) sendDescribeInstance(ctx context.Context) (res *InstanceInfo, e
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DescribeInstanceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -623,10 +739,12 @@ func (c *Client) sendDescribeInstance(ctx context.Context) (res *InstanceInfo, e
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -663,29 +781,49 @@ func (c *Client) sendGetExportVmConfig(ctx context.Context) (res *FullVmConfigur
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetExportVmConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -703,10 +841,12 @@ func (c *Client) sendGetExportVmConfig(ctx context.Context) (res *FullVmConfigur
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -745,29 +885,49 @@ func (c *Client) sendGetMachineConfiguration(ctx context.Context) (res *MachineC
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetMachineConfigurationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -785,10 +945,12 @@ func (c *Client) sendGetMachineConfiguration(ctx context.Context) (res *MachineC
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -826,29 +988,51 @@ func (c *Client) sendLoadSnapshot(ctx context.Context, request *SnapshotLoadPara
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, LoadSnapshotOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -868,11 +1052,15 @@ func (c *Client) sendLoadSnapshot(ctx context.Context, request *SnapshotLoadPara
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -908,29 +1096,51 @@ func (c *Client) sendMmdsConfigPut(ctx context.Context, request *MmdsConfig) (re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, MmdsConfigPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -950,11 +1160,15 @@ func (c *Client) sendMmdsConfigPut(ctx context.Context, request *MmdsConfig) (re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -990,29 +1204,49 @@ func (c *Client) sendMmdsGet(ctx context.Context) (res MmdsGetRes, err error) {
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, MmdsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1030,10 +1264,12 @@ func (c *Client) sendMmdsGet(ctx context.Context) (res MmdsGetRes, err error) {
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1069,29 +1305,51 @@ func (c *Client) sendMmdsPatch(ctx context.Context, request *MmdsPatchReq) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, MmdsPatchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1111,11 +1369,15 @@ func (c *Client) sendMmdsPatch(ctx context.Context, request *MmdsPatchReq) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1151,29 +1413,51 @@ func (c *Client) sendMmdsPut(ctx context.Context, request *MmdsPutReq) (res Mmds
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, MmdsPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1193,11 +1477,15 @@ func (c *Client) sendMmdsPut(ctx context.Context, request *MmdsPutReq) (res Mmds
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1235,29 +1523,51 @@ func (c *Client) sendPatchBalloon(ctx context.Context, request *BalloonUpdate) (
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchBalloonOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1277,11 +1587,15 @@ func (c *Client) sendPatchBalloon(ctx context.Context, request *BalloonUpdate) (
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1319,29 +1633,51 @@ func (c *Client) sendPatchBalloonStatsInterval(ctx context.Context, request *Bal
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchBalloonStatsIntervalOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1361,11 +1697,15 @@ func (c *Client) sendPatchBalloonStatsInterval(ctx context.Context, request *Bal
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1403,29 +1743,51 @@ func (c *Client) sendPatchGuestDriveByID(ctx context.Context, request *PartialDr
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchGuestDriveByIDOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1463,11 +1825,15 @@ func (c *Client) sendPatchGuestDriveByID(ctx context.Context, request *PartialDr
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1504,29 +1870,51 @@ func (c *Client) sendPatchGuestNetworkInterfaceByID(ctx context.Context, request
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchGuestNetworkInterfaceByIDOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1564,11 +1952,15 @@ func (c *Client) sendPatchGuestNetworkInterfaceByID(ctx context.Context, request
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1606,29 +1998,51 @@ func (c *Client) sendPatchMachineConfiguration(ctx context.Context, request OptM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchMachineConfigurationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1648,11 +2062,15 @@ func (c *Client) sendPatchMachineConfiguration(ctx context.Context, request OptM
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1689,29 +2107,51 @@ func (c *Client) sendPatchVm(ctx context.Context, request *VM) (res PatchVmRes,
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PatchVmOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1731,11 +2171,15 @@ func (c *Client) sendPatchVm(ctx context.Context, request *VM) (res PatchVmRes,
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1773,29 +2217,51 @@ func (c *Client) sendPutBalloon(ctx context.Context, request *Balloon) (res PutB
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutBalloonOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1815,11 +2281,15 @@ func (c *Client) sendPutBalloon(ctx context.Context, request *Balloon) (res PutB
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1857,29 +2327,51 @@ func (c *Client) sendPutGuestBootSource(ctx context.Context, request *BootSource
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutGuestBootSourceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1899,11 +2391,15 @@ func (c *Client) sendPutGuestBootSource(ctx context.Context, request *BootSource
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1941,29 +2437,51 @@ func (c *Client) sendPutGuestDriveByID(ctx context.Context, request *Drive, para
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutGuestDriveByIDOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2001,11 +2519,15 @@ func (c *Client) sendPutGuestDriveByID(ctx context.Context, request *Drive, para
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2042,29 +2564,51 @@ func (c *Client) sendPutGuestNetworkInterfaceByID(ctx context.Context, request *
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutGuestNetworkInterfaceByIDOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2102,11 +2646,15 @@ func (c *Client) sendPutGuestNetworkInterfaceByID(ctx context.Context, request *
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2144,29 +2692,51 @@ func (c *Client) sendPutGuestVsock(ctx context.Context, request *Vsock) (res Put
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutGuestVsockOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2186,11 +2756,15 @@ func (c *Client) sendPutGuestVsock(ctx context.Context, request *Vsock) (res Put
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2227,29 +2801,51 @@ func (c *Client) sendPutLogger(ctx context.Context, request *Logger) (res PutLog
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutLoggerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2269,11 +2865,15 @@ func (c *Client) sendPutLogger(ctx context.Context, request *Logger) (res PutLog
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2313,29 +2913,51 @@ func (c *Client) sendPutMachineConfiguration(ctx context.Context, request OptMac
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutMachineConfigurationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2355,11 +2977,15 @@ func (c *Client) sendPutMachineConfiguration(ctx context.Context, request OptMac
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2396,29 +3022,51 @@ func (c *Client) sendPutMetrics(ctx context.Context, request *Metrics) (res PutM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PutMetricsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2438,11 +3086,15 @@ func (c *Client) sendPutMetrics(ctx context.Context, request *Metrics) (res PutM
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -22,6 +22,7 @@ import (
 type codeRecorder struct {
 	http.ResponseWriter
 	status int
+	size   int64
 }
 
 func (c *codeRecorder) WriteHeader(status int) {
@@ -29,6 +30,16 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
+func (c *codeRecorder) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		// Status is sent implicitly.
+		c.status = http.StatusOK
+	}
+	n, err := c.ResponseWriter.Write(p)
+	c.size += int64(n)
+	return n, err
+}
+
 func (c *codeRecorder) Unwrap() http.ResponseWriter {
 	return c.ResponseWriter
 }
@@ -60,6 +71,15 @@ func (s *Server) handleCreateSnapshotRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80,6 +100,10 @@ func (s *Server) handleCreateSnapshotRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95,8 +119,9 @@ func (s *Server) handleCreateSnapshotRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -214,6 +239,15 @@ func (s *Server) handleCreateSyncActionRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -234,6 +268,10 @@ func (s *Server) handleCreateSyncActionRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -249,8 +287,9 @@ func (s *Server) handleCreateSyncActionRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -368,6 +407,11 @@ func (s *Server) handleDescribeBalloonConfigRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -388,6 +432,9 @@ func (s *Server) handleDescribeBalloonConfigRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -403,8 +450,9 @@ func (s *Server) handleDescribeBalloonConfigRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -503,6 +551,11 @@ func (s *Server) handleDescribeBalloonStatsRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -523,6 +576,9 @@ func (s *Server) handleDescribeBalloonStatsRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -538,8 +594,9 @@ func (s *Server) handleDescribeBalloonStatsRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -638,6 +695,11 @@ func (s *Server) handleDescribeInstanceRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -658,6 +720,9 @@ func (s *Server) handleDescribeInstanceRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -673,8 +738,9 @@ func (s *Server) handleDescribeInstanceRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -773,6 +839,11 @@ func (s *Server) handleGetExportVmConfigRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -793,6 +864,9 @@ func (s *Server) handleGetExportVmConfigRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -808,8 +882,9 @@ func (s *Server) handleGetExportVmConfigRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -910,6 +985,11 @@ func (s *Server) handleGetMachineConfigurationRequest(args [0]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -930,6 +1010,9 @@ func (s *Server) handleGetMachineConfigurationRequest(args [0]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -945,8 +1028,9 @@ func (s *Server) handleGetMachineConfigurationRequest(args [0]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1046,6 +1130,15 @@ func (s *Server) handleLoadSnapshotRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1066,6 +1159,10 @@ func (s *Server) handleLoadSnapshotRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1081,8 +1178,9 @@ func (s *Server) handleLoadSnapshotRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1199,6 +1297,15 @@ func (s *Server) handleMmdsConfigPutRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1219,6 +1326,10 @@ func (s *Server) handleMmdsConfigPutRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1234,8 +1345,9 @@ func (s *Server) handleMmdsConfigPutRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1352,6 +1464,11 @@ func (s *Server) handleMmdsGetRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1372,6 +1489,9 @@ func (s *Server) handleMmdsGetRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1387,8 +1507,9 @@ func (s *Server) handleMmdsGetRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1486,6 +1607,15 @@ func (s *Server) handleMmdsPatchRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1506,6 +1636,10 @@ func (s *Server) handleMmdsPatchRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1521,8 +1655,9 @@ func (s *Server) handleMmdsPatchRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1639,6 +1774,15 @@ func (s *Server) handleMmdsPutRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1659,6 +1803,10 @@ func (s *Server) handleMmdsPutRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1674,8 +1822,9 @@ func (s *Server) handleMmdsPutRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1794,6 +1943,15 @@ func (s *Server) handlePatchBalloonRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1814,6 +1972,10 @@ func (s *Server) handlePatchBalloonRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1829,8 +1991,9 @@ func (s *Server) handlePatchBalloonRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1949,6 +2112,15 @@ func (s *Server) handlePatchBalloonStatsIntervalRequest(args [0]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1969,6 +2141,10 @@ func (s *Server) handlePatchBalloonStatsIntervalRequest(args [0]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1984,8 +2160,9 @@ func (s *Server) handlePatchBalloonStatsIntervalRequest(args [0]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2104,6 +2281,15 @@ func (s *Server) handlePatchGuestDriveByIDRequest(args [1]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2124,6 +2310,10 @@ func (s *Server) handlePatchGuestDriveByIDRequest(args [1]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2139,8 +2329,9 @@ func (s *Server) handlePatchGuestDriveByIDRequest(args [1]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2273,6 +2464,15 @@ func (s *Server) handlePatchGuestNetworkInterfaceByIDRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2293,6 +2493,10 @@ func (s *Server) handlePatchGuestNetworkInterfaceByIDRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2308,8 +2512,9 @@ func (s *Server) handlePatchGuestNetworkInterfaceByIDRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2443,6 +2648,15 @@ func (s *Server) handlePatchMachineConfigurationRequest(args [0]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2463,6 +2677,10 @@ func (s *Server) handlePatchMachineConfigurationRequest(args [0]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2478,8 +2696,9 @@ func (s *Server) handlePatchMachineConfigurationRequest(args [0]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2597,6 +2816,15 @@ func (s *Server) handlePatchVmRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2617,6 +2845,10 @@ func (s *Server) handlePatchVmRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2632,8 +2864,9 @@ func (s *Server) handlePatchVmRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2752,6 +2985,15 @@ func (s *Server) handlePutBalloonRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2772,6 +3014,10 @@ func (s *Server) handlePutBalloonRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2787,8 +3033,9 @@ func (s *Server) handlePutBalloonRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2907,6 +3154,15 @@ func (s *Server) handlePutGuestBootSourceRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2927,6 +3183,10 @@ func (s *Server) handlePutGuestBootSourceRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2942,8 +3202,9 @@ func (s *Server) handlePutGuestBootSourceRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3062,6 +3323,15 @@ func (s *Server) handlePutGuestDriveByIDRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3082,6 +3352,10 @@ func (s *Server) handlePutGuestDriveByIDRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3097,8 +3371,9 @@ func (s *Server) handlePutGuestDriveByIDRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3231,6 +3506,15 @@ func (s *Server) handlePutGuestNetworkInterfaceByIDRequest(args [1]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3251,6 +3535,10 @@ func (s *Server) handlePutGuestNetworkInterfaceByIDRequest(args [1]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3266,8 +3554,9 @@ func (s *Server) handlePutGuestNetworkInterfaceByIDRequest(args [1]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3401,6 +3690,15 @@ func (s *Server) handlePutGuestVsockRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3421,6 +3719,10 @@ func (s *Server) handlePutGuestVsockRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3436,8 +3738,9 @@ func (s *Server) handlePutGuestVsockRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3555,6 +3858,15 @@ func (s *Server) handlePutLoggerRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3575,6 +3887,10 @@ func (s *Server) handlePutLoggerRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3590,8 +3906,9 @@ func (s *Server) handlePutLoggerRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3712,6 +4029,15 @@ func (s *Server) handlePutMachineConfigurationRequest(args [0]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3732,6 +4058,10 @@ func (s *Server) handlePutMachineConfigurationRequest(args [0]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3747,8 +4077,9 @@ func (s *Server) handlePutMachineConfigurationRequest(args [0]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3866,6 +4197,15 @@ func (s *Server) handlePutMetricsRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3886,6 +4226,10 @@ func (s *Server) handlePutMetricsRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3901,8 +4245,9 @@ func (s *Server) handlePutMetricsRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
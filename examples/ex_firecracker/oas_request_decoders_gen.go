@@ -35,6 +35,9 @@ func (s *Server) decodeCreateSnapshotRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -60,6 +63,9 @@ func (s *Server) decodeCreateSnapshotRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SnapshotCreateParams
@@ -114,6 +120,9 @@ func (s *Server) decodeCreateSyncActionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -139,6 +148,9 @@ func (s *Server) decodeCreateSyncActionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request InstanceActionInfo
@@ -193,6 +205,9 @@ func (s *Server) decodeLoadSnapshotRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -218,6 +233,9 @@ func (s *Server) decodeLoadSnapshotRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SnapshotLoadParams
@@ -264,6 +282,9 @@ func (s *Server) decodeMmdsConfigPutRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -289,6 +310,9 @@ func (s *Server) decodeMmdsConfigPutRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MmdsConfig
@@ -335,6 +359,9 @@ func (s *Server) decodeMmdsPatchRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -363,6 +390,9 @@ func (s *Server) decodeMmdsPatchRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request *MmdsPatchReq
@@ -412,6 +442,9 @@ func (s *Server) decodeMmdsPutRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -440,6 +473,9 @@ func (s *Server) decodeMmdsPutRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request *MmdsPutReq
@@ -489,6 +525,9 @@ func (s *Server) decodePatchBalloonRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -514,6 +553,9 @@ func (s *Server) decodePatchBalloonRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request BalloonUpdate
@@ -560,6 +602,9 @@ func (s *Server) decodePatchBalloonStatsIntervalRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -585,6 +630,9 @@ func (s *Server) decodePatchBalloonStatsIntervalRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request BalloonStatsUpdate
@@ -631,6 +679,9 @@ func (s *Server) decodePatchGuestDriveByIDRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -656,6 +707,9 @@ func (s *Server) decodePatchGuestDriveByIDRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PartialDrive
@@ -710,6 +764,9 @@ func (s *Server) decodePatchGuestNetworkInterfaceByIDRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -735,6 +792,9 @@ func (s *Server) decodePatchGuestNetworkInterfaceByIDRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PartialNetworkInterface
@@ -789,6 +849,9 @@ func (s *Server) decodePatchMachineConfigurationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -817,6 +880,9 @@ func (s *Server) decodePatchMachineConfigurationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptMachineConfiguration
@@ -879,6 +945,9 @@ func (s *Server) decodePatchVmRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -904,6 +973,9 @@ func (s *Server) decodePatchVmRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request VM
@@ -958,6 +1030,9 @@ func (s *Server) decodePutBalloonRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -983,6 +1058,9 @@ func (s *Server) decodePutBalloonRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Balloon
@@ -1029,6 +1107,9 @@ func (s *Server) decodePutGuestBootSourceRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1054,6 +1135,9 @@ func (s *Server) decodePutGuestBootSourceRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request BootSource
@@ -1100,6 +1184,9 @@ func (s *Server) decodePutGuestDriveByIDRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1125,6 +1212,9 @@ func (s *Server) decodePutGuestDriveByIDRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Drive
@@ -1179,6 +1269,9 @@ func (s *Server) decodePutGuestNetworkInterfaceByIDRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1204,6 +1297,9 @@ func (s *Server) decodePutGuestNetworkInterfaceByIDRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request NetworkInterface
@@ -1258,6 +1354,9 @@ func (s *Server) decodePutGuestVsockRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1283,6 +1382,9 @@ func (s *Server) decodePutGuestVsockRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Vsock
@@ -1337,6 +1439,9 @@ func (s *Server) decodePutLoggerRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1362,6 +1467,9 @@ func (s *Server) decodePutLoggerRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Logger
@@ -1416,6 +1524,9 @@ func (s *Server) decodePutMachineConfigurationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1444,6 +1555,9 @@ func (s *Server) decodePutMachineConfigurationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptMachineConfiguration
@@ -1506,6 +1620,9 @@ func (s *Server) decodePutMetricsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1531,6 +1648,9 @@ func (s *Server) decodePutMetricsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Metrics
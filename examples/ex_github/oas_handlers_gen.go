@@ -22,6 +22,7 @@ import (
 type codeRecorder struct {
 	http.ResponseWriter
 	status int
+	size   int64
 }
 
 func (c *codeRecorder) WriteHeader(status int) {
@@ -29,6 +30,16 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
+func (c *codeRecorder) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		// Status is sent implicitly.
+		c.status = http.StatusOK
+	}
+	n, err := c.ResponseWriter.Write(p)
+	c.size += int64(n)
+	return n, err
+}
+
 func (c *codeRecorder) Unwrap() http.ResponseWriter {
 	return c.ResponseWriter
 }
@@ -70,6 +81,11 @@ func (s *Server) handleActionsAddRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90,6 +106,9 @@ func (s *Server) handleActionsAddRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105,8 +124,9 @@ func (s *Server) handleActionsAddRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -226,6 +246,11 @@ func (s *Server) handleActionsAddSelectedRepoToOrgSecretRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -246,6 +271,9 @@ func (s *Server) handleActionsAddSelectedRepoToOrgSecretRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -261,8 +289,9 @@ func (s *Server) handleActionsAddSelectedRepoToOrgSecretRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -384,6 +413,11 @@ func (s *Server) handleActionsAddSelfHostedRunnerToGroupForOrgRequest(args [3]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -404,6 +438,9 @@ func (s *Server) handleActionsAddSelfHostedRunnerToGroupForOrgRequest(args [3]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -419,8 +456,9 @@ func (s *Server) handleActionsAddSelfHostedRunnerToGroupForOrgRequest(args [3]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -541,6 +579,11 @@ func (s *Server) handleActionsApproveWorkflowRunRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -561,6 +604,9 @@ func (s *Server) handleActionsApproveWorkflowRunRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -576,8 +622,9 @@ func (s *Server) handleActionsApproveWorkflowRunRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -694,6 +741,11 @@ func (s *Server) handleActionsCancelWorkflowRunRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -714,6 +766,9 @@ func (s *Server) handleActionsCancelWorkflowRunRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -729,8 +784,9 @@ func (s *Server) handleActionsCancelWorkflowRunRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -915,6 +971,15 @@ func (s *Server) handleActionsCreateOrUpdateEnvironmentSecretRequest(args [3]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -935,6 +1000,10 @@ func (s *Server) handleActionsCreateOrUpdateEnvironmentSecretRequest(args [3]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -950,8 +1019,9 @@ func (s *Server) handleActionsCreateOrUpdateEnvironmentSecretRequest(args [3]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1151,6 +1221,15 @@ func (s *Server) handleActionsCreateOrUpdateOrgSecretRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1171,6 +1250,10 @@ func (s *Server) handleActionsCreateOrUpdateOrgSecretRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1186,8 +1269,9 @@ func (s *Server) handleActionsCreateOrUpdateOrgSecretRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1383,6 +1467,15 @@ func (s *Server) handleActionsCreateOrUpdateRepoSecretRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1403,6 +1496,10 @@ func (s *Server) handleActionsCreateOrUpdateRepoSecretRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1418,8 +1515,9 @@ func (s *Server) handleActionsCreateOrUpdateRepoSecretRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1558,6 +1656,11 @@ func (s *Server) handleActionsCreateRegistrationTokenForOrgRequest(args [1]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1578,6 +1681,9 @@ func (s *Server) handleActionsCreateRegistrationTokenForOrgRequest(args [1]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1593,8 +1699,9 @@ func (s *Server) handleActionsCreateRegistrationTokenForOrgRequest(args [1]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1709,6 +1816,11 @@ func (s *Server) handleActionsCreateRegistrationTokenForRepoRequest(args [2]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1729,6 +1841,9 @@ func (s *Server) handleActionsCreateRegistrationTokenForRepoRequest(args [2]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1744,8 +1859,9 @@ func (s *Server) handleActionsCreateRegistrationTokenForRepoRequest(args [2]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1866,6 +1982,11 @@ func (s *Server) handleActionsCreateRemoveTokenForOrgRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1886,6 +2007,9 @@ func (s *Server) handleActionsCreateRemoveTokenForOrgRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1901,8 +2025,9 @@ func (s *Server) handleActionsCreateRemoveTokenForOrgRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2018,6 +2143,11 @@ func (s *Server) handleActionsCreateRemoveTokenForRepoRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2038,6 +2168,9 @@ func (s *Server) handleActionsCreateRemoveTokenForRepoRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2053,8 +2186,9 @@ func (s *Server) handleActionsCreateRemoveTokenForRepoRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2172,6 +2306,15 @@ func (s *Server) handleActionsCreateSelfHostedRunnerGroupForOrgRequest(args [1]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2192,6 +2335,10 @@ func (s *Server) handleActionsCreateSelfHostedRunnerGroupForOrgRequest(args [1]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2207,8 +2354,9 @@ func (s *Server) handleActionsCreateSelfHostedRunnerGroupForOrgRequest(args [1]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2343,6 +2491,15 @@ func (s *Server) handleActionsCreateWorkflowDispatchRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2363,6 +2520,10 @@ func (s *Server) handleActionsCreateWorkflowDispatchRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2378,8 +2539,9 @@ func (s *Server) handleActionsCreateWorkflowDispatchRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2511,6 +2673,11 @@ func (s *Server) handleActionsDeleteArtifactRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2531,6 +2698,9 @@ func (s *Server) handleActionsDeleteArtifactRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2546,8 +2716,9 @@ func (s *Server) handleActionsDeleteArtifactRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2664,6 +2835,11 @@ func (s *Server) handleActionsDeleteEnvironmentSecretRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2684,6 +2860,9 @@ func (s *Server) handleActionsDeleteEnvironmentSecretRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2699,8 +2878,9 @@ func (s *Server) handleActionsDeleteEnvironmentSecretRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2817,6 +2997,11 @@ func (s *Server) handleActionsDeleteOrgSecretRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2837,6 +3022,9 @@ func (s *Server) handleActionsDeleteOrgSecretRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2852,8 +3040,9 @@ func (s *Server) handleActionsDeleteOrgSecretRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2966,6 +3155,11 @@ func (s *Server) handleActionsDeleteRepoSecretRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2986,6 +3180,9 @@ func (s *Server) handleActionsDeleteRepoSecretRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3001,8 +3198,9 @@ func (s *Server) handleActionsDeleteRepoSecretRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3120,6 +3318,11 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromOrgRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3140,6 +3343,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromOrgRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3155,8 +3361,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromOrgRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3270,6 +3477,11 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromRepoRequest(args [3]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3290,6 +3502,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromRepoRequest(args [3]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3305,8 +3520,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerFromRepoRequest(args [3]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3428,6 +3644,11 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerGroupFromOrgRequest(args [2]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3448,6 +3669,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerGroupFromOrgRequest(args [2]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3463,8 +3687,9 @@ func (s *Server) handleActionsDeleteSelfHostedRunnerGroupFromOrgRequest(args [2]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3577,6 +3802,11 @@ func (s *Server) handleActionsDeleteWorkflowRunRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3597,6 +3827,9 @@ func (s *Server) handleActionsDeleteWorkflowRunRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3612,8 +3845,9 @@ func (s *Server) handleActionsDeleteWorkflowRunRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3730,6 +3964,11 @@ func (s *Server) handleActionsDeleteWorkflowRunLogsRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3750,6 +3989,9 @@ func (s *Server) handleActionsDeleteWorkflowRunLogsRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3765,8 +4007,9 @@ func (s *Server) handleActionsDeleteWorkflowRunLogsRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3889,6 +4132,11 @@ func (s *Server) handleActionsDisableSelectedRepositoryGithubActionsOrganization
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3909,6 +4157,9 @@ func (s *Server) handleActionsDisableSelectedRepositoryGithubActionsOrganization
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3924,8 +4175,9 @@ func (s *Server) handleActionsDisableSelectedRepositoryGithubActionsOrganization
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4040,6 +4292,11 @@ func (s *Server) handleActionsDisableWorkflowRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4060,6 +4317,9 @@ func (s *Server) handleActionsDisableWorkflowRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4075,8 +4335,9 @@ func (s *Server) handleActionsDisableWorkflowRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4195,6 +4456,11 @@ func (s *Server) handleActionsDownloadArtifactRequest(args [4]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4215,6 +4481,9 @@ func (s *Server) handleActionsDownloadArtifactRequest(args [4]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4230,8 +4499,9 @@ func (s *Server) handleActionsDownloadArtifactRequest(args [4]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4354,6 +4624,11 @@ func (s *Server) handleActionsDownloadJobLogsForWorkflowRunRequest(args [3]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4374,6 +4649,9 @@ func (s *Server) handleActionsDownloadJobLogsForWorkflowRunRequest(args [3]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4389,8 +4667,9 @@ func (s *Server) handleActionsDownloadJobLogsForWorkflowRunRequest(args [3]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4509,6 +4788,11 @@ func (s *Server) handleActionsDownloadWorkflowRunLogsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4529,6 +4813,9 @@ func (s *Server) handleActionsDownloadWorkflowRunLogsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4544,8 +4831,9 @@ func (s *Server) handleActionsDownloadWorkflowRunLogsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4668,6 +4956,11 @@ func (s *Server) handleActionsEnableSelectedRepositoryGithubActionsOrganizationR
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4688,6 +4981,9 @@ func (s *Server) handleActionsEnableSelectedRepositoryGithubActionsOrganizationR
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4703,8 +4999,9 @@ func (s *Server) handleActionsEnableSelectedRepositoryGithubActionsOrganizationR
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4819,6 +5116,11 @@ func (s *Server) handleActionsEnableWorkflowRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4839,6 +5141,9 @@ func (s *Server) handleActionsEnableWorkflowRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4854,8 +5159,9 @@ func (s *Server) handleActionsEnableWorkflowRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4977,6 +5283,11 @@ func (s *Server) handleActionsGetAllowedActionsOrganizationRequest(args [1]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4997,6 +5308,9 @@ func (s *Server) handleActionsGetAllowedActionsOrganizationRequest(args [1]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5012,8 +5326,9 @@ func (s *Server) handleActionsGetAllowedActionsOrganizationRequest(args [1]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5127,6 +5442,11 @@ func (s *Server) handleActionsGetAllowedActionsRepositoryRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5147,6 +5467,9 @@ func (s *Server) handleActionsGetAllowedActionsRepositoryRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5162,8 +5485,9 @@ func (s *Server) handleActionsGetAllowedActionsRepositoryRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5276,6 +5600,11 @@ func (s *Server) handleActionsGetArtifactRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5296,6 +5625,9 @@ func (s *Server) handleActionsGetArtifactRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5311,8 +5643,9 @@ func (s *Server) handleActionsGetArtifactRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5430,6 +5763,11 @@ func (s *Server) handleActionsGetEnvironmentPublicKeyRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5450,6 +5788,9 @@ func (s *Server) handleActionsGetEnvironmentPublicKeyRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5465,8 +5806,9 @@ func (s *Server) handleActionsGetEnvironmentPublicKeyRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5579,6 +5921,11 @@ func (s *Server) handleActionsGetEnvironmentSecretRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5599,6 +5946,9 @@ func (s *Server) handleActionsGetEnvironmentSecretRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5614,8 +5964,9 @@ func (s *Server) handleActionsGetEnvironmentSecretRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5733,6 +6084,11 @@ func (s *Server) handleActionsGetGithubActionsPermissionsOrganizationRequest(arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5753,6 +6109,9 @@ func (s *Server) handleActionsGetGithubActionsPermissionsOrganizationRequest(arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5768,8 +6127,9 @@ func (s *Server) handleActionsGetGithubActionsPermissionsOrganizationRequest(arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5880,6 +6240,11 @@ func (s *Server) handleActionsGetGithubActionsPermissionsRepositoryRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5900,6 +6265,9 @@ func (s *Server) handleActionsGetGithubActionsPermissionsRepositoryRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5915,8 +6283,9 @@ func (s *Server) handleActionsGetGithubActionsPermissionsRepositoryRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6029,6 +6398,11 @@ func (s *Server) handleActionsGetJobForWorkflowRunRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6049,6 +6423,9 @@ func (s *Server) handleActionsGetJobForWorkflowRunRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6064,8 +6441,9 @@ func (s *Server) handleActionsGetJobForWorkflowRunRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6182,6 +6560,11 @@ func (s *Server) handleActionsGetOrgPublicKeyRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6202,6 +6585,9 @@ func (s *Server) handleActionsGetOrgPublicKeyRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6217,8 +6603,9 @@ func (s *Server) handleActionsGetOrgPublicKeyRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6327,6 +6714,11 @@ func (s *Server) handleActionsGetOrgSecretRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6347,6 +6739,9 @@ func (s *Server) handleActionsGetOrgSecretRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6362,8 +6757,9 @@ func (s *Server) handleActionsGetOrgSecretRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6477,6 +6873,11 @@ func (s *Server) handleActionsGetRepoPublicKeyRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6497,6 +6898,9 @@ func (s *Server) handleActionsGetRepoPublicKeyRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6512,8 +6916,9 @@ func (s *Server) handleActionsGetRepoPublicKeyRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6626,6 +7031,11 @@ func (s *Server) handleActionsGetRepoSecretRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6646,6 +7056,9 @@ func (s *Server) handleActionsGetRepoSecretRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6661,8 +7074,9 @@ func (s *Server) handleActionsGetRepoSecretRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6779,6 +7193,11 @@ func (s *Server) handleActionsGetReviewsForRunRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6799,6 +7218,9 @@ func (s *Server) handleActionsGetReviewsForRunRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6814,8 +7236,9 @@ func (s *Server) handleActionsGetReviewsForRunRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6932,6 +7355,11 @@ func (s *Server) handleActionsGetSelfHostedRunnerForOrgRequest(args [2]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6952,6 +7380,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerForOrgRequest(args [2]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6967,8 +7398,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerForOrgRequest(args [2]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7081,6 +7513,11 @@ func (s *Server) handleActionsGetSelfHostedRunnerForRepoRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7101,6 +7538,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerForRepoRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7116,8 +7556,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerForRepoRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7239,6 +7680,11 @@ func (s *Server) handleActionsGetSelfHostedRunnerGroupForOrgRequest(args [2]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7259,6 +7705,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerGroupForOrgRequest(args [2]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7274,8 +7723,9 @@ func (s *Server) handleActionsGetSelfHostedRunnerGroupForOrgRequest(args [2]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7389,6 +7839,11 @@ func (s *Server) handleActionsGetWorkflowRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7409,6 +7864,9 @@ func (s *Server) handleActionsGetWorkflowRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7424,8 +7882,9 @@ func (s *Server) handleActionsGetWorkflowRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7542,6 +8001,11 @@ func (s *Server) handleActionsGetWorkflowRunRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7562,6 +8026,9 @@ func (s *Server) handleActionsGetWorkflowRunRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7577,8 +8044,9 @@ func (s *Server) handleActionsGetWorkflowRunRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7703,6 +8171,11 @@ func (s *Server) handleActionsGetWorkflowRunUsageRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7723,6 +8196,9 @@ func (s *Server) handleActionsGetWorkflowRunUsageRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7738,8 +8214,9 @@ func (s *Server) handleActionsGetWorkflowRunUsageRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7866,6 +8343,11 @@ func (s *Server) handleActionsGetWorkflowUsageRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7886,6 +8368,9 @@ func (s *Server) handleActionsGetWorkflowUsageRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7901,8 +8386,9 @@ func (s *Server) handleActionsGetWorkflowUsageRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8019,6 +8505,11 @@ func (s *Server) handleActionsListArtifactsForRepoRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8039,6 +8530,9 @@ func (s *Server) handleActionsListArtifactsForRepoRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8054,8 +8548,9 @@ func (s *Server) handleActionsListArtifactsForRepoRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8176,6 +8671,11 @@ func (s *Server) handleActionsListEnvironmentSecretsRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8196,6 +8696,9 @@ func (s *Server) handleActionsListEnvironmentSecretsRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8211,8 +8714,9 @@ func (s *Server) handleActionsListEnvironmentSecretsRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8336,6 +8840,11 @@ func (s *Server) handleActionsListJobsForWorkflowRunRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8356,6 +8865,9 @@ func (s *Server) handleActionsListJobsForWorkflowRunRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8371,8 +8883,9 @@ func (s *Server) handleActionsListJobsForWorkflowRunRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8501,6 +9014,11 @@ func (s *Server) handleActionsListOrgSecretsRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8521,6 +9039,9 @@ func (s *Server) handleActionsListOrgSecretsRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8536,8 +9057,9 @@ func (s *Server) handleActionsListOrgSecretsRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8659,6 +9181,11 @@ func (s *Server) handleActionsListRepoAccessToSelfHostedRunnerGroupInOrgRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8679,6 +9206,9 @@ func (s *Server) handleActionsListRepoAccessToSelfHostedRunnerGroupInOrgRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8694,8 +9224,9 @@ func (s *Server) handleActionsListRepoAccessToSelfHostedRunnerGroupInOrgRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8816,6 +9347,11 @@ func (s *Server) handleActionsListRepoSecretsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8836,6 +9372,9 @@ func (s *Server) handleActionsListRepoSecretsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8851,8 +9390,9 @@ func (s *Server) handleActionsListRepoSecretsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8973,6 +9513,11 @@ func (s *Server) handleActionsListRepoWorkflowsRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8993,6 +9538,9 @@ func (s *Server) handleActionsListRepoWorkflowsRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9008,8 +9556,9 @@ func (s *Server) handleActionsListRepoWorkflowsRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9130,6 +9679,11 @@ func (s *Server) handleActionsListRunnerApplicationsForOrgRequest(args [1]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9150,6 +9704,9 @@ func (s *Server) handleActionsListRunnerApplicationsForOrgRequest(args [1]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9165,8 +9722,9 @@ func (s *Server) handleActionsListRunnerApplicationsForOrgRequest(args [1]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9275,6 +9833,11 @@ func (s *Server) handleActionsListRunnerApplicationsForRepoRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9295,6 +9858,9 @@ func (s *Server) handleActionsListRunnerApplicationsForRepoRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9310,8 +9876,9 @@ func (s *Server) handleActionsListRunnerApplicationsForRepoRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9425,6 +9992,11 @@ func (s *Server) handleActionsListSelectedReposForOrgSecretRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9445,6 +10017,9 @@ func (s *Server) handleActionsListSelectedReposForOrgSecretRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9460,8 +10035,9 @@ func (s *Server) handleActionsListSelectedReposForOrgSecretRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9587,6 +10163,11 @@ func (s *Server) handleActionsListSelectedRepositoriesEnabledGithubActionsOrgani
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9607,6 +10188,9 @@ func (s *Server) handleActionsListSelectedRepositoriesEnabledGithubActionsOrgani
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9622,8 +10206,9 @@ func (s *Server) handleActionsListSelectedRepositoriesEnabledGithubActionsOrgani
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9745,6 +10330,11 @@ func (s *Server) handleActionsListSelfHostedRunnerGroupsForOrgRequest(args [1]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9765,6 +10355,9 @@ func (s *Server) handleActionsListSelfHostedRunnerGroupsForOrgRequest(args [1]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9780,8 +10373,9 @@ func (s *Server) handleActionsListSelfHostedRunnerGroupsForOrgRequest(args [1]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9898,6 +10492,11 @@ func (s *Server) handleActionsListSelfHostedRunnersForOrgRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9918,6 +10517,9 @@ func (s *Server) handleActionsListSelfHostedRunnersForOrgRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9933,8 +10535,9 @@ func (s *Server) handleActionsListSelfHostedRunnersForOrgRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10050,6 +10653,11 @@ func (s *Server) handleActionsListSelfHostedRunnersForRepoRequest(args [2]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10070,6 +10678,9 @@ func (s *Server) handleActionsListSelfHostedRunnersForRepoRequest(args [2]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10085,8 +10696,9 @@ func (s *Server) handleActionsListSelfHostedRunnersForRepoRequest(args [2]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10212,6 +10824,11 @@ func (s *Server) handleActionsListSelfHostedRunnersInGroupForOrgRequest(args [2]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10232,6 +10849,9 @@ func (s *Server) handleActionsListSelfHostedRunnersInGroupForOrgRequest(args [2]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10247,8 +10867,9 @@ func (s *Server) handleActionsListSelfHostedRunnersInGroupForOrgRequest(args [2]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10369,6 +10990,11 @@ func (s *Server) handleActionsListWorkflowRunArtifactsRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10389,6 +11015,9 @@ func (s *Server) handleActionsListWorkflowRunArtifactsRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10404,8 +11033,9 @@ func (s *Server) handleActionsListWorkflowRunArtifactsRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10535,6 +11165,11 @@ func (s *Server) handleActionsListWorkflowRunsRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10555,6 +11190,9 @@ func (s *Server) handleActionsListWorkflowRunsRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10570,8 +11208,9 @@ func (s *Server) handleActionsListWorkflowRunsRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10721,6 +11360,11 @@ func (s *Server) handleActionsListWorkflowRunsForRepoRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10741,6 +11385,9 @@ func (s *Server) handleActionsListWorkflowRunsForRepoRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10756,8 +11403,9 @@ func (s *Server) handleActionsListWorkflowRunsForRepoRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10905,6 +11553,11 @@ func (s *Server) handleActionsReRunWorkflowRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10925,6 +11578,9 @@ func (s *Server) handleActionsReRunWorkflowRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10940,8 +11596,9 @@ func (s *Server) handleActionsReRunWorkflowRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11066,6 +11723,11 @@ func (s *Server) handleActionsRemoveRepoAccessToSelfHostedRunnerGroupInOrgReques
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11086,6 +11748,9 @@ func (s *Server) handleActionsRemoveRepoAccessToSelfHostedRunnerGroupInOrgReques
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11101,8 +11766,9 @@ func (s *Server) handleActionsRemoveRepoAccessToSelfHostedRunnerGroupInOrgReques
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11222,6 +11888,11 @@ func (s *Server) handleActionsRemoveSelectedRepoFromOrgSecretRequest(args [3]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11242,6 +11913,9 @@ func (s *Server) handleActionsRemoveSelectedRepoFromOrgSecretRequest(args [3]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11257,8 +11931,9 @@ func (s *Server) handleActionsRemoveSelectedRepoFromOrgSecretRequest(args [3]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11381,6 +12056,11 @@ func (s *Server) handleActionsRemoveSelfHostedRunnerFromGroupForOrgRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11401,6 +12081,9 @@ func (s *Server) handleActionsRemoveSelfHostedRunnerFromGroupForOrgRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11416,8 +12099,9 @@ func (s *Server) handleActionsRemoveSelfHostedRunnerFromGroupForOrgRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11534,6 +12218,11 @@ func (s *Server) handleActionsRetryWorkflowRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11554,6 +12243,9 @@ func (s *Server) handleActionsRetryWorkflowRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11569,8 +12261,9 @@ func (s *Server) handleActionsRetryWorkflowRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11687,6 +12380,15 @@ func (s *Server) handleActionsReviewPendingDeploymentsForRunRequest(args [3]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11707,6 +12409,10 @@ func (s *Server) handleActionsReviewPendingDeploymentsForRunRequest(args [3]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11722,8 +12428,9 @@ func (s *Server) handleActionsReviewPendingDeploymentsForRunRequest(args [3]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11867,6 +12574,15 @@ func (s *Server) handleActionsSetAllowedActionsOrganizationRequest(args [1]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11887,6 +12603,10 @@ func (s *Server) handleActionsSetAllowedActionsOrganizationRequest(args [1]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11902,8 +12622,9 @@ func (s *Server) handleActionsSetAllowedActionsOrganizationRequest(args [1]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12039,6 +12760,15 @@ func (s *Server) handleActionsSetAllowedActionsRepositoryRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12059,6 +12789,10 @@ func (s *Server) handleActionsSetAllowedActionsRepositoryRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12074,8 +12808,9 @@ func (s *Server) handleActionsSetAllowedActionsRepositoryRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12208,6 +12943,15 @@ func (s *Server) handleActionsSetGithubActionsPermissionsOrganizationRequest(arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12228,6 +12972,10 @@ func (s *Server) handleActionsSetGithubActionsPermissionsOrganizationRequest(arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12243,8 +12991,9 @@ func (s *Server) handleActionsSetGithubActionsPermissionsOrganizationRequest(arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12374,6 +13123,15 @@ func (s *Server) handleActionsSetGithubActionsPermissionsRepositoryRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12394,6 +13152,10 @@ func (s *Server) handleActionsSetGithubActionsPermissionsRepositoryRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12409,8 +13171,9 @@ func (s *Server) handleActionsSetGithubActionsPermissionsRepositoryRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12544,6 +13307,15 @@ func (s *Server) handleActionsSetRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12564,6 +13336,10 @@ func (s *Server) handleActionsSetRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12579,8 +13355,9 @@ func (s *Server) handleActionsSetRepoAccessToSelfHostedRunnerGroupInOrgRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12711,6 +13488,15 @@ func (s *Server) handleActionsSetSelectedReposForOrgSecretRequest(args [2]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12731,6 +13517,10 @@ func (s *Server) handleActionsSetSelectedReposForOrgSecretRequest(args [2]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12746,8 +13536,9 @@ func (s *Server) handleActionsSetSelectedReposForOrgSecretRequest(args [2]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12881,6 +13672,15 @@ func (s *Server) handleActionsSetSelectedRepositoriesEnabledGithubActionsOrganiz
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12901,6 +13701,10 @@ func (s *Server) handleActionsSetSelectedRepositoriesEnabledGithubActionsOrganiz
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12916,8 +13720,9 @@ func (s *Server) handleActionsSetSelectedRepositoriesEnabledGithubActionsOrganiz
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13046,6 +13851,15 @@ func (s *Server) handleActionsSetSelfHostedRunnersInGroupForOrgRequest(args [2]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13066,6 +13880,10 @@ func (s *Server) handleActionsSetSelfHostedRunnersInGroupForOrgRequest(args [2]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13081,8 +13899,9 @@ func (s *Server) handleActionsSetSelfHostedRunnersInGroupForOrgRequest(args [2]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13215,6 +14034,15 @@ func (s *Server) handleActionsUpdateSelfHostedRunnerGroupForOrgRequest(args [2]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13235,6 +14063,10 @@ func (s *Server) handleActionsUpdateSelfHostedRunnerGroupForOrgRequest(args [2]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13250,8 +14082,9 @@ func (s *Server) handleActionsUpdateSelfHostedRunnerGroupForOrgRequest(args [2]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13377,6 +14210,11 @@ func (s *Server) handleActivityCheckRepoIsStarredByAuthenticatedUserRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13397,6 +14235,9 @@ func (s *Server) handleActivityCheckRepoIsStarredByAuthenticatedUserRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13412,8 +14253,9 @@ func (s *Server) handleActivityCheckRepoIsStarredByAuthenticatedUserRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13527,6 +14369,11 @@ func (s *Server) handleActivityDeleteRepoSubscriptionRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13547,6 +14394,9 @@ func (s *Server) handleActivityDeleteRepoSubscriptionRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13562,8 +14412,9 @@ func (s *Server) handleActivityDeleteRepoSubscriptionRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13679,6 +14530,11 @@ func (s *Server) handleActivityDeleteThreadSubscriptionRequest(args [1]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13699,6 +14555,9 @@ func (s *Server) handleActivityDeleteThreadSubscriptionRequest(args [1]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13714,8 +14573,9 @@ func (s *Server) handleActivityDeleteThreadSubscriptionRequest(args [1]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13840,6 +14700,11 @@ func (s *Server) handleActivityGetFeedsRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13860,6 +14725,9 @@ func (s *Server) handleActivityGetFeedsRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13875,8 +14743,9 @@ func (s *Server) handleActivityGetFeedsRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13964,6 +14833,11 @@ func (s *Server) handleActivityGetRepoSubscriptionRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13984,6 +14858,9 @@ func (s *Server) handleActivityGetRepoSubscriptionRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13999,8 +14876,9 @@ func (s *Server) handleActivityGetRepoSubscriptionRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14111,6 +14989,11 @@ func (s *Server) handleActivityGetThreadRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14131,6 +15014,9 @@ func (s *Server) handleActivityGetThreadRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14146,8 +15032,9 @@ func (s *Server) handleActivityGetThreadRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14260,6 +15147,11 @@ func (s *Server) handleActivityGetThreadSubscriptionForAuthenticatedUserRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14280,6 +15172,9 @@ func (s *Server) handleActivityGetThreadSubscriptionForAuthenticatedUserRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14295,8 +15190,9 @@ func (s *Server) handleActivityGetThreadSubscriptionForAuthenticatedUserRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14404,6 +15300,11 @@ func (s *Server) handleActivityListEventsForAuthenticatedUserRequest(args [1]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14424,6 +15325,9 @@ func (s *Server) handleActivityListEventsForAuthenticatedUserRequest(args [1]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14439,8 +15343,9 @@ func (s *Server) handleActivityListEventsForAuthenticatedUserRequest(args [1]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14555,6 +15460,11 @@ func (s *Server) handleActivityListNotificationsForAuthenticatedUserRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14575,6 +15485,9 @@ func (s *Server) handleActivityListNotificationsForAuthenticatedUserRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14590,8 +15503,9 @@ func (s *Server) handleActivityListNotificationsForAuthenticatedUserRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14718,6 +15632,11 @@ func (s *Server) handleActivityListOrgEventsForAuthenticatedUserRequest(args [2]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14738,6 +15657,9 @@ func (s *Server) handleActivityListOrgEventsForAuthenticatedUserRequest(args [2]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14753,8 +15675,9 @@ func (s *Server) handleActivityListOrgEventsForAuthenticatedUserRequest(args [2]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -14874,6 +15797,11 @@ func (s *Server) handleActivityListPublicEventsRequest(args [0]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -14894,6 +15822,9 @@ func (s *Server) handleActivityListPublicEventsRequest(args [0]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -14909,8 +15840,9 @@ func (s *Server) handleActivityListPublicEventsRequest(args [0]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15021,6 +15953,11 @@ func (s *Server) handleActivityListPublicEventsForRepoNetworkRequest(args [2]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15041,6 +15978,9 @@ func (s *Server) handleActivityListPublicEventsForRepoNetworkRequest(args [2]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15056,8 +15996,9 @@ func (s *Server) handleActivityListPublicEventsForRepoNetworkRequest(args [2]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15176,6 +16117,11 @@ func (s *Server) handleActivityListPublicEventsForUserRequest(args [1]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15196,6 +16142,9 @@ func (s *Server) handleActivityListPublicEventsForUserRequest(args [1]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15211,8 +16160,9 @@ func (s *Server) handleActivityListPublicEventsForUserRequest(args [1]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15327,6 +16277,11 @@ func (s *Server) handleActivityListPublicOrgEventsRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15347,6 +16302,9 @@ func (s *Server) handleActivityListPublicOrgEventsRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15362,8 +16320,9 @@ func (s *Server) handleActivityListPublicOrgEventsRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15480,6 +16439,11 @@ func (s *Server) handleActivityListReceivedEventsForUserRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15500,6 +16464,9 @@ func (s *Server) handleActivityListReceivedEventsForUserRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15515,8 +16482,9 @@ func (s *Server) handleActivityListReceivedEventsForUserRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15631,6 +16599,11 @@ func (s *Server) handleActivityListReceivedPublicEventsForUserRequest(args [1]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15651,6 +16624,9 @@ func (s *Server) handleActivityListReceivedPublicEventsForUserRequest(args [1]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15666,8 +16642,9 @@ func (s *Server) handleActivityListReceivedPublicEventsForUserRequest(args [1]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15782,6 +16759,11 @@ func (s *Server) handleActivityListRepoEventsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15802,6 +16784,9 @@ func (s *Server) handleActivityListRepoEventsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15817,8 +16802,9 @@ func (s *Server) handleActivityListRepoEventsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -15937,6 +16923,11 @@ func (s *Server) handleActivityListRepoNotificationsForAuthenticatedUserRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -15957,6 +16948,9 @@ func (s *Server) handleActivityListRepoNotificationsForAuthenticatedUserRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -15972,8 +16966,9 @@ func (s *Server) handleActivityListRepoNotificationsForAuthenticatedUserRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16113,6 +17108,11 @@ func (s *Server) handleActivityListReposStarredByAuthenticatedUserRequest(args [
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16133,6 +17133,9 @@ func (s *Server) handleActivityListReposStarredByAuthenticatedUserRequest(args [
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16148,8 +17151,9 @@ func (s *Server) handleActivityListReposStarredByAuthenticatedUserRequest(args [
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16268,6 +17272,11 @@ func (s *Server) handleActivityListReposWatchedByUserRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16288,6 +17297,9 @@ func (s *Server) handleActivityListReposWatchedByUserRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16303,8 +17315,9 @@ func (s *Server) handleActivityListReposWatchedByUserRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16419,6 +17432,11 @@ func (s *Server) handleActivityListWatchedReposForAuthenticatedUserRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16439,6 +17457,9 @@ func (s *Server) handleActivityListWatchedReposForAuthenticatedUserRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16454,8 +17475,9 @@ func (s *Server) handleActivityListWatchedReposForAuthenticatedUserRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16566,6 +17588,11 @@ func (s *Server) handleActivityListWatchersForRepoRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16586,6 +17613,9 @@ func (s *Server) handleActivityListWatchersForRepoRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16601,8 +17631,9 @@ func (s *Server) handleActivityListWatchersForRepoRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16728,6 +17759,15 @@ func (s *Server) handleActivityMarkNotificationsAsReadRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16748,6 +17788,10 @@ func (s *Server) handleActivityMarkNotificationsAsReadRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16763,8 +17807,9 @@ func (s *Server) handleActivityMarkNotificationsAsReadRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -16879,6 +17924,15 @@ func (s *Server) handleActivityMarkRepoNotificationsAsReadRequest(args [2]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -16899,6 +17953,10 @@ func (s *Server) handleActivityMarkRepoNotificationsAsReadRequest(args [2]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -16914,8 +17972,9 @@ func (s *Server) handleActivityMarkRepoNotificationsAsReadRequest(args [2]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17041,6 +18100,11 @@ func (s *Server) handleActivityMarkThreadAsReadRequest(args [1]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17061,6 +18125,9 @@ func (s *Server) handleActivityMarkThreadAsReadRequest(args [1]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17076,8 +18143,9 @@ func (s *Server) handleActivityMarkThreadAsReadRequest(args [1]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17188,6 +18256,15 @@ func (s *Server) handleActivitySetRepoSubscriptionRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17208,6 +18285,10 @@ func (s *Server) handleActivitySetRepoSubscriptionRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17223,8 +18304,9 @@ func (s *Server) handleActivitySetRepoSubscriptionRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17360,6 +18442,15 @@ func (s *Server) handleActivitySetThreadSubscriptionRequest(args [1]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17380,6 +18471,10 @@ func (s *Server) handleActivitySetThreadSubscriptionRequest(args [1]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17395,8 +18490,9 @@ func (s *Server) handleActivitySetThreadSubscriptionRequest(args [1]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17521,6 +18617,11 @@ func (s *Server) handleActivityStarRepoForAuthenticatedUserRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17541,6 +18642,9 @@ func (s *Server) handleActivityStarRepoForAuthenticatedUserRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17556,8 +18660,9 @@ func (s *Server) handleActivityStarRepoForAuthenticatedUserRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17668,6 +18773,11 @@ func (s *Server) handleActivityUnstarRepoForAuthenticatedUserRequest(args [2]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17688,6 +18798,9 @@ func (s *Server) handleActivityUnstarRepoForAuthenticatedUserRequest(args [2]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17703,8 +18816,9 @@ func (s *Server) handleActivityUnstarRepoForAuthenticatedUserRequest(args [2]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17822,6 +18936,11 @@ func (s *Server) handleAppsAddRepoToInstallationRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17842,6 +18961,9 @@ func (s *Server) handleAppsAddRepoToInstallationRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -17857,8 +18979,9 @@ func (s *Server) handleAppsAddRepoToInstallationRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -17975,6 +19098,15 @@ func (s *Server) handleAppsCheckTokenRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -17995,6 +19127,10 @@ func (s *Server) handleAppsCheckTokenRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18010,8 +19146,9 @@ func (s *Server) handleAppsCheckTokenRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18144,6 +19281,15 @@ func (s *Server) handleAppsCreateContentAttachmentRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18164,6 +19310,10 @@ func (s *Server) handleAppsCreateContentAttachmentRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18179,8 +19329,9 @@ func (s *Server) handleAppsCreateContentAttachmentRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18314,6 +19465,15 @@ func (s *Server) handleAppsCreateFromManifestRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18334,6 +19494,10 @@ func (s *Server) handleAppsCreateFromManifestRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18349,8 +19513,9 @@ func (s *Server) handleAppsCreateFromManifestRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18482,6 +19647,15 @@ func (s *Server) handleAppsCreateInstallationAccessTokenRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18502,6 +19676,10 @@ func (s *Server) handleAppsCreateInstallationAccessTokenRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18517,8 +19695,9 @@ func (s *Server) handleAppsCreateInstallationAccessTokenRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18649,6 +19828,15 @@ func (s *Server) handleAppsDeleteAuthorizationRequest(args [1]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18669,6 +19857,10 @@ func (s *Server) handleAppsDeleteAuthorizationRequest(args [1]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18684,8 +19876,9 @@ func (s *Server) handleAppsDeleteAuthorizationRequest(args [1]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18814,6 +20007,11 @@ func (s *Server) handleAppsDeleteInstallationRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18834,6 +20032,9 @@ func (s *Server) handleAppsDeleteInstallationRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18849,8 +20050,9 @@ func (s *Server) handleAppsDeleteInstallationRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -18961,6 +20163,15 @@ func (s *Server) handleAppsDeleteTokenRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -18981,6 +20192,10 @@ func (s *Server) handleAppsDeleteTokenRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -18996,8 +20211,9 @@ func (s *Server) handleAppsDeleteTokenRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19127,6 +20343,11 @@ func (s *Server) handleAppsGetAuthenticatedRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19147,6 +20368,9 @@ func (s *Server) handleAppsGetAuthenticatedRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19162,8 +20386,9 @@ func (s *Server) handleAppsGetAuthenticatedRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19259,6 +20484,11 @@ func (s *Server) handleAppsGetBySlugRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19279,6 +20509,9 @@ func (s *Server) handleAppsGetBySlugRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19294,8 +20527,9 @@ func (s *Server) handleAppsGetBySlugRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19410,6 +20644,11 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19430,6 +20669,9 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19445,8 +20687,9 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19561,6 +20804,11 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountStubbedRequest(args [1]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19581,6 +20829,9 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountStubbedRequest(args [1]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19596,8 +20847,9 @@ func (s *Server) handleAppsGetSubscriptionPlanForAccountStubbedRequest(args [1]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19710,6 +20962,11 @@ func (s *Server) handleAppsGetWebhookConfigForAppRequest(args [0]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19730,6 +20987,9 @@ func (s *Server) handleAppsGetWebhookConfigForAppRequest(args [0]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19745,8 +21005,9 @@ func (s *Server) handleAppsGetWebhookConfigForAppRequest(args [0]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19838,6 +21099,11 @@ func (s *Server) handleAppsGetWebhookDeliveryRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -19858,6 +21124,9 @@ func (s *Server) handleAppsGetWebhookDeliveryRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -19873,8 +21142,9 @@ func (s *Server) handleAppsGetWebhookDeliveryRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -19990,6 +21260,11 @@ func (s *Server) handleAppsListAccountsForPlanRequest(args [1]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20010,6 +21285,9 @@ func (s *Server) handleAppsListAccountsForPlanRequest(args [1]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20025,8 +21303,9 @@ func (s *Server) handleAppsListAccountsForPlanRequest(args [1]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20158,6 +21437,11 @@ func (s *Server) handleAppsListAccountsForPlanStubbedRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20178,6 +21462,9 @@ func (s *Server) handleAppsListAccountsForPlanStubbedRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20193,8 +21480,9 @@ func (s *Server) handleAppsListAccountsForPlanStubbedRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20328,6 +21616,11 @@ func (s *Server) handleAppsListInstallationReposForAuthenticatedUserRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20348,6 +21641,9 @@ func (s *Server) handleAppsListInstallationReposForAuthenticatedUserRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20363,8 +21659,9 @@ func (s *Server) handleAppsListInstallationReposForAuthenticatedUserRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20485,6 +21782,11 @@ func (s *Server) handleAppsListPlansRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20505,6 +21807,9 @@ func (s *Server) handleAppsListPlansRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20520,8 +21825,9 @@ func (s *Server) handleAppsListPlansRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20638,6 +21944,11 @@ func (s *Server) handleAppsListPlansStubbedRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20658,6 +21969,9 @@ func (s *Server) handleAppsListPlansStubbedRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20673,8 +21987,9 @@ func (s *Server) handleAppsListPlansStubbedRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20789,6 +22104,11 @@ func (s *Server) handleAppsListReposAccessibleToInstallationRequest(args [0]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20809,6 +22129,9 @@ func (s *Server) handleAppsListReposAccessibleToInstallationRequest(args [0]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20824,8 +22147,9 @@ func (s *Server) handleAppsListReposAccessibleToInstallationRequest(args [0]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -20941,6 +22265,11 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserRequest(args [0]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -20961,6 +22290,9 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserRequest(args [0]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -20976,8 +22308,9 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserRequest(args [0]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21093,6 +22426,11 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserStubbedRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21113,6 +22451,9 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserStubbedRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21128,8 +22469,9 @@ func (s *Server) handleAppsListSubscriptionsForAuthenticatedUserStubbedRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21244,6 +22586,11 @@ func (s *Server) handleAppsListWebhookDeliveriesRequest(args [0]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21264,6 +22611,9 @@ func (s *Server) handleAppsListWebhookDeliveriesRequest(args [0]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21279,8 +22629,9 @@ func (s *Server) handleAppsListWebhookDeliveriesRequest(args [0]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21395,6 +22746,11 @@ func (s *Server) handleAppsRedeliverWebhookDeliveryRequest(args [1]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21415,6 +22771,9 @@ func (s *Server) handleAppsRedeliverWebhookDeliveryRequest(args [1]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21430,8 +22789,9 @@ func (s *Server) handleAppsRedeliverWebhookDeliveryRequest(args [1]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21545,6 +22905,11 @@ func (s *Server) handleAppsRemoveRepoFromInstallationRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21565,6 +22930,9 @@ func (s *Server) handleAppsRemoveRepoFromInstallationRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21580,8 +22948,9 @@ func (s *Server) handleAppsRemoveRepoFromInstallationRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21698,6 +23067,15 @@ func (s *Server) handleAppsResetTokenRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21718,6 +23096,10 @@ func (s *Server) handleAppsResetTokenRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21733,8 +23115,9 @@ func (s *Server) handleAppsResetTokenRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21866,6 +23249,11 @@ func (s *Server) handleAppsRevokeInstallationAccessTokenRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -21886,6 +23274,9 @@ func (s *Server) handleAppsRevokeInstallationAccessTokenRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -21901,8 +23292,9 @@ func (s *Server) handleAppsRevokeInstallationAccessTokenRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -21996,6 +23388,15 @@ func (s *Server) handleAppsScopeTokenRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22016,6 +23417,10 @@ func (s *Server) handleAppsScopeTokenRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22031,8 +23436,9 @@ func (s *Server) handleAppsScopeTokenRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22160,6 +23566,11 @@ func (s *Server) handleAppsSuspendInstallationRequest(args [1]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22180,6 +23591,9 @@ func (s *Server) handleAppsSuspendInstallationRequest(args [1]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22195,8 +23609,9 @@ func (s *Server) handleAppsSuspendInstallationRequest(args [1]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22307,6 +23722,11 @@ func (s *Server) handleAppsUnsuspendInstallationRequest(args [1]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22327,6 +23747,9 @@ func (s *Server) handleAppsUnsuspendInstallationRequest(args [1]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22342,8 +23765,9 @@ func (s *Server) handleAppsUnsuspendInstallationRequest(args [1]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22456,6 +23880,15 @@ func (s *Server) handleAppsUpdateWebhookConfigForAppRequest(args [0]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22476,6 +23909,10 @@ func (s *Server) handleAppsUpdateWebhookConfigForAppRequest(args [0]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22491,8 +23928,9 @@ func (s *Server) handleAppsUpdateWebhookConfigForAppRequest(args [0]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22609,6 +24047,11 @@ func (s *Server) handleBillingGetGithubActionsBillingGheRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22629,6 +24072,9 @@ func (s *Server) handleBillingGetGithubActionsBillingGheRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22644,8 +24090,9 @@ func (s *Server) handleBillingGetGithubActionsBillingGheRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22762,6 +24209,11 @@ func (s *Server) handleBillingGetGithubActionsBillingOrgRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22782,6 +24234,9 @@ func (s *Server) handleBillingGetGithubActionsBillingOrgRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22797,8 +24252,9 @@ func (s *Server) handleBillingGetGithubActionsBillingOrgRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -22915,6 +24371,11 @@ func (s *Server) handleBillingGetGithubActionsBillingUserRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -22935,6 +24396,9 @@ func (s *Server) handleBillingGetGithubActionsBillingUserRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -22950,8 +24414,9 @@ func (s *Server) handleBillingGetGithubActionsBillingUserRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23065,6 +24530,11 @@ func (s *Server) handleBillingGetGithubPackagesBillingGheRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23085,6 +24555,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingGheRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23100,8 +24573,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingGheRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23215,6 +24689,11 @@ func (s *Server) handleBillingGetGithubPackagesBillingOrgRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23235,6 +24714,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingOrgRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23250,8 +24732,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingOrgRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23365,6 +24848,11 @@ func (s *Server) handleBillingGetGithubPackagesBillingUserRequest(args [1]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23385,6 +24873,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingUserRequest(args [1]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23400,8 +24891,9 @@ func (s *Server) handleBillingGetGithubPackagesBillingUserRequest(args [1]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23515,6 +25007,11 @@ func (s *Server) handleBillingGetSharedStorageBillingGheRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23535,6 +25032,9 @@ func (s *Server) handleBillingGetSharedStorageBillingGheRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23550,8 +25050,9 @@ func (s *Server) handleBillingGetSharedStorageBillingGheRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23665,6 +25166,11 @@ func (s *Server) handleBillingGetSharedStorageBillingOrgRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23685,6 +25191,9 @@ func (s *Server) handleBillingGetSharedStorageBillingOrgRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23700,8 +25209,9 @@ func (s *Server) handleBillingGetSharedStorageBillingOrgRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23815,6 +25325,11 @@ func (s *Server) handleBillingGetSharedStorageBillingUserRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23835,6 +25350,9 @@ func (s *Server) handleBillingGetSharedStorageBillingUserRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -23850,8 +25368,9 @@ func (s *Server) handleBillingGetSharedStorageBillingUserRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -23966,6 +25485,15 @@ func (s *Server) handleChecksCreateRequest(args [2]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -23986,6 +25514,10 @@ func (s *Server) handleChecksCreateRequest(args [2]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24001,8 +25533,9 @@ func (s *Server) handleChecksCreateRequest(args [2]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24138,6 +25671,15 @@ func (s *Server) handleChecksCreateSuiteRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24158,6 +25700,10 @@ func (s *Server) handleChecksCreateSuiteRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24173,8 +25719,9 @@ func (s *Server) handleChecksCreateSuiteRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24306,6 +25853,11 @@ func (s *Server) handleChecksGetRequest(args [3]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24326,6 +25878,9 @@ func (s *Server) handleChecksGetRequest(args [3]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24341,8 +25896,9 @@ func (s *Server) handleChecksGetRequest(args [3]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24463,6 +26019,11 @@ func (s *Server) handleChecksGetSuiteRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24483,6 +26044,9 @@ func (s *Server) handleChecksGetSuiteRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24498,8 +26062,9 @@ func (s *Server) handleChecksGetSuiteRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24617,6 +26182,11 @@ func (s *Server) handleChecksListAnnotationsRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24637,6 +26207,9 @@ func (s *Server) handleChecksListAnnotationsRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24652,8 +26225,9 @@ func (s *Server) handleChecksListAnnotationsRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24783,6 +26357,11 @@ func (s *Server) handleChecksListForRefRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24803,6 +26382,9 @@ func (s *Server) handleChecksListForRefRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24818,8 +26400,9 @@ func (s *Server) handleChecksListForRefRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -24964,6 +26547,11 @@ func (s *Server) handleChecksListForSuiteRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -24984,6 +26572,9 @@ func (s *Server) handleChecksListForSuiteRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -24999,8 +26590,9 @@ func (s *Server) handleChecksListForSuiteRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -25142,6 +26734,11 @@ func (s *Server) handleChecksListSuitesForRefRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -25162,6 +26759,9 @@ func (s *Server) handleChecksListSuitesForRefRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -25177,8 +26777,9 @@ func (s *Server) handleChecksListSuitesForRefRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -25316,6 +26917,11 @@ func (s *Server) handleChecksRerequestSuiteRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -25336,6 +26942,9 @@ func (s *Server) handleChecksRerequestSuiteRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -25351,8 +26960,9 @@ func (s *Server) handleChecksRerequestSuiteRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -25472,6 +27082,15 @@ func (s *Server) handleChecksSetSuitesPreferencesRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -25492,6 +27111,10 @@ func (s *Server) handleChecksSetSuitesPreferencesRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -25507,8 +27130,9 @@ func (s *Server) handleChecksSetSuitesPreferencesRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -25693,6 +27317,11 @@ func (s *Server) handleCodeScanningDeleteAnalysisRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -25713,6 +27342,9 @@ func (s *Server) handleCodeScanningDeleteAnalysisRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -25728,8 +27360,9 @@ func (s *Server) handleCodeScanningDeleteAnalysisRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -25853,6 +27486,11 @@ func (s *Server) handleCodeScanningGetAlertRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -25873,6 +27511,9 @@ func (s *Server) handleCodeScanningGetAlertRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -25888,8 +27529,9 @@ func (s *Server) handleCodeScanningGetAlertRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26022,6 +27664,11 @@ func (s *Server) handleCodeScanningGetAnalysisRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26042,6 +27689,9 @@ func (s *Server) handleCodeScanningGetAnalysisRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26057,8 +27707,9 @@ func (s *Server) handleCodeScanningGetAnalysisRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26179,6 +27830,11 @@ func (s *Server) handleCodeScanningGetSarifRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26199,6 +27855,9 @@ func (s *Server) handleCodeScanningGetSarifRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26214,8 +27873,9 @@ func (s *Server) handleCodeScanningGetSarifRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26332,6 +27992,11 @@ func (s *Server) handleCodeScanningListAlertInstancesRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26352,6 +28017,9 @@ func (s *Server) handleCodeScanningListAlertInstancesRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26367,8 +28035,9 @@ func (s *Server) handleCodeScanningListAlertInstancesRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26501,6 +28170,11 @@ func (s *Server) handleCodeScanningListAlertsForRepoRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26521,6 +28195,9 @@ func (s *Server) handleCodeScanningListAlertsForRepoRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26536,8 +28213,9 @@ func (s *Server) handleCodeScanningListAlertsForRepoRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26684,6 +28362,11 @@ func (s *Server) handleCodeScanningListRecentAnalysesRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26704,6 +28387,9 @@ func (s *Server) handleCodeScanningListRecentAnalysesRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26719,8 +28405,9 @@ func (s *Server) handleCodeScanningListRecentAnalysesRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -26857,6 +28544,15 @@ func (s *Server) handleCodeScanningUpdateAlertRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -26877,6 +28573,10 @@ func (s *Server) handleCodeScanningUpdateAlertRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -26892,8 +28592,9 @@ func (s *Server) handleCodeScanningUpdateAlertRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27053,6 +28754,15 @@ func (s *Server) handleCodeScanningUploadSarifRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27073,6 +28783,10 @@ func (s *Server) handleCodeScanningUploadSarifRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27088,8 +28802,9 @@ func (s *Server) handleCodeScanningUploadSarifRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27215,6 +28930,11 @@ func (s *Server) handleCodesOfConductGetAllCodesOfConductRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27235,6 +28955,9 @@ func (s *Server) handleCodesOfConductGetAllCodesOfConductRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27250,8 +28973,9 @@ func (s *Server) handleCodesOfConductGetAllCodesOfConductRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27339,6 +29063,11 @@ func (s *Server) handleCodesOfConductGetConductCodeRequest(args [1]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27359,6 +29088,9 @@ func (s *Server) handleCodesOfConductGetConductCodeRequest(args [1]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27374,8 +29106,9 @@ func (s *Server) handleCodesOfConductGetConductCodeRequest(args [1]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27482,6 +29215,11 @@ func (s *Server) handleEmojisGetRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27502,6 +29240,9 @@ func (s *Server) handleEmojisGetRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27517,8 +29258,9 @@ func (s *Server) handleEmojisGetRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27612,6 +29354,11 @@ func (s *Server) handleEnterpriseAdminAddOrgAccessToSelfHostedRunnerGroupInEnter
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27632,6 +29379,9 @@ func (s *Server) handleEnterpriseAdminAddOrgAccessToSelfHostedRunnerGroupInEnter
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27647,8 +29397,9 @@ func (s *Server) handleEnterpriseAdminAddOrgAccessToSelfHostedRunnerGroupInEnter
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27765,6 +29516,11 @@ func (s *Server) handleEnterpriseAdminAddSelfHostedRunnerToGroupForEnterpriseReq
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27785,6 +29541,9 @@ func (s *Server) handleEnterpriseAdminAddSelfHostedRunnerToGroupForEnterpriseReq
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27800,8 +29559,9 @@ func (s *Server) handleEnterpriseAdminAddSelfHostedRunnerToGroupForEnterpriseReq
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -27925,6 +29685,11 @@ func (s *Server) handleEnterpriseAdminCreateRegistrationTokenForEnterpriseReques
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -27945,6 +29710,9 @@ func (s *Server) handleEnterpriseAdminCreateRegistrationTokenForEnterpriseReques
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -27960,8 +29728,9 @@ func (s *Server) handleEnterpriseAdminCreateRegistrationTokenForEnterpriseReques
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28078,6 +29847,11 @@ func (s *Server) handleEnterpriseAdminCreateRemoveTokenForEnterpriseRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28098,6 +29872,9 @@ func (s *Server) handleEnterpriseAdminCreateRemoveTokenForEnterpriseRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28113,8 +29890,9 @@ func (s *Server) handleEnterpriseAdminCreateRemoveTokenForEnterpriseRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28223,6 +30001,15 @@ func (s *Server) handleEnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseRe
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28243,6 +30030,10 @@ func (s *Server) handleEnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseRe
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28258,8 +30049,9 @@ func (s *Server) handleEnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseRe
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28382,6 +30174,11 @@ func (s *Server) handleEnterpriseAdminDeleteScimGroupFromEnterpriseRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28402,6 +30199,9 @@ func (s *Server) handleEnterpriseAdminDeleteScimGroupFromEnterpriseRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28417,8 +30217,9 @@ func (s *Server) handleEnterpriseAdminDeleteScimGroupFromEnterpriseRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28532,6 +30333,11 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerFromEnterpriseReques
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28552,6 +30358,9 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerFromEnterpriseReques
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28567,8 +30376,9 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerFromEnterpriseReques
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28681,6 +30491,11 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerGroupFromEnterpriseR
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28701,6 +30516,9 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerGroupFromEnterpriseR
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28716,8 +30534,9 @@ func (s *Server) handleEnterpriseAdminDeleteSelfHostedRunnerGroupFromEnterpriseR
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28829,6 +30648,11 @@ func (s *Server) handleEnterpriseAdminDeleteUserFromEnterpriseRequest(args [2]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -28849,6 +30673,9 @@ func (s *Server) handleEnterpriseAdminDeleteUserFromEnterpriseRequest(args [2]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -28864,8 +30691,9 @@ func (s *Server) handleEnterpriseAdminDeleteUserFromEnterpriseRequest(args [2]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -28983,6 +30811,11 @@ func (s *Server) handleEnterpriseAdminDisableSelectedOrganizationGithubActionsEn
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29003,6 +30836,9 @@ func (s *Server) handleEnterpriseAdminDisableSelectedOrganizationGithubActionsEn
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29018,8 +30854,9 @@ func (s *Server) handleEnterpriseAdminDisableSelectedOrganizationGithubActionsEn
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29137,6 +30974,11 @@ func (s *Server) handleEnterpriseAdminEnableSelectedOrganizationGithubActionsEnt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29157,6 +30999,9 @@ func (s *Server) handleEnterpriseAdminEnableSelectedOrganizationGithubActionsEnt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29172,8 +31017,9 @@ func (s *Server) handleEnterpriseAdminEnableSelectedOrganizationGithubActionsEnt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29290,6 +31136,11 @@ func (s *Server) handleEnterpriseAdminGetAllowedActionsEnterpriseRequest(args [1
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29310,6 +31161,9 @@ func (s *Server) handleEnterpriseAdminGetAllowedActionsEnterpriseRequest(args [1
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29325,8 +31179,9 @@ func (s *Server) handleEnterpriseAdminGetAllowedActionsEnterpriseRequest(args [1
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29434,6 +31289,11 @@ func (s *Server) handleEnterpriseAdminGetAuditLogRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29454,6 +31314,9 @@ func (s *Server) handleEnterpriseAdminGetAuditLogRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29469,8 +31332,9 @@ func (s *Server) handleEnterpriseAdminGetAuditLogRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29607,6 +31471,11 @@ func (s *Server) handleEnterpriseAdminGetGithubActionsPermissionsEnterpriseReque
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29627,6 +31496,9 @@ func (s *Server) handleEnterpriseAdminGetGithubActionsPermissionsEnterpriseReque
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29642,8 +31514,9 @@ func (s *Server) handleEnterpriseAdminGetGithubActionsPermissionsEnterpriseReque
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29751,6 +31624,11 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseGro
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29771,6 +31649,9 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseGro
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29786,8 +31667,9 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseGro
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -29903,6 +31785,11 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseUse
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -29923,6 +31810,9 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseUse
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -29938,8 +31828,9 @@ func (s *Server) handleEnterpriseAdminGetProvisioningInformationForEnterpriseUse
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30052,6 +31943,11 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerForEnterpriseRequest(ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30072,6 +31968,9 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerForEnterpriseRequest(ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30087,8 +31986,9 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerForEnterpriseRequest(ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30201,6 +32101,11 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerGroupForEnterpriseReque
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30221,6 +32126,9 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerGroupForEnterpriseReque
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30236,8 +32144,9 @@ func (s *Server) handleEnterpriseAdminGetSelfHostedRunnerGroupForEnterpriseReque
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30350,6 +32259,11 @@ func (s *Server) handleEnterpriseAdminListOrgAccessToSelfHostedRunnerGroupInEnte
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30370,6 +32284,9 @@ func (s *Server) handleEnterpriseAdminListOrgAccessToSelfHostedRunnerGroupInEnte
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30385,8 +32302,9 @@ func (s *Server) handleEnterpriseAdminListOrgAccessToSelfHostedRunnerGroupInEnte
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30506,6 +32424,11 @@ func (s *Server) handleEnterpriseAdminListProvisionedGroupsEnterpriseRequest(arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30526,6 +32449,9 @@ func (s *Server) handleEnterpriseAdminListProvisionedGroupsEnterpriseRequest(arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30541,8 +32467,9 @@ func (s *Server) handleEnterpriseAdminListProvisionedGroupsEnterpriseRequest(arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30699,6 +32626,11 @@ func (s *Server) handleEnterpriseAdminListProvisionedIdentitiesEnterpriseRequest
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30719,6 +32651,9 @@ func (s *Server) handleEnterpriseAdminListProvisionedIdentitiesEnterpriseRequest
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30734,8 +32669,9 @@ func (s *Server) handleEnterpriseAdminListProvisionedIdentitiesEnterpriseRequest
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -30856,6 +32792,11 @@ func (s *Server) handleEnterpriseAdminListRunnerApplicationsForEnterpriseRequest
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -30876,6 +32817,9 @@ func (s *Server) handleEnterpriseAdminListRunnerApplicationsForEnterpriseRequest
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -30891,8 +32835,9 @@ func (s *Server) handleEnterpriseAdminListRunnerApplicationsForEnterpriseRequest
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31005,6 +32950,11 @@ func (s *Server) handleEnterpriseAdminListSelectedOrganizationsEnabledGithubActi
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31025,6 +32975,9 @@ func (s *Server) handleEnterpriseAdminListSelectedOrganizationsEnabledGithubActi
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31040,8 +32993,9 @@ func (s *Server) handleEnterpriseAdminListSelectedOrganizationsEnabledGithubActi
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31158,6 +33112,11 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnerGroupsForEnterpriseReq
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31178,6 +33137,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnerGroupsForEnterpriseReq
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31193,8 +33155,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnerGroupsForEnterpriseReq
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31311,6 +33274,11 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersForEnterpriseRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31331,6 +33299,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersForEnterpriseRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31346,8 +33317,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersForEnterpriseRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31464,6 +33436,11 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersInGroupForEnterpriseR
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31484,6 +33461,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersInGroupForEnterpriseR
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31499,8 +33479,9 @@ func (s *Server) handleEnterpriseAdminListSelfHostedRunnersInGroupForEnterpriseR
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31624,6 +33605,15 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseGroupRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31644,6 +33634,10 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseGroupRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31659,8 +33653,9 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseGroupRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31790,6 +33785,15 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseUserRequest(ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31810,6 +33814,10 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseUserRequest(ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31825,8 +33833,9 @@ func (s *Server) handleEnterpriseAdminProvisionAndInviteEnterpriseUserRequest(ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -31954,6 +33963,11 @@ func (s *Server) handleEnterpriseAdminRemoveOrgAccessToSelfHostedRunnerGroupInEn
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -31974,6 +33988,9 @@ func (s *Server) handleEnterpriseAdminRemoveOrgAccessToSelfHostedRunnerGroupInEn
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -31989,8 +34006,9 @@ func (s *Server) handleEnterpriseAdminRemoveOrgAccessToSelfHostedRunnerGroupInEn
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32108,6 +34126,11 @@ func (s *Server) handleEnterpriseAdminRemoveSelfHostedRunnerFromGroupForEnterpri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32128,6 +34151,9 @@ func (s *Server) handleEnterpriseAdminRemoveSelfHostedRunnerFromGroupForEnterpri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32143,8 +34169,9 @@ func (s *Server) handleEnterpriseAdminRemoveSelfHostedRunnerFromGroupForEnterpri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32265,6 +34292,15 @@ func (s *Server) handleEnterpriseAdminSetAllowedActionsEnterpriseRequest(args [1
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32285,6 +34321,10 @@ func (s *Server) handleEnterpriseAdminSetAllowedActionsEnterpriseRequest(args [1
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32300,8 +34340,9 @@ func (s *Server) handleEnterpriseAdminSetAllowedActionsEnterpriseRequest(args [1
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32425,6 +34466,15 @@ func (s *Server) handleEnterpriseAdminSetGithubActionsPermissionsEnterpriseReque
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32445,6 +34495,10 @@ func (s *Server) handleEnterpriseAdminSetGithubActionsPermissionsEnterpriseReque
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32460,8 +34514,9 @@ func (s *Server) handleEnterpriseAdminSetGithubActionsPermissionsEnterpriseReque
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32592,6 +34647,15 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseGrou
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32612,6 +34676,10 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseGrou
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32627,8 +34695,9 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseGrou
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32767,6 +34836,15 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseUser
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32787,6 +34865,10 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseUser
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32802,8 +34884,9 @@ func (s *Server) handleEnterpriseAdminSetInformationForProvisionedEnterpriseUser
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -32932,6 +35015,15 @@ func (s *Server) handleEnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnter
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -32952,6 +35044,10 @@ func (s *Server) handleEnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnter
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -32967,8 +35063,9 @@ func (s *Server) handleEnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnter
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33100,6 +35197,15 @@ func (s *Server) handleEnterpriseAdminSetSelectedOrganizationsEnabledGithubActio
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33120,6 +35226,10 @@ func (s *Server) handleEnterpriseAdminSetSelectedOrganizationsEnabledGithubActio
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33135,8 +35245,9 @@ func (s *Server) handleEnterpriseAdminSetSelectedOrganizationsEnabledGithubActio
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33260,6 +35371,15 @@ func (s *Server) handleEnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseRe
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33280,6 +35400,10 @@ func (s *Server) handleEnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseRe
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33295,8 +35419,9 @@ func (s *Server) handleEnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseRe
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33430,6 +35555,15 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseGroupRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33450,6 +35584,10 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseGroupRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33465,8 +35603,9 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseGroupRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33616,6 +35755,15 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseUserRequest(ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33636,6 +35784,10 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseUserRequest(ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33651,8 +35803,9 @@ func (s *Server) handleEnterpriseAdminUpdateAttributeForEnterpriseUserRequest(ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33780,6 +35933,15 @@ func (s *Server) handleEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseRe
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33800,6 +35962,10 @@ func (s *Server) handleEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseRe
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33815,8 +35981,9 @@ func (s *Server) handleEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseRe
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -33942,6 +36109,11 @@ func (s *Server) handleGistsCheckIsStarredRequest(args [1]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -33962,6 +36134,9 @@ func (s *Server) handleGistsCheckIsStarredRequest(args [1]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -33977,8 +36152,9 @@ func (s *Server) handleGistsCheckIsStarredRequest(args [1]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34088,6 +36264,15 @@ func (s *Server) handleGistsCreateRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34108,6 +36293,10 @@ func (s *Server) handleGistsCreateRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34123,8 +36312,9 @@ func (s *Server) handleGistsCreateRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34231,6 +36421,15 @@ func (s *Server) handleGistsCreateCommentRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34251,6 +36450,10 @@ func (s *Server) handleGistsCreateCommentRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34266,8 +36469,9 @@ func (s *Server) handleGistsCreateCommentRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34389,6 +36593,11 @@ func (s *Server) handleGistsDeleteRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34409,6 +36618,9 @@ func (s *Server) handleGistsDeleteRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34424,8 +36636,9 @@ func (s *Server) handleGistsDeleteRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34532,6 +36745,11 @@ func (s *Server) handleGistsDeleteCommentRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34552,6 +36770,9 @@ func (s *Server) handleGistsDeleteCommentRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34567,8 +36788,9 @@ func (s *Server) handleGistsDeleteCommentRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34679,6 +36901,11 @@ func (s *Server) handleGistsForkRequest(args [1]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34699,6 +36926,9 @@ func (s *Server) handleGistsForkRequest(args [1]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34714,8 +36944,9 @@ func (s *Server) handleGistsForkRequest(args [1]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34822,6 +37053,11 @@ func (s *Server) handleGistsGetRequest(args [1]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34842,6 +37078,9 @@ func (s *Server) handleGistsGetRequest(args [1]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -34857,8 +37096,9 @@ func (s *Server) handleGistsGetRequest(args [1]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -34965,6 +37205,11 @@ func (s *Server) handleGistsGetCommentRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -34985,6 +37230,9 @@ func (s *Server) handleGistsGetCommentRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35000,8 +37248,9 @@ func (s *Server) handleGistsGetCommentRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35112,6 +37361,11 @@ func (s *Server) handleGistsGetRevisionRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35132,6 +37386,9 @@ func (s *Server) handleGistsGetRevisionRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35147,8 +37404,9 @@ func (s *Server) handleGistsGetRevisionRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35260,6 +37518,11 @@ func (s *Server) handleGistsListRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35280,6 +37543,9 @@ func (s *Server) handleGistsListRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35295,8 +37561,9 @@ func (s *Server) handleGistsListRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35411,6 +37678,11 @@ func (s *Server) handleGistsListCommentsRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35431,6 +37703,9 @@ func (s *Server) handleGistsListCommentsRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35446,8 +37721,9 @@ func (s *Server) handleGistsListCommentsRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35562,6 +37838,11 @@ func (s *Server) handleGistsListCommitsRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35582,6 +37863,9 @@ func (s *Server) handleGistsListCommitsRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35597,8 +37881,9 @@ func (s *Server) handleGistsListCommitsRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35713,6 +37998,11 @@ func (s *Server) handleGistsListForUserRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35733,6 +38023,9 @@ func (s *Server) handleGistsListForUserRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35748,8 +38041,9 @@ func (s *Server) handleGistsListForUserRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -35868,6 +38162,11 @@ func (s *Server) handleGistsListForksRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -35888,6 +38187,9 @@ func (s *Server) handleGistsListForksRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -35903,8 +38205,9 @@ func (s *Server) handleGistsListForksRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36024,6 +38327,11 @@ func (s *Server) handleGistsListPublicRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36044,6 +38352,9 @@ func (s *Server) handleGistsListPublicRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36059,8 +38370,9 @@ func (s *Server) handleGistsListPublicRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36175,6 +38487,11 @@ func (s *Server) handleGistsListStarredRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36195,6 +38512,9 @@ func (s *Server) handleGistsListStarredRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36210,8 +38530,9 @@ func (s *Server) handleGistsListStarredRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36329,6 +38650,11 @@ func (s *Server) handleGistsStarRequest(args [1]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36349,6 +38675,9 @@ func (s *Server) handleGistsStarRequest(args [1]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36364,8 +38693,9 @@ func (s *Server) handleGistsStarRequest(args [1]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36472,6 +38802,11 @@ func (s *Server) handleGistsUnstarRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36492,6 +38827,9 @@ func (s *Server) handleGistsUnstarRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36507,8 +38845,9 @@ func (s *Server) handleGistsUnstarRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36615,6 +38954,15 @@ func (s *Server) handleGistsUpdateCommentRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36635,6 +38983,10 @@ func (s *Server) handleGistsUpdateCommentRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36650,8 +39002,9 @@ func (s *Server) handleGistsUpdateCommentRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36777,6 +39130,15 @@ func (s *Server) handleGitCreateBlobRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36797,6 +39159,10 @@ func (s *Server) handleGitCreateBlobRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -36812,8 +39178,9 @@ func (s *Server) handleGitCreateBlobRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -36971,6 +39338,15 @@ func (s *Server) handleGitCreateCommitRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -36991,6 +39367,10 @@ func (s *Server) handleGitCreateCommitRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37006,8 +39386,9 @@ func (s *Server) handleGitCreateCommitRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -37135,6 +39516,15 @@ func (s *Server) handleGitCreateRefRequest(args [2]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -37155,6 +39545,10 @@ func (s *Server) handleGitCreateRefRequest(args [2]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37170,8 +39564,9 @@ func (s *Server) handleGitCreateRefRequest(args [2]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -37332,6 +39727,15 @@ func (s *Server) handleGitCreateTagRequest(args [2]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -37352,6 +39756,10 @@ func (s *Server) handleGitCreateTagRequest(args [2]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37367,8 +39775,9 @@ func (s *Server) handleGitCreateTagRequest(args [2]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -37503,6 +39912,15 @@ func (s *Server) handleGitCreateTreeRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -37523,6 +39941,10 @@ func (s *Server) handleGitCreateTreeRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37538,8 +39960,9 @@ func (s *Server) handleGitCreateTreeRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -37665,6 +40088,11 @@ func (s *Server) handleGitDeleteRefRequest(args [3]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -37685,6 +40113,9 @@ func (s *Server) handleGitDeleteRefRequest(args [3]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37700,8 +40131,9 @@ func (s *Server) handleGitDeleteRefRequest(args [3]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -37818,6 +40250,11 @@ func (s *Server) handleGitGetBlobRequest(args [3]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -37838,6 +40275,9 @@ func (s *Server) handleGitGetBlobRequest(args [3]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -37853,8 +40293,9 @@ func (s *Server) handleGitGetBlobRequest(args [3]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38001,6 +40442,11 @@ func (s *Server) handleGitGetCommitRequest(args [3]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38021,6 +40467,9 @@ func (s *Server) handleGitGetCommitRequest(args [3]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38036,8 +40485,9 @@ func (s *Server) handleGitGetCommitRequest(args [3]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38161,6 +40611,11 @@ func (s *Server) handleGitGetRefRequest(args [3]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38181,6 +40636,9 @@ func (s *Server) handleGitGetRefRequest(args [3]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38196,8 +40654,9 @@ func (s *Server) handleGitGetRefRequest(args [3]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38340,6 +40799,11 @@ func (s *Server) handleGitGetTagRequest(args [3]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38360,6 +40824,9 @@ func (s *Server) handleGitGetTagRequest(args [3]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38375,8 +40842,9 @@ func (s *Server) handleGitGetTagRequest(args [3]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38495,6 +40963,11 @@ func (s *Server) handleGitGetTreeRequest(args [3]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38515,6 +40988,9 @@ func (s *Server) handleGitGetTreeRequest(args [3]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38530,8 +41006,9 @@ func (s *Server) handleGitGetTreeRequest(args [3]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38668,6 +41145,11 @@ func (s *Server) handleGitListMatchingRefsRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38688,6 +41170,9 @@ func (s *Server) handleGitListMatchingRefsRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38703,8 +41188,9 @@ func (s *Server) handleGitListMatchingRefsRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38827,6 +41313,15 @@ func (s *Server) handleGitUpdateRefRequest(args [3]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -38847,6 +41342,10 @@ func (s *Server) handleGitUpdateRefRequest(args [3]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -38862,8 +41361,9 @@ func (s *Server) handleGitUpdateRefRequest(args [3]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -38995,6 +41495,11 @@ func (s *Server) handleGitignoreGetAllTemplatesRequest(args [0]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39015,6 +41520,9 @@ func (s *Server) handleGitignoreGetAllTemplatesRequest(args [0]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39030,8 +41538,9 @@ func (s *Server) handleGitignoreGetAllTemplatesRequest(args [0]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39122,6 +41631,11 @@ func (s *Server) handleGitignoreGetTemplateRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39142,6 +41656,9 @@ func (s *Server) handleGitignoreGetTemplateRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39157,8 +41674,9 @@ func (s *Server) handleGitignoreGetTemplateRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39265,6 +41783,11 @@ func (s *Server) handleInteractionsRemoveRestrictionsForAuthenticatedUserRequest
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39285,6 +41808,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForAuthenticatedUserRequest
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39300,8 +41826,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForAuthenticatedUserRequest
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39390,6 +41917,11 @@ func (s *Server) handleInteractionsRemoveRestrictionsForOrgRequest(args [1]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39410,6 +41942,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForOrgRequest(args [1]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39425,8 +41960,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForOrgRequest(args [1]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39536,6 +42072,11 @@ func (s *Server) handleInteractionsRemoveRestrictionsForRepoRequest(args [2]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39556,6 +42097,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForRepoRequest(args [2]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39571,8 +42115,9 @@ func (s *Server) handleInteractionsRemoveRestrictionsForRepoRequest(args [2]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39685,6 +42230,15 @@ func (s *Server) handleInteractionsSetRestrictionsForAuthenticatedUserRequest(ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39705,6 +42259,10 @@ func (s *Server) handleInteractionsSetRestrictionsForAuthenticatedUserRequest(ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39720,8 +42278,9 @@ func (s *Server) handleInteractionsSetRestrictionsForAuthenticatedUserRequest(ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39831,6 +42390,15 @@ func (s *Server) handleInteractionsSetRestrictionsForOrgRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -39851,6 +42419,10 @@ func (s *Server) handleInteractionsSetRestrictionsForOrgRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -39866,8 +42438,9 @@ func (s *Server) handleInteractionsSetRestrictionsForOrgRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -39992,6 +42565,15 @@ func (s *Server) handleInteractionsSetRestrictionsForRepoRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40012,6 +42594,10 @@ func (s *Server) handleInteractionsSetRestrictionsForRepoRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40027,8 +42613,9 @@ func (s *Server) handleInteractionsSetRestrictionsForRepoRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40154,6 +42741,15 @@ func (s *Server) handleIssuesAddAssigneesRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40174,6 +42770,10 @@ func (s *Server) handleIssuesAddAssigneesRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40189,8 +42789,9 @@ func (s *Server) handleIssuesAddAssigneesRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40325,6 +42926,11 @@ func (s *Server) handleIssuesCheckUserCanBeAssignedRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40345,6 +42951,9 @@ func (s *Server) handleIssuesCheckUserCanBeAssignedRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40360,8 +42969,9 @@ func (s *Server) handleIssuesCheckUserCanBeAssignedRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40486,6 +43096,15 @@ func (s *Server) handleIssuesCreateRequest(args [2]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40506,6 +43125,10 @@ func (s *Server) handleIssuesCreateRequest(args [2]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40521,8 +43144,9 @@ func (s *Server) handleIssuesCreateRequest(args [2]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40654,6 +43278,15 @@ func (s *Server) handleIssuesCreateCommentRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40674,6 +43307,10 @@ func (s *Server) handleIssuesCreateCommentRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40689,8 +43326,9 @@ func (s *Server) handleIssuesCreateCommentRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40820,6 +43458,15 @@ func (s *Server) handleIssuesCreateLabelRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -40840,6 +43487,10 @@ func (s *Server) handleIssuesCreateLabelRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -40855,8 +43506,9 @@ func (s *Server) handleIssuesCreateLabelRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -40982,6 +43634,15 @@ func (s *Server) handleIssuesCreateMilestoneRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41002,6 +43663,10 @@ func (s *Server) handleIssuesCreateMilestoneRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41017,8 +43682,9 @@ func (s *Server) handleIssuesCreateMilestoneRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41144,6 +43810,11 @@ func (s *Server) handleIssuesDeleteCommentRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41164,6 +43835,9 @@ func (s *Server) handleIssuesDeleteCommentRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41179,8 +43853,9 @@ func (s *Server) handleIssuesDeleteCommentRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41295,6 +43970,11 @@ func (s *Server) handleIssuesDeleteLabelRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41315,6 +43995,9 @@ func (s *Server) handleIssuesDeleteLabelRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41330,8 +44013,9 @@ func (s *Server) handleIssuesDeleteLabelRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41446,6 +44130,11 @@ func (s *Server) handleIssuesDeleteMilestoneRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41466,6 +44155,9 @@ func (s *Server) handleIssuesDeleteMilestoneRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41481,8 +44173,9 @@ func (s *Server) handleIssuesDeleteMilestoneRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41612,6 +44305,11 @@ func (s *Server) handleIssuesGetRequest(args [3]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41632,6 +44330,9 @@ func (s *Server) handleIssuesGetRequest(args [3]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41647,8 +44348,9 @@ func (s *Server) handleIssuesGetRequest(args [3]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41763,6 +44465,11 @@ func (s *Server) handleIssuesGetCommentRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41783,6 +44490,9 @@ func (s *Server) handleIssuesGetCommentRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41798,8 +44508,9 @@ func (s *Server) handleIssuesGetCommentRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -41914,6 +44625,11 @@ func (s *Server) handleIssuesGetEventRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -41934,6 +44650,9 @@ func (s *Server) handleIssuesGetEventRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -41949,8 +44668,9 @@ func (s *Server) handleIssuesGetEventRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42065,6 +44785,11 @@ func (s *Server) handleIssuesGetLabelRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42085,6 +44810,9 @@ func (s *Server) handleIssuesGetLabelRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42100,8 +44828,9 @@ func (s *Server) handleIssuesGetLabelRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42216,6 +44945,11 @@ func (s *Server) handleIssuesGetMilestoneRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42236,6 +44970,9 @@ func (s *Server) handleIssuesGetMilestoneRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42251,8 +44988,9 @@ func (s *Server) handleIssuesGetMilestoneRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42377,6 +45115,11 @@ func (s *Server) handleIssuesListRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42397,6 +45140,9 @@ func (s *Server) handleIssuesListRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42412,8 +45158,9 @@ func (s *Server) handleIssuesListRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42566,6 +45313,11 @@ func (s *Server) handleIssuesListAssigneesRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42586,6 +45338,9 @@ func (s *Server) handleIssuesListAssigneesRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42601,8 +45356,9 @@ func (s *Server) handleIssuesListAssigneesRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42721,6 +45477,11 @@ func (s *Server) handleIssuesListCommentsRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42741,6 +45502,9 @@ func (s *Server) handleIssuesListCommentsRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42756,8 +45520,9 @@ func (s *Server) handleIssuesListCommentsRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -42884,6 +45649,11 @@ func (s *Server) handleIssuesListCommentsForRepoRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -42904,6 +45674,9 @@ func (s *Server) handleIssuesListCommentsForRepoRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -42919,8 +45692,9 @@ func (s *Server) handleIssuesListCommentsForRepoRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43051,6 +45825,11 @@ func (s *Server) handleIssuesListEventsForRepoRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43071,6 +45850,9 @@ func (s *Server) handleIssuesListEventsForRepoRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43086,8 +45868,9 @@ func (s *Server) handleIssuesListEventsForRepoRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43214,6 +45997,11 @@ func (s *Server) handleIssuesListForAuthenticatedUserRequest(args [0]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43234,6 +46022,9 @@ func (s *Server) handleIssuesListForAuthenticatedUserRequest(args [0]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43249,8 +46040,9 @@ func (s *Server) handleIssuesListForAuthenticatedUserRequest(args [0]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43393,6 +46185,11 @@ func (s *Server) handleIssuesListForOrgRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43413,6 +46210,9 @@ func (s *Server) handleIssuesListForOrgRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43428,8 +46228,9 @@ func (s *Server) handleIssuesListForOrgRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43576,6 +46377,11 @@ func (s *Server) handleIssuesListForRepoRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43596,6 +46402,9 @@ func (s *Server) handleIssuesListForRepoRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43611,8 +46420,9 @@ func (s *Server) handleIssuesListForRepoRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43767,6 +46577,11 @@ func (s *Server) handleIssuesListLabelsForMilestoneRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43787,6 +46602,9 @@ func (s *Server) handleIssuesListLabelsForMilestoneRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43802,8 +46620,9 @@ func (s *Server) handleIssuesListLabelsForMilestoneRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -43926,6 +46745,11 @@ func (s *Server) handleIssuesListLabelsForRepoRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -43946,6 +46770,9 @@ func (s *Server) handleIssuesListLabelsForRepoRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -43961,8 +46788,9 @@ func (s *Server) handleIssuesListLabelsForRepoRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44081,6 +46909,11 @@ func (s *Server) handleIssuesListLabelsOnIssueRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44101,6 +46934,9 @@ func (s *Server) handleIssuesListLabelsOnIssueRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44116,8 +46952,9 @@ func (s *Server) handleIssuesListLabelsOnIssueRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44240,6 +47077,11 @@ func (s *Server) handleIssuesListMilestonesRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44260,6 +47102,9 @@ func (s *Server) handleIssuesListMilestonesRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44275,8 +47120,9 @@ func (s *Server) handleIssuesListMilestonesRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44412,6 +47258,15 @@ func (s *Server) handleIssuesLockRequest(args [3]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44432,6 +47287,10 @@ func (s *Server) handleIssuesLockRequest(args [3]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44447,8 +47306,9 @@ func (s *Server) handleIssuesLockRequest(args [3]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44578,6 +47438,11 @@ func (s *Server) handleIssuesRemoveAllLabelsRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44598,6 +47463,9 @@ func (s *Server) handleIssuesRemoveAllLabelsRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44613,8 +47481,9 @@ func (s *Server) handleIssuesRemoveAllLabelsRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44729,6 +47598,15 @@ func (s *Server) handleIssuesRemoveAssigneesRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44749,6 +47627,10 @@ func (s *Server) handleIssuesRemoveAssigneesRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44764,8 +47646,9 @@ func (s *Server) handleIssuesRemoveAssigneesRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -44896,6 +47779,11 @@ func (s *Server) handleIssuesRemoveLabelRequest(args [4]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -44916,6 +47804,9 @@ func (s *Server) handleIssuesRemoveLabelRequest(args [4]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -44931,8 +47822,9 @@ func (s *Server) handleIssuesRemoveLabelRequest(args [4]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45051,6 +47943,11 @@ func (s *Server) handleIssuesUnlockRequest(args [3]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45071,6 +47968,9 @@ func (s *Server) handleIssuesUnlockRequest(args [3]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45086,8 +47986,9 @@ func (s *Server) handleIssuesUnlockRequest(args [3]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45202,6 +48103,15 @@ func (s *Server) handleIssuesUpdateRequest(args [3]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45222,6 +48132,10 @@ func (s *Server) handleIssuesUpdateRequest(args [3]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45237,8 +48151,9 @@ func (s *Server) handleIssuesUpdateRequest(args [3]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45368,6 +48283,15 @@ func (s *Server) handleIssuesUpdateCommentRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45388,6 +48312,10 @@ func (s *Server) handleIssuesUpdateCommentRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45403,8 +48331,9 @@ func (s *Server) handleIssuesUpdateCommentRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45534,6 +48463,15 @@ func (s *Server) handleIssuesUpdateLabelRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45554,6 +48492,10 @@ func (s *Server) handleIssuesUpdateLabelRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45569,8 +48511,9 @@ func (s *Server) handleIssuesUpdateLabelRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45700,6 +48643,15 @@ func (s *Server) handleIssuesUpdateMilestoneRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45720,6 +48672,10 @@ func (s *Server) handleIssuesUpdateMilestoneRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45735,8 +48691,9 @@ func (s *Server) handleIssuesUpdateMilestoneRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -45866,6 +48823,11 @@ func (s *Server) handleLicensesGetRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -45886,6 +48848,9 @@ func (s *Server) handleLicensesGetRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -45901,8 +48866,9 @@ func (s *Server) handleLicensesGetRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46009,6 +48975,11 @@ func (s *Server) handleLicensesGetAllCommonlyUsedRequest(args [0]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46029,6 +49000,9 @@ func (s *Server) handleLicensesGetAllCommonlyUsedRequest(args [0]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46044,8 +49018,9 @@ func (s *Server) handleLicensesGetAllCommonlyUsedRequest(args [0]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46166,6 +49141,11 @@ func (s *Server) handleLicensesGetForRepoRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46186,6 +49166,9 @@ func (s *Server) handleLicensesGetForRepoRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46201,8 +49184,9 @@ func (s *Server) handleLicensesGetForRepoRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46313,6 +49297,15 @@ func (s *Server) handleMarkdownRenderRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46333,6 +49326,10 @@ func (s *Server) handleMarkdownRenderRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46348,8 +49345,9 @@ func (s *Server) handleMarkdownRenderRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46461,6 +49459,15 @@ func (s *Server) handleMarkdownRenderRawRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46481,6 +49488,10 @@ func (s *Server) handleMarkdownRenderRawRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46496,8 +49507,9 @@ func (s *Server) handleMarkdownRenderRawRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46610,6 +49622,11 @@ func (s *Server) handleMetaGetRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46630,6 +49647,9 @@ func (s *Server) handleMetaGetRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46645,8 +49665,9 @@ func (s *Server) handleMetaGetRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46734,6 +49755,11 @@ func (s *Server) handleMetaGetOctocatRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46754,6 +49780,9 @@ func (s *Server) handleMetaGetOctocatRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46769,8 +49798,9 @@ func (s *Server) handleMetaGetOctocatRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -46877,6 +49907,11 @@ func (s *Server) handleMetaGetZenRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -46897,6 +49932,9 @@ func (s *Server) handleMetaGetZenRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -46912,8 +49950,9 @@ func (s *Server) handleMetaGetZenRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47001,6 +50040,11 @@ func (s *Server) handleMetaRootRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47021,6 +50065,9 @@ func (s *Server) handleMetaRootRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47036,8 +50083,9 @@ func (s *Server) handleMetaRootRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47125,6 +50173,11 @@ func (s *Server) handleMigrationsCancelImportRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47145,6 +50198,9 @@ func (s *Server) handleMigrationsCancelImportRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47160,8 +50216,9 @@ func (s *Server) handleMigrationsCancelImportRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47278,6 +50335,11 @@ func (s *Server) handleMigrationsDeleteArchiveForAuthenticatedUserRequest(args [
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47298,6 +50360,9 @@ func (s *Server) handleMigrationsDeleteArchiveForAuthenticatedUserRequest(args [
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47313,8 +50378,9 @@ func (s *Server) handleMigrationsDeleteArchiveForAuthenticatedUserRequest(args [
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47421,6 +50487,11 @@ func (s *Server) handleMigrationsDeleteArchiveForOrgRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47441,6 +50512,9 @@ func (s *Server) handleMigrationsDeleteArchiveForOrgRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47456,8 +50530,9 @@ func (s *Server) handleMigrationsDeleteArchiveForOrgRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47568,6 +50643,11 @@ func (s *Server) handleMigrationsDownloadArchiveForOrgRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47588,6 +50668,9 @@ func (s *Server) handleMigrationsDownloadArchiveForOrgRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47603,8 +50686,9 @@ func (s *Server) handleMigrationsDownloadArchiveForOrgRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47737,6 +50821,11 @@ func (s *Server) handleMigrationsGetArchiveForAuthenticatedUserRequest(args [1]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47757,6 +50846,9 @@ func (s *Server) handleMigrationsGetArchiveForAuthenticatedUserRequest(args [1]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47772,8 +50864,9 @@ func (s *Server) handleMigrationsGetArchiveForAuthenticatedUserRequest(args [1]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -47889,6 +50982,11 @@ func (s *Server) handleMigrationsGetCommitAuthorsRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -47909,6 +51007,9 @@ func (s *Server) handleMigrationsGetCommitAuthorsRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -47924,8 +51025,9 @@ func (s *Server) handleMigrationsGetCommitAuthorsRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48104,6 +51206,11 @@ func (s *Server) handleMigrationsGetImportStatusRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48124,6 +51231,9 @@ func (s *Server) handleMigrationsGetImportStatusRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48139,8 +51249,9 @@ func (s *Server) handleMigrationsGetImportStatusRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48251,6 +51362,11 @@ func (s *Server) handleMigrationsGetLargeFilesRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48271,6 +51387,9 @@ func (s *Server) handleMigrationsGetLargeFilesRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48286,8 +51405,9 @@ func (s *Server) handleMigrationsGetLargeFilesRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48408,6 +51528,11 @@ func (s *Server) handleMigrationsGetStatusForAuthenticatedUserRequest(args [1]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48428,6 +51553,9 @@ func (s *Server) handleMigrationsGetStatusForAuthenticatedUserRequest(args [1]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48443,8 +51571,9 @@ func (s *Server) handleMigrationsGetStatusForAuthenticatedUserRequest(args [1]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48562,6 +51691,11 @@ func (s *Server) handleMigrationsGetStatusForOrgRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48582,6 +51716,9 @@ func (s *Server) handleMigrationsGetStatusForOrgRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48597,8 +51734,9 @@ func (s *Server) handleMigrationsGetStatusForOrgRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48713,6 +51851,11 @@ func (s *Server) handleMigrationsListForAuthenticatedUserRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48733,6 +51876,9 @@ func (s *Server) handleMigrationsListForAuthenticatedUserRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48748,8 +51894,9 @@ func (s *Server) handleMigrationsListForAuthenticatedUserRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -48860,6 +52007,11 @@ func (s *Server) handleMigrationsListForOrgRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -48880,6 +52032,9 @@ func (s *Server) handleMigrationsListForOrgRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -48895,8 +52050,9 @@ func (s *Server) handleMigrationsListForOrgRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49015,6 +52171,11 @@ func (s *Server) handleMigrationsListReposForOrgRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49035,6 +52196,9 @@ func (s *Server) handleMigrationsListReposForOrgRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49050,8 +52214,9 @@ func (s *Server) handleMigrationsListReposForOrgRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49170,6 +52335,11 @@ func (s *Server) handleMigrationsListReposForUserRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49190,6 +52360,9 @@ func (s *Server) handleMigrationsListReposForUserRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49205,8 +52378,9 @@ func (s *Server) handleMigrationsListReposForUserRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49322,6 +52496,15 @@ func (s *Server) handleMigrationsMapCommitAuthorRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49342,6 +52525,10 @@ func (s *Server) handleMigrationsMapCommitAuthorRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49357,8 +52544,9 @@ func (s *Server) handleMigrationsMapCommitAuthorRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49493,6 +52681,15 @@ func (s *Server) handleMigrationsSetLfsPreferenceRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49513,6 +52710,10 @@ func (s *Server) handleMigrationsSetLfsPreferenceRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49528,8 +52729,9 @@ func (s *Server) handleMigrationsSetLfsPreferenceRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49655,6 +52857,15 @@ func (s *Server) handleMigrationsStartForAuthenticatedUserRequest(args [0]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49675,6 +52886,10 @@ func (s *Server) handleMigrationsStartForAuthenticatedUserRequest(args [0]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49690,8 +52905,9 @@ func (s *Server) handleMigrationsStartForAuthenticatedUserRequest(args [0]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49798,6 +53014,15 @@ func (s *Server) handleMigrationsStartForOrgRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49818,6 +53043,10 @@ func (s *Server) handleMigrationsStartForOrgRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49833,8 +53062,9 @@ func (s *Server) handleMigrationsStartForOrgRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -49956,6 +53186,15 @@ func (s *Server) handleMigrationsStartImportRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -49976,6 +53215,10 @@ func (s *Server) handleMigrationsStartImportRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -49991,8 +53234,9 @@ func (s *Server) handleMigrationsStartImportRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50124,6 +53368,11 @@ func (s *Server) handleMigrationsUnlockRepoForAuthenticatedUserRequest(args [2]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50144,6 +53393,9 @@ func (s *Server) handleMigrationsUnlockRepoForAuthenticatedUserRequest(args [2]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50159,8 +53411,9 @@ func (s *Server) handleMigrationsUnlockRepoForAuthenticatedUserRequest(args [2]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50274,6 +53527,11 @@ func (s *Server) handleMigrationsUnlockRepoForOrgRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50294,6 +53552,9 @@ func (s *Server) handleMigrationsUnlockRepoForOrgRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50309,8 +53570,9 @@ func (s *Server) handleMigrationsUnlockRepoForOrgRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50426,6 +53688,15 @@ func (s *Server) handleMigrationsUpdateImportRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50446,6 +53717,10 @@ func (s *Server) handleMigrationsUpdateImportRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50461,8 +53736,9 @@ func (s *Server) handleMigrationsUpdateImportRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50624,6 +53900,15 @@ func (s *Server) handleOAuthAuthorizationsCreateAuthorizationRequest(args [0]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50644,6 +53929,10 @@ func (s *Server) handleOAuthAuthorizationsCreateAuthorizationRequest(args [0]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50659,8 +53948,9 @@ func (s *Server) handleOAuthAuthorizationsCreateAuthorizationRequest(args [0]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50776,6 +54066,11 @@ func (s *Server) handleOAuthAuthorizationsDeleteAuthorizationRequest(args [1]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50796,6 +54091,9 @@ func (s *Server) handleOAuthAuthorizationsDeleteAuthorizationRequest(args [1]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50811,8 +54109,9 @@ func (s *Server) handleOAuthAuthorizationsDeleteAuthorizationRequest(args [1]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -50933,6 +54232,11 @@ func (s *Server) handleOAuthAuthorizationsDeleteGrantRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -50953,6 +54257,9 @@ func (s *Server) handleOAuthAuthorizationsDeleteGrantRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -50968,8 +54275,9 @@ func (s *Server) handleOAuthAuthorizationsDeleteGrantRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51085,6 +54393,11 @@ func (s *Server) handleOAuthAuthorizationsGetAuthorizationRequest(args [1]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51105,6 +54418,9 @@ func (s *Server) handleOAuthAuthorizationsGetAuthorizationRequest(args [1]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51120,8 +54436,9 @@ func (s *Server) handleOAuthAuthorizationsGetAuthorizationRequest(args [1]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51237,6 +54554,11 @@ func (s *Server) handleOAuthAuthorizationsGetGrantRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51257,6 +54579,9 @@ func (s *Server) handleOAuthAuthorizationsGetGrantRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51272,8 +54597,9 @@ func (s *Server) handleOAuthAuthorizationsGetGrantRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51414,6 +54740,15 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51434,6 +54769,10 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51449,8 +54788,9 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51601,6 +54941,15 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppAndFinge
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51621,6 +54970,10 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppAndFinge
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51636,8 +54989,9 @@ func (s *Server) handleOAuthAuthorizationsGetOrCreateAuthorizationForAppAndFinge
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51772,6 +55126,11 @@ func (s *Server) handleOAuthAuthorizationsListAuthorizationsRequest(args [0]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51792,6 +55151,9 @@ func (s *Server) handleOAuthAuthorizationsListAuthorizationsRequest(args [0]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51807,8 +55169,9 @@ func (s *Server) handleOAuthAuthorizationsListAuthorizationsRequest(args [0]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -51945,6 +55308,11 @@ func (s *Server) handleOAuthAuthorizationsListGrantsRequest(args [0]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -51965,6 +55333,9 @@ func (s *Server) handleOAuthAuthorizationsListGrantsRequest(args [0]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -51980,8 +55351,9 @@ func (s *Server) handleOAuthAuthorizationsListGrantsRequest(args [0]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52114,6 +55486,15 @@ func (s *Server) handleOAuthAuthorizationsUpdateAuthorizationRequest(args [1]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52134,6 +55515,10 @@ func (s *Server) handleOAuthAuthorizationsUpdateAuthorizationRequest(args [1]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52149,8 +55534,9 @@ func (s *Server) handleOAuthAuthorizationsUpdateAuthorizationRequest(args [1]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52272,6 +55658,11 @@ func (s *Server) handleOrgsBlockUserRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52292,6 +55683,9 @@ func (s *Server) handleOrgsBlockUserRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52307,8 +55701,9 @@ func (s *Server) handleOrgsBlockUserRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52424,6 +55819,11 @@ func (s *Server) handleOrgsCancelInvitationRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52444,6 +55844,9 @@ func (s *Server) handleOrgsCancelInvitationRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52459,8 +55862,9 @@ func (s *Server) handleOrgsCancelInvitationRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52571,6 +55975,11 @@ func (s *Server) handleOrgsCheckBlockedUserRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52591,6 +56000,9 @@ func (s *Server) handleOrgsCheckBlockedUserRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52606,8 +56018,9 @@ func (s *Server) handleOrgsCheckBlockedUserRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52718,6 +56131,11 @@ func (s *Server) handleOrgsCheckMembershipForUserRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52738,6 +56156,9 @@ func (s *Server) handleOrgsCheckMembershipForUserRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52753,8 +56174,9 @@ func (s *Server) handleOrgsCheckMembershipForUserRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -52865,6 +56287,11 @@ func (s *Server) handleOrgsCheckPublicMembershipForUserRequest(args [2]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -52885,6 +56312,9 @@ func (s *Server) handleOrgsCheckPublicMembershipForUserRequest(args [2]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -52900,8 +56330,9 @@ func (s *Server) handleOrgsCheckPublicMembershipForUserRequest(args [2]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53017,6 +56448,11 @@ func (s *Server) handleOrgsConvertMemberToOutsideCollaboratorRequest(args [2]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53037,6 +56473,9 @@ func (s *Server) handleOrgsConvertMemberToOutsideCollaboratorRequest(args [2]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53052,8 +56491,9 @@ func (s *Server) handleOrgsConvertMemberToOutsideCollaboratorRequest(args [2]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53173,6 +56613,15 @@ func (s *Server) handleOrgsCreateInvitationRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53193,6 +56642,10 @@ func (s *Server) handleOrgsCreateInvitationRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53208,8 +56661,9 @@ func (s *Server) handleOrgsCreateInvitationRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53331,6 +56785,15 @@ func (s *Server) handleOrgsCreateWebhookRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53351,6 +56814,10 @@ func (s *Server) handleOrgsCreateWebhookRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53366,8 +56833,9 @@ func (s *Server) handleOrgsCreateWebhookRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53489,6 +56957,11 @@ func (s *Server) handleOrgsDeleteWebhookRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53509,6 +56982,9 @@ func (s *Server) handleOrgsDeleteWebhookRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53524,8 +57000,9 @@ func (s *Server) handleOrgsDeleteWebhookRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53646,6 +57123,11 @@ func (s *Server) handleOrgsGetRequest(args [1]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53666,6 +57148,9 @@ func (s *Server) handleOrgsGetRequest(args [1]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53681,8 +57166,9 @@ func (s *Server) handleOrgsGetRequest(args [1]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53796,6 +57282,11 @@ func (s *Server) handleOrgsGetAuditLogRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53816,6 +57307,9 @@ func (s *Server) handleOrgsGetAuditLogRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -53831,8 +57325,9 @@ func (s *Server) handleOrgsGetAuditLogRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -53967,6 +57462,11 @@ func (s *Server) handleOrgsGetMembershipForAuthenticatedUserRequest(args [1]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -53987,6 +57487,9 @@ func (s *Server) handleOrgsGetMembershipForAuthenticatedUserRequest(args [1]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54002,8 +57505,9 @@ func (s *Server) handleOrgsGetMembershipForAuthenticatedUserRequest(args [1]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54112,6 +57616,11 @@ func (s *Server) handleOrgsGetMembershipForUserRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54132,6 +57641,9 @@ func (s *Server) handleOrgsGetMembershipForUserRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54147,8 +57659,9 @@ func (s *Server) handleOrgsGetMembershipForUserRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54262,6 +57775,11 @@ func (s *Server) handleOrgsGetWebhookRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54282,6 +57800,9 @@ func (s *Server) handleOrgsGetWebhookRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54297,8 +57818,9 @@ func (s *Server) handleOrgsGetWebhookRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54415,6 +57937,11 @@ func (s *Server) handleOrgsGetWebhookConfigForOrgRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54435,6 +57962,9 @@ func (s *Server) handleOrgsGetWebhookConfigForOrgRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54450,8 +57980,9 @@ func (s *Server) handleOrgsGetWebhookConfigForOrgRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54562,6 +58093,11 @@ func (s *Server) handleOrgsGetWebhookDeliveryRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54582,6 +58118,9 @@ func (s *Server) handleOrgsGetWebhookDeliveryRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54597,8 +58136,9 @@ func (s *Server) handleOrgsGetWebhookDeliveryRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54718,6 +58258,11 @@ func (s *Server) handleOrgsListRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54738,6 +58283,9 @@ func (s *Server) handleOrgsListRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54753,8 +58301,9 @@ func (s *Server) handleOrgsListRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -54865,6 +58414,11 @@ func (s *Server) handleOrgsListBlockedUsersRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -54885,6 +58439,9 @@ func (s *Server) handleOrgsListBlockedUsersRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -54900,8 +58457,9 @@ func (s *Server) handleOrgsListBlockedUsersRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55009,6 +58567,11 @@ func (s *Server) handleOrgsListFailedInvitationsRequest(args [1]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55029,6 +58592,9 @@ func (s *Server) handleOrgsListFailedInvitationsRequest(args [1]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55044,8 +58610,9 @@ func (s *Server) handleOrgsListFailedInvitationsRequest(args [1]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55167,6 +58734,11 @@ func (s *Server) handleOrgsListForAuthenticatedUserRequest(args [0]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55187,6 +58759,9 @@ func (s *Server) handleOrgsListForAuthenticatedUserRequest(args [0]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55202,8 +58777,9 @@ func (s *Server) handleOrgsListForAuthenticatedUserRequest(args [0]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55321,6 +58897,11 @@ func (s *Server) handleOrgsListForUserRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55341,6 +58922,9 @@ func (s *Server) handleOrgsListForUserRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55356,8 +58940,9 @@ func (s *Server) handleOrgsListForUserRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55473,6 +59058,11 @@ func (s *Server) handleOrgsListInvitationTeamsRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55493,6 +59083,9 @@ func (s *Server) handleOrgsListInvitationTeamsRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55508,8 +59101,9 @@ func (s *Server) handleOrgsListInvitationTeamsRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55629,6 +59223,11 @@ func (s *Server) handleOrgsListMembersRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55649,6 +59248,9 @@ func (s *Server) handleOrgsListMembersRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55664,8 +59266,9 @@ func (s *Server) handleOrgsListMembersRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55788,6 +59391,11 @@ func (s *Server) handleOrgsListMembershipsForAuthenticatedUserRequest(args [0]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55808,6 +59416,9 @@ func (s *Server) handleOrgsListMembershipsForAuthenticatedUserRequest(args [0]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55823,8 +59434,9 @@ func (s *Server) handleOrgsListMembershipsForAuthenticatedUserRequest(args [0]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -55939,6 +59551,11 @@ func (s *Server) handleOrgsListOutsideCollaboratorsRequest(args [1]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -55959,6 +59576,9 @@ func (s *Server) handleOrgsListOutsideCollaboratorsRequest(args [1]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -55974,8 +59594,9 @@ func (s *Server) handleOrgsListOutsideCollaboratorsRequest(args [1]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56097,6 +59718,11 @@ func (s *Server) handleOrgsListPendingInvitationsRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56117,6 +59743,9 @@ func (s *Server) handleOrgsListPendingInvitationsRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56132,8 +59761,9 @@ func (s *Server) handleOrgsListPendingInvitationsRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56248,6 +59878,11 @@ func (s *Server) handleOrgsListPublicMembersRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56268,6 +59903,9 @@ func (s *Server) handleOrgsListPublicMembersRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56283,8 +59921,9 @@ func (s *Server) handleOrgsListPublicMembersRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56408,6 +60047,11 @@ func (s *Server) handleOrgsListSamlSSOAuthorizationsRequest(args [1]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56428,6 +60072,9 @@ func (s *Server) handleOrgsListSamlSSOAuthorizationsRequest(args [1]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56443,8 +60090,9 @@ func (s *Server) handleOrgsListSamlSSOAuthorizationsRequest(args [1]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56551,6 +60199,11 @@ func (s *Server) handleOrgsListWebhookDeliveriesRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56571,6 +60224,9 @@ func (s *Server) handleOrgsListWebhookDeliveriesRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56586,8 +60242,9 @@ func (s *Server) handleOrgsListWebhookDeliveriesRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56706,6 +60363,11 @@ func (s *Server) handleOrgsListWebhooksRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56726,6 +60388,9 @@ func (s *Server) handleOrgsListWebhooksRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56741,8 +60406,9 @@ func (s *Server) handleOrgsListWebhooksRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -56859,6 +60525,11 @@ func (s *Server) handleOrgsPingWebhookRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -56879,6 +60550,9 @@ func (s *Server) handleOrgsPingWebhookRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -56894,8 +60568,9 @@ func (s *Server) handleOrgsPingWebhookRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57006,6 +60681,11 @@ func (s *Server) handleOrgsRedeliverWebhookDeliveryRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57026,6 +60706,9 @@ func (s *Server) handleOrgsRedeliverWebhookDeliveryRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57041,8 +60724,9 @@ func (s *Server) handleOrgsRedeliverWebhookDeliveryRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57158,6 +60842,11 @@ func (s *Server) handleOrgsRemoveMemberRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57178,6 +60867,9 @@ func (s *Server) handleOrgsRemoveMemberRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57193,8 +60885,9 @@ func (s *Server) handleOrgsRemoveMemberRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57310,6 +61003,11 @@ func (s *Server) handleOrgsRemoveMembershipForUserRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57330,6 +61028,9 @@ func (s *Server) handleOrgsRemoveMembershipForUserRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57345,8 +61046,9 @@ func (s *Server) handleOrgsRemoveMembershipForUserRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57457,6 +61159,11 @@ func (s *Server) handleOrgsRemoveOutsideCollaboratorRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57477,6 +61184,9 @@ func (s *Server) handleOrgsRemoveOutsideCollaboratorRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57492,8 +61202,9 @@ func (s *Server) handleOrgsRemoveOutsideCollaboratorRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57604,6 +61315,11 @@ func (s *Server) handleOrgsRemovePublicMembershipForAuthenticatedUserRequest(arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57624,6 +61340,9 @@ func (s *Server) handleOrgsRemovePublicMembershipForAuthenticatedUserRequest(arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57639,8 +61358,9 @@ func (s *Server) handleOrgsRemovePublicMembershipForAuthenticatedUserRequest(arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57759,6 +61479,11 @@ func (s *Server) handleOrgsRemoveSamlSSOAuthorizationRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57779,6 +61504,9 @@ func (s *Server) handleOrgsRemoveSamlSSOAuthorizationRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57794,8 +61522,9 @@ func (s *Server) handleOrgsRemoveSamlSSOAuthorizationRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -57923,6 +61652,15 @@ func (s *Server) handleOrgsSetMembershipForUserRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -57943,6 +61681,10 @@ func (s *Server) handleOrgsSetMembershipForUserRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -57958,8 +61700,9 @@ func (s *Server) handleOrgsSetMembershipForUserRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58091,6 +61834,11 @@ func (s *Server) handleOrgsSetPublicMembershipForAuthenticatedUserRequest(args [
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58111,6 +61859,9 @@ func (s *Server) handleOrgsSetPublicMembershipForAuthenticatedUserRequest(args [
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58126,8 +61877,9 @@ func (s *Server) handleOrgsSetPublicMembershipForAuthenticatedUserRequest(args [
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58238,6 +61990,11 @@ func (s *Server) handleOrgsUnblockUserRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58258,6 +62015,9 @@ func (s *Server) handleOrgsUnblockUserRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58273,8 +62033,9 @@ func (s *Server) handleOrgsUnblockUserRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58385,6 +62146,15 @@ func (s *Server) handleOrgsUpdateMembershipForAuthenticatedUserRequest(args [1]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58405,6 +62175,10 @@ func (s *Server) handleOrgsUpdateMembershipForAuthenticatedUserRequest(args [1]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58420,8 +62194,9 @@ func (s *Server) handleOrgsUpdateMembershipForAuthenticatedUserRequest(args [1]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58548,6 +62323,15 @@ func (s *Server) handleOrgsUpdateWebhookRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58568,6 +62352,10 @@ func (s *Server) handleOrgsUpdateWebhookRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58583,8 +62371,9 @@ func (s *Server) handleOrgsUpdateWebhookRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58716,6 +62505,15 @@ func (s *Server) handleOrgsUpdateWebhookConfigForOrgRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58736,6 +62534,10 @@ func (s *Server) handleOrgsUpdateWebhookConfigForOrgRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58751,8 +62553,9 @@ func (s *Server) handleOrgsUpdateWebhookConfigForOrgRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -58884,6 +62687,11 @@ func (s *Server) handlePackagesDeletePackageForAuthenticatedUserRequest(args [2]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -58904,6 +62712,9 @@ func (s *Server) handlePackagesDeletePackageForAuthenticatedUserRequest(args [2]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -58919,8 +62730,9 @@ func (s *Server) handlePackagesDeletePackageForAuthenticatedUserRequest(args [2]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59040,6 +62852,11 @@ func (s *Server) handlePackagesDeletePackageForOrgRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59060,6 +62877,9 @@ func (s *Server) handlePackagesDeletePackageForOrgRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59075,8 +62895,9 @@ func (s *Server) handlePackagesDeletePackageForOrgRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59200,6 +63021,11 @@ func (s *Server) handlePackagesDeletePackageForUserRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59220,6 +63046,9 @@ func (s *Server) handlePackagesDeletePackageForUserRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59235,8 +63064,9 @@ func (s *Server) handlePackagesDeletePackageForUserRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59357,6 +63187,11 @@ func (s *Server) handlePackagesDeletePackageVersionForAuthenticatedUserRequest(a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59377,6 +63212,9 @@ func (s *Server) handlePackagesDeletePackageVersionForAuthenticatedUserRequest(a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59392,8 +63230,9 @@ func (s *Server) handlePackagesDeletePackageVersionForAuthenticatedUserRequest(a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59517,6 +63356,11 @@ func (s *Server) handlePackagesDeletePackageVersionForOrgRequest(args [4]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59537,6 +63381,9 @@ func (s *Server) handlePackagesDeletePackageVersionForOrgRequest(args [4]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59552,8 +63399,9 @@ func (s *Server) handlePackagesDeletePackageVersionForOrgRequest(args [4]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59681,6 +63529,11 @@ func (s *Server) handlePackagesDeletePackageVersionForUserRequest(args [4]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59701,6 +63554,9 @@ func (s *Server) handlePackagesDeletePackageVersionForUserRequest(args [4]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59716,8 +63572,9 @@ func (s *Server) handlePackagesDeletePackageVersionForUserRequest(args [4]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -59839,6 +63696,11 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByAuthenticat
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -59859,6 +63721,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByAuthenticat
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -59874,8 +63739,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByAuthenticat
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60001,6 +63867,11 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByOrgRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60021,6 +63892,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByOrgRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60036,8 +63910,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByOrgRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60167,6 +64042,11 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByUserRequest
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60187,6 +64067,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByUserRequest
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60202,8 +64085,9 @@ func (s *Server) handlePackagesGetAllPackageVersionsForPackageOwnedByUserRequest
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60321,6 +64205,11 @@ func (s *Server) handlePackagesGetPackageForAuthenticatedUserRequest(args [2]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60341,6 +64230,9 @@ func (s *Server) handlePackagesGetPackageForAuthenticatedUserRequest(args [2]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60356,8 +64248,9 @@ func (s *Server) handlePackagesGetPackageForAuthenticatedUserRequest(args [2]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60471,6 +64364,11 @@ func (s *Server) handlePackagesGetPackageForOrganizationRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60491,6 +64389,9 @@ func (s *Server) handlePackagesGetPackageForOrganizationRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60506,8 +64407,9 @@ func (s *Server) handlePackagesGetPackageForOrganizationRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60625,6 +64527,11 @@ func (s *Server) handlePackagesGetPackageForUserRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60645,6 +64552,9 @@ func (s *Server) handlePackagesGetPackageForUserRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60660,8 +64570,9 @@ func (s *Server) handlePackagesGetPackageForUserRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60779,6 +64690,11 @@ func (s *Server) handlePackagesGetPackageVersionForAuthenticatedUserRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60799,6 +64715,9 @@ func (s *Server) handlePackagesGetPackageVersionForAuthenticatedUserRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60814,8 +64733,9 @@ func (s *Server) handlePackagesGetPackageVersionForAuthenticatedUserRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -60933,6 +64853,11 @@ func (s *Server) handlePackagesGetPackageVersionForOrganizationRequest(args [4]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -60953,6 +64878,9 @@ func (s *Server) handlePackagesGetPackageVersionForOrganizationRequest(args [4]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -60968,8 +64896,9 @@ func (s *Server) handlePackagesGetPackageVersionForOrganizationRequest(args [4]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61092,6 +65021,11 @@ func (s *Server) handlePackagesGetPackageVersionForUserRequest(args [4]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61112,6 +65046,9 @@ func (s *Server) handlePackagesGetPackageVersionForUserRequest(args [4]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61127,8 +65064,9 @@ func (s *Server) handlePackagesGetPackageVersionForUserRequest(args [4]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61250,6 +65188,11 @@ func (s *Server) handlePackagesListPackagesForAuthenticatedUserRequest(args [0]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61270,6 +65213,9 @@ func (s *Server) handlePackagesListPackagesForAuthenticatedUserRequest(args [0]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61285,8 +65231,9 @@ func (s *Server) handlePackagesListPackagesForAuthenticatedUserRequest(args [0]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61400,6 +65347,11 @@ func (s *Server) handlePackagesListPackagesForOrganizationRequest(args [1]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61420,6 +65372,9 @@ func (s *Server) handlePackagesListPackagesForOrganizationRequest(args [1]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61435,8 +65390,9 @@ func (s *Server) handlePackagesListPackagesForOrganizationRequest(args [1]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61554,6 +65510,11 @@ func (s *Server) handlePackagesListPackagesForUserRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61574,6 +65535,9 @@ func (s *Server) handlePackagesListPackagesForUserRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61589,8 +65553,9 @@ func (s *Server) handlePackagesListPackagesForUserRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61717,6 +65682,11 @@ func (s *Server) handlePackagesRestorePackageForAuthenticatedUserRequest(args [2
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61737,6 +65707,9 @@ func (s *Server) handlePackagesRestorePackageForAuthenticatedUserRequest(args [2
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61752,8 +65725,9 @@ func (s *Server) handlePackagesRestorePackageForAuthenticatedUserRequest(args [2
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -61883,6 +65857,11 @@ func (s *Server) handlePackagesRestorePackageForOrgRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -61903,6 +65882,9 @@ func (s *Server) handlePackagesRestorePackageForOrgRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -61918,8 +65900,9 @@ func (s *Server) handlePackagesRestorePackageForOrgRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62053,6 +66036,11 @@ func (s *Server) handlePackagesRestorePackageForUserRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62073,6 +66061,9 @@ func (s *Server) handlePackagesRestorePackageForUserRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62088,8 +66079,9 @@ func (s *Server) handlePackagesRestorePackageForUserRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62220,6 +66212,11 @@ func (s *Server) handlePackagesRestorePackageVersionForAuthenticatedUserRequest(
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62240,6 +66237,9 @@ func (s *Server) handlePackagesRestorePackageVersionForAuthenticatedUserRequest(
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62255,8 +66255,9 @@ func (s *Server) handlePackagesRestorePackageVersionForAuthenticatedUserRequest(
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62386,6 +66387,11 @@ func (s *Server) handlePackagesRestorePackageVersionForOrgRequest(args [4]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62406,6 +66412,9 @@ func (s *Server) handlePackagesRestorePackageVersionForOrgRequest(args [4]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62421,8 +66430,9 @@ func (s *Server) handlePackagesRestorePackageVersionForOrgRequest(args [4]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62556,6 +66566,11 @@ func (s *Server) handlePackagesRestorePackageVersionForUserRequest(args [4]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62576,6 +66591,9 @@ func (s *Server) handlePackagesRestorePackageVersionForUserRequest(args [4]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62591,8 +66609,9 @@ func (s *Server) handlePackagesRestorePackageVersionForUserRequest(args [4]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62712,6 +66731,15 @@ func (s *Server) handleProjectsAddCollaboratorRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62732,6 +66760,10 @@ func (s *Server) handleProjectsAddCollaboratorRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62747,8 +66779,9 @@ func (s *Server) handleProjectsAddCollaboratorRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -62874,6 +66907,15 @@ func (s *Server) handleProjectsCreateColumnRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -62894,6 +66936,10 @@ func (s *Server) handleProjectsCreateColumnRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -62909,8 +66955,9 @@ func (s *Server) handleProjectsCreateColumnRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63032,6 +67079,15 @@ func (s *Server) handleProjectsCreateForAuthenticatedUserRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63052,6 +67108,10 @@ func (s *Server) handleProjectsCreateForAuthenticatedUserRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63067,8 +67127,9 @@ func (s *Server) handleProjectsCreateForAuthenticatedUserRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63177,6 +67238,15 @@ func (s *Server) handleProjectsCreateForOrgRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63197,6 +67267,10 @@ func (s *Server) handleProjectsCreateForOrgRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63212,8 +67286,9 @@ func (s *Server) handleProjectsCreateForOrgRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63337,6 +67412,15 @@ func (s *Server) handleProjectsCreateForRepoRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63357,6 +67441,10 @@ func (s *Server) handleProjectsCreateForRepoRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63372,8 +67460,9 @@ func (s *Server) handleProjectsCreateForRepoRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63499,6 +67588,11 @@ func (s *Server) handleProjectsDeleteRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63519,6 +67613,9 @@ func (s *Server) handleProjectsDeleteRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63534,8 +67631,9 @@ func (s *Server) handleProjectsDeleteRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63642,6 +67740,11 @@ func (s *Server) handleProjectsDeleteCardRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63662,6 +67765,9 @@ func (s *Server) handleProjectsDeleteCardRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63677,8 +67783,9 @@ func (s *Server) handleProjectsDeleteCardRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63785,6 +67892,11 @@ func (s *Server) handleProjectsDeleteColumnRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63805,6 +67917,9 @@ func (s *Server) handleProjectsDeleteColumnRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63820,8 +67935,9 @@ func (s *Server) handleProjectsDeleteColumnRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -63930,6 +68046,11 @@ func (s *Server) handleProjectsGetRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -63950,6 +68071,9 @@ func (s *Server) handleProjectsGetRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -63965,8 +68089,9 @@ func (s *Server) handleProjectsGetRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64073,6 +68198,11 @@ func (s *Server) handleProjectsGetCardRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64093,6 +68223,9 @@ func (s *Server) handleProjectsGetCardRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64108,8 +68241,9 @@ func (s *Server) handleProjectsGetCardRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64216,6 +68350,11 @@ func (s *Server) handleProjectsGetColumnRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64236,6 +68375,9 @@ func (s *Server) handleProjectsGetColumnRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64251,8 +68393,9 @@ func (s *Server) handleProjectsGetColumnRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64361,6 +68504,11 @@ func (s *Server) handleProjectsGetPermissionForUserRequest(args [2]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64381,6 +68529,9 @@ func (s *Server) handleProjectsGetPermissionForUserRequest(args [2]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64396,8 +68547,9 @@ func (s *Server) handleProjectsGetPermissionForUserRequest(args [2]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64508,6 +68660,11 @@ func (s *Server) handleProjectsListCardsRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64528,6 +68685,9 @@ func (s *Server) handleProjectsListCardsRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64543,8 +68703,9 @@ func (s *Server) handleProjectsListCardsRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64667,6 +68828,11 @@ func (s *Server) handleProjectsListCollaboratorsRequest(args [1]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64687,6 +68853,9 @@ func (s *Server) handleProjectsListCollaboratorsRequest(args [1]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64702,8 +68871,9 @@ func (s *Server) handleProjectsListCollaboratorsRequest(args [1]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64822,6 +68992,11 @@ func (s *Server) handleProjectsListColumnsRequest(args [1]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64842,6 +69017,9 @@ func (s *Server) handleProjectsListColumnsRequest(args [1]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -64857,8 +69035,9 @@ func (s *Server) handleProjectsListColumnsRequest(args [1]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -64975,6 +69154,11 @@ func (s *Server) handleProjectsListForOrgRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -64995,6 +69179,9 @@ func (s *Server) handleProjectsListForOrgRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65010,8 +69197,9 @@ func (s *Server) handleProjectsListForOrgRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65132,6 +69320,11 @@ func (s *Server) handleProjectsListForRepoRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65152,6 +69345,9 @@ func (s *Server) handleProjectsListForRepoRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65167,8 +69363,9 @@ func (s *Server) handleProjectsListForRepoRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65291,6 +69488,11 @@ func (s *Server) handleProjectsListForUserRequest(args [1]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65311,6 +69513,9 @@ func (s *Server) handleProjectsListForUserRequest(args [1]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65326,8 +69531,9 @@ func (s *Server) handleProjectsListForUserRequest(args [1]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65446,6 +69652,15 @@ func (s *Server) handleProjectsMoveCardRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65466,6 +69681,10 @@ func (s *Server) handleProjectsMoveCardRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65481,8 +69700,9 @@ func (s *Server) handleProjectsMoveCardRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65604,6 +69824,15 @@ func (s *Server) handleProjectsMoveColumnRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65624,6 +69853,10 @@ func (s *Server) handleProjectsMoveColumnRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65639,8 +69872,9 @@ func (s *Server) handleProjectsMoveColumnRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65763,6 +69997,11 @@ func (s *Server) handleProjectsRemoveCollaboratorRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65783,6 +70022,9 @@ func (s *Server) handleProjectsRemoveCollaboratorRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65798,8 +70040,9 @@ func (s *Server) handleProjectsRemoveCollaboratorRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -65912,6 +70155,15 @@ func (s *Server) handleProjectsUpdateRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -65932,6 +70184,10 @@ func (s *Server) handleProjectsUpdateRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -65947,8 +70203,9 @@ func (s *Server) handleProjectsUpdateRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66070,6 +70327,15 @@ func (s *Server) handleProjectsUpdateCardRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66090,6 +70356,10 @@ func (s *Server) handleProjectsUpdateCardRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66105,8 +70375,9 @@ func (s *Server) handleProjectsUpdateCardRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66228,6 +70499,15 @@ func (s *Server) handleProjectsUpdateColumnRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66248,6 +70528,10 @@ func (s *Server) handleProjectsUpdateColumnRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66263,8 +70547,9 @@ func (s *Server) handleProjectsUpdateColumnRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66386,6 +70671,11 @@ func (s *Server) handlePullsCheckIfMergedRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66406,6 +70696,9 @@ func (s *Server) handlePullsCheckIfMergedRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66421,8 +70714,9 @@ func (s *Server) handlePullsCheckIfMergedRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66555,6 +70849,15 @@ func (s *Server) handlePullsCreateRequest(args [2]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66575,6 +70878,10 @@ func (s *Server) handlePullsCreateRequest(args [2]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66590,8 +70897,9 @@ func (s *Server) handlePullsCreateRequest(args [2]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66727,6 +71035,15 @@ func (s *Server) handlePullsCreateReplyForReviewCommentRequest(args [4]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66747,6 +71064,10 @@ func (s *Server) handlePullsCreateReplyForReviewCommentRequest(args [4]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66762,8 +71083,9 @@ func (s *Server) handlePullsCreateReplyForReviewCommentRequest(args [4]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -66918,6 +71240,15 @@ func (s *Server) handlePullsCreateReviewRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -66938,6 +71269,10 @@ func (s *Server) handlePullsCreateReviewRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -66953,8 +71288,9 @@ func (s *Server) handlePullsCreateReviewRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67106,6 +71442,15 @@ func (s *Server) handlePullsCreateReviewCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67126,6 +71471,10 @@ func (s *Server) handlePullsCreateReviewCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67141,8 +71490,9 @@ func (s *Server) handlePullsCreateReviewCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67272,6 +71622,11 @@ func (s *Server) handlePullsDeletePendingReviewRequest(args [4]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67292,6 +71647,9 @@ func (s *Server) handlePullsDeletePendingReviewRequest(args [4]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67307,8 +71665,9 @@ func (s *Server) handlePullsDeletePendingReviewRequest(args [4]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67427,6 +71786,11 @@ func (s *Server) handlePullsDeleteReviewCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67447,6 +71811,9 @@ func (s *Server) handlePullsDeleteReviewCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67462,8 +71829,9 @@ func (s *Server) handlePullsDeleteReviewCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67581,6 +71949,15 @@ func (s *Server) handlePullsDismissReviewRequest(args [4]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67601,6 +71978,10 @@ func (s *Server) handlePullsDismissReviewRequest(args [4]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67616,8 +71997,9 @@ func (s *Server) handlePullsDismissReviewRequest(args [4]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67788,6 +72170,11 @@ func (s *Server) handlePullsGetRequest(args [3]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67808,6 +72195,9 @@ func (s *Server) handlePullsGetRequest(args [3]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67823,8 +72213,9 @@ func (s *Server) handlePullsGetRequest(args [3]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -67939,6 +72330,11 @@ func (s *Server) handlePullsGetReviewRequest(args [4]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -67959,6 +72355,9 @@ func (s *Server) handlePullsGetReviewRequest(args [4]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -67974,8 +72373,9 @@ func (s *Server) handlePullsGetReviewRequest(args [4]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68094,6 +72494,11 @@ func (s *Server) handlePullsGetReviewCommentRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68114,6 +72519,9 @@ func (s *Server) handlePullsGetReviewCommentRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68129,8 +72537,9 @@ func (s *Server) handlePullsGetReviewCommentRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68250,6 +72659,11 @@ func (s *Server) handlePullsListRequest(args [2]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68270,6 +72684,9 @@ func (s *Server) handlePullsListRequest(args [2]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68285,8 +72702,9 @@ func (s *Server) handlePullsListRequest(args [2]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68425,6 +72843,11 @@ func (s *Server) handlePullsListCommentsForReviewRequest(args [4]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68445,6 +72868,9 @@ func (s *Server) handlePullsListCommentsForReviewRequest(args [4]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68460,8 +72886,9 @@ func (s *Server) handlePullsListCommentsForReviewRequest(args [4]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68591,6 +73018,11 @@ func (s *Server) handlePullsListCommitsRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68611,6 +73043,9 @@ func (s *Server) handlePullsListCommitsRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68626,8 +73061,9 @@ func (s *Server) handlePullsListCommitsRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68751,6 +73187,11 @@ func (s *Server) handlePullsListFilesRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68771,6 +73212,9 @@ func (s *Server) handlePullsListFilesRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68786,8 +73230,9 @@ func (s *Server) handlePullsListFilesRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -68910,6 +73355,11 @@ func (s *Server) handlePullsListRequestedReviewersRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -68930,6 +73380,9 @@ func (s *Server) handlePullsListRequestedReviewersRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -68945,8 +73398,9 @@ func (s *Server) handlePullsListRequestedReviewersRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69070,6 +73524,11 @@ func (s *Server) handlePullsListReviewCommentsRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69090,6 +73549,9 @@ func (s *Server) handlePullsListReviewCommentsRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69105,8 +73567,9 @@ func (s *Server) handlePullsListReviewCommentsRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69242,6 +73705,11 @@ func (s *Server) handlePullsListReviewCommentsForRepoRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69262,6 +73730,9 @@ func (s *Server) handlePullsListReviewCommentsForRepoRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69277,8 +73748,9 @@ func (s *Server) handlePullsListReviewCommentsForRepoRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69409,6 +73881,11 @@ func (s *Server) handlePullsListReviewsRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69429,6 +73906,9 @@ func (s *Server) handlePullsListReviewsRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69444,8 +73924,9 @@ func (s *Server) handlePullsListReviewsRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69574,6 +74055,15 @@ func (s *Server) handlePullsMergeRequest(args [3]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69594,6 +74084,10 @@ func (s *Server) handlePullsMergeRequest(args [3]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69609,8 +74103,9 @@ func (s *Server) handlePullsMergeRequest(args [3]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69740,6 +74235,15 @@ func (s *Server) handlePullsRemoveRequestedReviewersRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69760,6 +74264,10 @@ func (s *Server) handlePullsRemoveRequestedReviewersRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69775,8 +74283,9 @@ func (s *Server) handlePullsRemoveRequestedReviewersRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -69906,6 +74415,15 @@ func (s *Server) handlePullsSubmitReviewRequest(args [4]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -69926,6 +74444,10 @@ func (s *Server) handlePullsSubmitReviewRequest(args [4]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -69941,8 +74463,9 @@ func (s *Server) handlePullsSubmitReviewRequest(args [4]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70085,6 +74608,15 @@ func (s *Server) handlePullsUpdateRequest(args [3]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70105,6 +74637,10 @@ func (s *Server) handlePullsUpdateRequest(args [3]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70120,8 +74656,9 @@ func (s *Server) handlePullsUpdateRequest(args [3]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70252,6 +74789,15 @@ func (s *Server) handlePullsUpdateBranchRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70272,6 +74818,10 @@ func (s *Server) handlePullsUpdateBranchRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70287,8 +74837,9 @@ func (s *Server) handlePullsUpdateBranchRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70418,6 +74969,15 @@ func (s *Server) handlePullsUpdateReviewRequest(args [4]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70438,6 +74998,10 @@ func (s *Server) handlePullsUpdateReviewRequest(args [4]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70453,8 +75017,9 @@ func (s *Server) handlePullsUpdateReviewRequest(args [4]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70588,6 +75153,15 @@ func (s *Server) handlePullsUpdateReviewCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70608,6 +75182,10 @@ func (s *Server) handlePullsUpdateReviewCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70623,8 +75201,9 @@ func (s *Server) handlePullsUpdateReviewCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70758,6 +75337,11 @@ func (s *Server) handleRateLimitGetRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70778,6 +75362,9 @@ func (s *Server) handleRateLimitGetRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70793,8 +75380,9 @@ func (s *Server) handleRateLimitGetRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -70885,6 +75473,15 @@ func (s *Server) handleReactionsCreateForCommitCommentRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -70905,6 +75502,10 @@ func (s *Server) handleReactionsCreateForCommitCommentRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -70920,8 +75521,9 @@ func (s *Server) handleReactionsCreateForCommitCommentRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71054,6 +75656,15 @@ func (s *Server) handleReactionsCreateForIssueRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71074,6 +75685,10 @@ func (s *Server) handleReactionsCreateForIssueRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71089,8 +75704,9 @@ func (s *Server) handleReactionsCreateForIssueRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71223,6 +75839,15 @@ func (s *Server) handleReactionsCreateForIssueCommentRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71243,6 +75868,10 @@ func (s *Server) handleReactionsCreateForIssueCommentRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71258,8 +75887,9 @@ func (s *Server) handleReactionsCreateForIssueCommentRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71392,6 +76022,15 @@ func (s *Server) handleReactionsCreateForPullRequestReviewCommentRequest(args [3
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71412,6 +76051,10 @@ func (s *Server) handleReactionsCreateForPullRequestReviewCommentRequest(args [3
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71427,8 +76070,9 @@ func (s *Server) handleReactionsCreateForPullRequestReviewCommentRequest(args [3
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71561,6 +76205,15 @@ func (s *Server) handleReactionsCreateForReleaseRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71581,6 +76234,10 @@ func (s *Server) handleReactionsCreateForReleaseRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71596,8 +76253,9 @@ func (s *Server) handleReactionsCreateForReleaseRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71735,6 +76393,15 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentInOrgRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71755,6 +76422,10 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentInOrgRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71770,8 +76441,9 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentInOrgRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -71917,6 +76589,15 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentLegacyRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -71937,6 +76618,10 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentLegacyRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -71952,8 +76637,9 @@ func (s *Server) handleReactionsCreateForTeamDiscussionCommentLegacyRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72091,6 +76777,15 @@ func (s *Server) handleReactionsCreateForTeamDiscussionInOrgRequest(args [3]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72111,6 +76806,10 @@ func (s *Server) handleReactionsCreateForTeamDiscussionInOrgRequest(args [3]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72126,8 +76825,9 @@ func (s *Server) handleReactionsCreateForTeamDiscussionInOrgRequest(args [3]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72269,6 +76969,15 @@ func (s *Server) handleReactionsCreateForTeamDiscussionLegacyRequest(args [2]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72289,6 +76998,10 @@ func (s *Server) handleReactionsCreateForTeamDiscussionLegacyRequest(args [2]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72304,8 +77017,9 @@ func (s *Server) handleReactionsCreateForTeamDiscussionLegacyRequest(args [2]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72436,6 +77150,11 @@ func (s *Server) handleReactionsDeleteForCommitCommentRequest(args [4]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72456,6 +77175,9 @@ func (s *Server) handleReactionsDeleteForCommitCommentRequest(args [4]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72471,8 +77193,9 @@ func (s *Server) handleReactionsDeleteForCommitCommentRequest(args [4]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72596,6 +77319,11 @@ func (s *Server) handleReactionsDeleteForIssueRequest(args [4]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72616,6 +77344,9 @@ func (s *Server) handleReactionsDeleteForIssueRequest(args [4]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72631,8 +77362,9 @@ func (s *Server) handleReactionsDeleteForIssueRequest(args [4]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72756,6 +77488,11 @@ func (s *Server) handleReactionsDeleteForIssueCommentRequest(args [4]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72776,6 +77513,9 @@ func (s *Server) handleReactionsDeleteForIssueCommentRequest(args [4]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72791,8 +77531,9 @@ func (s *Server) handleReactionsDeleteForIssueCommentRequest(args [4]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -72916,6 +77657,11 @@ func (s *Server) handleReactionsDeleteForPullRequestCommentRequest(args [4]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -72936,6 +77682,9 @@ func (s *Server) handleReactionsDeleteForPullRequestCommentRequest(args [4]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -72951,8 +77700,9 @@ func (s *Server) handleReactionsDeleteForPullRequestCommentRequest(args [4]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73078,6 +77828,11 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionRequest(args [4]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73098,6 +77853,9 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionRequest(args [4]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73113,8 +77871,9 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionRequest(args [4]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73240,6 +77999,11 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionCommentRequest(args [5]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73260,6 +78024,9 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionCommentRequest(args [5]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73275,8 +78042,9 @@ func (s *Server) handleReactionsDeleteForTeamDiscussionCommentRequest(args [5]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73411,6 +78179,11 @@ func (s *Server) handleReactionsDeleteLegacyRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73431,6 +78204,9 @@ func (s *Server) handleReactionsDeleteLegacyRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73446,8 +78222,9 @@ func (s *Server) handleReactionsDeleteLegacyRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73556,6 +78333,11 @@ func (s *Server) handleReactionsListForCommitCommentRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73576,6 +78358,9 @@ func (s *Server) handleReactionsListForCommitCommentRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73591,8 +78376,9 @@ func (s *Server) handleReactionsListForCommitCommentRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73721,6 +78507,11 @@ func (s *Server) handleReactionsListForIssueRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73741,6 +78532,9 @@ func (s *Server) handleReactionsListForIssueRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73756,8 +78550,9 @@ func (s *Server) handleReactionsListForIssueRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -73886,6 +78681,11 @@ func (s *Server) handleReactionsListForIssueCommentRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -73906,6 +78706,9 @@ func (s *Server) handleReactionsListForIssueCommentRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -73921,8 +78724,9 @@ func (s *Server) handleReactionsListForIssueCommentRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74051,6 +78855,11 @@ func (s *Server) handleReactionsListForPullRequestReviewCommentRequest(args [3]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74071,6 +78880,9 @@ func (s *Server) handleReactionsListForPullRequestReviewCommentRequest(args [3]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74086,8 +78898,9 @@ func (s *Server) handleReactionsListForPullRequestReviewCommentRequest(args [3]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74221,6 +79034,11 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentInOrgRequest(args [4
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74241,6 +79059,9 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentInOrgRequest(args [4
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74256,8 +79077,9 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentInOrgRequest(args [4
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74399,6 +79221,11 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentLegacyRequest(args [
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74419,6 +79246,9 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentLegacyRequest(args [
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74434,8 +79264,9 @@ func (s *Server) handleReactionsListForTeamDiscussionCommentLegacyRequest(args [
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74569,6 +79400,11 @@ func (s *Server) handleReactionsListForTeamDiscussionInOrgRequest(args [3]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74589,6 +79425,9 @@ func (s *Server) handleReactionsListForTeamDiscussionInOrgRequest(args [3]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74604,8 +79443,9 @@ func (s *Server) handleReactionsListForTeamDiscussionInOrgRequest(args [3]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74743,6 +79583,11 @@ func (s *Server) handleReactionsListForTeamDiscussionLegacyRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74763,6 +79608,9 @@ func (s *Server) handleReactionsListForTeamDiscussionLegacyRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74778,8 +79626,9 @@ func (s *Server) handleReactionsListForTeamDiscussionLegacyRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -74902,6 +79751,11 @@ func (s *Server) handleReposAcceptInvitationRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -74922,6 +79776,9 @@ func (s *Server) handleReposAcceptInvitationRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -74937,8 +79794,9 @@ func (s *Server) handleReposAcceptInvitationRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75057,6 +79915,15 @@ func (s *Server) handleReposAddAppAccessRestrictionsRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75077,6 +79944,10 @@ func (s *Server) handleReposAddAppAccessRestrictionsRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75092,8 +79963,9 @@ func (s *Server) handleReposAddAppAccessRestrictionsRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75247,6 +80119,15 @@ func (s *Server) handleReposAddCollaboratorRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75267,6 +80148,10 @@ func (s *Server) handleReposAddCollaboratorRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75282,8 +80167,9 @@ func (s *Server) handleReposAddCollaboratorRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75418,6 +80304,15 @@ func (s *Server) handleReposAddStatusCheckContextsRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75438,6 +80333,10 @@ func (s *Server) handleReposAddStatusCheckContextsRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75453,8 +80352,9 @@ func (s *Server) handleReposAddStatusCheckContextsRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75596,6 +80496,15 @@ func (s *Server) handleReposAddTeamAccessRestrictionsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75616,6 +80525,10 @@ func (s *Server) handleReposAddTeamAccessRestrictionsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75631,8 +80544,9 @@ func (s *Server) handleReposAddTeamAccessRestrictionsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75773,6 +80687,15 @@ func (s *Server) handleReposAddUserAccessRestrictionsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75793,6 +80716,10 @@ func (s *Server) handleReposAddUserAccessRestrictionsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75808,8 +80735,9 @@ func (s *Server) handleReposAddUserAccessRestrictionsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -75944,6 +80872,11 @@ func (s *Server) handleReposCheckCollaboratorRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -75964,6 +80897,9 @@ func (s *Server) handleReposCheckCollaboratorRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -75979,8 +80915,9 @@ func (s *Server) handleReposCheckCollaboratorRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76099,6 +81036,11 @@ func (s *Server) handleReposCheckVulnerabilityAlertsRequest(args [2]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76119,6 +81061,9 @@ func (s *Server) handleReposCheckVulnerabilityAlertsRequest(args [2]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76134,8 +81079,9 @@ func (s *Server) handleReposCheckVulnerabilityAlertsRequest(args [2]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76303,6 +81249,11 @@ func (s *Server) handleReposCompareCommitsRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76323,6 +81274,9 @@ func (s *Server) handleReposCompareCommitsRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76338,8 +81292,9 @@ func (s *Server) handleReposCompareCommitsRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76462,6 +81417,15 @@ func (s *Server) handleReposCreateAutolinkRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76482,6 +81446,10 @@ func (s *Server) handleReposCreateAutolinkRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76497,8 +81465,9 @@ func (s *Server) handleReposCreateAutolinkRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76632,6 +81601,15 @@ func (s *Server) handleReposCreateCommitCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76652,6 +81630,10 @@ func (s *Server) handleReposCreateCommitCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76667,8 +81649,9 @@ func (s *Server) handleReposCreateCommitCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76806,6 +81789,11 @@ func (s *Server) handleReposCreateCommitSignatureProtectionRequest(args [3]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76826,6 +81814,9 @@ func (s *Server) handleReposCreateCommitSignatureProtectionRequest(args [3]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76841,8 +81832,9 @@ func (s *Server) handleReposCreateCommitSignatureProtectionRequest(args [3]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -76960,6 +81952,15 @@ func (s *Server) handleReposCreateCommitStatusRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -76980,6 +81981,10 @@ func (s *Server) handleReposCreateCommitStatusRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -76995,8 +82000,9 @@ func (s *Server) handleReposCreateCommitStatusRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -77126,6 +82132,15 @@ func (s *Server) handleReposCreateDeployKeyRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -77146,6 +82161,10 @@ func (s *Server) handleReposCreateDeployKeyRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -77161,8 +82180,9 @@ func (s *Server) handleReposCreateDeployKeyRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -77343,6 +82363,15 @@ func (s *Server) handleReposCreateDeploymentRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -77363,6 +82392,10 @@ func (s *Server) handleReposCreateDeploymentRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -77378,8 +82411,9 @@ func (s *Server) handleReposCreateDeploymentRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -77508,6 +82542,15 @@ func (s *Server) handleReposCreateDeploymentStatusRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -77528,6 +82571,10 @@ func (s *Server) handleReposCreateDeploymentStatusRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -77543,8 +82590,9 @@ func (s *Server) handleReposCreateDeploymentStatusRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -77693,6 +82741,15 @@ func (s *Server) handleReposCreateDispatchEventRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -77713,6 +82770,10 @@ func (s *Server) handleReposCreateDispatchEventRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -77728,8 +82789,9 @@ func (s *Server) handleReposCreateDispatchEventRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -77865,6 +82927,15 @@ func (s *Server) handleReposCreateForAuthenticatedUserRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -77885,6 +82956,10 @@ func (s *Server) handleReposCreateForAuthenticatedUserRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -77900,8 +82975,9 @@ func (s *Server) handleReposCreateForAuthenticatedUserRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78014,6 +83090,15 @@ func (s *Server) handleReposCreateForkRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78034,6 +83119,10 @@ func (s *Server) handleReposCreateForkRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78049,8 +83138,9 @@ func (s *Server) handleReposCreateForkRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78187,6 +83277,15 @@ func (s *Server) handleReposCreateInOrgRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78207,6 +83306,10 @@ func (s *Server) handleReposCreateInOrgRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78222,8 +83325,9 @@ func (s *Server) handleReposCreateInOrgRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78345,6 +83449,15 @@ func (s *Server) handleReposCreateOrUpdateFileContentsRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78365,6 +83478,10 @@ func (s *Server) handleReposCreateOrUpdateFileContentsRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78380,8 +83497,9 @@ func (s *Server) handleReposCreateOrUpdateFileContentsRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78513,6 +83631,15 @@ func (s *Server) handleReposCreatePagesSiteRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78533,6 +83660,10 @@ func (s *Server) handleReposCreatePagesSiteRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78548,8 +83679,9 @@ func (s *Server) handleReposCreatePagesSiteRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78683,6 +83815,15 @@ func (s *Server) handleReposCreateReleaseRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78703,6 +83844,10 @@ func (s *Server) handleReposCreateReleaseRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78718,8 +83863,9 @@ func (s *Server) handleReposCreateReleaseRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -78860,6 +84006,15 @@ func (s *Server) handleReposCreateUsingTemplateRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78880,6 +84035,10 @@ func (s *Server) handleReposCreateUsingTemplateRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -78895,8 +84054,9 @@ func (s *Server) handleReposCreateUsingTemplateRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79024,6 +84184,15 @@ func (s *Server) handleReposCreateWebhookRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79044,6 +84213,10 @@ func (s *Server) handleReposCreateWebhookRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79059,8 +84232,9 @@ func (s *Server) handleReposCreateWebhookRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79186,6 +84360,11 @@ func (s *Server) handleReposDeclineInvitationRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79206,6 +84385,9 @@ func (s *Server) handleReposDeclineInvitationRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79221,8 +84403,9 @@ func (s *Server) handleReposDeclineInvitationRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79332,6 +84515,11 @@ func (s *Server) handleReposDeleteRequest(args [2]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79352,6 +84540,9 @@ func (s *Server) handleReposDeleteRequest(args [2]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79367,8 +84558,9 @@ func (s *Server) handleReposDeleteRequest(args [2]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79486,6 +84678,11 @@ func (s *Server) handleReposDeleteAccessRestrictionsRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79506,6 +84703,9 @@ func (s *Server) handleReposDeleteAccessRestrictionsRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79521,8 +84721,9 @@ func (s *Server) handleReposDeleteAccessRestrictionsRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79645,6 +84846,11 @@ func (s *Server) handleReposDeleteAdminBranchProtectionRequest(args [3]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79665,6 +84871,9 @@ func (s *Server) handleReposDeleteAdminBranchProtectionRequest(args [3]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79680,8 +84889,9 @@ func (s *Server) handleReposDeleteAdminBranchProtectionRequest(args [3]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79796,6 +85006,11 @@ func (s *Server) handleReposDeleteAnEnvironmentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79816,6 +85031,9 @@ func (s *Server) handleReposDeleteAnEnvironmentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79831,8 +85049,9 @@ func (s *Server) handleReposDeleteAnEnvironmentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -79949,6 +85168,11 @@ func (s *Server) handleReposDeleteAutolinkRequest(args [3]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -79969,6 +85193,9 @@ func (s *Server) handleReposDeleteAutolinkRequest(args [3]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -79984,8 +85211,9 @@ func (s *Server) handleReposDeleteAutolinkRequest(args [3]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80105,6 +85333,11 @@ func (s *Server) handleReposDeleteBranchProtectionRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80125,6 +85358,9 @@ func (s *Server) handleReposDeleteBranchProtectionRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80140,8 +85376,9 @@ func (s *Server) handleReposDeleteBranchProtectionRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80256,6 +85493,11 @@ func (s *Server) handleReposDeleteCommitCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80276,6 +85518,9 @@ func (s *Server) handleReposDeleteCommitCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80291,8 +85536,9 @@ func (s *Server) handleReposDeleteCommitCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80416,6 +85662,11 @@ func (s *Server) handleReposDeleteCommitSignatureProtectionRequest(args [3]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80436,6 +85687,9 @@ func (s *Server) handleReposDeleteCommitSignatureProtectionRequest(args [3]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80451,8 +85705,9 @@ func (s *Server) handleReposDeleteCommitSignatureProtectionRequest(args [3]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80567,6 +85822,11 @@ func (s *Server) handleReposDeleteDeployKeyRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80587,6 +85847,9 @@ func (s *Server) handleReposDeleteDeployKeyRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80602,8 +85865,9 @@ func (s *Server) handleReposDeleteDeployKeyRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80730,6 +85994,11 @@ func (s *Server) handleReposDeleteDeploymentRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80750,6 +86019,9 @@ func (s *Server) handleReposDeleteDeploymentRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80765,8 +86037,9 @@ func (s *Server) handleReposDeleteDeploymentRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -80891,6 +86164,15 @@ func (s *Server) handleReposDeleteFileRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -80911,6 +86193,10 @@ func (s *Server) handleReposDeleteFileRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -80926,8 +86212,9 @@ func (s *Server) handleReposDeleteFileRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81057,6 +86344,11 @@ func (s *Server) handleReposDeleteInvitationRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81077,6 +86369,9 @@ func (s *Server) handleReposDeleteInvitationRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81092,8 +86387,9 @@ func (s *Server) handleReposDeleteInvitationRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81208,6 +86504,11 @@ func (s *Server) handleReposDeletePagesSiteRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81228,6 +86529,9 @@ func (s *Server) handleReposDeletePagesSiteRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81243,8 +86547,9 @@ func (s *Server) handleReposDeletePagesSiteRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81360,6 +86665,11 @@ func (s *Server) handleReposDeletePullRequestReviewProtectionRequest(args [3]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81380,6 +86690,9 @@ func (s *Server) handleReposDeletePullRequestReviewProtectionRequest(args [3]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81395,8 +86708,9 @@ func (s *Server) handleReposDeletePullRequestReviewProtectionRequest(args [3]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81511,6 +86825,11 @@ func (s *Server) handleReposDeleteReleaseRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81531,6 +86850,9 @@ func (s *Server) handleReposDeleteReleaseRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81546,8 +86868,9 @@ func (s *Server) handleReposDeleteReleaseRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81662,6 +86985,11 @@ func (s *Server) handleReposDeleteReleaseAssetRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81682,6 +87010,9 @@ func (s *Server) handleReposDeleteReleaseAssetRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81697,8 +87028,9 @@ func (s *Server) handleReposDeleteReleaseAssetRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81813,6 +87145,11 @@ func (s *Server) handleReposDeleteWebhookRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81833,6 +87170,9 @@ func (s *Server) handleReposDeleteWebhookRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -81848,8 +87188,9 @@ func (s *Server) handleReposDeleteWebhookRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -81967,6 +87308,11 @@ func (s *Server) handleReposDisableAutomatedSecurityFixesRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -81987,6 +87333,9 @@ func (s *Server) handleReposDisableAutomatedSecurityFixesRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82002,8 +87351,9 @@ func (s *Server) handleReposDisableAutomatedSecurityFixesRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82114,6 +87464,11 @@ func (s *Server) handleReposDisableLfsForRepoRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82134,6 +87489,9 @@ func (s *Server) handleReposDisableLfsForRepoRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82149,8 +87507,9 @@ func (s *Server) handleReposDisableLfsForRepoRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82265,6 +87624,11 @@ func (s *Server) handleReposDisableVulnerabilityAlertsRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82285,6 +87649,9 @@ func (s *Server) handleReposDisableVulnerabilityAlertsRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82300,8 +87667,9 @@ func (s *Server) handleReposDisableVulnerabilityAlertsRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82416,6 +87784,11 @@ func (s *Server) handleReposDownloadTarballArchiveRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82436,6 +87809,9 @@ func (s *Server) handleReposDownloadTarballArchiveRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82451,8 +87827,9 @@ func (s *Server) handleReposDownloadTarballArchiveRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82571,6 +87948,11 @@ func (s *Server) handleReposDownloadZipballArchiveRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82591,6 +87973,9 @@ func (s *Server) handleReposDownloadZipballArchiveRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82606,8 +87991,9 @@ func (s *Server) handleReposDownloadZipballArchiveRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82725,6 +88111,11 @@ func (s *Server) handleReposEnableAutomatedSecurityFixesRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82745,6 +88136,9 @@ func (s *Server) handleReposEnableAutomatedSecurityFixesRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82760,8 +88154,9 @@ func (s *Server) handleReposEnableAutomatedSecurityFixesRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -82872,6 +88267,11 @@ func (s *Server) handleReposEnableLfsForRepoRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -82892,6 +88292,9 @@ func (s *Server) handleReposEnableLfsForRepoRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -82907,8 +88310,9 @@ func (s *Server) handleReposEnableLfsForRepoRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83023,6 +88427,11 @@ func (s *Server) handleReposEnableVulnerabilityAlertsRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83043,6 +88452,9 @@ func (s *Server) handleReposEnableVulnerabilityAlertsRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83058,8 +88470,9 @@ func (s *Server) handleReposEnableVulnerabilityAlertsRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83171,6 +88584,11 @@ func (s *Server) handleReposGetRequest(args [2]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83191,6 +88609,9 @@ func (s *Server) handleReposGetRequest(args [2]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83206,8 +88627,9 @@ func (s *Server) handleReposGetRequest(args [2]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83327,6 +88749,11 @@ func (s *Server) handleReposGetAccessRestrictionsRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83347,6 +88774,9 @@ func (s *Server) handleReposGetAccessRestrictionsRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83362,8 +88792,9 @@ func (s *Server) handleReposGetAccessRestrictionsRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83483,6 +88914,11 @@ func (s *Server) handleReposGetAdminBranchProtectionRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83503,6 +88939,9 @@ func (s *Server) handleReposGetAdminBranchProtectionRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83518,8 +88957,9 @@ func (s *Server) handleReposGetAdminBranchProtectionRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83639,6 +89079,11 @@ func (s *Server) handleReposGetAllStatusCheckContextsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83659,6 +89104,9 @@ func (s *Server) handleReposGetAllStatusCheckContextsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83674,8 +89122,9 @@ func (s *Server) handleReposGetAllStatusCheckContextsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83790,6 +89239,11 @@ func (s *Server) handleReposGetAllTopicsRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83810,6 +89264,9 @@ func (s *Server) handleReposGetAllTopicsRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83825,8 +89282,9 @@ func (s *Server) handleReposGetAllTopicsRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -83953,6 +89411,11 @@ func (s *Server) handleReposGetAppsWithAccessToProtectedBranchRequest(args [3]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -83973,6 +89436,9 @@ func (s *Server) handleReposGetAppsWithAccessToProtectedBranchRequest(args [3]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -83988,8 +89454,9 @@ func (s *Server) handleReposGetAppsWithAccessToProtectedBranchRequest(args [3]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84106,6 +89573,11 @@ func (s *Server) handleReposGetAutolinkRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84126,6 +89598,9 @@ func (s *Server) handleReposGetAutolinkRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84141,8 +89616,9 @@ func (s *Server) handleReposGetAutolinkRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84257,6 +89733,11 @@ func (s *Server) handleReposGetBranchRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84277,6 +89758,9 @@ func (s *Server) handleReposGetBranchRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84292,8 +89776,9 @@ func (s *Server) handleReposGetBranchRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84413,6 +89898,11 @@ func (s *Server) handleReposGetBranchProtectionRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84433,6 +89923,9 @@ func (s *Server) handleReposGetBranchProtectionRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84448,8 +89941,9 @@ func (s *Server) handleReposGetBranchProtectionRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84565,6 +90059,11 @@ func (s *Server) handleReposGetClonesRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84585,6 +90084,9 @@ func (s *Server) handleReposGetClonesRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84600,8 +90102,9 @@ func (s *Server) handleReposGetClonesRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84716,6 +90219,11 @@ func (s *Server) handleReposGetCodeFrequencyStatsRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84736,6 +90244,9 @@ func (s *Server) handleReposGetCodeFrequencyStatsRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84751,8 +90262,9 @@ func (s *Server) handleReposGetCodeFrequencyStatsRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -84864,6 +90376,11 @@ func (s *Server) handleReposGetCollaboratorPermissionLevelRequest(args [3]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -84884,6 +90401,9 @@ func (s *Server) handleReposGetCollaboratorPermissionLevelRequest(args [3]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -84899,8 +90419,9 @@ func (s *Server) handleReposGetCollaboratorPermissionLevelRequest(args [3]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85027,6 +90548,11 @@ func (s *Server) handleReposGetCombinedStatusForRefRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85047,6 +90573,9 @@ func (s *Server) handleReposGetCombinedStatusForRefRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85062,8 +90591,9 @@ func (s *Server) handleReposGetCombinedStatusForRefRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85230,6 +90760,11 @@ func (s *Server) handleReposGetCommitRequest(args [3]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85250,6 +90785,9 @@ func (s *Server) handleReposGetCommitRequest(args [3]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85265,8 +90803,9 @@ func (s *Server) handleReposGetCommitRequest(args [3]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85390,6 +90929,11 @@ func (s *Server) handleReposGetCommitActivityStatsRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85410,6 +90954,9 @@ func (s *Server) handleReposGetCommitActivityStatsRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85425,8 +90972,9 @@ func (s *Server) handleReposGetCommitActivityStatsRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85537,6 +91085,11 @@ func (s *Server) handleReposGetCommitCommentRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85557,6 +91110,9 @@ func (s *Server) handleReposGetCommitCommentRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85572,8 +91128,9 @@ func (s *Server) handleReposGetCommitCommentRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85700,6 +91257,11 @@ func (s *Server) handleReposGetCommitSignatureProtectionRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85720,6 +91282,9 @@ func (s *Server) handleReposGetCommitSignatureProtectionRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85735,8 +91300,9 @@ func (s *Server) handleReposGetCommitSignatureProtectionRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -85860,6 +91426,11 @@ func (s *Server) handleReposGetCommunityProfileMetricsRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -85880,6 +91451,9 @@ func (s *Server) handleReposGetCommunityProfileMetricsRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -85895,8 +91469,9 @@ func (s *Server) handleReposGetCommunityProfileMetricsRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86015,6 +91590,11 @@ func (s *Server) handleReposGetContributorsStatsRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86035,6 +91615,9 @@ func (s *Server) handleReposGetContributorsStatsRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86050,8 +91633,9 @@ func (s *Server) handleReposGetContributorsStatsRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86162,6 +91746,11 @@ func (s *Server) handleReposGetDeployKeyRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86182,6 +91771,9 @@ func (s *Server) handleReposGetDeployKeyRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86197,8 +91789,9 @@ func (s *Server) handleReposGetDeployKeyRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86313,6 +91906,11 @@ func (s *Server) handleReposGetDeploymentRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86333,6 +91931,9 @@ func (s *Server) handleReposGetDeploymentRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86348,8 +91949,9 @@ func (s *Server) handleReposGetDeploymentRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86464,6 +92066,11 @@ func (s *Server) handleReposGetDeploymentStatusRequest(args [4]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86484,6 +92091,9 @@ func (s *Server) handleReposGetDeploymentStatusRequest(args [4]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86499,8 +92109,9 @@ func (s *Server) handleReposGetDeploymentStatusRequest(args [4]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86619,6 +92230,11 @@ func (s *Server) handleReposGetLatestPagesBuildRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86639,6 +92255,9 @@ func (s *Server) handleReposGetLatestPagesBuildRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86654,8 +92273,9 @@ func (s *Server) handleReposGetLatestPagesBuildRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86770,6 +92390,11 @@ func (s *Server) handleReposGetLatestReleaseRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86790,6 +92415,9 @@ func (s *Server) handleReposGetLatestReleaseRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86805,8 +92433,9 @@ func (s *Server) handleReposGetLatestReleaseRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -86917,6 +92546,11 @@ func (s *Server) handleReposGetPagesRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -86937,6 +92571,9 @@ func (s *Server) handleReposGetPagesRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -86952,8 +92589,9 @@ func (s *Server) handleReposGetPagesRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87064,6 +92702,11 @@ func (s *Server) handleReposGetPagesBuildRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87084,6 +92727,9 @@ func (s *Server) handleReposGetPagesBuildRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87099,8 +92745,9 @@ func (s *Server) handleReposGetPagesBuildRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87223,6 +92870,11 @@ func (s *Server) handleReposGetPagesHealthCheckRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87243,6 +92895,9 @@ func (s *Server) handleReposGetPagesHealthCheckRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87258,8 +92913,9 @@ func (s *Server) handleReposGetPagesHealthCheckRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87374,6 +93030,11 @@ func (s *Server) handleReposGetParticipationStatsRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87394,6 +93055,9 @@ func (s *Server) handleReposGetParticipationStatsRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87409,8 +93073,9 @@ func (s *Server) handleReposGetParticipationStatsRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87526,6 +93191,11 @@ func (s *Server) handleReposGetPullRequestReviewProtectionRequest(args [3]string
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87546,6 +93216,9 @@ func (s *Server) handleReposGetPullRequestReviewProtectionRequest(args [3]string
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87561,8 +93234,9 @@ func (s *Server) handleReposGetPullRequestReviewProtectionRequest(args [3]string
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87684,6 +93358,11 @@ func (s *Server) handleReposGetPunchCardStatsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87704,6 +93383,9 @@ func (s *Server) handleReposGetPunchCardStatsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87719,8 +93401,9 @@ func (s *Server) handleReposGetPunchCardStatsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87835,6 +93518,11 @@ func (s *Server) handleReposGetReadmeRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -87855,6 +93543,9 @@ func (s *Server) handleReposGetReadmeRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -87870,8 +93561,9 @@ func (s *Server) handleReposGetReadmeRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -87990,6 +93682,11 @@ func (s *Server) handleReposGetReadmeInDirectoryRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88010,6 +93707,9 @@ func (s *Server) handleReposGetReadmeInDirectoryRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88025,8 +93725,9 @@ func (s *Server) handleReposGetReadmeInDirectoryRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88148,6 +93849,11 @@ func (s *Server) handleReposGetReleaseRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88168,6 +93874,9 @@ func (s *Server) handleReposGetReleaseRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88183,8 +93892,9 @@ func (s *Server) handleReposGetReleaseRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88303,6 +94013,11 @@ func (s *Server) handleReposGetReleaseAssetRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88323,6 +94038,9 @@ func (s *Server) handleReposGetReleaseAssetRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88338,8 +94056,9 @@ func (s *Server) handleReposGetReleaseAssetRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88454,6 +94173,11 @@ func (s *Server) handleReposGetReleaseByTagRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88474,6 +94198,9 @@ func (s *Server) handleReposGetReleaseByTagRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88489,8 +94216,9 @@ func (s *Server) handleReposGetReleaseByTagRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88610,6 +94338,11 @@ func (s *Server) handleReposGetStatusChecksProtectionRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88630,6 +94363,9 @@ func (s *Server) handleReposGetStatusChecksProtectionRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88645,8 +94381,9 @@ func (s *Server) handleReposGetStatusChecksProtectionRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88768,6 +94505,11 @@ func (s *Server) handleReposGetTeamsWithAccessToProtectedBranchRequest(args [3]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88788,6 +94530,9 @@ func (s *Server) handleReposGetTeamsWithAccessToProtectedBranchRequest(args [3]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88803,8 +94548,9 @@ func (s *Server) handleReposGetTeamsWithAccessToProtectedBranchRequest(args [3]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -88919,6 +94665,11 @@ func (s *Server) handleReposGetTopPathsRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -88939,6 +94690,9 @@ func (s *Server) handleReposGetTopPathsRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -88954,8 +94708,9 @@ func (s *Server) handleReposGetTopPathsRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89066,6 +94821,11 @@ func (s *Server) handleReposGetTopReferrersRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89086,6 +94846,9 @@ func (s *Server) handleReposGetTopReferrersRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89101,8 +94864,9 @@ func (s *Server) handleReposGetTopReferrersRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89220,6 +94984,11 @@ func (s *Server) handleReposGetUsersWithAccessToProtectedBranchRequest(args [3]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89240,6 +95009,9 @@ func (s *Server) handleReposGetUsersWithAccessToProtectedBranchRequest(args [3]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89255,8 +95027,9 @@ func (s *Server) handleReposGetUsersWithAccessToProtectedBranchRequest(args [3]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89372,6 +95145,11 @@ func (s *Server) handleReposGetViewsRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89392,6 +95170,9 @@ func (s *Server) handleReposGetViewsRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89407,8 +95188,9 @@ func (s *Server) handleReposGetViewsRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89526,6 +95308,11 @@ func (s *Server) handleReposGetWebhookRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89546,6 +95333,9 @@ func (s *Server) handleReposGetWebhookRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89561,8 +95351,9 @@ func (s *Server) handleReposGetWebhookRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89683,6 +95474,11 @@ func (s *Server) handleReposGetWebhookConfigForRepoRequest(args [3]string, argsE
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89703,6 +95499,9 @@ func (s *Server) handleReposGetWebhookConfigForRepoRequest(args [3]string, argsE
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89718,8 +95517,9 @@ func (s *Server) handleReposGetWebhookConfigForRepoRequest(args [3]string, argsE
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89834,6 +95634,11 @@ func (s *Server) handleReposGetWebhookDeliveryRequest(args [4]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -89854,6 +95659,9 @@ func (s *Server) handleReposGetWebhookDeliveryRequest(args [4]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -89869,8 +95677,9 @@ func (s *Server) handleReposGetWebhookDeliveryRequest(args [4]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -89991,6 +95800,11 @@ func (s *Server) handleReposListAutolinksRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90011,6 +95825,9 @@ func (s *Server) handleReposListAutolinksRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90026,8 +95843,9 @@ func (s *Server) handleReposListAutolinksRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90142,6 +95960,11 @@ func (s *Server) handleReposListBranchesRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90162,6 +95985,9 @@ func (s *Server) handleReposListBranchesRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90177,8 +96003,9 @@ func (s *Server) handleReposListBranchesRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90308,6 +96135,11 @@ func (s *Server) handleReposListBranchesForHeadCommitRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90328,6 +96160,9 @@ func (s *Server) handleReposListBranchesForHeadCommitRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90343,8 +96178,9 @@ func (s *Server) handleReposListBranchesForHeadCommitRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90464,6 +96300,11 @@ func (s *Server) handleReposListCollaboratorsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90484,6 +96325,9 @@ func (s *Server) handleReposListCollaboratorsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90499,8 +96343,9 @@ func (s *Server) handleReposListCollaboratorsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90623,6 +96468,11 @@ func (s *Server) handleReposListCommentsForCommitRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90643,6 +96493,9 @@ func (s *Server) handleReposListCommentsForCommitRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90658,8 +96511,9 @@ func (s *Server) handleReposListCommentsForCommitRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90788,6 +96642,11 @@ func (s *Server) handleReposListCommitCommentsForRepoRequest(args [2]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90808,6 +96667,9 @@ func (s *Server) handleReposListCommitCommentsForRepoRequest(args [2]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90823,8 +96685,9 @@ func (s *Server) handleReposListCommitCommentsForRepoRequest(args [2]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -90947,6 +96810,11 @@ func (s *Server) handleReposListCommitStatusesForRefRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -90967,6 +96835,9 @@ func (s *Server) handleReposListCommitStatusesForRefRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -90982,8 +96853,9 @@ func (s *Server) handleReposListCommitStatusesForRefRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91134,6 +97006,11 @@ func (s *Server) handleReposListCommitsRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91154,6 +97031,9 @@ func (s *Server) handleReposListCommitsRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91169,8 +97049,9 @@ func (s *Server) handleReposListCommitsRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91316,6 +97197,11 @@ func (s *Server) handleReposListContributorsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91336,6 +97222,9 @@ func (s *Server) handleReposListContributorsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91351,8 +97240,9 @@ func (s *Server) handleReposListContributorsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91475,6 +97365,11 @@ func (s *Server) handleReposListDeployKeysRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91495,6 +97390,9 @@ func (s *Server) handleReposListDeployKeysRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91510,8 +97408,9 @@ func (s *Server) handleReposListDeployKeysRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91630,6 +97529,11 @@ func (s *Server) handleReposListDeploymentStatusesRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91650,6 +97554,9 @@ func (s *Server) handleReposListDeploymentStatusesRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91665,8 +97572,9 @@ func (s *Server) handleReposListDeploymentStatusesRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91789,6 +97697,11 @@ func (s *Server) handleReposListDeploymentsRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91809,6 +97722,9 @@ func (s *Server) handleReposListDeploymentsRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91824,8 +97740,9 @@ func (s *Server) handleReposListDeploymentsRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -91964,6 +97881,11 @@ func (s *Server) handleReposListForAuthenticatedUserRequest(args [0]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -91984,6 +97906,9 @@ func (s *Server) handleReposListForAuthenticatedUserRequest(args [0]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -91999,8 +97924,9 @@ func (s *Server) handleReposListForAuthenticatedUserRequest(args [0]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92139,6 +98065,11 @@ func (s *Server) handleReposListForOrgRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92159,6 +98090,9 @@ func (s *Server) handleReposListForOrgRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92174,8 +98108,9 @@ func (s *Server) handleReposListForOrgRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92303,6 +98238,11 @@ func (s *Server) handleReposListForUserRequest(args [1]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92323,6 +98263,9 @@ func (s *Server) handleReposListForUserRequest(args [1]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92338,8 +98281,9 @@ func (s *Server) handleReposListForUserRequest(args [1]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92466,6 +98410,11 @@ func (s *Server) handleReposListForksRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92486,6 +98435,9 @@ func (s *Server) handleReposListForksRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92501,8 +98453,9 @@ func (s *Server) handleReposListForksRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92626,6 +98579,11 @@ func (s *Server) handleReposListInvitationsRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92646,6 +98604,9 @@ func (s *Server) handleReposListInvitationsRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92661,8 +98622,9 @@ func (s *Server) handleReposListInvitationsRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92782,6 +98744,11 @@ func (s *Server) handleReposListInvitationsForAuthenticatedUserRequest(args [0]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92802,6 +98769,9 @@ func (s *Server) handleReposListInvitationsForAuthenticatedUserRequest(args [0]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92817,8 +98787,9 @@ func (s *Server) handleReposListInvitationsForAuthenticatedUserRequest(args [0]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -92930,6 +98901,11 @@ func (s *Server) handleReposListLanguagesRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -92950,6 +98926,9 @@ func (s *Server) handleReposListLanguagesRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -92965,8 +98944,9 @@ func (s *Server) handleReposListLanguagesRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93077,6 +99057,11 @@ func (s *Server) handleReposListPagesBuildsRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93097,6 +99082,9 @@ func (s *Server) handleReposListPagesBuildsRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93112,8 +99100,9 @@ func (s *Server) handleReposListPagesBuildsRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93241,6 +99230,11 @@ func (s *Server) handleReposListPublicRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93261,6 +99255,9 @@ func (s *Server) handleReposListPublicRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93276,8 +99273,9 @@ func (s *Server) handleReposListPublicRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93391,6 +99389,11 @@ func (s *Server) handleReposListPullRequestsAssociatedWithCommitRequest(args [3]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93411,6 +99414,9 @@ func (s *Server) handleReposListPullRequestsAssociatedWithCommitRequest(args [3]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93426,8 +99432,9 @@ func (s *Server) handleReposListPullRequestsAssociatedWithCommitRequest(args [3]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93550,6 +99557,11 @@ func (s *Server) handleReposListReleaseAssetsRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93570,6 +99582,9 @@ func (s *Server) handleReposListReleaseAssetsRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93585,8 +99600,9 @@ func (s *Server) handleReposListReleaseAssetsRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93715,6 +99731,11 @@ func (s *Server) handleReposListReleasesRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93735,6 +99756,9 @@ func (s *Server) handleReposListReleasesRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93750,8 +99774,9 @@ func (s *Server) handleReposListReleasesRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -93870,6 +99895,11 @@ func (s *Server) handleReposListTagsRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -93890,6 +99920,9 @@ func (s *Server) handleReposListTagsRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93905,8 +99938,9 @@ func (s *Server) handleReposListTagsRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94025,6 +100059,11 @@ func (s *Server) handleReposListTeamsRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94045,6 +100084,9 @@ func (s *Server) handleReposListTeamsRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94060,8 +100102,9 @@ func (s *Server) handleReposListTeamsRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94180,6 +100223,11 @@ func (s *Server) handleReposListWebhookDeliveriesRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94200,6 +100248,9 @@ func (s *Server) handleReposListWebhookDeliveriesRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94215,8 +100266,9 @@ func (s *Server) handleReposListWebhookDeliveriesRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94339,6 +100391,11 @@ func (s *Server) handleReposListWebhooksRequest(args [2]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94359,6 +100416,9 @@ func (s *Server) handleReposListWebhooksRequest(args [2]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94374,8 +100434,9 @@ func (s *Server) handleReposListWebhooksRequest(args [2]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94494,6 +100555,15 @@ func (s *Server) handleReposMergeRequest(args [2]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94514,6 +100584,10 @@ func (s *Server) handleReposMergeRequest(args [2]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94529,8 +100603,9 @@ func (s *Server) handleReposMergeRequest(args [2]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94658,6 +100733,15 @@ func (s *Server) handleReposMergeUpstreamRequest(args [2]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94678,6 +100762,10 @@ func (s *Server) handleReposMergeUpstreamRequest(args [2]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94693,8 +100781,9 @@ func (s *Server) handleReposMergeUpstreamRequest(args [2]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94822,6 +100911,11 @@ func (s *Server) handleReposPingWebhookRequest(args [3]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94842,6 +100936,9 @@ func (s *Server) handleReposPingWebhookRequest(args [3]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -94857,8 +100954,9 @@ func (s *Server) handleReposPingWebhookRequest(args [3]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -94973,6 +101071,11 @@ func (s *Server) handleReposRedeliverWebhookDeliveryRequest(args [4]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -94993,6 +101096,9 @@ func (s *Server) handleReposRedeliverWebhookDeliveryRequest(args [4]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95008,8 +101114,9 @@ func (s *Server) handleReposRedeliverWebhookDeliveryRequest(args [4]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95140,6 +101247,15 @@ func (s *Server) handleReposRemoveAppAccessRestrictionsRequest(args [3]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95160,6 +101276,10 @@ func (s *Server) handleReposRemoveAppAccessRestrictionsRequest(args [3]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95175,8 +101295,9 @@ func (s *Server) handleReposRemoveAppAccessRestrictionsRequest(args [3]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95306,6 +101427,11 @@ func (s *Server) handleReposRemoveCollaboratorRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95326,6 +101452,9 @@ func (s *Server) handleReposRemoveCollaboratorRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95341,8 +101470,9 @@ func (s *Server) handleReposRemoveCollaboratorRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95462,6 +101592,15 @@ func (s *Server) handleReposRemoveStatusCheckContextsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95482,6 +101621,10 @@ func (s *Server) handleReposRemoveStatusCheckContextsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95497,8 +101640,9 @@ func (s *Server) handleReposRemoveStatusCheckContextsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95633,6 +101777,11 @@ func (s *Server) handleReposRemoveStatusCheckProtectionRequest(args [3]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95653,6 +101802,9 @@ func (s *Server) handleReposRemoveStatusCheckProtectionRequest(args [3]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95668,8 +101820,9 @@ func (s *Server) handleReposRemoveStatusCheckProtectionRequest(args [3]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95796,6 +101949,15 @@ func (s *Server) handleReposRemoveTeamAccessRestrictionsRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95816,6 +101978,10 @@ func (s *Server) handleReposRemoveTeamAccessRestrictionsRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -95831,8 +101997,9 @@ func (s *Server) handleReposRemoveTeamAccessRestrictionsRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -95973,6 +102140,15 @@ func (s *Server) handleReposRemoveUserAccessRestrictionsRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -95993,6 +102169,10 @@ func (s *Server) handleReposRemoveUserAccessRestrictionsRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96008,8 +102188,9 @@ func (s *Server) handleReposRemoveUserAccessRestrictionsRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96158,6 +102339,15 @@ func (s *Server) handleReposRenameBranchRequest(args [3]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -96178,6 +102368,10 @@ func (s *Server) handleReposRenameBranchRequest(args [3]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96193,8 +102387,9 @@ func (s *Server) handleReposRenameBranchRequest(args [3]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96324,6 +102519,15 @@ func (s *Server) handleReposReplaceAllTopicsRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -96344,6 +102548,10 @@ func (s *Server) handleReposReplaceAllTopicsRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96359,8 +102567,9 @@ func (s *Server) handleReposReplaceAllTopicsRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96492,6 +102701,11 @@ func (s *Server) handleReposRequestPagesBuildRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -96512,6 +102726,9 @@ func (s *Server) handleReposRequestPagesBuildRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96527,8 +102744,9 @@ func (s *Server) handleReposRequestPagesBuildRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96647,6 +102865,11 @@ func (s *Server) handleReposSetAdminBranchProtectionRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -96667,6 +102890,9 @@ func (s *Server) handleReposSetAdminBranchProtectionRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96682,8 +102908,9 @@ func (s *Server) handleReposSetAdminBranchProtectionRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96812,6 +103039,15 @@ func (s *Server) handleReposSetAppAccessRestrictionsRequest(args [3]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -96832,6 +103068,10 @@ func (s *Server) handleReposSetAppAccessRestrictionsRequest(args [3]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -96847,8 +103087,9 @@ func (s *Server) handleReposSetAppAccessRestrictionsRequest(args [3]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -96983,6 +103224,15 @@ func (s *Server) handleReposSetStatusCheckContextsRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97003,6 +103253,10 @@ func (s *Server) handleReposSetStatusCheckContextsRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97018,8 +103272,9 @@ func (s *Server) handleReposSetStatusCheckContextsRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -97162,6 +103417,15 @@ func (s *Server) handleReposSetTeamAccessRestrictionsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97182,6 +103446,10 @@ func (s *Server) handleReposSetTeamAccessRestrictionsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97197,8 +103465,9 @@ func (s *Server) handleReposSetTeamAccessRestrictionsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -97340,6 +103609,15 @@ func (s *Server) handleReposSetUserAccessRestrictionsRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97360,6 +103638,10 @@ func (s *Server) handleReposSetUserAccessRestrictionsRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97375,8 +103657,9 @@ func (s *Server) handleReposSetUserAccessRestrictionsRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -97510,6 +103793,11 @@ func (s *Server) handleReposTestPushWebhookRequest(args [3]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97530,6 +103818,9 @@ func (s *Server) handleReposTestPushWebhookRequest(args [3]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97545,8 +103836,9 @@ func (s *Server) handleReposTestPushWebhookRequest(args [3]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -97666,6 +103958,15 @@ func (s *Server) handleReposTransferRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97686,6 +103987,10 @@ func (s *Server) handleReposTransferRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97701,8 +104006,9 @@ func (s *Server) handleReposTransferRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -97830,6 +104136,15 @@ func (s *Server) handleReposUpdateRequest(args [2]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -97850,6 +104165,10 @@ func (s *Server) handleReposUpdateRequest(args [2]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -97865,8 +104184,9 @@ func (s *Server) handleReposUpdateRequest(args [2]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98003,6 +104323,15 @@ func (s *Server) handleReposUpdateBranchProtectionRequest(args [3]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98023,6 +104352,10 @@ func (s *Server) handleReposUpdateBranchProtectionRequest(args [3]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98038,8 +104371,9 @@ func (s *Server) handleReposUpdateBranchProtectionRequest(args [3]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98169,6 +104503,15 @@ func (s *Server) handleReposUpdateCommitCommentRequest(args [3]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98189,6 +104532,10 @@ func (s *Server) handleReposUpdateCommitCommentRequest(args [3]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98204,8 +104551,9 @@ func (s *Server) handleReposUpdateCommitCommentRequest(args [3]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98335,6 +104683,15 @@ func (s *Server) handleReposUpdateInvitationRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98355,6 +104712,10 @@ func (s *Server) handleReposUpdateInvitationRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98370,8 +104731,9 @@ func (s *Server) handleReposUpdateInvitationRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98511,6 +104873,15 @@ func (s *Server) handleReposUpdatePullRequestReviewProtectionRequest(args [3]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98531,6 +104902,10 @@ func (s *Server) handleReposUpdatePullRequestReviewProtectionRequest(args [3]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98546,8 +104921,9 @@ func (s *Server) handleReposUpdatePullRequestReviewProtectionRequest(args [3]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98677,6 +105053,15 @@ func (s *Server) handleReposUpdateReleaseRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98697,6 +105082,10 @@ func (s *Server) handleReposUpdateReleaseRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98712,8 +105101,9 @@ func (s *Server) handleReposUpdateReleaseRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -98843,6 +105233,15 @@ func (s *Server) handleReposUpdateReleaseAssetRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -98863,6 +105262,10 @@ func (s *Server) handleReposUpdateReleaseAssetRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -98878,8 +105281,9 @@ func (s *Server) handleReposUpdateReleaseAssetRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99017,6 +105421,15 @@ func (s *Server) handleReposUpdateStatusCheckProtectionRequest(args [3]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99037,6 +105450,10 @@ func (s *Server) handleReposUpdateStatusCheckProtectionRequest(args [3]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99052,8 +105469,9 @@ func (s *Server) handleReposUpdateStatusCheckProtectionRequest(args [3]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99187,6 +105605,15 @@ func (s *Server) handleReposUpdateWebhookRequest(args [3]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99207,6 +105634,10 @@ func (s *Server) handleReposUpdateWebhookRequest(args [3]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99222,8 +105653,9 @@ func (s *Server) handleReposUpdateWebhookRequest(args [3]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99359,6 +105791,15 @@ func (s *Server) handleReposUpdateWebhookConfigForRepoRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99379,6 +105820,10 @@ func (s *Server) handleReposUpdateWebhookConfigForRepoRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99394,8 +105839,9 @@ func (s *Server) handleReposUpdateWebhookConfigForRepoRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99558,6 +106004,15 @@ func (s *Server) handleReposUploadReleaseAssetRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99578,6 +106033,10 @@ func (s *Server) handleReposUploadReleaseAssetRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99593,8 +106052,9 @@ func (s *Server) handleReposUploadReleaseAssetRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99732,6 +106192,11 @@ func (s *Server) handleScimDeleteUserFromOrgRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99752,6 +106217,9 @@ func (s *Server) handleScimDeleteUserFromOrgRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99767,8 +106235,9 @@ func (s *Server) handleScimDeleteUserFromOrgRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -99879,6 +106348,11 @@ func (s *Server) handleScimGetProvisioningInformationForUserRequest(args [2]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -99899,6 +106373,9 @@ func (s *Server) handleScimGetProvisioningInformationForUserRequest(args [2]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -99914,8 +106391,9 @@ func (s *Server) handleScimGetProvisioningInformationForUserRequest(args [2]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100059,6 +106537,11 @@ func (s *Server) handleScimListProvisionedIdentitiesRequest(args [1]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100079,6 +106562,9 @@ func (s *Server) handleScimListProvisionedIdentitiesRequest(args [1]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100094,8 +106580,9 @@ func (s *Server) handleScimListProvisionedIdentitiesRequest(args [1]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100214,6 +106701,15 @@ func (s *Server) handleScimProvisionAndInviteUserRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100234,6 +106730,10 @@ func (s *Server) handleScimProvisionAndInviteUserRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100249,8 +106749,9 @@ func (s *Server) handleScimProvisionAndInviteUserRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100382,6 +106883,15 @@ func (s *Server) handleScimSetInformationForProvisionedUserRequest(args [2]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100402,6 +106912,10 @@ func (s *Server) handleScimSetInformationForProvisionedUserRequest(args [2]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100417,8 +106931,9 @@ func (s *Server) handleScimSetInformationForProvisionedUserRequest(args [2]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100565,6 +107080,15 @@ func (s *Server) handleScimUpdateAttributeForUserRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100585,6 +107109,10 @@ func (s *Server) handleScimUpdateAttributeForUserRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100600,8 +107128,9 @@ func (s *Server) handleScimUpdateAttributeForUserRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100754,6 +107283,11 @@ func (s *Server) handleSearchCodeRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100774,6 +107308,9 @@ func (s *Server) handleSearchCodeRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100789,8 +107326,9 @@ func (s *Server) handleSearchCodeRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -100927,6 +107465,11 @@ func (s *Server) handleSearchCommitsRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -100947,6 +107490,9 @@ func (s *Server) handleSearchCommitsRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -100962,8 +107508,9 @@ func (s *Server) handleSearchCommitsRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101110,6 +107657,11 @@ func (s *Server) handleSearchIssuesAndPullRequestsRequest(args [0]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101130,6 +107682,9 @@ func (s *Server) handleSearchIssuesAndPullRequestsRequest(args [0]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -101145,8 +107700,9 @@ func (s *Server) handleSearchIssuesAndPullRequestsRequest(args [0]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101284,6 +107840,11 @@ func (s *Server) handleSearchLabelsRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101304,6 +107865,9 @@ func (s *Server) handleSearchLabelsRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -101319,8 +107883,9 @@ func (s *Server) handleSearchLabelsRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101469,6 +108034,11 @@ func (s *Server) handleSearchReposRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101489,6 +108059,9 @@ func (s *Server) handleSearchReposRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -101504,8 +108077,9 @@ func (s *Server) handleSearchReposRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101646,6 +108220,11 @@ func (s *Server) handleSearchTopicsRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101666,6 +108245,9 @@ func (s *Server) handleSearchTopicsRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -101681,8 +108263,9 @@ func (s *Server) handleSearchTopicsRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101812,6 +108395,11 @@ func (s *Server) handleSearchUsersRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101832,6 +108420,9 @@ func (s *Server) handleSearchUsersRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -101847,8 +108438,9 @@ func (s *Server) handleSearchUsersRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -101975,6 +108567,11 @@ func (s *Server) handleSecretScanningGetAlertRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -101995,6 +108592,9 @@ func (s *Server) handleSecretScanningGetAlertRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102010,8 +108610,9 @@ func (s *Server) handleSecretScanningGetAlertRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -102130,6 +108731,11 @@ func (s *Server) handleSecretScanningListAlertsForOrgRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -102150,6 +108756,9 @@ func (s *Server) handleSecretScanningListAlertsForOrgRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102165,8 +108774,9 @@ func (s *Server) handleSecretScanningListAlertsForOrgRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -102293,6 +108903,11 @@ func (s *Server) handleSecretScanningListAlertsForRepoRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -102313,6 +108928,9 @@ func (s *Server) handleSecretScanningListAlertsForRepoRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102328,8 +108946,9 @@ func (s *Server) handleSecretScanningListAlertsForRepoRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -102460,6 +109079,15 @@ func (s *Server) handleSecretScanningUpdateAlertRequest(args [3]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -102480,6 +109108,10 @@ func (s *Server) handleSecretScanningUpdateAlertRequest(args [3]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102495,8 +109127,9 @@ func (s *Server) handleSecretScanningUpdateAlertRequest(args [3]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -102653,6 +109286,11 @@ func (s *Server) handleTeamsAddMemberLegacyRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -102673,6 +109311,9 @@ func (s *Server) handleTeamsAddMemberLegacyRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102688,8 +109329,9 @@ func (s *Server) handleTeamsAddMemberLegacyRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -102827,6 +109469,15 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserInOrgRequest(args [3]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -102847,6 +109498,10 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserInOrgRequest(args [3]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -102862,8 +109517,9 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserInOrgRequest(args [3]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103025,6 +109681,15 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserLegacyRequest(args [2]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103045,6 +109710,10 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserLegacyRequest(args [2]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103060,8 +109729,9 @@ func (s *Server) handleTeamsAddOrUpdateMembershipForUserLegacyRequest(args [2]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103192,6 +109862,15 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsInOrgRequest(args [3]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103212,6 +109891,10 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsInOrgRequest(args [3]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103227,8 +109910,9 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsInOrgRequest(args [3]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103368,6 +110052,15 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsLegacyRequest(args [2]s
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103388,6 +110081,10 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsLegacyRequest(args [2]s
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103403,8 +110100,9 @@ func (s *Server) handleTeamsAddOrUpdateProjectPermissionsLegacyRequest(args [2]s
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103545,6 +110243,15 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsInOrgRequest(args [4]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103565,6 +110272,10 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsInOrgRequest(args [4]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103580,8 +110291,9 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsInOrgRequest(args [4]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103731,6 +110443,15 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsLegacyRequest(args [3]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103751,6 +110472,10 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsLegacyRequest(args [3]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103766,8 +110491,9 @@ func (s *Server) handleTeamsAddOrUpdateRepoPermissionsLegacyRequest(args [3]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -103901,6 +110627,11 @@ func (s *Server) handleTeamsCheckPermissionsForProjectInOrgRequest(args [3]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -103921,6 +110652,9 @@ func (s *Server) handleTeamsCheckPermissionsForProjectInOrgRequest(args [3]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -103936,8 +110670,9 @@ func (s *Server) handleTeamsCheckPermissionsForProjectInOrgRequest(args [3]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104061,6 +110796,11 @@ func (s *Server) handleTeamsCheckPermissionsForProjectLegacyRequest(args [2]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104081,6 +110821,9 @@ func (s *Server) handleTeamsCheckPermissionsForProjectLegacyRequest(args [2]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104096,8 +110839,9 @@ func (s *Server) handleTeamsCheckPermissionsForProjectLegacyRequest(args [2]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104221,6 +110965,11 @@ func (s *Server) handleTeamsCheckPermissionsForRepoInOrgRequest(args [4]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104241,6 +110990,9 @@ func (s *Server) handleTeamsCheckPermissionsForRepoInOrgRequest(args [4]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104256,8 +111008,9 @@ func (s *Server) handleTeamsCheckPermissionsForRepoInOrgRequest(args [4]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104388,6 +111141,11 @@ func (s *Server) handleTeamsCheckPermissionsForRepoLegacyRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104408,6 +111166,9 @@ func (s *Server) handleTeamsCheckPermissionsForRepoLegacyRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104423,8 +111184,9 @@ func (s *Server) handleTeamsCheckPermissionsForRepoLegacyRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104547,6 +111309,15 @@ func (s *Server) handleTeamsCreateRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104567,6 +111338,10 @@ func (s *Server) handleTeamsCreateRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104582,8 +111357,9 @@ func (s *Server) handleTeamsCreateRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104718,6 +111494,15 @@ func (s *Server) handleTeamsCreateDiscussionCommentInOrgRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104738,6 +111523,10 @@ func (s *Server) handleTeamsCreateDiscussionCommentInOrgRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104753,8 +111542,9 @@ func (s *Server) handleTeamsCreateDiscussionCommentInOrgRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -104900,6 +111690,15 @@ func (s *Server) handleTeamsCreateDiscussionCommentLegacyRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -104920,6 +111719,10 @@ func (s *Server) handleTeamsCreateDiscussionCommentLegacyRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -104935,8 +111738,9 @@ func (s *Server) handleTeamsCreateDiscussionCommentLegacyRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105075,6 +111879,15 @@ func (s *Server) handleTeamsCreateDiscussionInOrgRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105095,6 +111908,10 @@ func (s *Server) handleTeamsCreateDiscussionInOrgRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105110,8 +111927,9 @@ func (s *Server) handleTeamsCreateDiscussionInOrgRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105253,6 +112071,15 @@ func (s *Server) handleTeamsCreateDiscussionLegacyRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105273,6 +112100,10 @@ func (s *Server) handleTeamsCreateDiscussionLegacyRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105288,8 +112119,9 @@ func (s *Server) handleTeamsCreateDiscussionLegacyRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105421,6 +112253,15 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsInOrgRequest(args [
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105441,6 +112282,10 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsInOrgRequest(args [
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105456,8 +112301,9 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsInOrgRequest(args [
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105597,6 +112443,15 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsLegacyRequest(args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105617,6 +112472,10 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsLegacyRequest(args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105632,8 +112491,9 @@ func (s *Server) handleTeamsCreateOrUpdateIdpGroupConnectionsLegacyRequest(args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105760,6 +112620,11 @@ func (s *Server) handleTeamsDeleteDiscussionCommentInOrgRequest(args [4]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105780,6 +112645,9 @@ func (s *Server) handleTeamsDeleteDiscussionCommentInOrgRequest(args [4]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105795,8 +112663,9 @@ func (s *Server) handleTeamsDeleteDiscussionCommentInOrgRequest(args [4]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -105923,6 +112792,11 @@ func (s *Server) handleTeamsDeleteDiscussionCommentLegacyRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -105943,6 +112817,9 @@ func (s *Server) handleTeamsDeleteDiscussionCommentLegacyRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -105958,8 +112835,9 @@ func (s *Server) handleTeamsDeleteDiscussionCommentLegacyRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106079,6 +112957,11 @@ func (s *Server) handleTeamsDeleteDiscussionInOrgRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106099,6 +112982,9 @@ func (s *Server) handleTeamsDeleteDiscussionInOrgRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106114,8 +113000,9 @@ func (s *Server) handleTeamsDeleteDiscussionInOrgRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106238,6 +113125,11 @@ func (s *Server) handleTeamsDeleteDiscussionLegacyRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106258,6 +113150,9 @@ func (s *Server) handleTeamsDeleteDiscussionLegacyRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106273,8 +113168,9 @@ func (s *Server) handleTeamsDeleteDiscussionLegacyRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106390,6 +113286,11 @@ func (s *Server) handleTeamsDeleteInOrgRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106410,6 +113311,9 @@ func (s *Server) handleTeamsDeleteInOrgRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106425,8 +113329,9 @@ func (s *Server) handleTeamsDeleteInOrgRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106546,6 +113451,11 @@ func (s *Server) handleTeamsDeleteLegacyRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106566,6 +113476,9 @@ func (s *Server) handleTeamsDeleteLegacyRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106581,8 +113494,9 @@ func (s *Server) handleTeamsDeleteLegacyRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106692,6 +113606,11 @@ func (s *Server) handleTeamsGetByNameRequest(args [2]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106712,6 +113631,9 @@ func (s *Server) handleTeamsGetByNameRequest(args [2]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106727,8 +113649,9 @@ func (s *Server) handleTeamsGetByNameRequest(args [2]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -106845,6 +113768,11 @@ func (s *Server) handleTeamsGetDiscussionCommentInOrgRequest(args [4]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -106865,6 +113793,9 @@ func (s *Server) handleTeamsGetDiscussionCommentInOrgRequest(args [4]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -106880,8 +113811,9 @@ func (s *Server) handleTeamsGetDiscussionCommentInOrgRequest(args [4]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107009,6 +113941,11 @@ func (s *Server) handleTeamsGetDiscussionCommentLegacyRequest(args [3]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107029,6 +113966,9 @@ func (s *Server) handleTeamsGetDiscussionCommentLegacyRequest(args [3]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107044,8 +113984,9 @@ func (s *Server) handleTeamsGetDiscussionCommentLegacyRequest(args [3]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107166,6 +114107,11 @@ func (s *Server) handleTeamsGetDiscussionInOrgRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107186,6 +114132,9 @@ func (s *Server) handleTeamsGetDiscussionInOrgRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107201,8 +114150,9 @@ func (s *Server) handleTeamsGetDiscussionInOrgRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107326,6 +114276,11 @@ func (s *Server) handleTeamsGetDiscussionLegacyRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107346,6 +114301,9 @@ func (s *Server) handleTeamsGetDiscussionLegacyRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107361,8 +114319,9 @@ func (s *Server) handleTeamsGetDiscussionLegacyRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107478,6 +114437,11 @@ func (s *Server) handleTeamsGetLegacyRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107498,6 +114462,9 @@ func (s *Server) handleTeamsGetLegacyRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107513,8 +114480,9 @@ func (s *Server) handleTeamsGetLegacyRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107630,6 +114598,11 @@ func (s *Server) handleTeamsGetMemberLegacyRequest(args [2]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107650,6 +114623,9 @@ func (s *Server) handleTeamsGetMemberLegacyRequest(args [2]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107665,8 +114641,9 @@ func (s *Server) handleTeamsGetMemberLegacyRequest(args [2]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107789,6 +114766,11 @@ func (s *Server) handleTeamsGetMembershipForUserInOrgRequest(args [3]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107809,6 +114791,9 @@ func (s *Server) handleTeamsGetMembershipForUserInOrgRequest(args [3]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107824,8 +114809,9 @@ func (s *Server) handleTeamsGetMembershipForUserInOrgRequest(args [3]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -107955,6 +114941,11 @@ func (s *Server) handleTeamsGetMembershipForUserLegacyRequest(args [2]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -107975,6 +114966,9 @@ func (s *Server) handleTeamsGetMembershipForUserLegacyRequest(args [2]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -107990,8 +114984,9 @@ func (s *Server) handleTeamsGetMembershipForUserLegacyRequest(args [2]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108102,6 +115097,11 @@ func (s *Server) handleTeamsListRequest(args [1]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108122,6 +115122,9 @@ func (s *Server) handleTeamsListRequest(args [1]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108137,8 +115140,9 @@ func (s *Server) handleTeamsListRequest(args [1]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108256,6 +115260,11 @@ func (s *Server) handleTeamsListChildInOrgRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108276,6 +115285,9 @@ func (s *Server) handleTeamsListChildInOrgRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108291,8 +115303,9 @@ func (s *Server) handleTeamsListChildInOrgRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108416,6 +115429,11 @@ func (s *Server) handleTeamsListChildLegacyRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108436,6 +115454,9 @@ func (s *Server) handleTeamsListChildLegacyRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108451,8 +115472,9 @@ func (s *Server) handleTeamsListChildLegacyRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108572,6 +115594,11 @@ func (s *Server) handleTeamsListDiscussionCommentsInOrgRequest(args [3]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108592,6 +115619,9 @@ func (s *Server) handleTeamsListDiscussionCommentsInOrgRequest(args [3]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108607,8 +115637,9 @@ func (s *Server) handleTeamsListDiscussionCommentsInOrgRequest(args [3]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108743,6 +115774,11 @@ func (s *Server) handleTeamsListDiscussionCommentsLegacyRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108763,6 +115799,9 @@ func (s *Server) handleTeamsListDiscussionCommentsLegacyRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108778,8 +115817,9 @@ func (s *Server) handleTeamsListDiscussionCommentsLegacyRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -108907,6 +115947,11 @@ func (s *Server) handleTeamsListDiscussionsInOrgRequest(args [2]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -108927,6 +115972,9 @@ func (s *Server) handleTeamsListDiscussionsInOrgRequest(args [2]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -108942,8 +115990,9 @@ func (s *Server) handleTeamsListDiscussionsInOrgRequest(args [2]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109078,6 +116127,11 @@ func (s *Server) handleTeamsListDiscussionsLegacyRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109098,6 +116152,9 @@ func (s *Server) handleTeamsListDiscussionsLegacyRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109113,8 +116170,9 @@ func (s *Server) handleTeamsListDiscussionsLegacyRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109237,6 +116295,11 @@ func (s *Server) handleTeamsListForAuthenticatedUserRequest(args [0]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109257,6 +116320,9 @@ func (s *Server) handleTeamsListForAuthenticatedUserRequest(args [0]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109272,8 +116338,9 @@ func (s *Server) handleTeamsListForAuthenticatedUserRequest(args [0]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109395,6 +116462,11 @@ func (s *Server) handleTeamsListIdpGroupsForLegacyRequest(args [1]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109415,6 +116487,9 @@ func (s *Server) handleTeamsListIdpGroupsForLegacyRequest(args [1]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109430,8 +116505,9 @@ func (s *Server) handleTeamsListIdpGroupsForLegacyRequest(args [1]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109546,6 +116622,11 @@ func (s *Server) handleTeamsListIdpGroupsForOrgRequest(args [1]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109566,6 +116647,9 @@ func (s *Server) handleTeamsListIdpGroupsForOrgRequest(args [1]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109581,8 +116665,9 @@ func (s *Server) handleTeamsListIdpGroupsForOrgRequest(args [1]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109705,6 +116790,11 @@ func (s *Server) handleTeamsListIdpGroupsInOrgRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109725,6 +116815,9 @@ func (s *Server) handleTeamsListIdpGroupsInOrgRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109740,8 +116833,9 @@ func (s *Server) handleTeamsListIdpGroupsInOrgRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -109854,6 +116948,11 @@ func (s *Server) handleTeamsListMembersInOrgRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -109874,6 +116973,9 @@ func (s *Server) handleTeamsListMembersInOrgRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -109889,8 +116991,9 @@ func (s *Server) handleTeamsListMembersInOrgRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110020,6 +117123,11 @@ func (s *Server) handleTeamsListMembersLegacyRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110040,6 +117148,9 @@ func (s *Server) handleTeamsListMembersLegacyRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110055,8 +117166,9 @@ func (s *Server) handleTeamsListMembersLegacyRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110181,6 +117293,11 @@ func (s *Server) handleTeamsListPendingInvitationsInOrgRequest(args [2]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110201,6 +117318,9 @@ func (s *Server) handleTeamsListPendingInvitationsInOrgRequest(args [2]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110216,8 +117336,9 @@ func (s *Server) handleTeamsListPendingInvitationsInOrgRequest(args [2]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110346,6 +117467,11 @@ func (s *Server) handleTeamsListPendingInvitationsLegacyRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110366,6 +117492,9 @@ func (s *Server) handleTeamsListPendingInvitationsLegacyRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110381,8 +117510,9 @@ func (s *Server) handleTeamsListPendingInvitationsLegacyRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110500,6 +117630,11 @@ func (s *Server) handleTeamsListProjectsInOrgRequest(args [2]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110520,6 +117655,9 @@ func (s *Server) handleTeamsListProjectsInOrgRequest(args [2]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110535,8 +117673,9 @@ func (s *Server) handleTeamsListProjectsInOrgRequest(args [2]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110662,6 +117801,11 @@ func (s *Server) handleTeamsListProjectsLegacyRequest(args [1]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110682,6 +117826,9 @@ func (s *Server) handleTeamsListProjectsLegacyRequest(args [1]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110697,8 +117844,9 @@ func (s *Server) handleTeamsListProjectsLegacyRequest(args [1]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110816,6 +117964,11 @@ func (s *Server) handleTeamsListReposInOrgRequest(args [2]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110836,6 +117989,9 @@ func (s *Server) handleTeamsListReposInOrgRequest(args [2]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -110851,8 +118007,9 @@ func (s *Server) handleTeamsListReposInOrgRequest(args [2]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -110976,6 +118133,11 @@ func (s *Server) handleTeamsListReposLegacyRequest(args [1]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -110996,6 +118158,9 @@ func (s *Server) handleTeamsListReposLegacyRequest(args [1]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111011,8 +118176,9 @@ func (s *Server) handleTeamsListReposLegacyRequest(args [1]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111150,6 +118316,11 @@ func (s *Server) handleTeamsRemoveMemberLegacyRequest(args [2]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111170,6 +118341,9 @@ func (s *Server) handleTeamsRemoveMemberLegacyRequest(args [2]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111185,8 +118359,9 @@ func (s *Server) handleTeamsRemoveMemberLegacyRequest(args [2]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111315,6 +118490,11 @@ func (s *Server) handleTeamsRemoveMembershipForUserInOrgRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111335,6 +118515,9 @@ func (s *Server) handleTeamsRemoveMembershipForUserInOrgRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111350,8 +118533,9 @@ func (s *Server) handleTeamsRemoveMembershipForUserInOrgRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111487,6 +118671,11 @@ func (s *Server) handleTeamsRemoveMembershipForUserLegacyRequest(args [2]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111507,6 +118696,9 @@ func (s *Server) handleTeamsRemoveMembershipForUserLegacyRequest(args [2]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111522,8 +118714,9 @@ func (s *Server) handleTeamsRemoveMembershipForUserLegacyRequest(args [2]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111640,6 +118833,11 @@ func (s *Server) handleTeamsRemoveProjectInOrgRequest(args [3]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111660,6 +118858,9 @@ func (s *Server) handleTeamsRemoveProjectInOrgRequest(args [3]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111675,8 +118876,9 @@ func (s *Server) handleTeamsRemoveProjectInOrgRequest(args [3]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111801,6 +119003,11 @@ func (s *Server) handleTeamsRemoveProjectLegacyRequest(args [2]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111821,6 +119028,9 @@ func (s *Server) handleTeamsRemoveProjectLegacyRequest(args [2]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111836,8 +119046,9 @@ func (s *Server) handleTeamsRemoveProjectLegacyRequest(args [2]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -111954,6 +119165,11 @@ func (s *Server) handleTeamsRemoveRepoInOrgRequest(args [4]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -111974,6 +119190,9 @@ func (s *Server) handleTeamsRemoveRepoInOrgRequest(args [4]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -111989,8 +119208,9 @@ func (s *Server) handleTeamsRemoveRepoInOrgRequest(args [4]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112119,6 +119339,11 @@ func (s *Server) handleTeamsRemoveRepoLegacyRequest(args [3]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112139,6 +119364,9 @@ func (s *Server) handleTeamsRemoveRepoLegacyRequest(args [3]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -112154,8 +119382,9 @@ func (s *Server) handleTeamsRemoveRepoLegacyRequest(args [3]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112276,6 +119505,15 @@ func (s *Server) handleTeamsUpdateDiscussionCommentInOrgRequest(args [4]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112296,6 +119534,10 @@ func (s *Server) handleTeamsUpdateDiscussionCommentInOrgRequest(args [4]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -112311,8 +119553,9 @@ func (s *Server) handleTeamsUpdateDiscussionCommentInOrgRequest(args [4]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112455,6 +119698,15 @@ func (s *Server) handleTeamsUpdateDiscussionCommentLegacyRequest(args [3]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112475,6 +119727,10 @@ func (s *Server) handleTeamsUpdateDiscussionCommentLegacyRequest(args [3]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -112490,8 +119746,9 @@ func (s *Server) handleTeamsUpdateDiscussionCommentLegacyRequest(args [3]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112627,6 +119884,15 @@ func (s *Server) handleTeamsUpdateDiscussionInOrgRequest(args [3]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112647,6 +119913,10 @@ func (s *Server) handleTeamsUpdateDiscussionInOrgRequest(args [3]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -112662,8 +119932,9 @@ func (s *Server) handleTeamsUpdateDiscussionInOrgRequest(args [3]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112802,6 +120073,15 @@ func (s *Server) handleTeamsUpdateDiscussionLegacyRequest(args [2]string, argsEs
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112822,6 +120102,10 @@ func (s *Server) handleTeamsUpdateDiscussionLegacyRequest(args [2]string, argsEs
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -112837,8 +120121,9 @@ func (s *Server) handleTeamsUpdateDiscussionLegacyRequest(args [2]string, argsEs
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -112967,6 +120252,15 @@ func (s *Server) handleTeamsUpdateInOrgRequest(args [2]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -112987,6 +120281,10 @@ func (s *Server) handleTeamsUpdateInOrgRequest(args [2]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113002,8 +120300,9 @@ func (s *Server) handleTeamsUpdateInOrgRequest(args [2]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113138,6 +120437,15 @@ func (s *Server) handleTeamsUpdateLegacyRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113158,6 +120466,10 @@ func (s *Server) handleTeamsUpdateLegacyRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113173,8 +120485,9 @@ func (s *Server) handleTeamsUpdateLegacyRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113296,6 +120609,15 @@ func (s *Server) handleUsersAddEmailForAuthenticatedRequest(args [0]string, args
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113316,6 +120638,10 @@ func (s *Server) handleUsersAddEmailForAuthenticatedRequest(args [0]string, args
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113331,8 +120657,9 @@ func (s *Server) handleUsersAddEmailForAuthenticatedRequest(args [0]string, args
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113439,6 +120766,11 @@ func (s *Server) handleUsersBlockRequest(args [1]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113459,6 +120791,9 @@ func (s *Server) handleUsersBlockRequest(args [1]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113474,8 +120809,9 @@ func (s *Server) handleUsersBlockRequest(args [1]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113582,6 +120918,11 @@ func (s *Server) handleUsersCheckBlockedRequest(args [1]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113602,6 +120943,9 @@ func (s *Server) handleUsersCheckBlockedRequest(args [1]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113617,8 +120961,9 @@ func (s *Server) handleUsersCheckBlockedRequest(args [1]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113725,6 +121070,11 @@ func (s *Server) handleUsersCheckFollowingForUserRequest(args [2]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113745,6 +121095,9 @@ func (s *Server) handleUsersCheckFollowingForUserRequest(args [2]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113760,8 +121113,9 @@ func (s *Server) handleUsersCheckFollowingForUserRequest(args [2]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -113872,6 +121226,11 @@ func (s *Server) handleUsersCheckPersonIsFollowedByAuthenticatedRequest(args [1]
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -113892,6 +121251,9 @@ func (s *Server) handleUsersCheckPersonIsFollowedByAuthenticatedRequest(args [1]
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -113907,8 +121269,9 @@ func (s *Server) handleUsersCheckPersonIsFollowedByAuthenticatedRequest(args [1]
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114018,6 +121381,15 @@ func (s *Server) handleUsersCreateGpgKeyForAuthenticatedRequest(args [0]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114038,6 +121410,10 @@ func (s *Server) handleUsersCreateGpgKeyForAuthenticatedRequest(args [0]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114053,8 +121429,9 @@ func (s *Server) handleUsersCreateGpgKeyForAuthenticatedRequest(args [0]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114164,6 +121541,15 @@ func (s *Server) handleUsersCreatePublicSSHKeyForAuthenticatedRequest(args [0]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114184,6 +121570,10 @@ func (s *Server) handleUsersCreatePublicSSHKeyForAuthenticatedRequest(args [0]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114199,8 +121589,9 @@ func (s *Server) handleUsersCreatePublicSSHKeyForAuthenticatedRequest(args [0]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114307,6 +121698,15 @@ func (s *Server) handleUsersDeleteEmailForAuthenticatedRequest(args [0]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114327,6 +121727,10 @@ func (s *Server) handleUsersDeleteEmailForAuthenticatedRequest(args [0]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114342,8 +121746,9 @@ func (s *Server) handleUsersDeleteEmailForAuthenticatedRequest(args [0]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114453,6 +121858,11 @@ func (s *Server) handleUsersDeleteGpgKeyForAuthenticatedRequest(args [1]string,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114473,6 +121883,9 @@ func (s *Server) handleUsersDeleteGpgKeyForAuthenticatedRequest(args [1]string,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114488,8 +121901,9 @@ func (s *Server) handleUsersDeleteGpgKeyForAuthenticatedRequest(args [1]string,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114599,6 +122013,11 @@ func (s *Server) handleUsersDeletePublicSSHKeyForAuthenticatedRequest(args [1]st
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114619,6 +122038,9 @@ func (s *Server) handleUsersDeletePublicSSHKeyForAuthenticatedRequest(args [1]st
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114634,8 +122056,9 @@ func (s *Server) handleUsersDeletePublicSSHKeyForAuthenticatedRequest(args [1]st
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114748,6 +122171,11 @@ func (s *Server) handleUsersFollowRequest(args [1]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114768,6 +122196,9 @@ func (s *Server) handleUsersFollowRequest(args [1]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114783,8 +122214,9 @@ func (s *Server) handleUsersFollowRequest(args [1]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -114895,6 +122327,11 @@ func (s *Server) handleUsersGetAuthenticatedRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -114915,6 +122352,9 @@ func (s *Server) handleUsersGetAuthenticatedRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -114930,8 +122370,9 @@ func (s *Server) handleUsersGetAuthenticatedRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115038,6 +122479,11 @@ func (s *Server) handleUsersGetByUsernameRequest(args [1]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115058,6 +122504,9 @@ func (s *Server) handleUsersGetByUsernameRequest(args [1]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115073,8 +122522,9 @@ func (s *Server) handleUsersGetByUsernameRequest(args [1]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115190,6 +122640,11 @@ func (s *Server) handleUsersGetContextForUserRequest(args [1]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115210,6 +122665,9 @@ func (s *Server) handleUsersGetContextForUserRequest(args [1]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115225,8 +122683,9 @@ func (s *Server) handleUsersGetContextForUserRequest(args [1]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115344,6 +122803,11 @@ func (s *Server) handleUsersGetGpgKeyForAuthenticatedRequest(args [1]string, arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115364,6 +122828,9 @@ func (s *Server) handleUsersGetGpgKeyForAuthenticatedRequest(args [1]string, arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115379,8 +122846,9 @@ func (s *Server) handleUsersGetGpgKeyForAuthenticatedRequest(args [1]string, arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115490,6 +122958,11 @@ func (s *Server) handleUsersGetPublicSSHKeyForAuthenticatedRequest(args [1]strin
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115510,6 +122983,9 @@ func (s *Server) handleUsersGetPublicSSHKeyForAuthenticatedRequest(args [1]strin
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115525,8 +123001,9 @@ func (s *Server) handleUsersGetPublicSSHKeyForAuthenticatedRequest(args [1]strin
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115639,6 +123116,11 @@ func (s *Server) handleUsersListRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115659,6 +123141,9 @@ func (s *Server) handleUsersListRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115674,8 +123159,9 @@ func (s *Server) handleUsersListRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115786,6 +123272,11 @@ func (s *Server) handleUsersListBlockedByAuthenticatedRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115806,6 +123297,9 @@ func (s *Server) handleUsersListBlockedByAuthenticatedRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115821,8 +123315,9 @@ func (s *Server) handleUsersListBlockedByAuthenticatedRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -115911,6 +123406,11 @@ func (s *Server) handleUsersListEmailsForAuthenticatedRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -115931,6 +123431,9 @@ func (s *Server) handleUsersListEmailsForAuthenticatedRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -115946,8 +123449,9 @@ func (s *Server) handleUsersListEmailsForAuthenticatedRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116058,6 +123562,11 @@ func (s *Server) handleUsersListFollowedByAuthenticatedRequest(args [0]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116078,6 +123587,9 @@ func (s *Server) handleUsersListFollowedByAuthenticatedRequest(args [0]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116093,8 +123605,9 @@ func (s *Server) handleUsersListFollowedByAuthenticatedRequest(args [0]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116205,6 +123718,11 @@ func (s *Server) handleUsersListFollowersForAuthenticatedUserRequest(args [0]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116225,6 +123743,9 @@ func (s *Server) handleUsersListFollowersForAuthenticatedUserRequest(args [0]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116240,8 +123761,9 @@ func (s *Server) handleUsersListFollowersForAuthenticatedUserRequest(args [0]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116352,6 +123874,11 @@ func (s *Server) handleUsersListFollowersForUserRequest(args [1]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116372,6 +123899,9 @@ func (s *Server) handleUsersListFollowersForUserRequest(args [1]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116387,8 +123917,9 @@ func (s *Server) handleUsersListFollowersForUserRequest(args [1]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116503,6 +124034,11 @@ func (s *Server) handleUsersListFollowingForUserRequest(args [1]string, argsEsca
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116523,6 +124059,9 @@ func (s *Server) handleUsersListFollowingForUserRequest(args [1]string, argsEsca
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116538,8 +124077,9 @@ func (s *Server) handleUsersListFollowingForUserRequest(args [1]string, argsEsca
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116657,6 +124197,11 @@ func (s *Server) handleUsersListGpgKeysForAuthenticatedRequest(args [0]string, a
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116677,6 +124222,9 @@ func (s *Server) handleUsersListGpgKeysForAuthenticatedRequest(args [0]string, a
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116692,8 +124240,9 @@ func (s *Server) handleUsersListGpgKeysForAuthenticatedRequest(args [0]string, a
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116804,6 +124353,11 @@ func (s *Server) handleUsersListGpgKeysForUserRequest(args [1]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116824,6 +124378,9 @@ func (s *Server) handleUsersListGpgKeysForUserRequest(args [1]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116839,8 +124396,9 @@ func (s *Server) handleUsersListGpgKeysForUserRequest(args [1]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -116959,6 +124517,11 @@ func (s *Server) handleUsersListPublicEmailsForAuthenticatedRequest(args [0]stri
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -116979,6 +124542,9 @@ func (s *Server) handleUsersListPublicEmailsForAuthenticatedRequest(args [0]stri
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -116994,8 +124560,9 @@ func (s *Server) handleUsersListPublicEmailsForAuthenticatedRequest(args [0]stri
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117106,6 +124673,11 @@ func (s *Server) handleUsersListPublicKeysForUserRequest(args [1]string, argsEsc
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117126,6 +124698,9 @@ func (s *Server) handleUsersListPublicKeysForUserRequest(args [1]string, argsEsc
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117141,8 +124716,9 @@ func (s *Server) handleUsersListPublicKeysForUserRequest(args [1]string, argsEsc
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117260,6 +124836,11 @@ func (s *Server) handleUsersListPublicSSHKeysForAuthenticatedRequest(args [0]str
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117280,6 +124861,9 @@ func (s *Server) handleUsersListPublicSSHKeysForAuthenticatedRequest(args [0]str
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117295,8 +124879,9 @@ func (s *Server) handleUsersListPublicSSHKeysForAuthenticatedRequest(args [0]str
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117407,6 +124992,15 @@ func (s *Server) handleUsersSetPrimaryEmailVisibilityForAuthenticatedRequest(arg
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117427,6 +125021,10 @@ func (s *Server) handleUsersSetPrimaryEmailVisibilityForAuthenticatedRequest(arg
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117442,8 +125040,9 @@ func (s *Server) handleUsersSetPrimaryEmailVisibilityForAuthenticatedRequest(arg
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117550,6 +125149,11 @@ func (s *Server) handleUsersUnblockRequest(args [1]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117570,6 +125174,9 @@ func (s *Server) handleUsersUnblockRequest(args [1]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117585,8 +125192,9 @@ func (s *Server) handleUsersUnblockRequest(args [1]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117694,6 +125302,11 @@ func (s *Server) handleUsersUnfollowRequest(args [1]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117714,6 +125327,9 @@ func (s *Server) handleUsersUnfollowRequest(args [1]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117729,8 +125345,9 @@ func (s *Server) handleUsersUnfollowRequest(args [1]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -117839,6 +125456,15 @@ func (s *Server) handleUsersUpdateAuthenticatedRequest(args [0]string, argsEscap
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -117859,6 +125485,10 @@ func (s *Server) handleUsersUpdateAuthenticatedRequest(args [0]string, argsEscap
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -117874,8 +125504,9 @@ func (s *Server) handleUsersUpdateAuthenticatedRequest(args [0]string, argsEscap
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
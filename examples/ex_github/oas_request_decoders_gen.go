@@ -36,6 +36,9 @@ func (s *Server) decodeActionsCreateOrUpdateEnvironmentSecretRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -61,6 +64,9 @@ func (s *Server) decodeActionsCreateOrUpdateEnvironmentSecretRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsCreateOrUpdateEnvironmentSecretReq
@@ -115,6 +121,9 @@ func (s *Server) decodeActionsCreateOrUpdateOrgSecretRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -140,6 +149,9 @@ func (s *Server) decodeActionsCreateOrUpdateOrgSecretRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsCreateOrUpdateOrgSecretReq
@@ -194,6 +206,9 @@ func (s *Server) decodeActionsCreateOrUpdateRepoSecretRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -219,6 +234,9 @@ func (s *Server) decodeActionsCreateOrUpdateRepoSecretRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsCreateOrUpdateRepoSecretReq
@@ -273,6 +291,9 @@ func (s *Server) decodeActionsCreateSelfHostedRunnerGroupForOrgRequest(r *http.R
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -298,6 +319,9 @@ func (s *Server) decodeActionsCreateSelfHostedRunnerGroupForOrgRequest(r *http.R
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsCreateSelfHostedRunnerGroupForOrgReq
@@ -352,6 +376,9 @@ func (s *Server) decodeActionsCreateWorkflowDispatchRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -377,6 +404,9 @@ func (s *Server) decodeActionsCreateWorkflowDispatchRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsCreateWorkflowDispatchReq
@@ -431,6 +461,9 @@ func (s *Server) decodeActionsReviewPendingDeploymentsForRunRequest(r *http.Requ
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -456,6 +489,9 @@ func (s *Server) decodeActionsReviewPendingDeploymentsForRunRequest(r *http.Requ
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsReviewPendingDeploymentsForRunReq
@@ -510,6 +546,9 @@ func (s *Server) decodeActionsSetAllowedActionsOrganizationRequest(r *http.Reque
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -538,6 +577,9 @@ func (s *Server) decodeActionsSetAllowedActionsOrganizationRequest(r *http.Reque
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptSelectedActions
@@ -585,6 +627,9 @@ func (s *Server) decodeActionsSetAllowedActionsRepositoryRequest(r *http.Request
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -613,6 +658,9 @@ func (s *Server) decodeActionsSetAllowedActionsRepositoryRequest(r *http.Request
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptSelectedActions
@@ -660,6 +708,9 @@ func (s *Server) decodeActionsSetGithubActionsPermissionsOrganizationRequest(r *
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -685,6 +736,9 @@ func (s *Server) decodeActionsSetGithubActionsPermissionsOrganizationRequest(r *
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetGithubActionsPermissionsOrganizationReq
@@ -739,6 +793,9 @@ func (s *Server) decodeActionsSetGithubActionsPermissionsRepositoryRequest(r *ht
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -764,6 +821,9 @@ func (s *Server) decodeActionsSetGithubActionsPermissionsRepositoryRequest(r *ht
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetGithubActionsPermissionsRepositoryReq
@@ -818,6 +878,9 @@ func (s *Server) decodeActionsSetRepoAccessToSelfHostedRunnerGroupInOrgRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -843,6 +906,9 @@ func (s *Server) decodeActionsSetRepoAccessToSelfHostedRunnerGroupInOrgRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetRepoAccessToSelfHostedRunnerGroupInOrgReq
@@ -897,6 +963,9 @@ func (s *Server) decodeActionsSetSelectedReposForOrgSecretRequest(r *http.Reques
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -922,6 +991,9 @@ func (s *Server) decodeActionsSetSelectedReposForOrgSecretRequest(r *http.Reques
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetSelectedReposForOrgSecretReq
@@ -976,6 +1048,9 @@ func (s *Server) decodeActionsSetSelectedRepositoriesEnabledGithubActionsOrganiz
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1001,6 +1076,9 @@ func (s *Server) decodeActionsSetSelectedRepositoriesEnabledGithubActionsOrganiz
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetSelectedRepositoriesEnabledGithubActionsOrganizationReq
@@ -1055,6 +1133,9 @@ func (s *Server) decodeActionsSetSelfHostedRunnersInGroupForOrgRequest(r *http.R
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1080,6 +1161,9 @@ func (s *Server) decodeActionsSetSelfHostedRunnersInGroupForOrgRequest(r *http.R
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsSetSelfHostedRunnersInGroupForOrgReq
@@ -1134,6 +1218,9 @@ func (s *Server) decodeActionsUpdateSelfHostedRunnerGroupForOrgRequest(r *http.R
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1159,6 +1246,9 @@ func (s *Server) decodeActionsUpdateSelfHostedRunnerGroupForOrgRequest(r *http.R
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ActionsUpdateSelfHostedRunnerGroupForOrgReq
@@ -1213,6 +1303,9 @@ func (s *Server) decodeActivityMarkNotificationsAsReadRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1241,6 +1334,9 @@ func (s *Server) decodeActivityMarkNotificationsAsReadRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptActivityMarkNotificationsAsReadReq
@@ -1288,6 +1384,9 @@ func (s *Server) decodeActivityMarkRepoNotificationsAsReadRequest(r *http.Reques
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1316,6 +1415,9 @@ func (s *Server) decodeActivityMarkRepoNotificationsAsReadRequest(r *http.Reques
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptActivityMarkRepoNotificationsAsReadReq
@@ -1363,6 +1465,9 @@ func (s *Server) decodeActivitySetRepoSubscriptionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1391,6 +1496,9 @@ func (s *Server) decodeActivitySetRepoSubscriptionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptActivitySetRepoSubscriptionReq
@@ -1438,6 +1546,9 @@ func (s *Server) decodeActivitySetThreadSubscriptionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1466,6 +1577,9 @@ func (s *Server) decodeActivitySetThreadSubscriptionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptActivitySetThreadSubscriptionReq
@@ -1513,6 +1627,9 @@ func (s *Server) decodeAppsCheckTokenRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1538,6 +1655,9 @@ func (s *Server) decodeAppsCheckTokenRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsCheckTokenReq
@@ -1584,6 +1704,9 @@ func (s *Server) decodeAppsCreateContentAttachmentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1609,6 +1732,9 @@ func (s *Server) decodeAppsCreateContentAttachmentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsCreateContentAttachmentReq
@@ -1663,6 +1789,9 @@ func (s *Server) decodeAppsCreateFromManifestRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1691,6 +1820,9 @@ func (s *Server) decodeAppsCreateFromManifestRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request *AppsCreateFromManifestReq
@@ -1740,6 +1872,9 @@ func (s *Server) decodeAppsCreateInstallationAccessTokenRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1768,6 +1903,9 @@ func (s *Server) decodeAppsCreateInstallationAccessTokenRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptAppsCreateInstallationAccessTokenReq
@@ -1830,6 +1968,9 @@ func (s *Server) decodeAppsDeleteAuthorizationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1855,6 +1996,9 @@ func (s *Server) decodeAppsDeleteAuthorizationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsDeleteAuthorizationReq
@@ -1901,6 +2045,9 @@ func (s *Server) decodeAppsDeleteTokenRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1926,6 +2073,9 @@ func (s *Server) decodeAppsDeleteTokenRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsDeleteTokenReq
@@ -1972,6 +2122,9 @@ func (s *Server) decodeAppsResetTokenRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1997,6 +2150,9 @@ func (s *Server) decodeAppsResetTokenRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsResetTokenReq
@@ -2043,6 +2199,9 @@ func (s *Server) decodeAppsScopeTokenRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2068,6 +2227,9 @@ func (s *Server) decodeAppsScopeTokenRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AppsScopeTokenReq
@@ -2122,6 +2284,9 @@ func (s *Server) decodeAppsUpdateWebhookConfigForAppRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -2150,6 +2315,9 @@ func (s *Server) decodeAppsUpdateWebhookConfigForAppRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptAppsUpdateWebhookConfigForAppReq
@@ -2212,6 +2380,9 @@ func (s *Server) decodeChecksCreateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2237,6 +2408,9 @@ func (s *Server) decodeChecksCreateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ChecksCreateReq
@@ -2291,6 +2465,9 @@ func (s *Server) decodeChecksCreateSuiteRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2316,6 +2493,9 @@ func (s *Server) decodeChecksCreateSuiteRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ChecksCreateSuiteReq
@@ -2362,6 +2542,9 @@ func (s *Server) decodeChecksSetSuitesPreferencesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2387,6 +2570,9 @@ func (s *Server) decodeChecksSetSuitesPreferencesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ChecksSetSuitesPreferencesReq
@@ -2433,6 +2619,9 @@ func (s *Server) decodeCodeScanningUpdateAlertRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2458,6 +2647,9 @@ func (s *Server) decodeCodeScanningUpdateAlertRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CodeScanningUpdateAlertReq
@@ -2512,6 +2704,9 @@ func (s *Server) decodeCodeScanningUploadSarifRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2537,6 +2732,9 @@ func (s *Server) decodeCodeScanningUploadSarifRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CodeScanningUploadSarifReq
@@ -2591,6 +2789,9 @@ func (s *Server) decodeEnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseRe
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2616,6 +2817,9 @@ func (s *Server) decodeEnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseRe
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminCreateSelfHostedRunnerGroupForEnterpriseReq
@@ -2670,6 +2874,9 @@ func (s *Server) decodeEnterpriseAdminProvisionAndInviteEnterpriseGroupRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2695,6 +2902,9 @@ func (s *Server) decodeEnterpriseAdminProvisionAndInviteEnterpriseGroupRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminProvisionAndInviteEnterpriseGroupReq
@@ -2749,6 +2959,9 @@ func (s *Server) decodeEnterpriseAdminProvisionAndInviteEnterpriseUserRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2774,6 +2987,9 @@ func (s *Server) decodeEnterpriseAdminProvisionAndInviteEnterpriseUserRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminProvisionAndInviteEnterpriseUserReq
@@ -2828,6 +3044,9 @@ func (s *Server) decodeEnterpriseAdminSetAllowedActionsEnterpriseRequest(r *http
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2853,6 +3072,9 @@ func (s *Server) decodeEnterpriseAdminSetAllowedActionsEnterpriseRequest(r *http
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SelectedActions
@@ -2899,6 +3121,9 @@ func (s *Server) decodeEnterpriseAdminSetGithubActionsPermissionsEnterpriseReque
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2924,6 +3149,9 @@ func (s *Server) decodeEnterpriseAdminSetGithubActionsPermissionsEnterpriseReque
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetGithubActionsPermissionsEnterpriseReq
@@ -2978,6 +3206,9 @@ func (s *Server) decodeEnterpriseAdminSetInformationForProvisionedEnterpriseGrou
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3003,6 +3234,9 @@ func (s *Server) decodeEnterpriseAdminSetInformationForProvisionedEnterpriseGrou
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetInformationForProvisionedEnterpriseGroupReq
@@ -3057,6 +3291,9 @@ func (s *Server) decodeEnterpriseAdminSetInformationForProvisionedEnterpriseUser
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3082,6 +3319,9 @@ func (s *Server) decodeEnterpriseAdminSetInformationForProvisionedEnterpriseUser
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetInformationForProvisionedEnterpriseUserReq
@@ -3136,6 +3376,9 @@ func (s *Server) decodeEnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnter
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3161,6 +3404,9 @@ func (s *Server) decodeEnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnter
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetOrgAccessToSelfHostedRunnerGroupInEnterpriseReq
@@ -3215,6 +3461,9 @@ func (s *Server) decodeEnterpriseAdminSetSelectedOrganizationsEnabledGithubActio
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3240,6 +3489,9 @@ func (s *Server) decodeEnterpriseAdminSetSelectedOrganizationsEnabledGithubActio
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetSelectedOrganizationsEnabledGithubActionsEnterpriseReq
@@ -3294,6 +3546,9 @@ func (s *Server) decodeEnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseRe
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3319,6 +3574,9 @@ func (s *Server) decodeEnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseRe
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminSetSelfHostedRunnersInGroupForEnterpriseReq
@@ -3373,6 +3631,9 @@ func (s *Server) decodeEnterpriseAdminUpdateAttributeForEnterpriseGroupRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3398,6 +3659,9 @@ func (s *Server) decodeEnterpriseAdminUpdateAttributeForEnterpriseGroupRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminUpdateAttributeForEnterpriseGroupReq
@@ -3452,6 +3716,9 @@ func (s *Server) decodeEnterpriseAdminUpdateAttributeForEnterpriseUserRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3477,6 +3744,9 @@ func (s *Server) decodeEnterpriseAdminUpdateAttributeForEnterpriseUserRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EnterpriseAdminUpdateAttributeForEnterpriseUserReq
@@ -3531,6 +3801,9 @@ func (s *Server) decodeEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseRe
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -3559,6 +3832,9 @@ func (s *Server) decodeEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseRe
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptEnterpriseAdminUpdateSelfHostedRunnerGroupForEnterpriseReq
@@ -3621,6 +3897,9 @@ func (s *Server) decodeGistsCreateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3646,6 +3925,9 @@ func (s *Server) decodeGistsCreateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GistsCreateReq
@@ -3700,6 +3982,9 @@ func (s *Server) decodeGistsCreateCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3725,6 +4010,9 @@ func (s *Server) decodeGistsCreateCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GistsCreateCommentReq
@@ -3779,6 +4067,9 @@ func (s *Server) decodeGistsUpdateCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3804,6 +4095,9 @@ func (s *Server) decodeGistsUpdateCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GistsUpdateCommentReq
@@ -3858,6 +4152,9 @@ func (s *Server) decodeGitCreateBlobRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3883,6 +4180,9 @@ func (s *Server) decodeGitCreateBlobRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitCreateBlobReq
@@ -3929,6 +4229,9 @@ func (s *Server) decodeGitCreateCommitRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3954,6 +4257,9 @@ func (s *Server) decodeGitCreateCommitRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitCreateCommitReq
@@ -4000,6 +4306,9 @@ func (s *Server) decodeGitCreateRefRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4025,6 +4334,9 @@ func (s *Server) decodeGitCreateRefRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitCreateRefReq
@@ -4071,6 +4383,9 @@ func (s *Server) decodeGitCreateTagRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4096,6 +4411,9 @@ func (s *Server) decodeGitCreateTagRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitCreateTagReq
@@ -4150,6 +4468,9 @@ func (s *Server) decodeGitCreateTreeRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4175,6 +4496,9 @@ func (s *Server) decodeGitCreateTreeRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitCreateTreeReq
@@ -4229,6 +4553,9 @@ func (s *Server) decodeGitUpdateRefRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4254,6 +4581,9 @@ func (s *Server) decodeGitUpdateRefRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GitUpdateRefReq
@@ -4300,6 +4630,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForAuthenticatedUserRequest(r
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4325,6 +4658,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForAuthenticatedUserRequest(r
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request InteractionLimit
@@ -4379,6 +4715,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForOrgRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4404,6 +4743,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForOrgRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request InteractionLimit
@@ -4458,6 +4800,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForRepoRequest(r *http.Request
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4483,6 +4828,9 @@ func (s *Server) decodeInteractionsSetRestrictionsForRepoRequest(r *http.Request
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request InteractionLimit
@@ -4537,6 +4885,9 @@ func (s *Server) decodeIssuesAddAssigneesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -4565,6 +4916,9 @@ func (s *Server) decodeIssuesAddAssigneesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptIssuesAddAssigneesReq
@@ -4612,6 +4966,9 @@ func (s *Server) decodeIssuesCreateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4637,6 +4994,9 @@ func (s *Server) decodeIssuesCreateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request IssuesCreateReq
@@ -4683,6 +5043,9 @@ func (s *Server) decodeIssuesCreateCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4708,6 +5071,9 @@ func (s *Server) decodeIssuesCreateCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request IssuesCreateCommentReq
@@ -4754,6 +5120,9 @@ func (s *Server) decodeIssuesCreateLabelRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4779,6 +5148,9 @@ func (s *Server) decodeIssuesCreateLabelRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request IssuesCreateLabelReq
@@ -4825,6 +5197,9 @@ func (s *Server) decodeIssuesCreateMilestoneRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4850,6 +5225,9 @@ func (s *Server) decodeIssuesCreateMilestoneRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request IssuesCreateMilestoneReq
@@ -4904,6 +5282,9 @@ func (s *Server) decodeIssuesLockRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -4932,6 +5313,9 @@ func (s *Server) decodeIssuesLockRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilIssuesLockReq
@@ -4994,6 +5378,9 @@ func (s *Server) decodeIssuesRemoveAssigneesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5022,6 +5409,9 @@ func (s *Server) decodeIssuesRemoveAssigneesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptIssuesRemoveAssigneesReq
@@ -5069,6 +5459,9 @@ func (s *Server) decodeIssuesUpdateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5097,6 +5490,9 @@ func (s *Server) decodeIssuesUpdateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptIssuesUpdateReq
@@ -5159,6 +5555,9 @@ func (s *Server) decodeIssuesUpdateCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5184,6 +5583,9 @@ func (s *Server) decodeIssuesUpdateCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request IssuesUpdateCommentReq
@@ -5230,6 +5632,9 @@ func (s *Server) decodeIssuesUpdateLabelRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5258,6 +5663,9 @@ func (s *Server) decodeIssuesUpdateLabelRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptIssuesUpdateLabelReq
@@ -5305,6 +5713,9 @@ func (s *Server) decodeIssuesUpdateMilestoneRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5333,6 +5744,9 @@ func (s *Server) decodeIssuesUpdateMilestoneRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptIssuesUpdateMilestoneReq
@@ -5395,6 +5809,9 @@ func (s *Server) decodeMarkdownRenderRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5420,6 +5837,9 @@ func (s *Server) decodeMarkdownRenderRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MarkdownRenderReq
@@ -5475,6 +5895,9 @@ func (s *Server) decodeMarkdownRenderRawRequest(r *http.Request) (
 		}
 	}()
 	req = &MarkdownRenderRawReqEmptyBody{}
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5517,6 +5940,9 @@ func (s *Server) decodeMigrationsMapCommitAuthorRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5545,6 +5971,9 @@ func (s *Server) decodeMigrationsMapCommitAuthorRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptMigrationsMapCommitAuthorReq
@@ -5592,6 +6021,9 @@ func (s *Server) decodeMigrationsSetLfsPreferenceRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5617,6 +6049,9 @@ func (s *Server) decodeMigrationsSetLfsPreferenceRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MigrationsSetLfsPreferenceReq
@@ -5671,6 +6106,9 @@ func (s *Server) decodeMigrationsStartForAuthenticatedUserRequest(r *http.Reques
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5696,6 +6134,9 @@ func (s *Server) decodeMigrationsStartForAuthenticatedUserRequest(r *http.Reques
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MigrationsStartForAuthenticatedUserReq
@@ -5750,6 +6191,9 @@ func (s *Server) decodeMigrationsStartForOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5775,6 +6219,9 @@ func (s *Server) decodeMigrationsStartForOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MigrationsStartForOrgReq
@@ -5829,6 +6276,9 @@ func (s *Server) decodeMigrationsStartImportRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5854,6 +6304,9 @@ func (s *Server) decodeMigrationsStartImportRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request MigrationsStartImportReq
@@ -5908,6 +6361,9 @@ func (s *Server) decodeMigrationsUpdateImportRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5936,6 +6392,9 @@ func (s *Server) decodeMigrationsUpdateImportRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilMigrationsUpdateImportReq
@@ -5983,6 +6442,9 @@ func (s *Server) decodeOAuthAuthorizationsCreateAuthorizationRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6011,6 +6473,9 @@ func (s *Server) decodeOAuthAuthorizationsCreateAuthorizationRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOAuthAuthorizationsCreateAuthorizationReq
@@ -6073,6 +6538,9 @@ func (s *Server) decodeOAuthAuthorizationsGetOrCreateAuthorizationForAppRequest(
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6098,6 +6566,9 @@ func (s *Server) decodeOAuthAuthorizationsGetOrCreateAuthorizationForAppRequest(
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OAuthAuthorizationsGetOrCreateAuthorizationForAppReq
@@ -6152,6 +6623,9 @@ func (s *Server) decodeOAuthAuthorizationsGetOrCreateAuthorizationForAppAndFinge
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6177,6 +6651,9 @@ func (s *Server) decodeOAuthAuthorizationsGetOrCreateAuthorizationForAppAndFinge
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OAuthAuthorizationsGetOrCreateAuthorizationForAppAndFingerprintReq
@@ -6231,6 +6708,9 @@ func (s *Server) decodeOAuthAuthorizationsUpdateAuthorizationRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6259,6 +6739,9 @@ func (s *Server) decodeOAuthAuthorizationsUpdateAuthorizationRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOAuthAuthorizationsUpdateAuthorizationReq
@@ -6321,6 +6804,9 @@ func (s *Server) decodeOrgsCreateInvitationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6349,6 +6835,9 @@ func (s *Server) decodeOrgsCreateInvitationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOrgsCreateInvitationReq
@@ -6411,6 +6900,9 @@ func (s *Server) decodeOrgsCreateWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6436,6 +6928,9 @@ func (s *Server) decodeOrgsCreateWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OrgsCreateWebhookReq
@@ -6490,6 +6985,9 @@ func (s *Server) decodeOrgsSetMembershipForUserRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6518,6 +7016,9 @@ func (s *Server) decodeOrgsSetMembershipForUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOrgsSetMembershipForUserReq
@@ -6580,6 +7081,9 @@ func (s *Server) decodeOrgsUpdateMembershipForAuthenticatedUserRequest(r *http.R
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6605,6 +7109,9 @@ func (s *Server) decodeOrgsUpdateMembershipForAuthenticatedUserRequest(r *http.R
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OrgsUpdateMembershipForAuthenticatedUserReq
@@ -6659,6 +7166,9 @@ func (s *Server) decodeOrgsUpdateWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6687,6 +7197,9 @@ func (s *Server) decodeOrgsUpdateWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOrgsUpdateWebhookReq
@@ -6749,6 +7262,9 @@ func (s *Server) decodeOrgsUpdateWebhookConfigForOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6777,6 +7293,9 @@ func (s *Server) decodeOrgsUpdateWebhookConfigForOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptOrgsUpdateWebhookConfigForOrgReq
@@ -6839,6 +7358,9 @@ func (s *Server) decodeProjectsAddCollaboratorRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -6867,6 +7389,9 @@ func (s *Server) decodeProjectsAddCollaboratorRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilProjectsAddCollaboratorReq
@@ -6929,6 +7454,9 @@ func (s *Server) decodeProjectsCreateColumnRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6954,6 +7482,9 @@ func (s *Server) decodeProjectsCreateColumnRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsCreateColumnReq
@@ -7000,6 +7531,9 @@ func (s *Server) decodeProjectsCreateForAuthenticatedUserRequest(r *http.Request
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7025,6 +7559,9 @@ func (s *Server) decodeProjectsCreateForAuthenticatedUserRequest(r *http.Request
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsCreateForAuthenticatedUserReq
@@ -7071,6 +7608,9 @@ func (s *Server) decodeProjectsCreateForOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7096,6 +7636,9 @@ func (s *Server) decodeProjectsCreateForOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsCreateForOrgReq
@@ -7142,6 +7685,9 @@ func (s *Server) decodeProjectsCreateForRepoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7167,6 +7713,9 @@ func (s *Server) decodeProjectsCreateForRepoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsCreateForRepoReq
@@ -7213,6 +7762,9 @@ func (s *Server) decodeProjectsMoveCardRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7238,6 +7790,9 @@ func (s *Server) decodeProjectsMoveCardRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsMoveCardReq
@@ -7292,6 +7847,9 @@ func (s *Server) decodeProjectsMoveColumnRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7317,6 +7875,9 @@ func (s *Server) decodeProjectsMoveColumnRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsMoveColumnReq
@@ -7371,6 +7932,9 @@ func (s *Server) decodeProjectsUpdateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -7399,6 +7963,9 @@ func (s *Server) decodeProjectsUpdateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptProjectsUpdateReq
@@ -7461,6 +8028,9 @@ func (s *Server) decodeProjectsUpdateCardRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -7489,6 +8059,9 @@ func (s *Server) decodeProjectsUpdateCardRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptProjectsUpdateCardReq
@@ -7536,6 +8109,9 @@ func (s *Server) decodeProjectsUpdateColumnRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7561,6 +8137,9 @@ func (s *Server) decodeProjectsUpdateColumnRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ProjectsUpdateColumnReq
@@ -7607,6 +8186,9 @@ func (s *Server) decodePullsCreateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7632,6 +8214,9 @@ func (s *Server) decodePullsCreateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsCreateReq
@@ -7678,6 +8263,9 @@ func (s *Server) decodePullsCreateReplyForReviewCommentRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7703,6 +8291,9 @@ func (s *Server) decodePullsCreateReplyForReviewCommentRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsCreateReplyForReviewCommentReq
@@ -7749,6 +8340,9 @@ func (s *Server) decodePullsCreateReviewRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -7777,6 +8371,9 @@ func (s *Server) decodePullsCreateReviewRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptPullsCreateReviewReq
@@ -7839,6 +8436,9 @@ func (s *Server) decodePullsCreateReviewCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7864,6 +8464,9 @@ func (s *Server) decodePullsCreateReviewCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsCreateReviewCommentReq
@@ -7918,6 +8521,9 @@ func (s *Server) decodePullsDismissReviewRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -7943,6 +8549,9 @@ func (s *Server) decodePullsDismissReviewRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsDismissReviewReq
@@ -7989,6 +8598,9 @@ func (s *Server) decodePullsMergeRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -8017,6 +8629,9 @@ func (s *Server) decodePullsMergeRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilPullsMergeReq
@@ -8079,6 +8694,9 @@ func (s *Server) decodePullsRemoveRequestedReviewersRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8104,6 +8722,9 @@ func (s *Server) decodePullsRemoveRequestedReviewersRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsRemoveRequestedReviewersReq
@@ -8158,6 +8779,9 @@ func (s *Server) decodePullsSubmitReviewRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8183,6 +8807,9 @@ func (s *Server) decodePullsSubmitReviewRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsSubmitReviewReq
@@ -8237,6 +8864,9 @@ func (s *Server) decodePullsUpdateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -8265,6 +8895,9 @@ func (s *Server) decodePullsUpdateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptPullsUpdateReq
@@ -8327,6 +8960,9 @@ func (s *Server) decodePullsUpdateBranchRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -8355,6 +8991,9 @@ func (s *Server) decodePullsUpdateBranchRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilPullsUpdateBranchReq
@@ -8402,6 +9041,9 @@ func (s *Server) decodePullsUpdateReviewRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8427,6 +9069,9 @@ func (s *Server) decodePullsUpdateReviewRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsUpdateReviewReq
@@ -8473,6 +9118,9 @@ func (s *Server) decodePullsUpdateReviewCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8498,6 +9146,9 @@ func (s *Server) decodePullsUpdateReviewCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PullsUpdateReviewCommentReq
@@ -8544,6 +9195,9 @@ func (s *Server) decodeReactionsCreateForCommitCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8569,6 +9223,9 @@ func (s *Server) decodeReactionsCreateForCommitCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForCommitCommentReq
@@ -8623,6 +9280,9 @@ func (s *Server) decodeReactionsCreateForIssueRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8648,6 +9308,9 @@ func (s *Server) decodeReactionsCreateForIssueRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForIssueReq
@@ -8702,6 +9365,9 @@ func (s *Server) decodeReactionsCreateForIssueCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8727,6 +9393,9 @@ func (s *Server) decodeReactionsCreateForIssueCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForIssueCommentReq
@@ -8781,6 +9450,9 @@ func (s *Server) decodeReactionsCreateForPullRequestReviewCommentRequest(r *http
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8806,6 +9478,9 @@ func (s *Server) decodeReactionsCreateForPullRequestReviewCommentRequest(r *http
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForPullRequestReviewCommentReq
@@ -8860,6 +9535,9 @@ func (s *Server) decodeReactionsCreateForReleaseRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8885,6 +9563,9 @@ func (s *Server) decodeReactionsCreateForReleaseRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForReleaseReq
@@ -8939,6 +9620,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionCommentInOrgRequest(r *ht
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -8964,6 +9648,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionCommentInOrgRequest(r *ht
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForTeamDiscussionCommentInOrgReq
@@ -9018,6 +9705,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionCommentLegacyRequest(r *h
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9043,6 +9733,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionCommentLegacyRequest(r *h
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForTeamDiscussionCommentLegacyReq
@@ -9097,6 +9790,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionInOrgRequest(r *http.Requ
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9122,6 +9818,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionInOrgRequest(r *http.Requ
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForTeamDiscussionInOrgReq
@@ -9176,6 +9875,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionLegacyRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9201,6 +9903,9 @@ func (s *Server) decodeReactionsCreateForTeamDiscussionLegacyRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReactionsCreateForTeamDiscussionLegacyReq
@@ -9255,6 +9960,9 @@ func (s *Server) decodeReposAddAppAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -9283,6 +9991,9 @@ func (s *Server) decodeReposAddAppAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposAddAppAccessRestrictionsReq
@@ -9345,6 +10056,9 @@ func (s *Server) decodeReposAddCollaboratorRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -9373,6 +10087,9 @@ func (s *Server) decodeReposAddCollaboratorRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposAddCollaboratorReq
@@ -9435,6 +10152,9 @@ func (s *Server) decodeReposAddStatusCheckContextsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -9463,6 +10183,9 @@ func (s *Server) decodeReposAddStatusCheckContextsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposAddStatusCheckContextsReq
@@ -9525,6 +10248,9 @@ func (s *Server) decodeReposAddTeamAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -9553,6 +10279,9 @@ func (s *Server) decodeReposAddTeamAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposAddTeamAccessRestrictionsReq
@@ -9615,6 +10344,9 @@ func (s *Server) decodeReposAddUserAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -9643,6 +10375,9 @@ func (s *Server) decodeReposAddUserAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposAddUserAccessRestrictionsReq
@@ -9705,6 +10440,9 @@ func (s *Server) decodeReposCreateAutolinkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9730,6 +10468,9 @@ func (s *Server) decodeReposCreateAutolinkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateAutolinkReq
@@ -9776,6 +10517,9 @@ func (s *Server) decodeReposCreateCommitCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9801,6 +10545,9 @@ func (s *Server) decodeReposCreateCommitCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateCommitCommentReq
@@ -9847,6 +10594,9 @@ func (s *Server) decodeReposCreateCommitStatusRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9872,6 +10622,9 @@ func (s *Server) decodeReposCreateCommitStatusRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateCommitStatusReq
@@ -9926,6 +10679,9 @@ func (s *Server) decodeReposCreateDeployKeyRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -9951,6 +10707,9 @@ func (s *Server) decodeReposCreateDeployKeyRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateDeployKeyReq
@@ -9997,6 +10756,9 @@ func (s *Server) decodeReposCreateDeploymentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10022,6 +10784,9 @@ func (s *Server) decodeReposCreateDeploymentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateDeploymentReq
@@ -10068,6 +10833,9 @@ func (s *Server) decodeReposCreateDeploymentStatusRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10093,6 +10861,9 @@ func (s *Server) decodeReposCreateDeploymentStatusRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateDeploymentStatusReq
@@ -10147,6 +10918,9 @@ func (s *Server) decodeReposCreateDispatchEventRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10172,6 +10946,9 @@ func (s *Server) decodeReposCreateDispatchEventRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateDispatchEventReq
@@ -10226,6 +11003,9 @@ func (s *Server) decodeReposCreateForAuthenticatedUserRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10251,6 +11031,9 @@ func (s *Server) decodeReposCreateForAuthenticatedUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateForAuthenticatedUserReq
@@ -10297,6 +11080,9 @@ func (s *Server) decodeReposCreateForkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -10325,6 +11111,9 @@ func (s *Server) decodeReposCreateForkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilReposCreateForkReq
@@ -10372,6 +11161,9 @@ func (s *Server) decodeReposCreateInOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10397,6 +11189,9 @@ func (s *Server) decodeReposCreateInOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateInOrgReq
@@ -10451,6 +11246,9 @@ func (s *Server) decodeReposCreateOrUpdateFileContentsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10476,6 +11274,9 @@ func (s *Server) decodeReposCreateOrUpdateFileContentsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateOrUpdateFileContentsReq
@@ -10522,6 +11323,9 @@ func (s *Server) decodeReposCreatePagesSiteRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10547,6 +11351,9 @@ func (s *Server) decodeReposCreatePagesSiteRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request NilReposCreatePagesSiteReq
@@ -10608,6 +11415,9 @@ func (s *Server) decodeReposCreateReleaseRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10633,6 +11443,9 @@ func (s *Server) decodeReposCreateReleaseRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateReleaseReq
@@ -10679,6 +11492,9 @@ func (s *Server) decodeReposCreateUsingTemplateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10704,6 +11520,9 @@ func (s *Server) decodeReposCreateUsingTemplateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposCreateUsingTemplateReq
@@ -10750,6 +11569,9 @@ func (s *Server) decodeReposCreateWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -10778,6 +11600,9 @@ func (s *Server) decodeReposCreateWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilReposCreateWebhookReq
@@ -10840,6 +11665,9 @@ func (s *Server) decodeReposDeleteFileRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10865,6 +11693,9 @@ func (s *Server) decodeReposDeleteFileRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposDeleteFileReq
@@ -10911,6 +11742,9 @@ func (s *Server) decodeReposMergeRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -10936,6 +11770,9 @@ func (s *Server) decodeReposMergeRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposMergeReq
@@ -10982,6 +11819,9 @@ func (s *Server) decodeReposMergeUpstreamRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -11007,6 +11847,9 @@ func (s *Server) decodeReposMergeUpstreamRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposMergeUpstreamReq
@@ -11053,6 +11896,9 @@ func (s *Server) decodeReposRemoveAppAccessRestrictionsRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11081,6 +11927,9 @@ func (s *Server) decodeReposRemoveAppAccessRestrictionsRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposRemoveAppAccessRestrictionsReq
@@ -11143,6 +11992,9 @@ func (s *Server) decodeReposRemoveStatusCheckContextsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11171,6 +12023,9 @@ func (s *Server) decodeReposRemoveStatusCheckContextsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposRemoveStatusCheckContextsReq
@@ -11233,6 +12088,9 @@ func (s *Server) decodeReposRemoveTeamAccessRestrictionsRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11261,6 +12119,9 @@ func (s *Server) decodeReposRemoveTeamAccessRestrictionsRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposRemoveTeamAccessRestrictionsReq
@@ -11323,6 +12184,9 @@ func (s *Server) decodeReposRemoveUserAccessRestrictionsRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11351,6 +12215,9 @@ func (s *Server) decodeReposRemoveUserAccessRestrictionsRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposRemoveUserAccessRestrictionsReq
@@ -11413,6 +12280,9 @@ func (s *Server) decodeReposRenameBranchRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11441,6 +12311,9 @@ func (s *Server) decodeReposRenameBranchRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposRenameBranchReq
@@ -11488,6 +12361,9 @@ func (s *Server) decodeReposReplaceAllTopicsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -11513,6 +12389,9 @@ func (s *Server) decodeReposReplaceAllTopicsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposReplaceAllTopicsReq
@@ -11567,6 +12446,9 @@ func (s *Server) decodeReposSetAppAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11595,6 +12477,9 @@ func (s *Server) decodeReposSetAppAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposSetAppAccessRestrictionsReq
@@ -11657,6 +12542,9 @@ func (s *Server) decodeReposSetStatusCheckContextsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11685,6 +12573,9 @@ func (s *Server) decodeReposSetStatusCheckContextsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposSetStatusCheckContextsReq
@@ -11747,6 +12638,9 @@ func (s *Server) decodeReposSetTeamAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11775,6 +12669,9 @@ func (s *Server) decodeReposSetTeamAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposSetTeamAccessRestrictionsReq
@@ -11837,6 +12734,9 @@ func (s *Server) decodeReposSetUserAccessRestrictionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -11865,6 +12765,9 @@ func (s *Server) decodeReposSetUserAccessRestrictionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposSetUserAccessRestrictionsReq
@@ -11927,6 +12830,9 @@ func (s *Server) decodeReposTransferRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -11952,6 +12858,9 @@ func (s *Server) decodeReposTransferRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposTransferReq
@@ -11998,6 +12907,9 @@ func (s *Server) decodeReposUpdateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12026,6 +12938,9 @@ func (s *Server) decodeReposUpdateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateReq
@@ -12088,6 +13003,9 @@ func (s *Server) decodeReposUpdateBranchProtectionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -12113,6 +13031,9 @@ func (s *Server) decodeReposUpdateBranchProtectionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposUpdateBranchProtectionReq
@@ -12167,6 +13088,9 @@ func (s *Server) decodeReposUpdateCommitCommentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -12192,6 +13116,9 @@ func (s *Server) decodeReposUpdateCommitCommentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ReposUpdateCommitCommentReq
@@ -12238,6 +13165,9 @@ func (s *Server) decodeReposUpdateInvitationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12266,6 +13196,9 @@ func (s *Server) decodeReposUpdateInvitationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateInvitationReq
@@ -12328,6 +13261,9 @@ func (s *Server) decodeReposUpdatePullRequestReviewProtectionRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12356,6 +13292,9 @@ func (s *Server) decodeReposUpdatePullRequestReviewProtectionRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdatePullRequestReviewProtectionReq
@@ -12403,6 +13342,9 @@ func (s *Server) decodeReposUpdateReleaseRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12431,6 +13373,9 @@ func (s *Server) decodeReposUpdateReleaseRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateReleaseReq
@@ -12478,6 +13423,9 @@ func (s *Server) decodeReposUpdateReleaseAssetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12506,6 +13454,9 @@ func (s *Server) decodeReposUpdateReleaseAssetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateReleaseAssetReq
@@ -12553,6 +13504,9 @@ func (s *Server) decodeReposUpdateStatusCheckProtectionRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12581,6 +13535,9 @@ func (s *Server) decodeReposUpdateStatusCheckProtectionRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateStatusCheckProtectionReq
@@ -12628,6 +13585,9 @@ func (s *Server) decodeReposUpdateWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12656,6 +13616,9 @@ func (s *Server) decodeReposUpdateWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateWebhookReq
@@ -12718,6 +13681,9 @@ func (s *Server) decodeReposUpdateWebhookConfigForRepoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12746,6 +13712,9 @@ func (s *Server) decodeReposUpdateWebhookConfigForRepoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptReposUpdateWebhookConfigForRepoReq
@@ -12808,6 +13777,9 @@ func (s *Server) decodeReposUploadReleaseAssetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -12850,6 +13822,9 @@ func (s *Server) decodeScimProvisionAndInviteUserRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -12875,6 +13850,9 @@ func (s *Server) decodeScimProvisionAndInviteUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ScimProvisionAndInviteUserReq
@@ -12929,6 +13907,9 @@ func (s *Server) decodeScimSetInformationForProvisionedUserRequest(r *http.Reque
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -12954,6 +13935,9 @@ func (s *Server) decodeScimSetInformationForProvisionedUserRequest(r *http.Reque
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ScimSetInformationForProvisionedUserReq
@@ -13008,6 +13992,9 @@ func (s *Server) decodeScimUpdateAttributeForUserRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13033,6 +14020,9 @@ func (s *Server) decodeScimUpdateAttributeForUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ScimUpdateAttributeForUserReq
@@ -13087,6 +14077,9 @@ func (s *Server) decodeSecretScanningUpdateAlertRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13112,6 +14105,9 @@ func (s *Server) decodeSecretScanningUpdateAlertRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SecretScanningUpdateAlertReq
@@ -13166,6 +14162,9 @@ func (s *Server) decodeTeamsAddOrUpdateMembershipForUserInOrgRequest(r *http.Req
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13194,6 +14193,9 @@ func (s *Server) decodeTeamsAddOrUpdateMembershipForUserInOrgRequest(r *http.Req
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsAddOrUpdateMembershipForUserInOrgReq
@@ -13256,6 +14258,9 @@ func (s *Server) decodeTeamsAddOrUpdateMembershipForUserLegacyRequest(r *http.Re
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13284,6 +14289,9 @@ func (s *Server) decodeTeamsAddOrUpdateMembershipForUserLegacyRequest(r *http.Re
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsAddOrUpdateMembershipForUserLegacyReq
@@ -13346,6 +14354,9 @@ func (s *Server) decodeTeamsAddOrUpdateProjectPermissionsInOrgRequest(r *http.Re
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13374,6 +14385,9 @@ func (s *Server) decodeTeamsAddOrUpdateProjectPermissionsInOrgRequest(r *http.Re
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptNilTeamsAddOrUpdateProjectPermissionsInOrgReq
@@ -13436,6 +14450,9 @@ func (s *Server) decodeTeamsAddOrUpdateProjectPermissionsLegacyRequest(r *http.R
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13464,6 +14481,9 @@ func (s *Server) decodeTeamsAddOrUpdateProjectPermissionsLegacyRequest(r *http.R
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsAddOrUpdateProjectPermissionsLegacyReq
@@ -13526,6 +14546,9 @@ func (s *Server) decodeTeamsAddOrUpdateRepoPermissionsInOrgRequest(r *http.Reque
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13554,6 +14577,9 @@ func (s *Server) decodeTeamsAddOrUpdateRepoPermissionsInOrgRequest(r *http.Reque
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsAddOrUpdateRepoPermissionsInOrgReq
@@ -13616,6 +14642,9 @@ func (s *Server) decodeTeamsAddOrUpdateRepoPermissionsLegacyRequest(r *http.Requ
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -13644,6 +14673,9 @@ func (s *Server) decodeTeamsAddOrUpdateRepoPermissionsLegacyRequest(r *http.Requ
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsAddOrUpdateRepoPermissionsLegacyReq
@@ -13706,6 +14738,9 @@ func (s *Server) decodeTeamsCreateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13731,6 +14766,9 @@ func (s *Server) decodeTeamsCreateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateReq
@@ -13785,6 +14823,9 @@ func (s *Server) decodeTeamsCreateDiscussionCommentInOrgRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13810,6 +14851,9 @@ func (s *Server) decodeTeamsCreateDiscussionCommentInOrgRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateDiscussionCommentInOrgReq
@@ -13856,6 +14900,9 @@ func (s *Server) decodeTeamsCreateDiscussionCommentLegacyRequest(r *http.Request
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13881,6 +14928,9 @@ func (s *Server) decodeTeamsCreateDiscussionCommentLegacyRequest(r *http.Request
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateDiscussionCommentLegacyReq
@@ -13927,6 +14977,9 @@ func (s *Server) decodeTeamsCreateDiscussionInOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -13952,6 +15005,9 @@ func (s *Server) decodeTeamsCreateDiscussionInOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateDiscussionInOrgReq
@@ -13998,6 +15054,9 @@ func (s *Server) decodeTeamsCreateDiscussionLegacyRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14023,6 +15082,9 @@ func (s *Server) decodeTeamsCreateDiscussionLegacyRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateDiscussionLegacyReq
@@ -14069,6 +15131,9 @@ func (s *Server) decodeTeamsCreateOrUpdateIdpGroupConnectionsInOrgRequest(r *htt
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14094,6 +15159,9 @@ func (s *Server) decodeTeamsCreateOrUpdateIdpGroupConnectionsInOrgRequest(r *htt
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateOrUpdateIdpGroupConnectionsInOrgReq
@@ -14140,6 +15208,9 @@ func (s *Server) decodeTeamsCreateOrUpdateIdpGroupConnectionsLegacyRequest(r *ht
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14165,6 +15236,9 @@ func (s *Server) decodeTeamsCreateOrUpdateIdpGroupConnectionsLegacyRequest(r *ht
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsCreateOrUpdateIdpGroupConnectionsLegacyReq
@@ -14219,6 +15293,9 @@ func (s *Server) decodeTeamsUpdateDiscussionCommentInOrgRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14244,6 +15321,9 @@ func (s *Server) decodeTeamsUpdateDiscussionCommentInOrgRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsUpdateDiscussionCommentInOrgReq
@@ -14290,6 +15370,9 @@ func (s *Server) decodeTeamsUpdateDiscussionCommentLegacyRequest(r *http.Request
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14315,6 +15398,9 @@ func (s *Server) decodeTeamsUpdateDiscussionCommentLegacyRequest(r *http.Request
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsUpdateDiscussionCommentLegacyReq
@@ -14361,6 +15447,9 @@ func (s *Server) decodeTeamsUpdateDiscussionInOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -14389,6 +15478,9 @@ func (s *Server) decodeTeamsUpdateDiscussionInOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsUpdateDiscussionInOrgReq
@@ -14436,6 +15528,9 @@ func (s *Server) decodeTeamsUpdateDiscussionLegacyRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -14464,6 +15559,9 @@ func (s *Server) decodeTeamsUpdateDiscussionLegacyRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsUpdateDiscussionLegacyReq
@@ -14511,6 +15609,9 @@ func (s *Server) decodeTeamsUpdateInOrgRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -14539,6 +15640,9 @@ func (s *Server) decodeTeamsUpdateInOrgRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptTeamsUpdateInOrgReq
@@ -14601,6 +15705,9 @@ func (s *Server) decodeTeamsUpdateLegacyRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14626,6 +15733,9 @@ func (s *Server) decodeTeamsUpdateLegacyRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request TeamsUpdateLegacyReq
@@ -14680,6 +15790,9 @@ func (s *Server) decodeUsersAddEmailForAuthenticatedRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -14708,6 +15821,9 @@ func (s *Server) decodeUsersAddEmailForAuthenticatedRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptUsersAddEmailForAuthenticatedReq
@@ -14770,6 +15886,9 @@ func (s *Server) decodeUsersCreateGpgKeyForAuthenticatedRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14795,6 +15914,9 @@ func (s *Server) decodeUsersCreateGpgKeyForAuthenticatedRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UsersCreateGpgKeyForAuthenticatedReq
@@ -14841,6 +15963,9 @@ func (s *Server) decodeUsersCreatePublicSSHKeyForAuthenticatedRequest(r *http.Re
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -14866,6 +15991,9 @@ func (s *Server) decodeUsersCreatePublicSSHKeyForAuthenticatedRequest(r *http.Re
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UsersCreatePublicSSHKeyForAuthenticatedReq
@@ -14920,6 +16048,9 @@ func (s *Server) decodeUsersDeleteEmailForAuthenticatedRequest(r *http.Request)
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -14948,6 +16079,9 @@ func (s *Server) decodeUsersDeleteEmailForAuthenticatedRequest(r *http.Request)
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptUsersDeleteEmailForAuthenticatedReq
@@ -15010,6 +16144,9 @@ func (s *Server) decodeUsersSetPrimaryEmailVisibilityForAuthenticatedRequest(r *
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -15035,6 +16172,9 @@ func (s *Server) decodeUsersSetPrimaryEmailVisibilityForAuthenticatedRequest(r *
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UsersSetPrimaryEmailVisibilityForAuthenticatedReq
@@ -15089,6 +16229,9 @@ func (s *Server) decodeUsersUpdateAuthenticatedRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -15117,6 +16260,9 @@ func (s *Server) decodeUsersUpdateAuthenticatedRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptUsersUpdateAuthenticatedReq
@@ -3752,15 +3752,18 @@ func (s *ChecksCreateReq) Validate() error {
 		if s.Actions == nil {
 			return nil // optional
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    0,
 			MinLengthSet: false,
 			MaxLength:    3,
 			MaxLengthSet: true,
 		}).ValidateLength(len(s.Actions)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Actions {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -3968,15 +3971,18 @@ func (s *ChecksCreateReqOutput) Validate() error {
 		if s.Annotations == nil {
 			return nil // optional
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    0,
 			MinLengthSet: false,
 			MaxLength:    50,
 			MaxLengthSet: true,
 		}).ValidateLength(len(s.Annotations)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Annotations {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -6247,15 +6253,18 @@ func (s *EnterpriseAdminUpdateAttributeForEnterpriseGroupReq) Validate() error {
 		if s.Operations == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.Operations)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Operations {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -18001,15 +18010,18 @@ func (s *ScimUpdateAttributeForUserReq) Validate() error {
 		if s.Operations == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.Operations)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Operations {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -18157,15 +18169,18 @@ func (s *ScimUser) Validate() error {
 		if s.Operations == nil {
 			return nil // optional
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.Operations)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Operations {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
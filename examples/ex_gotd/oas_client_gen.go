@@ -432,29 +432,51 @@ func (c *Client) sendAddStickerToSet(ctx context.Context, request *AddStickerToS
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AddStickerToSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -474,11 +496,15 @@ func (c *Client) sendAddStickerToSet(ctx context.Context, request *AddStickerToS
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -513,29 +539,51 @@ func (c *Client) sendAnswerCallbackQuery(ctx context.Context, request *AnswerCal
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AnswerCallbackQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -555,11 +603,15 @@ func (c *Client) sendAnswerCallbackQuery(ctx context.Context, request *AnswerCal
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -594,29 +646,51 @@ func (c *Client) sendAnswerInlineQuery(ctx context.Context, request *AnswerInlin
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AnswerInlineQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -636,11 +710,15 @@ func (c *Client) sendAnswerInlineQuery(ctx context.Context, request *AnswerInlin
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -675,29 +753,51 @@ func (c *Client) sendAnswerPreCheckoutQuery(ctx context.Context, request *Answer
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AnswerPreCheckoutQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -717,11 +817,15 @@ func (c *Client) sendAnswerPreCheckoutQuery(ctx context.Context, request *Answer
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -756,29 +860,51 @@ func (c *Client) sendAnswerShippingQuery(ctx context.Context, request *AnswerShi
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AnswerShippingQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -798,11 +924,15 @@ func (c *Client) sendAnswerShippingQuery(ctx context.Context, request *AnswerShi
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -837,29 +967,51 @@ func (c *Client) sendAnswerWebAppQuery(ctx context.Context, request *AnswerWebAp
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, AnswerWebAppQueryOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -879,11 +1031,15 @@ func (c *Client) sendAnswerWebAppQuery(ctx context.Context, request *AnswerWebAp
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -918,29 +1074,51 @@ func (c *Client) sendApproveChatJoinRequest(ctx context.Context, request *Approv
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ApproveChatJoinRequestOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -960,11 +1138,15 @@ func (c *Client) sendApproveChatJoinRequest(ctx context.Context, request *Approv
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -999,29 +1181,51 @@ func (c *Client) sendBanChatMember(ctx context.Context, request *BanChatMember)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, BanChatMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1041,11 +1245,15 @@ func (c *Client) sendBanChatMember(ctx context.Context, request *BanChatMember)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1080,29 +1288,51 @@ func (c *Client) sendBanChatSenderChat(ctx context.Context, request *BanChatSend
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, BanChatSenderChatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1122,11 +1352,15 @@ func (c *Client) sendBanChatSenderChat(ctx context.Context, request *BanChatSend
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1161,29 +1395,49 @@ func (c *Client) sendClose(ctx context.Context) (res *Result, err error) {
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CloseOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1205,6 +1459,8 @@ func (c *Client) sendClose(ctx context.Context) (res *Result, err error) {
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1239,29 +1495,51 @@ func (c *Client) sendCopyMessage(ctx context.Context, request *CopyMessage) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CopyMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1281,11 +1559,15 @@ func (c *Client) sendCopyMessage(ctx context.Context, request *CopyMessage) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1320,29 +1602,51 @@ func (c *Client) sendCreateChatInviteLink(ctx context.Context, request *CreateCh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateChatInviteLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1362,11 +1666,15 @@ func (c *Client) sendCreateChatInviteLink(ctx context.Context, request *CreateCh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1401,29 +1709,51 @@ func (c *Client) sendCreateNewStickerSet(ctx context.Context, request *CreateNew
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateNewStickerSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1443,11 +1773,15 @@ func (c *Client) sendCreateNewStickerSet(ctx context.Context, request *CreateNew
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1482,29 +1816,51 @@ func (c *Client) sendDeclineChatJoinRequest(ctx context.Context, request *Declin
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeclineChatJoinRequestOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1524,11 +1880,15 @@ func (c *Client) sendDeclineChatJoinRequest(ctx context.Context, request *Declin
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1563,29 +1923,51 @@ func (c *Client) sendDeleteChatPhoto(ctx context.Context, request *DeleteChatPho
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChatPhotoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1605,11 +1987,15 @@ func (c *Client) sendDeleteChatPhoto(ctx context.Context, request *DeleteChatPho
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1644,29 +2030,51 @@ func (c *Client) sendDeleteChatStickerSet(ctx context.Context, request *DeleteCh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChatStickerSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1686,11 +2094,15 @@ func (c *Client) sendDeleteChatStickerSet(ctx context.Context, request *DeleteCh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1725,29 +2137,51 @@ func (c *Client) sendDeleteMessage(ctx context.Context, request *DeleteMessage)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1767,11 +2201,15 @@ func (c *Client) sendDeleteMessage(ctx context.Context, request *DeleteMessage)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1806,29 +2244,51 @@ func (c *Client) sendDeleteMyCommands(ctx context.Context, request OptDeleteMyCo
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteMyCommandsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1848,11 +2308,15 @@ func (c *Client) sendDeleteMyCommands(ctx context.Context, request OptDeleteMyCo
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1887,29 +2351,51 @@ func (c *Client) sendDeleteStickerFromSet(ctx context.Context, request *DeleteSt
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteStickerFromSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -1929,11 +2415,15 @@ func (c *Client) sendDeleteStickerFromSet(ctx context.Context, request *DeleteSt
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -1968,29 +2458,51 @@ func (c *Client) sendDeleteWebhook(ctx context.Context, request OptDeleteWebhook
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, DeleteWebhookOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2010,11 +2522,15 @@ func (c *Client) sendDeleteWebhook(ctx context.Context, request OptDeleteWebhook
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2049,29 +2565,51 @@ func (c *Client) sendEditChatInviteLink(ctx context.Context, request *EditChatIn
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditChatInviteLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2091,11 +2629,15 @@ func (c *Client) sendEditChatInviteLink(ctx context.Context, request *EditChatIn
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2130,29 +2672,51 @@ func (c *Client) sendEditMessageCaption(ctx context.Context, request *EditMessag
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditMessageCaptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2172,11 +2736,15 @@ func (c *Client) sendEditMessageCaption(ctx context.Context, request *EditMessag
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2211,29 +2779,51 @@ func (c *Client) sendEditMessageLiveLocation(ctx context.Context, request *EditM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditMessageLiveLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2253,11 +2843,15 @@ func (c *Client) sendEditMessageLiveLocation(ctx context.Context, request *EditM
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2292,29 +2886,51 @@ func (c *Client) sendEditMessageMedia(ctx context.Context, request *EditMessageM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditMessageMediaOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2334,11 +2950,15 @@ func (c *Client) sendEditMessageMedia(ctx context.Context, request *EditMessageM
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2373,29 +2993,51 @@ func (c *Client) sendEditMessageReplyMarkup(ctx context.Context, request *EditMe
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditMessageReplyMarkupOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2415,11 +3057,15 @@ func (c *Client) sendEditMessageReplyMarkup(ctx context.Context, request *EditMe
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2454,29 +3100,51 @@ func (c *Client) sendEditMessageText(ctx context.Context, request *EditMessageTe
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, EditMessageTextOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2496,11 +3164,15 @@ func (c *Client) sendEditMessageText(ctx context.Context, request *EditMessageTe
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2529,35 +3201,57 @@ func (c *Client) ExportChatInviteLink(ctx context.Context, request *ExportChatIn
 
 func (c *Client) sendExportChatInviteLink(ctx context.Context, request *ExportChatInviteLink) (res *ResultString, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("exportChatInviteLink"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/exportChatInviteLink"),
-	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
+		otelogen.OperationID("exportChatInviteLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/exportChatInviteLink"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ExportChatInviteLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2577,11 +3271,15 @@ func (c *Client) sendExportChatInviteLink(ctx context.Context, request *ExportCh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2616,29 +3314,51 @@ func (c *Client) sendForwardMessage(ctx context.Context, request *ForwardMessage
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, ForwardMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2658,11 +3378,15 @@ func (c *Client) sendForwardMessage(ctx context.Context, request *ForwardMessage
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2697,29 +3421,51 @@ func (c *Client) sendGetChat(ctx context.Context, request *GetChat) (res *Result
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetChatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2739,11 +3485,15 @@ func (c *Client) sendGetChat(ctx context.Context, request *GetChat) (res *Result
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2778,29 +3528,51 @@ func (c *Client) sendGetChatAdministrators(ctx context.Context, request *GetChat
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetChatAdministratorsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2820,11 +3592,15 @@ func (c *Client) sendGetChatAdministrators(ctx context.Context, request *GetChat
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2859,29 +3635,51 @@ func (c *Client) sendGetChatMember(ctx context.Context, request *GetChatMember)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetChatMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2901,11 +3699,15 @@ func (c *Client) sendGetChatMember(ctx context.Context, request *GetChatMember)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -2940,29 +3742,51 @@ func (c *Client) sendGetChatMemberCount(ctx context.Context, request *GetChatMem
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetChatMemberCountOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -2982,11 +3806,15 @@ func (c *Client) sendGetChatMemberCount(ctx context.Context, request *GetChatMem
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3021,29 +3849,51 @@ func (c *Client) sendGetChatMenuButton(ctx context.Context, request OptGetChatMe
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetChatMenuButtonOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3063,11 +3913,15 @@ func (c *Client) sendGetChatMenuButton(ctx context.Context, request OptGetChatMe
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3102,29 +3956,51 @@ func (c *Client) sendGetFile(ctx context.Context, request *GetFile) (res *Result
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetFileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3144,11 +4020,15 @@ func (c *Client) sendGetFile(ctx context.Context, request *GetFile) (res *Result
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3183,29 +4063,51 @@ func (c *Client) sendGetGameHighScores(ctx context.Context, request *GetGameHigh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetGameHighScoresOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3225,11 +4127,15 @@ func (c *Client) sendGetGameHighScores(ctx context.Context, request *GetGameHigh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3264,29 +4170,49 @@ func (c *Client) sendGetMe(ctx context.Context) (res *ResultUser, err error) {
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetMeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3308,6 +4234,8 @@ func (c *Client) sendGetMe(ctx context.Context) (res *ResultUser, err error) {
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3342,29 +4270,51 @@ func (c *Client) sendGetMyCommands(ctx context.Context, request OptGetMyCommands
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetMyCommandsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3384,11 +4334,15 @@ func (c *Client) sendGetMyCommands(ctx context.Context, request OptGetMyCommands
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3423,29 +4377,51 @@ func (c *Client) sendGetMyDefaultAdministratorRights(ctx context.Context, reques
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetMyDefaultAdministratorRightsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3465,11 +4441,15 @@ func (c *Client) sendGetMyDefaultAdministratorRights(ctx context.Context, reques
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3504,29 +4484,51 @@ func (c *Client) sendGetStickerSet(ctx context.Context, request *GetStickerSet)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetStickerSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3546,11 +4548,15 @@ func (c *Client) sendGetStickerSet(ctx context.Context, request *GetStickerSet)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3585,29 +4591,51 @@ func (c *Client) sendGetUpdates(ctx context.Context, request OptGetUpdates) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetUpdatesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3627,11 +4655,15 @@ func (c *Client) sendGetUpdates(ctx context.Context, request OptGetUpdates) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3666,29 +4698,51 @@ func (c *Client) sendGetUserProfilePhotos(ctx context.Context, request *GetUserP
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetUserProfilePhotosOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3708,11 +4762,15 @@ func (c *Client) sendGetUserProfilePhotos(ctx context.Context, request *GetUserP
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3747,29 +4805,49 @@ func (c *Client) sendGetWebhookInfo(ctx context.Context) (res *ResultWebhookInfo
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetWebhookInfoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3791,6 +4869,8 @@ func (c *Client) sendGetWebhookInfo(ctx context.Context) (res *ResultWebhookInfo
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3825,29 +4905,51 @@ func (c *Client) sendLeaveChat(ctx context.Context, request *LeaveChat) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, LeaveChatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3867,11 +4969,15 @@ func (c *Client) sendLeaveChat(ctx context.Context, request *LeaveChat) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3906,29 +5012,49 @@ func (c *Client) sendLogOut(ctx context.Context) (res *Result, err error) {
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, LogOutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -3950,6 +5076,8 @@ func (c *Client) sendLogOut(ctx context.Context) (res *Result, err error) {
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -3984,29 +5112,51 @@ func (c *Client) sendPinChatMessage(ctx context.Context, request *PinChatMessage
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PinChatMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4026,11 +5176,15 @@ func (c *Client) sendPinChatMessage(ctx context.Context, request *PinChatMessage
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4065,29 +5219,51 @@ func (c *Client) sendPromoteChatMember(ctx context.Context, request *PromoteChat
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, PromoteChatMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4107,11 +5283,15 @@ func (c *Client) sendPromoteChatMember(ctx context.Context, request *PromoteChat
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4146,29 +5326,51 @@ func (c *Client) sendRestrictChatMember(ctx context.Context, request *RestrictCh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, RestrictChatMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4188,11 +5390,15 @@ func (c *Client) sendRestrictChatMember(ctx context.Context, request *RestrictCh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4227,29 +5433,51 @@ func (c *Client) sendRevokeChatInviteLink(ctx context.Context, request *RevokeCh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, RevokeChatInviteLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4269,11 +5497,15 @@ func (c *Client) sendRevokeChatInviteLink(ctx context.Context, request *RevokeCh
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4308,29 +5540,51 @@ func (c *Client) sendSendAnimation(ctx context.Context, request *SendAnimation)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendAnimationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4350,11 +5604,15 @@ func (c *Client) sendSendAnimation(ctx context.Context, request *SendAnimation)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4389,29 +5647,51 @@ func (c *Client) sendSendAudio(ctx context.Context, request *SendAudio) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendAudioOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4431,11 +5711,15 @@ func (c *Client) sendSendAudio(ctx context.Context, request *SendAudio) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4470,29 +5754,51 @@ func (c *Client) sendSendChatAction(ctx context.Context, request *SendChatAction
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SendChatActionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
 		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
 
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
 
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SendChatActionOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4512,11 +5818,15 @@ func (c *Client) sendSendChatAction(ctx context.Context, request *SendChatAction
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4551,29 +5861,51 @@ func (c *Client) sendSendContact(ctx context.Context, request *SendContact) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendContactOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4593,11 +5925,15 @@ func (c *Client) sendSendContact(ctx context.Context, request *SendContact) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4632,29 +5968,51 @@ func (c *Client) sendSendDice(ctx context.Context, request *SendDice) (res *Resu
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendDiceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4674,11 +6032,15 @@ func (c *Client) sendSendDice(ctx context.Context, request *SendDice) (res *Resu
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4713,29 +6075,51 @@ func (c *Client) sendSendDocument(ctx context.Context, request *SendDocument) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendDocumentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4755,11 +6139,15 @@ func (c *Client) sendSendDocument(ctx context.Context, request *SendDocument) (r
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4794,29 +6182,51 @@ func (c *Client) sendSendGame(ctx context.Context, request *SendGame) (res *Resu
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendGameOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4836,11 +6246,15 @@ func (c *Client) sendSendGame(ctx context.Context, request *SendGame) (res *Resu
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4875,29 +6289,51 @@ func (c *Client) sendSendInvoice(ctx context.Context, request *SendInvoice) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendInvoiceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4917,11 +6353,15 @@ func (c *Client) sendSendInvoice(ctx context.Context, request *SendInvoice) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -4956,29 +6396,51 @@ func (c *Client) sendSendLocation(ctx context.Context, request *SendLocation) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -4998,11 +6460,15 @@ func (c *Client) sendSendLocation(ctx context.Context, request *SendLocation) (r
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5037,29 +6503,51 @@ func (c *Client) sendSendMediaGroup(ctx context.Context, request *SendMediaGroup
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendMediaGroupOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5079,11 +6567,15 @@ func (c *Client) sendSendMediaGroup(ctx context.Context, request *SendMediaGroup
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5118,29 +6610,51 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessage) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5160,11 +6674,15 @@ func (c *Client) sendSendMessage(ctx context.Context, request *SendMessage) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5199,29 +6717,51 @@ func (c *Client) sendSendPhoto(ctx context.Context, request *SendPhoto) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendPhotoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5241,11 +6781,15 @@ func (c *Client) sendSendPhoto(ctx context.Context, request *SendPhoto) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5280,29 +6824,51 @@ func (c *Client) sendSendPoll(ctx context.Context, request *SendPoll) (res *Resu
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendPollOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5322,11 +6888,15 @@ func (c *Client) sendSendPoll(ctx context.Context, request *SendPoll) (res *Resu
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5361,29 +6931,51 @@ func (c *Client) sendSendSticker(ctx context.Context, request *SendSticker) (res
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendStickerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5403,11 +6995,15 @@ func (c *Client) sendSendSticker(ctx context.Context, request *SendSticker) (res
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5442,29 +7038,51 @@ func (c *Client) sendSendVenue(ctx context.Context, request *SendVenue) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SendVenueOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
 		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
 
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
 
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SendVenueOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5484,11 +7102,15 @@ func (c *Client) sendSendVenue(ctx context.Context, request *SendVenue) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5523,29 +7145,51 @@ func (c *Client) sendSendVideo(ctx context.Context, request *SendVideo) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendVideoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5565,11 +7209,15 @@ func (c *Client) sendSendVideo(ctx context.Context, request *SendVideo) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5604,29 +7252,51 @@ func (c *Client) sendSendVideoNote(ctx context.Context, request *SendVideoNote)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendVideoNoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5646,11 +7316,15 @@ func (c *Client) sendSendVideoNote(ctx context.Context, request *SendVideoNote)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5685,29 +7359,51 @@ func (c *Client) sendSendVoice(ctx context.Context, request *SendVoice) (res *Re
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SendVoiceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5727,11 +7423,15 @@ func (c *Client) sendSendVoice(ctx context.Context, request *SendVoice) (res *Re
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5766,29 +7466,51 @@ func (c *Client) sendSetChatAdministratorCustomTitle(ctx context.Context, reques
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatAdministratorCustomTitleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5808,11 +7530,15 @@ func (c *Client) sendSetChatAdministratorCustomTitle(ctx context.Context, reques
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5847,29 +7573,51 @@ func (c *Client) sendSetChatDescription(ctx context.Context, request *SetChatDes
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatDescriptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5889,11 +7637,15 @@ func (c *Client) sendSetChatDescription(ctx context.Context, request *SetChatDes
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -5928,29 +7680,51 @@ func (c *Client) sendSetChatMenuButton(ctx context.Context, request OptSetChatMe
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatMenuButtonOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -5970,11 +7744,15 @@ func (c *Client) sendSetChatMenuButton(ctx context.Context, request OptSetChatMe
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6009,29 +7787,51 @@ func (c *Client) sendSetChatPermissions(ctx context.Context, request *SetChatPer
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatPermissionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6051,11 +7851,15 @@ func (c *Client) sendSetChatPermissions(ctx context.Context, request *SetChatPer
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6090,29 +7894,51 @@ func (c *Client) sendSetChatPhoto(ctx context.Context, request *SetChatPhoto) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatPhotoOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6132,11 +7958,15 @@ func (c *Client) sendSetChatPhoto(ctx context.Context, request *SetChatPhoto) (r
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6171,29 +8001,51 @@ func (c *Client) sendSetChatStickerSet(ctx context.Context, request *SetChatStic
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatStickerSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6213,11 +8065,15 @@ func (c *Client) sendSetChatStickerSet(ctx context.Context, request *SetChatStic
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6252,29 +8108,51 @@ func (c *Client) sendSetChatTitle(ctx context.Context, request *SetChatTitle) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetChatTitleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6294,11 +8172,15 @@ func (c *Client) sendSetChatTitle(ctx context.Context, request *SetChatTitle) (r
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6333,29 +8215,51 @@ func (c *Client) sendSetGameScore(ctx context.Context, request *SetGameScore) (r
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetGameScoreOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6375,11 +8279,15 @@ func (c *Client) sendSetGameScore(ctx context.Context, request *SetGameScore) (r
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6414,29 +8322,51 @@ func (c *Client) sendSetMyCommands(ctx context.Context, request *SetMyCommands)
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetMyCommandsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6456,11 +8386,15 @@ func (c *Client) sendSetMyCommands(ctx context.Context, request *SetMyCommands)
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6495,29 +8429,51 @@ func (c *Client) sendSetMyDefaultAdministratorRights(ctx context.Context, reques
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetMyDefaultAdministratorRightsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6537,11 +8493,15 @@ func (c *Client) sendSetMyDefaultAdministratorRights(ctx context.Context, reques
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6576,29 +8536,51 @@ func (c *Client) sendSetPassportDataErrors(ctx context.Context, request *SetPass
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetPassportDataErrorsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6618,11 +8600,15 @@ func (c *Client) sendSetPassportDataErrors(ctx context.Context, request *SetPass
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6657,29 +8643,51 @@ func (c *Client) sendSetStickerPositionInSet(ctx context.Context, request *SetSt
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetStickerPositionInSetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6699,11 +8707,15 @@ func (c *Client) sendSetStickerPositionInSet(ctx context.Context, request *SetSt
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6738,29 +8750,51 @@ func (c *Client) sendSetStickerSetThumb(ctx context.Context, request *SetSticker
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetStickerSetThumbOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6780,11 +8814,15 @@ func (c *Client) sendSetStickerSetThumb(ctx context.Context, request *SetSticker
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6819,29 +8857,51 @@ func (c *Client) sendSetWebhook(ctx context.Context, request *SetWebhook) (res *
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SetWebhookOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6861,11 +8921,15 @@ func (c *Client) sendSetWebhook(ctx context.Context, request *SetWebhook) (res *
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6900,29 +8964,51 @@ func (c *Client) sendStopMessageLiveLocation(ctx context.Context, request *StopM
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, StopMessageLiveLocationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -6942,11 +9028,15 @@ func (c *Client) sendStopMessageLiveLocation(ctx context.Context, request *StopM
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -6981,29 +9071,51 @@ func (c *Client) sendStopPoll(ctx context.Context, request *StopPoll) (res *Resu
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, StopPollOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7023,11 +9135,15 @@ func (c *Client) sendStopPoll(ctx context.Context, request *StopPoll) (res *Resu
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -7062,29 +9178,51 @@ func (c *Client) sendUnbanChatMember(ctx context.Context, request *UnbanChatMemb
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UnbanChatMemberOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7104,11 +9242,15 @@ func (c *Client) sendUnbanChatMember(ctx context.Context, request *UnbanChatMemb
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -7143,29 +9285,51 @@ func (c *Client) sendUnbanChatSenderChat(ctx context.Context, request *UnbanChat
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UnbanChatSenderChatOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7185,11 +9349,15 @@ func (c *Client) sendUnbanChatSenderChat(ctx context.Context, request *UnbanChat
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -7224,29 +9392,51 @@ func (c *Client) sendUnpinAllChatMessages(ctx context.Context, request *UnpinAll
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UnpinAllChatMessagesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7266,11 +9456,15 @@ func (c *Client) sendUnpinAllChatMessages(ctx context.Context, request *UnpinAll
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -7305,29 +9499,51 @@ func (c *Client) sendUnpinChatMessage(ctx context.Context, request *UnpinChatMes
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UnpinChatMessageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7347,11 +9563,15 @@ func (c *Client) sendUnpinChatMessage(ctx context.Context, request *UnpinChatMes
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -7386,29 +9606,51 @@ func (c *Client) sendUploadStickerFile(ctx context.Context, request *UploadStick
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, UploadStickerFileOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		requestBody  ht.BodyCounter
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -7428,11 +9670,15 @@ func (c *Client) sendUploadStickerFile(ctx context.Context, request *UploadStick
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	requestBody.WrapRequest(r)
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
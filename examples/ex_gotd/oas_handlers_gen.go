@@ -22,6 +22,7 @@ import (
 type codeRecorder struct {
 	http.ResponseWriter
 	status int
+	size   int64
 }
 
 func (c *codeRecorder) WriteHeader(status int) {
@@ -29,6 +30,16 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
+func (c *codeRecorder) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		// Status is sent implicitly.
+		c.status = http.StatusOK
+	}
+	n, err := c.ResponseWriter.Write(p)
+	c.size += int64(n)
+	return n, err
+}
+
 func (c *codeRecorder) Unwrap() http.ResponseWriter {
 	return c.ResponseWriter
 }
@@ -58,6 +69,15 @@ func (s *Server) handleAddStickerToSetRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -78,6 +98,10 @@ func (s *Server) handleAddStickerToSetRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -93,8 +117,9 @@ func (s *Server) handleAddStickerToSetRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -210,6 +235,15 @@ func (s *Server) handleAnswerCallbackQueryRequest(args [0]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -230,6 +264,10 @@ func (s *Server) handleAnswerCallbackQueryRequest(args [0]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -245,8 +283,9 @@ func (s *Server) handleAnswerCallbackQueryRequest(args [0]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -362,6 +401,15 @@ func (s *Server) handleAnswerInlineQueryRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -382,6 +430,10 @@ func (s *Server) handleAnswerInlineQueryRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -397,8 +449,9 @@ func (s *Server) handleAnswerInlineQueryRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -514,6 +567,15 @@ func (s *Server) handleAnswerPreCheckoutQueryRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -534,6 +596,10 @@ func (s *Server) handleAnswerPreCheckoutQueryRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -549,8 +615,9 @@ func (s *Server) handleAnswerPreCheckoutQueryRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -666,6 +733,15 @@ func (s *Server) handleAnswerShippingQueryRequest(args [0]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -686,6 +762,10 @@ func (s *Server) handleAnswerShippingQueryRequest(args [0]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -701,8 +781,9 @@ func (s *Server) handleAnswerShippingQueryRequest(args [0]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -818,6 +899,15 @@ func (s *Server) handleAnswerWebAppQueryRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -838,6 +928,10 @@ func (s *Server) handleAnswerWebAppQueryRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -853,8 +947,9 @@ func (s *Server) handleAnswerWebAppQueryRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -970,6 +1065,15 @@ func (s *Server) handleApproveChatJoinRequestRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -990,6 +1094,10 @@ func (s *Server) handleApproveChatJoinRequestRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1005,8 +1113,9 @@ func (s *Server) handleApproveChatJoinRequestRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1122,6 +1231,15 @@ func (s *Server) handleBanChatMemberRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1142,6 +1260,10 @@ func (s *Server) handleBanChatMemberRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1157,8 +1279,9 @@ func (s *Server) handleBanChatMemberRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1274,6 +1397,15 @@ func (s *Server) handleBanChatSenderChatRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1294,6 +1426,10 @@ func (s *Server) handleBanChatSenderChatRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1309,8 +1445,9 @@ func (s *Server) handleBanChatSenderChatRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1426,6 +1563,11 @@ func (s *Server) handleCloseRequest(args [0]string, argsEscaped bool, w http.Res
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1446,6 +1588,9 @@ func (s *Server) handleCloseRequest(args [0]string, argsEscaped bool, w http.Res
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1461,8 +1606,9 @@ func (s *Server) handleCloseRequest(args [0]string, argsEscaped bool, w http.Res
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1559,6 +1705,15 @@ func (s *Server) handleCopyMessageRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1579,6 +1734,10 @@ func (s *Server) handleCopyMessageRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1594,8 +1753,9 @@ func (s *Server) handleCopyMessageRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1711,6 +1871,15 @@ func (s *Server) handleCreateChatInviteLinkRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1731,6 +1900,10 @@ func (s *Server) handleCreateChatInviteLinkRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1746,8 +1919,9 @@ func (s *Server) handleCreateChatInviteLinkRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -1863,6 +2037,15 @@ func (s *Server) handleCreateNewStickerSetRequest(args [0]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -1883,6 +2066,10 @@ func (s *Server) handleCreateNewStickerSetRequest(args [0]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -1898,8 +2085,9 @@ func (s *Server) handleCreateNewStickerSetRequest(args [0]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2015,6 +2203,15 @@ func (s *Server) handleDeclineChatJoinRequestRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2035,6 +2232,10 @@ func (s *Server) handleDeclineChatJoinRequestRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2050,8 +2251,9 @@ func (s *Server) handleDeclineChatJoinRequestRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2167,6 +2369,15 @@ func (s *Server) handleDeleteChatPhotoRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2187,6 +2398,10 @@ func (s *Server) handleDeleteChatPhotoRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2202,8 +2417,9 @@ func (s *Server) handleDeleteChatPhotoRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2319,6 +2535,15 @@ func (s *Server) handleDeleteChatStickerSetRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2339,6 +2564,10 @@ func (s *Server) handleDeleteChatStickerSetRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2354,8 +2583,9 @@ func (s *Server) handleDeleteChatStickerSetRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2471,6 +2701,15 @@ func (s *Server) handleDeleteMessageRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2491,6 +2730,10 @@ func (s *Server) handleDeleteMessageRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2506,8 +2749,9 @@ func (s *Server) handleDeleteMessageRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2623,6 +2867,15 @@ func (s *Server) handleDeleteMyCommandsRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2643,6 +2896,10 @@ func (s *Server) handleDeleteMyCommandsRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2658,8 +2915,9 @@ func (s *Server) handleDeleteMyCommandsRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2775,6 +3033,15 @@ func (s *Server) handleDeleteStickerFromSetRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2795,6 +3062,10 @@ func (s *Server) handleDeleteStickerFromSetRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2810,8 +3081,9 @@ func (s *Server) handleDeleteStickerFromSetRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -2927,6 +3199,15 @@ func (s *Server) handleDeleteWebhookRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -2947,6 +3228,10 @@ func (s *Server) handleDeleteWebhookRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -2962,8 +3247,9 @@ func (s *Server) handleDeleteWebhookRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3079,6 +3365,15 @@ func (s *Server) handleEditChatInviteLinkRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3099,6 +3394,10 @@ func (s *Server) handleEditChatInviteLinkRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3114,8 +3413,9 @@ func (s *Server) handleEditChatInviteLinkRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3231,6 +3531,15 @@ func (s *Server) handleEditMessageCaptionRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3251,6 +3560,10 @@ func (s *Server) handleEditMessageCaptionRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3266,8 +3579,9 @@ func (s *Server) handleEditMessageCaptionRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3383,6 +3697,15 @@ func (s *Server) handleEditMessageLiveLocationRequest(args [0]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3403,6 +3726,10 @@ func (s *Server) handleEditMessageLiveLocationRequest(args [0]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3418,8 +3745,9 @@ func (s *Server) handleEditMessageLiveLocationRequest(args [0]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3535,6 +3863,15 @@ func (s *Server) handleEditMessageMediaRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3555,6 +3892,10 @@ func (s *Server) handleEditMessageMediaRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3570,8 +3911,9 @@ func (s *Server) handleEditMessageMediaRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3687,6 +4029,15 @@ func (s *Server) handleEditMessageReplyMarkupRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3707,6 +4058,10 @@ func (s *Server) handleEditMessageReplyMarkupRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3722,8 +4077,9 @@ func (s *Server) handleEditMessageReplyMarkupRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3839,6 +4195,15 @@ func (s *Server) handleEditMessageTextRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -3859,6 +4224,10 @@ func (s *Server) handleEditMessageTextRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -3874,8 +4243,9 @@ func (s *Server) handleEditMessageTextRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -3991,6 +4361,15 @@ func (s *Server) handleExportChatInviteLinkRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4011,6 +4390,10 @@ func (s *Server) handleExportChatInviteLinkRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4026,8 +4409,9 @@ func (s *Server) handleExportChatInviteLinkRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4143,6 +4527,15 @@ func (s *Server) handleForwardMessageRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4163,6 +4556,10 @@ func (s *Server) handleForwardMessageRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4178,8 +4575,9 @@ func (s *Server) handleForwardMessageRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4295,6 +4693,15 @@ func (s *Server) handleGetChatRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4315,6 +4722,10 @@ func (s *Server) handleGetChatRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4330,8 +4741,9 @@ func (s *Server) handleGetChatRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4447,6 +4859,15 @@ func (s *Server) handleGetChatAdministratorsRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4467,6 +4888,10 @@ func (s *Server) handleGetChatAdministratorsRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4482,8 +4907,9 @@ func (s *Server) handleGetChatAdministratorsRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4599,6 +5025,15 @@ func (s *Server) handleGetChatMemberRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4619,6 +5054,10 @@ func (s *Server) handleGetChatMemberRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4634,8 +5073,9 @@ func (s *Server) handleGetChatMemberRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4751,6 +5191,15 @@ func (s *Server) handleGetChatMemberCountRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4771,6 +5220,10 @@ func (s *Server) handleGetChatMemberCountRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4786,8 +5239,9 @@ func (s *Server) handleGetChatMemberCountRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -4903,6 +5357,15 @@ func (s *Server) handleGetChatMenuButtonRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -4923,6 +5386,10 @@ func (s *Server) handleGetChatMenuButtonRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -4938,8 +5405,9 @@ func (s *Server) handleGetChatMenuButtonRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5055,6 +5523,15 @@ func (s *Server) handleGetFileRequest(args [0]string, argsEscaped bool, w http.R
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5075,6 +5552,10 @@ func (s *Server) handleGetFileRequest(args [0]string, argsEscaped bool, w http.R
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5090,8 +5571,9 @@ func (s *Server) handleGetFileRequest(args [0]string, argsEscaped bool, w http.R
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5207,6 +5689,15 @@ func (s *Server) handleGetGameHighScoresRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5227,6 +5718,10 @@ func (s *Server) handleGetGameHighScoresRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5242,8 +5737,9 @@ func (s *Server) handleGetGameHighScoresRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5359,6 +5855,11 @@ func (s *Server) handleGetMeRequest(args [0]string, argsEscaped bool, w http.Res
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5379,6 +5880,9 @@ func (s *Server) handleGetMeRequest(args [0]string, argsEscaped bool, w http.Res
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5394,8 +5898,9 @@ func (s *Server) handleGetMeRequest(args [0]string, argsEscaped bool, w http.Res
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5492,6 +5997,15 @@ func (s *Server) handleGetMyCommandsRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5512,6 +6026,10 @@ func (s *Server) handleGetMyCommandsRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5527,8 +6045,9 @@ func (s *Server) handleGetMyCommandsRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5644,6 +6163,15 @@ func (s *Server) handleGetMyDefaultAdministratorRightsRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5664,6 +6192,10 @@ func (s *Server) handleGetMyDefaultAdministratorRightsRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5679,8 +6211,9 @@ func (s *Server) handleGetMyDefaultAdministratorRightsRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5796,6 +6329,15 @@ func (s *Server) handleGetStickerSetRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5816,6 +6358,10 @@ func (s *Server) handleGetStickerSetRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5831,8 +6377,9 @@ func (s *Server) handleGetStickerSetRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -5948,6 +6495,15 @@ func (s *Server) handleGetUpdatesRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -5968,6 +6524,10 @@ func (s *Server) handleGetUpdatesRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -5983,8 +6543,9 @@ func (s *Server) handleGetUpdatesRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6100,6 +6661,15 @@ func (s *Server) handleGetUserProfilePhotosRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6120,6 +6690,10 @@ func (s *Server) handleGetUserProfilePhotosRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6135,8 +6709,9 @@ func (s *Server) handleGetUserProfilePhotosRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6252,6 +6827,11 @@ func (s *Server) handleGetWebhookInfoRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6272,6 +6852,9 @@ func (s *Server) handleGetWebhookInfoRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6287,8 +6870,9 @@ func (s *Server) handleGetWebhookInfoRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6385,6 +6969,15 @@ func (s *Server) handleLeaveChatRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6405,6 +6998,10 @@ func (s *Server) handleLeaveChatRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6420,8 +7017,9 @@ func (s *Server) handleLeaveChatRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6537,6 +7135,11 @@ func (s *Server) handleLogOutRequest(args [0]string, argsEscaped bool, w http.Re
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6557,6 +7160,9 @@ func (s *Server) handleLogOutRequest(args [0]string, argsEscaped bool, w http.Re
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6572,8 +7178,9 @@ func (s *Server) handleLogOutRequest(args [0]string, argsEscaped bool, w http.Re
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6670,6 +7277,15 @@ func (s *Server) handlePinChatMessageRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6690,6 +7306,10 @@ func (s *Server) handlePinChatMessageRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6705,8 +7325,9 @@ func (s *Server) handlePinChatMessageRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6822,6 +7443,15 @@ func (s *Server) handlePromoteChatMemberRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6842,6 +7472,10 @@ func (s *Server) handlePromoteChatMemberRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -6857,8 +7491,9 @@ func (s *Server) handlePromoteChatMemberRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -6974,6 +7609,15 @@ func (s *Server) handleRestrictChatMemberRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -6994,6 +7638,10 @@ func (s *Server) handleRestrictChatMemberRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7009,8 +7657,9 @@ func (s *Server) handleRestrictChatMemberRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7126,6 +7775,15 @@ func (s *Server) handleRevokeChatInviteLinkRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7146,6 +7804,10 @@ func (s *Server) handleRevokeChatInviteLinkRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7161,8 +7823,9 @@ func (s *Server) handleRevokeChatInviteLinkRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7278,6 +7941,15 @@ func (s *Server) handleSendAnimationRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7298,6 +7970,10 @@ func (s *Server) handleSendAnimationRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7313,8 +7989,9 @@ func (s *Server) handleSendAnimationRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7430,6 +8107,15 @@ func (s *Server) handleSendAudioRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7450,6 +8136,10 @@ func (s *Server) handleSendAudioRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7465,8 +8155,9 @@ func (s *Server) handleSendAudioRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7582,6 +8273,15 @@ func (s *Server) handleSendChatActionRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7602,6 +8302,10 @@ func (s *Server) handleSendChatActionRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7617,8 +8321,9 @@ func (s *Server) handleSendChatActionRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7734,6 +8439,15 @@ func (s *Server) handleSendContactRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7754,6 +8468,10 @@ func (s *Server) handleSendContactRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7769,8 +8487,9 @@ func (s *Server) handleSendContactRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -7886,6 +8605,15 @@ func (s *Server) handleSendDiceRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -7906,6 +8634,10 @@ func (s *Server) handleSendDiceRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -7921,8 +8653,9 @@ func (s *Server) handleSendDiceRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8038,6 +8771,15 @@ func (s *Server) handleSendDocumentRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8058,6 +8800,10 @@ func (s *Server) handleSendDocumentRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8073,8 +8819,9 @@ func (s *Server) handleSendDocumentRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8190,6 +8937,15 @@ func (s *Server) handleSendGameRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8210,6 +8966,10 @@ func (s *Server) handleSendGameRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8225,8 +8985,9 @@ func (s *Server) handleSendGameRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8342,6 +9103,15 @@ func (s *Server) handleSendInvoiceRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8362,6 +9132,10 @@ func (s *Server) handleSendInvoiceRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8377,8 +9151,9 @@ func (s *Server) handleSendInvoiceRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8494,6 +9269,15 @@ func (s *Server) handleSendLocationRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8514,6 +9298,10 @@ func (s *Server) handleSendLocationRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8529,8 +9317,9 @@ func (s *Server) handleSendLocationRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8646,6 +9435,15 @@ func (s *Server) handleSendMediaGroupRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8666,6 +9464,10 @@ func (s *Server) handleSendMediaGroupRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8681,8 +9483,9 @@ func (s *Server) handleSendMediaGroupRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8798,6 +9601,15 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8818,6 +9630,10 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8833,8 +9649,9 @@ func (s *Server) handleSendMessageRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -8950,6 +9767,15 @@ func (s *Server) handleSendPhotoRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -8970,6 +9796,10 @@ func (s *Server) handleSendPhotoRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -8985,8 +9815,9 @@ func (s *Server) handleSendPhotoRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9102,6 +9933,15 @@ func (s *Server) handleSendPollRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9122,6 +9962,10 @@ func (s *Server) handleSendPollRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9137,8 +9981,9 @@ func (s *Server) handleSendPollRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9254,6 +10099,15 @@ func (s *Server) handleSendStickerRequest(args [0]string, argsEscaped bool, w ht
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9274,6 +10128,10 @@ func (s *Server) handleSendStickerRequest(args [0]string, argsEscaped bool, w ht
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9289,8 +10147,9 @@ func (s *Server) handleSendStickerRequest(args [0]string, argsEscaped bool, w ht
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9406,6 +10265,15 @@ func (s *Server) handleSendVenueRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9426,6 +10294,10 @@ func (s *Server) handleSendVenueRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9441,8 +10313,9 @@ func (s *Server) handleSendVenueRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9558,6 +10431,15 @@ func (s *Server) handleSendVideoRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9578,6 +10460,10 @@ func (s *Server) handleSendVideoRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9593,8 +10479,9 @@ func (s *Server) handleSendVideoRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9710,6 +10597,15 @@ func (s *Server) handleSendVideoNoteRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9730,6 +10626,10 @@ func (s *Server) handleSendVideoNoteRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9745,8 +10645,9 @@ func (s *Server) handleSendVideoNoteRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -9862,6 +10763,15 @@ func (s *Server) handleSendVoiceRequest(args [0]string, argsEscaped bool, w http
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -9882,6 +10792,10 @@ func (s *Server) handleSendVoiceRequest(args [0]string, argsEscaped bool, w http
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -9897,8 +10811,9 @@ func (s *Server) handleSendVoiceRequest(args [0]string, argsEscaped bool, w http
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10014,6 +10929,15 @@ func (s *Server) handleSetChatAdministratorCustomTitleRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10034,6 +10958,10 @@ func (s *Server) handleSetChatAdministratorCustomTitleRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10049,8 +10977,9 @@ func (s *Server) handleSetChatAdministratorCustomTitleRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10166,6 +11095,15 @@ func (s *Server) handleSetChatDescriptionRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10186,6 +11124,10 @@ func (s *Server) handleSetChatDescriptionRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10201,8 +11143,9 @@ func (s *Server) handleSetChatDescriptionRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10318,6 +11261,15 @@ func (s *Server) handleSetChatMenuButtonRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10338,6 +11290,10 @@ func (s *Server) handleSetChatMenuButtonRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10353,8 +11309,9 @@ func (s *Server) handleSetChatMenuButtonRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10470,6 +11427,15 @@ func (s *Server) handleSetChatPermissionsRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10490,6 +11456,10 @@ func (s *Server) handleSetChatPermissionsRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10505,8 +11475,9 @@ func (s *Server) handleSetChatPermissionsRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10622,6 +11593,15 @@ func (s *Server) handleSetChatPhotoRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10642,6 +11622,10 @@ func (s *Server) handleSetChatPhotoRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10657,8 +11641,9 @@ func (s *Server) handleSetChatPhotoRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10774,6 +11759,15 @@ func (s *Server) handleSetChatStickerSetRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10794,6 +11788,10 @@ func (s *Server) handleSetChatStickerSetRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10809,8 +11807,9 @@ func (s *Server) handleSetChatStickerSetRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -10926,6 +11925,15 @@ func (s *Server) handleSetChatTitleRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -10946,6 +11954,10 @@ func (s *Server) handleSetChatTitleRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -10961,8 +11973,9 @@ func (s *Server) handleSetChatTitleRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11078,6 +12091,15 @@ func (s *Server) handleSetGameScoreRequest(args [0]string, argsEscaped bool, w h
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11098,6 +12120,10 @@ func (s *Server) handleSetGameScoreRequest(args [0]string, argsEscaped bool, w h
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11113,8 +12139,9 @@ func (s *Server) handleSetGameScoreRequest(args [0]string, argsEscaped bool, w h
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11230,6 +12257,15 @@ func (s *Server) handleSetMyCommandsRequest(args [0]string, argsEscaped bool, w
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11250,6 +12286,10 @@ func (s *Server) handleSetMyCommandsRequest(args [0]string, argsEscaped bool, w
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11265,8 +12305,9 @@ func (s *Server) handleSetMyCommandsRequest(args [0]string, argsEscaped bool, w
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11382,6 +12423,15 @@ func (s *Server) handleSetMyDefaultAdministratorRightsRequest(args [0]string, ar
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11402,6 +12452,10 @@ func (s *Server) handleSetMyDefaultAdministratorRightsRequest(args [0]string, ar
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11417,8 +12471,9 @@ func (s *Server) handleSetMyDefaultAdministratorRightsRequest(args [0]string, ar
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11534,6 +12589,15 @@ func (s *Server) handleSetPassportDataErrorsRequest(args [0]string, argsEscaped
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11554,6 +12618,10 @@ func (s *Server) handleSetPassportDataErrorsRequest(args [0]string, argsEscaped
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11569,8 +12637,9 @@ func (s *Server) handleSetPassportDataErrorsRequest(args [0]string, argsEscaped
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11686,6 +12755,15 @@ func (s *Server) handleSetStickerPositionInSetRequest(args [0]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11706,6 +12784,10 @@ func (s *Server) handleSetStickerPositionInSetRequest(args [0]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11721,8 +12803,9 @@ func (s *Server) handleSetStickerPositionInSetRequest(args [0]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11838,6 +12921,15 @@ func (s *Server) handleSetStickerSetThumbRequest(args [0]string, argsEscaped boo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -11858,6 +12950,10 @@ func (s *Server) handleSetStickerSetThumbRequest(args [0]string, argsEscaped boo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -11873,8 +12969,9 @@ func (s *Server) handleSetStickerSetThumbRequest(args [0]string, argsEscaped boo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -11990,6 +13087,15 @@ func (s *Server) handleSetWebhookRequest(args [0]string, argsEscaped bool, w htt
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12010,6 +13116,10 @@ func (s *Server) handleSetWebhookRequest(args [0]string, argsEscaped bool, w htt
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12025,8 +13135,9 @@ func (s *Server) handleSetWebhookRequest(args [0]string, argsEscaped bool, w htt
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12142,6 +13253,15 @@ func (s *Server) handleStopMessageLiveLocationRequest(args [0]string, argsEscape
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12162,6 +13282,10 @@ func (s *Server) handleStopMessageLiveLocationRequest(args [0]string, argsEscape
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12177,8 +13301,9 @@ func (s *Server) handleStopMessageLiveLocationRequest(args [0]string, argsEscape
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12294,6 +13419,15 @@ func (s *Server) handleStopPollRequest(args [0]string, argsEscaped bool, w http.
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12314,6 +13448,10 @@ func (s *Server) handleStopPollRequest(args [0]string, argsEscaped bool, w http.
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12329,8 +13467,9 @@ func (s *Server) handleStopPollRequest(args [0]string, argsEscaped bool, w http.
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12446,6 +13585,15 @@ func (s *Server) handleUnbanChatMemberRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12466,6 +13614,10 @@ func (s *Server) handleUnbanChatMemberRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12481,8 +13633,9 @@ func (s *Server) handleUnbanChatMemberRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12598,6 +13751,15 @@ func (s *Server) handleUnbanChatSenderChatRequest(args [0]string, argsEscaped bo
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12618,6 +13780,10 @@ func (s *Server) handleUnbanChatSenderChatRequest(args [0]string, argsEscaped bo
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12633,8 +13799,9 @@ func (s *Server) handleUnbanChatSenderChatRequest(args [0]string, argsEscaped bo
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12750,6 +13917,15 @@ func (s *Server) handleUnpinAllChatMessagesRequest(args [0]string, argsEscaped b
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12770,6 +13946,10 @@ func (s *Server) handleUnpinAllChatMessagesRequest(args [0]string, argsEscaped b
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12785,8 +13965,9 @@ func (s *Server) handleUnpinAllChatMessagesRequest(args [0]string, argsEscaped b
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -12902,6 +14083,15 @@ func (s *Server) handleUnpinChatMessageRequest(args [0]string, argsEscaped bool,
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -12922,6 +14112,10 @@ func (s *Server) handleUnpinChatMessageRequest(args [0]string, argsEscaped bool,
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -12937,8 +14131,9 @@ func (s *Server) handleUnpinChatMessageRequest(args [0]string, argsEscaped bool,
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
@@ -13054,6 +14249,15 @@ func (s *Server) handleUploadStickerFileRequest(args [0]string, argsEscaped bool
 	labeler := &Labeler{attrs: otelAttrs}
 	ctx = contextWithLabeler(ctx, labeler)
 
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	s.activeRequests.Add(ctx, 1, activeOpt)
+	defer s.activeRequests.Add(ctx, -1, activeOpt)
+
+	// Count request body size.
+	var requestBody ht.BodyCounter
+	r.Body = requestBody.Wrap(r.Body)
+
 	// Run stopwatch.
 	startTime := time.Now()
 	defer func() {
@@ -13074,6 +14278,10 @@ func (s *Server) handleUploadStickerFileRequest(args [0]string, argsEscaped bool
 
 		// Use floating point division here for higher precision (instead of Millisecond method).
 		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		s.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		s.responseBodySize.Record(ctx, statusWriter.size, attrOpt)
 	}()
 
 	var (
@@ -13089,8 +14297,9 @@ func (s *Server) handleUploadStickerFileRequest(args [0]string, argsEscaped bool
 				span.SetStatus(codes.Error, stage)
 			}
 
+			// Error attributes go first, so Labeler can override them.
 			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
+			attrs := append(otelogen.ErrorAttributes(stage, err), attrSet.ToSlice()...)
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
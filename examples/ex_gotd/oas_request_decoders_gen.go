@@ -35,6 +35,9 @@ func (s *Server) decodeAddStickerToSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -60,6 +63,9 @@ func (s *Server) decodeAddStickerToSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AddStickerToSet
@@ -114,6 +120,9 @@ func (s *Server) decodeAnswerCallbackQueryRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -139,6 +148,9 @@ func (s *Server) decodeAnswerCallbackQueryRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AnswerCallbackQuery
@@ -193,6 +205,9 @@ func (s *Server) decodeAnswerInlineQueryRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -218,6 +233,9 @@ func (s *Server) decodeAnswerInlineQueryRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AnswerInlineQuery
@@ -272,6 +290,9 @@ func (s *Server) decodeAnswerPreCheckoutQueryRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -297,6 +318,9 @@ func (s *Server) decodeAnswerPreCheckoutQueryRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AnswerPreCheckoutQuery
@@ -343,6 +367,9 @@ func (s *Server) decodeAnswerShippingQueryRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -368,6 +395,9 @@ func (s *Server) decodeAnswerShippingQueryRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AnswerShippingQuery
@@ -422,6 +452,9 @@ func (s *Server) decodeAnswerWebAppQueryRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -447,6 +480,9 @@ func (s *Server) decodeAnswerWebAppQueryRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request AnswerWebAppQuery
@@ -501,6 +537,9 @@ func (s *Server) decodeApproveChatJoinRequestRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -526,6 +565,9 @@ func (s *Server) decodeApproveChatJoinRequestRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ApproveChatJoinRequest
@@ -572,6 +614,9 @@ func (s *Server) decodeBanChatMemberRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -597,6 +642,9 @@ func (s *Server) decodeBanChatMemberRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request BanChatMember
@@ -643,6 +691,9 @@ func (s *Server) decodeBanChatSenderChatRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -668,6 +719,9 @@ func (s *Server) decodeBanChatSenderChatRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request BanChatSenderChat
@@ -714,6 +768,9 @@ func (s *Server) decodeCopyMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -739,6 +796,9 @@ func (s *Server) decodeCopyMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CopyMessage
@@ -793,6 +853,9 @@ func (s *Server) decodeCreateChatInviteLinkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -818,6 +881,9 @@ func (s *Server) decodeCreateChatInviteLinkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreateChatInviteLink
@@ -872,6 +938,9 @@ func (s *Server) decodeCreateNewStickerSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -897,6 +966,9 @@ func (s *Server) decodeCreateNewStickerSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreateNewStickerSet
@@ -951,6 +1023,9 @@ func (s *Server) decodeDeclineChatJoinRequestRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -976,6 +1051,9 @@ func (s *Server) decodeDeclineChatJoinRequestRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request DeclineChatJoinRequest
@@ -1022,6 +1100,9 @@ func (s *Server) decodeDeleteChatPhotoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1047,6 +1128,9 @@ func (s *Server) decodeDeleteChatPhotoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request DeleteChatPhoto
@@ -1093,6 +1177,9 @@ func (s *Server) decodeDeleteChatStickerSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1118,6 +1205,9 @@ func (s *Server) decodeDeleteChatStickerSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request DeleteChatStickerSet
@@ -1164,6 +1254,9 @@ func (s *Server) decodeDeleteMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1189,6 +1282,9 @@ func (s *Server) decodeDeleteMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request DeleteMessage
@@ -1235,6 +1331,9 @@ func (s *Server) decodeDeleteMyCommandsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1263,6 +1362,9 @@ func (s *Server) decodeDeleteMyCommandsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptDeleteMyCommands
@@ -1310,6 +1412,9 @@ func (s *Server) decodeDeleteStickerFromSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1335,6 +1440,9 @@ func (s *Server) decodeDeleteStickerFromSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request DeleteStickerFromSet
@@ -1381,6 +1489,9 @@ func (s *Server) decodeDeleteWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -1409,6 +1520,9 @@ func (s *Server) decodeDeleteWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptDeleteWebhook
@@ -1456,6 +1570,9 @@ func (s *Server) decodeEditChatInviteLinkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1481,6 +1598,9 @@ func (s *Server) decodeEditChatInviteLinkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditChatInviteLink
@@ -1535,6 +1655,9 @@ func (s *Server) decodeEditMessageCaptionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1560,6 +1683,9 @@ func (s *Server) decodeEditMessageCaptionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditMessageCaption
@@ -1614,6 +1740,9 @@ func (s *Server) decodeEditMessageLiveLocationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1639,6 +1768,9 @@ func (s *Server) decodeEditMessageLiveLocationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditMessageLiveLocation
@@ -1693,6 +1825,9 @@ func (s *Server) decodeEditMessageMediaRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1718,6 +1853,9 @@ func (s *Server) decodeEditMessageMediaRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditMessageMedia
@@ -1772,6 +1910,9 @@ func (s *Server) decodeEditMessageReplyMarkupRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1797,6 +1938,9 @@ func (s *Server) decodeEditMessageReplyMarkupRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditMessageReplyMarkup
@@ -1851,6 +1995,9 @@ func (s *Server) decodeEditMessageTextRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1876,6 +2023,9 @@ func (s *Server) decodeEditMessageTextRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request EditMessageText
@@ -1930,6 +2080,9 @@ func (s *Server) decodeExportChatInviteLinkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1955,6 +2108,9 @@ func (s *Server) decodeExportChatInviteLinkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ExportChatInviteLink
@@ -2001,6 +2157,9 @@ func (s *Server) decodeForwardMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2026,6 +2185,9 @@ func (s *Server) decodeForwardMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request ForwardMessage
@@ -2072,6 +2234,9 @@ func (s *Server) decodeGetChatRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2097,6 +2262,9 @@ func (s *Server) decodeGetChatRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetChat
@@ -2143,6 +2311,9 @@ func (s *Server) decodeGetChatAdministratorsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2168,6 +2339,9 @@ func (s *Server) decodeGetChatAdministratorsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetChatAdministrators
@@ -2214,6 +2388,9 @@ func (s *Server) decodeGetChatMemberRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2239,6 +2416,9 @@ func (s *Server) decodeGetChatMemberRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetChatMember
@@ -2285,6 +2465,9 @@ func (s *Server) decodeGetChatMemberCountRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2310,6 +2493,9 @@ func (s *Server) decodeGetChatMemberCountRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetChatMemberCount
@@ -2356,6 +2542,9 @@ func (s *Server) decodeGetChatMenuButtonRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -2384,6 +2573,9 @@ func (s *Server) decodeGetChatMenuButtonRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptGetChatMenuButton
@@ -2431,6 +2623,9 @@ func (s *Server) decodeGetFileRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2456,6 +2651,9 @@ func (s *Server) decodeGetFileRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetFile
@@ -2502,6 +2700,9 @@ func (s *Server) decodeGetGameHighScoresRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2527,6 +2728,9 @@ func (s *Server) decodeGetGameHighScoresRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetGameHighScores
@@ -2573,6 +2777,9 @@ func (s *Server) decodeGetMyCommandsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -2601,6 +2808,9 @@ func (s *Server) decodeGetMyCommandsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptGetMyCommands
@@ -2648,6 +2858,9 @@ func (s *Server) decodeGetMyDefaultAdministratorRightsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -2676,6 +2889,9 @@ func (s *Server) decodeGetMyDefaultAdministratorRightsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptGetMyDefaultAdministratorRights
@@ -2723,6 +2939,9 @@ func (s *Server) decodeGetStickerSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2748,6 +2967,9 @@ func (s *Server) decodeGetStickerSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetStickerSet
@@ -2794,6 +3016,9 @@ func (s *Server) decodeGetUpdatesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -2822,6 +3047,9 @@ func (s *Server) decodeGetUpdatesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptGetUpdates
@@ -2884,6 +3112,9 @@ func (s *Server) decodeGetUserProfilePhotosRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2909,6 +3140,9 @@ func (s *Server) decodeGetUserProfilePhotosRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request GetUserProfilePhotos
@@ -2963,6 +3197,9 @@ func (s *Server) decodeLeaveChatRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -2988,6 +3225,9 @@ func (s *Server) decodeLeaveChatRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request LeaveChat
@@ -3034,6 +3274,9 @@ func (s *Server) decodePinChatMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3059,6 +3302,9 @@ func (s *Server) decodePinChatMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PinChatMessage
@@ -3105,6 +3351,9 @@ func (s *Server) decodePromoteChatMemberRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3130,6 +3379,9 @@ func (s *Server) decodePromoteChatMemberRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PromoteChatMember
@@ -3176,6 +3428,9 @@ func (s *Server) decodeRestrictChatMemberRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3201,6 +3456,9 @@ func (s *Server) decodeRestrictChatMemberRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request RestrictChatMember
@@ -3247,6 +3505,9 @@ func (s *Server) decodeRevokeChatInviteLinkRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3272,6 +3533,9 @@ func (s *Server) decodeRevokeChatInviteLinkRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request RevokeChatInviteLink
@@ -3318,6 +3582,9 @@ func (s *Server) decodeSendAnimationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3343,6 +3610,9 @@ func (s *Server) decodeSendAnimationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendAnimation
@@ -3397,6 +3667,9 @@ func (s *Server) decodeSendAudioRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3422,6 +3695,9 @@ func (s *Server) decodeSendAudioRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendAudio
@@ -3476,6 +3752,9 @@ func (s *Server) decodeSendChatActionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3501,6 +3780,9 @@ func (s *Server) decodeSendChatActionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendChatAction
@@ -3547,6 +3829,9 @@ func (s *Server) decodeSendContactRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3572,6 +3857,9 @@ func (s *Server) decodeSendContactRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendContact
@@ -3626,6 +3914,9 @@ func (s *Server) decodeSendDiceRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3651,6 +3942,9 @@ func (s *Server) decodeSendDiceRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendDice
@@ -3705,6 +3999,9 @@ func (s *Server) decodeSendDocumentRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3730,6 +4027,9 @@ func (s *Server) decodeSendDocumentRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendDocument
@@ -3784,6 +4084,9 @@ func (s *Server) decodeSendGameRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3809,6 +4112,9 @@ func (s *Server) decodeSendGameRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendGame
@@ -3863,6 +4169,9 @@ func (s *Server) decodeSendInvoiceRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3888,6 +4197,9 @@ func (s *Server) decodeSendInvoiceRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendInvoice
@@ -3942,6 +4254,9 @@ func (s *Server) decodeSendLocationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -3967,6 +4282,9 @@ func (s *Server) decodeSendLocationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendLocation
@@ -4021,6 +4339,9 @@ func (s *Server) decodeSendMediaGroupRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4046,6 +4367,9 @@ func (s *Server) decodeSendMediaGroupRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendMediaGroup
@@ -4100,6 +4424,9 @@ func (s *Server) decodeSendMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4125,6 +4452,9 @@ func (s *Server) decodeSendMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendMessage
@@ -4179,6 +4509,9 @@ func (s *Server) decodeSendPhotoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4204,6 +4537,9 @@ func (s *Server) decodeSendPhotoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendPhoto
@@ -4258,6 +4594,9 @@ func (s *Server) decodeSendPollRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4283,6 +4622,9 @@ func (s *Server) decodeSendPollRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendPoll
@@ -4337,6 +4679,9 @@ func (s *Server) decodeSendStickerRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4362,6 +4707,9 @@ func (s *Server) decodeSendStickerRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendSticker
@@ -4416,6 +4764,9 @@ func (s *Server) decodeSendVenueRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4441,6 +4792,9 @@ func (s *Server) decodeSendVenueRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendVenue
@@ -4495,6 +4849,9 @@ func (s *Server) decodeSendVideoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4520,6 +4877,9 @@ func (s *Server) decodeSendVideoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendVideo
@@ -4574,6 +4934,9 @@ func (s *Server) decodeSendVideoNoteRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4599,6 +4962,9 @@ func (s *Server) decodeSendVideoNoteRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendVideoNote
@@ -4653,6 +5019,9 @@ func (s *Server) decodeSendVoiceRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4678,6 +5047,9 @@ func (s *Server) decodeSendVoiceRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SendVoice
@@ -4732,6 +5104,9 @@ func (s *Server) decodeSetChatAdministratorCustomTitleRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4757,6 +5132,9 @@ func (s *Server) decodeSetChatAdministratorCustomTitleRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatAdministratorCustomTitle
@@ -4811,6 +5189,9 @@ func (s *Server) decodeSetChatDescriptionRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4836,6 +5217,9 @@ func (s *Server) decodeSetChatDescriptionRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatDescription
@@ -4890,6 +5274,9 @@ func (s *Server) decodeSetChatMenuButtonRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -4918,6 +5305,9 @@ func (s *Server) decodeSetChatMenuButtonRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptSetChatMenuButton
@@ -4965,6 +5355,9 @@ func (s *Server) decodeSetChatPermissionsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -4990,6 +5383,9 @@ func (s *Server) decodeSetChatPermissionsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatPermissions
@@ -5036,6 +5432,9 @@ func (s *Server) decodeSetChatPhotoRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5061,6 +5460,9 @@ func (s *Server) decodeSetChatPhotoRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatPhoto
@@ -5107,6 +5509,9 @@ func (s *Server) decodeSetChatStickerSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5132,6 +5537,9 @@ func (s *Server) decodeSetChatStickerSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatStickerSet
@@ -5178,6 +5586,9 @@ func (s *Server) decodeSetChatTitleRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5203,6 +5614,9 @@ func (s *Server) decodeSetChatTitleRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetChatTitle
@@ -5257,6 +5671,9 @@ func (s *Server) decodeSetGameScoreRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5282,6 +5699,9 @@ func (s *Server) decodeSetGameScoreRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetGameScore
@@ -5328,6 +5748,9 @@ func (s *Server) decodeSetMyCommandsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5353,6 +5776,9 @@ func (s *Server) decodeSetMyCommandsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetMyCommands
@@ -5407,6 +5833,9 @@ func (s *Server) decodeSetMyDefaultAdministratorRightsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -5435,6 +5864,9 @@ func (s *Server) decodeSetMyDefaultAdministratorRightsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request OptSetMyDefaultAdministratorRights
@@ -5482,6 +5914,9 @@ func (s *Server) decodeSetPassportDataErrorsRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5507,6 +5942,9 @@ func (s *Server) decodeSetPassportDataErrorsRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetPassportDataErrors
@@ -5561,6 +5999,9 @@ func (s *Server) decodeSetStickerPositionInSetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5586,6 +6027,9 @@ func (s *Server) decodeSetStickerPositionInSetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetStickerPositionInSet
@@ -5632,6 +6076,9 @@ func (s *Server) decodeSetStickerSetThumbRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5657,6 +6104,9 @@ func (s *Server) decodeSetStickerSetThumbRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetStickerSetThumb
@@ -5703,6 +6153,9 @@ func (s *Server) decodeSetWebhookRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5728,6 +6181,9 @@ func (s *Server) decodeSetWebhookRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SetWebhook
@@ -5774,6 +6230,9 @@ func (s *Server) decodeStopMessageLiveLocationRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5799,6 +6258,9 @@ func (s *Server) decodeStopMessageLiveLocationRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request StopMessageLiveLocation
@@ -5853,6 +6315,9 @@ func (s *Server) decodeStopPollRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5878,6 +6343,9 @@ func (s *Server) decodeStopPollRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request StopPoll
@@ -5932,6 +6400,9 @@ func (s *Server) decodeUnbanChatMemberRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -5957,6 +6428,9 @@ func (s *Server) decodeUnbanChatMemberRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UnbanChatMember
@@ -6003,6 +6477,9 @@ func (s *Server) decodeUnbanChatSenderChatRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6028,6 +6505,9 @@ func (s *Server) decodeUnbanChatSenderChatRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UnbanChatSenderChat
@@ -6074,6 +6554,9 @@ func (s *Server) decodeUnpinAllChatMessagesRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6099,6 +6582,9 @@ func (s *Server) decodeUnpinAllChatMessagesRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UnpinAllChatMessages
@@ -6145,6 +6631,9 @@ func (s *Server) decodeUnpinChatMessageRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6170,6 +6659,9 @@ func (s *Server) decodeUnpinChatMessageRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UnpinChatMessage
@@ -6216,6 +6708,9 @@ func (s *Server) decodeUploadStickerFileRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -6241,6 +6736,9 @@ func (s *Server) decodeUploadStickerFileRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UploadStickerFile
@@ -123,29 +123,49 @@ func (c *Client) sendGetBook(ctx context.Context, params GetBookParams) (res Get
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetBookOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -181,10 +201,12 @@ func (c *Client) sendGetBook(ctx context.Context, params GetBookParams) (res Get
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -221,29 +243,49 @@ func (c *Client) sendGetPageCoverImage(ctx context.Context, params GetPageCoverI
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetPageCoverImageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -298,10 +340,12 @@ func (c *Client) sendGetPageCoverImage(ctx context.Context, params GetPageCoverI
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -338,29 +382,49 @@ func (c *Client) sendGetPageImage(ctx context.Context, params GetPageImageParams
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetPageImageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -434,10 +498,12 @@ func (c *Client) sendGetPageImage(ctx context.Context, params GetPageImageParams
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -474,29 +540,49 @@ func (c *Client) sendGetPageThumbnailImage(ctx context.Context, params GetPageTh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, GetPageThumbnailImageOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -570,10 +656,12 @@ func (c *Client) sendGetPageThumbnailImage(ctx context.Context, params GetPageTh
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -610,29 +698,49 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res Searc
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SearchOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -685,10 +793,12 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res Searc
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
@@ -725,29 +835,49 @@ func (c *Client) sendSearchByTagID(ctx context.Context, params SearchByTagIDPara
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, SearchByTagIDOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage        string
+		statusCode   int
+		responseBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		if statusCode != 0 {
+			c.responseBodySize.Record(ctx, responseBody.Count(), attrOpt)
+		}
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -800,10 +930,12 @@ func (c *Client) sendSearchByTagID(ctx context.Context, params SearchByTagIDPara
 	}
 
 	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
+	resp, err := c.doRetry(c.cfg.Client, r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
+	resp.Body = responseBody.Wrap(resp.Body)
 	body := resp.Body
 	defer func() {
 		// Drain the body to EOF before closing, so the underlying
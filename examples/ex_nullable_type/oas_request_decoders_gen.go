@@ -35,6 +35,9 @@ func (s *Server) decodeCreateTemplateRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -60,6 +63,9 @@ func (s *Server) decodeCreateTemplateRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request Template
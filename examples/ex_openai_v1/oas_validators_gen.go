@@ -114,29 +114,35 @@ func (s *CreateAnswerRequest) Validate() error {
 		if s.Examples == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    200,
 			MaxLengthSet: true,
 		}).ValidateLength(len(s.Examples)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Examples {
 			if err := func() error {
 				if elem == nil {
 					return errors.New("nil is invalid value")
 				}
+				var failures []validate.FieldError
 				if err := (validate.Array{
 					MinLength:    2,
 					MinLengthSet: true,
 					MaxLength:    2,
 					MaxLengthSet: true,
 				}).ValidateLength(len(elem)); err != nil {
-					return errors.Wrap(err, "array")
+					// Failure of the array itself.
+					failures = append(failures, validate.FieldError{
+						Error: errors.Wrap(err, "array"),
+					})
 				}
-				var failures []validate.FieldError
 				for i, elem := range elem {
 					if err := func() error {
 						if err := (validate.String{
@@ -357,15 +363,18 @@ func (s *CreateChatCompletionRequest) Validate() error {
 		if s.Messages == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.Messages)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Messages {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -680,29 +689,35 @@ func (s *CreateClassificationRequest) Validate() error {
 				if value == nil {
 					return errors.New("nil is invalid value")
 				}
+				var failures []validate.FieldError
 				if err := (validate.Array{
 					MinLength:    2,
 					MinLengthSet: true,
 					MaxLength:    200,
 					MaxLengthSet: true,
 				}).ValidateLength(len(value)); err != nil {
-					return errors.Wrap(err, "array")
+					// Failure of the array itself.
+					failures = append(failures, validate.FieldError{
+						Error: errors.Wrap(err, "array"),
+					})
 				}
-				var failures []validate.FieldError
 				for i, elem := range value {
 					if err := func() error {
 						if elem == nil {
 							return errors.New("nil is invalid value")
 						}
+						var failures []validate.FieldError
 						if err := (validate.Array{
 							MinLength:    2,
 							MinLengthSet: true,
 							MaxLength:    2,
 							MaxLengthSet: true,
 						}).ValidateLength(len(elem)); err != nil {
-							return errors.Wrap(err, "array")
+							// Failure of the array itself.
+							failures = append(failures, validate.FieldError{
+								Error: errors.Wrap(err, "array"),
+							})
 						}
-						var failures []validate.FieldError
 						for i, elem := range elem {
 							if err := func() error {
 								if err := (validate.String{
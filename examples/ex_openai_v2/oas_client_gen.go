@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -91,162 +92,6 @@ func WithRequestSSEOptions(opts ...SSEClientOption) RequestOption {
 	}
 }
 
-type sseClientConfig struct {
-	LastEventID       string
-	Retry             *time.Duration
-	MaxRetries        int
-	InitialBufferCap  int
-	MaxEventSize      int
-	RetryErrorHandler sse.RetryErrorHandler
-}
-
-type SSEClientOption func(*sseClientConfig)
-
-func newSSEClientConfig(opts ...SSEClientOption) sseClientConfig {
-	var cfg sseClientConfig
-	cfg.apply(opts...)
-	return cfg
-}
-
-func (c *sseClientConfig) apply(opts ...SSEClientOption) {
-	for _, opt := range opts {
-		if opt != nil {
-			opt(c)
-		}
-	}
-}
-
-// WithSSELastEventID sets the initial lastEventID value for the stream.
-func WithSSELastEventID(lastEventID string) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.LastEventID = lastEventID
-	}
-}
-
-// WithSSERetry sets the initial SSE reconnect delay.
-func WithSSERetry(delay time.Duration) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.Retry = &delay
-	}
-}
-
-// WithSSEMaxRetries sets the maximum number of reconnect attempts.
-//
-// Zero sets unlimited reconnect attempts.
-func WithSSEMaxRetries(n int) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.MaxRetries = n
-	}
-}
-
-// WithSSEInitialBufferCap sets the initial decoder line buffer capacity.
-func WithSSEInitialBufferCap(n int) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.InitialBufferCap = n
-	}
-}
-
-// WithSSEMaxEventSize sets the maximum parsable SSE event size in bytes.
-//
-// Zero disables the limit.
-func WithSSEMaxEventSize(n int) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.MaxEventSize = n
-	}
-}
-
-// WithSSERetryErrorHandler sets the callback invoked after a reconnect attempt fails.
-func WithSSERetryErrorHandler(h sse.RetryErrorHandler) SSEClientOption {
-	return func(o *sseClientConfig) {
-		o.RetryErrorHandler = h
-	}
-}
-
-// sseConnectFunc reconnects an SSE stream using the lastEventID value.
-type sseConnectFunc func(ctx context.Context, lastEventID string) (*http.Response, error)
-
-func newSSEResponseDecoder(resp *http.Response, options sseClientConfig) *sse.Decoder {
-	if resp == nil || resp.Body == nil {
-		return nil
-	}
-	return sse.NewDecoder(resp.Body,
-		options.InitialBufferCap,
-		options.MaxEventSize,
-		options.LastEventID,
-		options.Retry,
-	)
-}
-
-func reconnectSSE(ctx context.Context,
-	resp *http.Response,
-	decoder *sse.Decoder,
-	connect sseConnectFunc,
-	options sseClientConfig,
-	stateUpdater interface{ setState(sse.State, error) },
-) (*http.Response, *sse.Decoder, error) {
-	if resp != nil && resp.Body != nil {
-		if err := resp.Body.Close(); err != nil {
-			return nil, nil, err
-		}
-	}
-
-	retry := sse.DefaultRetry
-	if decoder != nil {
-		retry = decoder.Retry()
-	} else if options.Retry != nil {
-		retry = *options.Retry
-	}
-
-	lastEventID := options.LastEventID
-	if decoder != nil {
-		lastEventID = decoder.LastEventID()
-	}
-
-	if err := waitSSERetry(ctx, retry); err != nil {
-		if stateUpdater != nil {
-			stateUpdater.setState(sse.StateConnecting, err)
-		}
-		return nil, nil, err
-	}
-
-	var attempts int
-	for {
-		nextResp, err := connect(ctx, lastEventID)
-		if err == nil {
-			options.LastEventID = lastEventID
-			options.Retry = &retry
-			return nextResp, newSSEResponseDecoder(nextResp, options), nil
-		}
-
-		attempts++
-		stateUpdater.setState(sse.StateConnecting, err)
-		if options.RetryErrorHandler != nil {
-			options.RetryErrorHandler(ctx, err)
-		}
-		if options.MaxRetries > 0 && attempts >= options.MaxRetries {
-			return nil, nil, errors.Wrap(sse.ErrMaxRetriesExceeded, err.Error())
-		}
-		if err := waitSSERetry(ctx, retry); err != nil {
-			if stateUpdater != nil {
-				stateUpdater.setState(sse.StateConnecting, err)
-			}
-			return nil, nil, err
-		}
-	}
-}
-
-func waitSSERetry(ctx context.Context, retry time.Duration) error {
-	timer := time.NewTimer(retry)
-	defer timer.Stop()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-timer.C:
-		return nil
-	}
-}
-
 func trimTrailingSlashes(u *url.URL) {
 	u.Path = strings.TrimRight(u.Path, "/")
 	u.RawPath = strings.TrimRight(u.RawPath, "/")
@@ -369,29 +214,48 @@ func (c *Client) sendCreateChatCompletion(ctx context.Context, request *CreateCh
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateChatCompletionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage       string
+		statusCode  int
+		requestBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		// Response body is consumed after return, its size is not recorded.
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -471,12 +335,14 @@ func (c *Client) sendCreateChatCompletion(ctx context.Context, request *CreateCh
 	if err := reqCfg.onRequest(r); err != nil {
 		return res, errors.Wrap(err, "edit request")
 	}
+	requestBody.WrapRequest(r)
 
 	stage = "SendRequest"
 	resp, err := reqCfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
 
 	if err := c.onResponse(ctx, resp); err != nil {
 		return res, errors.Wrap(err, "client edit response")
@@ -499,7 +365,24 @@ func (c *Client) sendCreateChatCompletion(ctx context.Context, request *CreateCh
 	}
 	// For SSE response keep the body open for streaming.
 	if ht.MatchContentType("text/event-stream", ct) {
-		result.initSSEStream(func(reconnectCtx context.Context, lastEventID string) (*http.Response, error) {
+		result.initSSEStream(func(reconnectCtx context.Context, lastEventID string, attempt int) (_ *http.Response, rerr error) {
+			// Each reconnect attempt is traced as a child of the operation span.
+			reconnectCtx, reconnectSpan := c.cfg.Tracer.Start(trace.ContextWithSpan(reconnectCtx, span), CreateChatCompletionOperation,
+				trace.WithAttributes(otelAttrs...),
+				trace.WithAttributes(
+					otelogen.SSELastEventID(lastEventID),
+					otelogen.SSEReconnectAttempt(attempt),
+				),
+				clientSpanKind,
+			)
+			defer func() {
+				if rerr != nil {
+					reconnectSpan.RecordError(rerr)
+					reconnectSpan.SetStatus(codes.Error, "Reconnect")
+				}
+				reconnectSpan.End()
+			}()
+
 			reconnectReq := r.Clone(reconnectCtx)
 			if r.GetBody != nil {
 				body, err := r.GetBody()
@@ -517,6 +400,7 @@ func (c *Client) sendCreateChatCompletion(ctx context.Context, request *CreateCh
 			} else {
 				reconnectReq.Header.Del("Last-Event-ID")
 			}
+			c.cfg.Propagator.Inject(reconnectCtx, propagation.HeaderCarrier(reconnectReq.Header))
 
 			if err := c.onRequest(reconnectCtx, reconnectReq); err != nil {
 				return nil, errors.Wrap(err, "client edit reconnect request")
@@ -529,6 +413,7 @@ func (c *Client) sendCreateChatCompletion(ctx context.Context, request *CreateCh
 			if err != nil {
 				return nil, errors.Wrap(err, "do reconnect request")
 			}
+			reconnectSpan.SetAttributes(semconv.HTTPResponseStatusCode(reconnectResp.StatusCode))
 
 			if err := c.onResponse(reconnectCtx, reconnectResp); err != nil {
 				_ = reconnectResp.Body.Close()
@@ -596,29 +481,48 @@ func (c *Client) sendCreateResponse(ctx context.Context, request *CreateResponse
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	// Track requests in flight.
+	activeOpt := metric.WithAttributes(otelAttrs...)
+	c.activeRequests.Add(ctx, 1, activeOpt)
+	defer c.activeRequests.Add(ctx, -1, activeOpt)
 
 	// Start a span for this request.
 	ctx, span := c.cfg.Tracer.Start(ctx, CreateResponseOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
-	// Track stage for error reporting.
-	var stage string
+	// Track stage for error reporting and response for metrics.
+	var (
+		stage       string
+		statusCode  int
+		requestBody ht.BodyCounter
+	)
+
+	// Run stopwatch.
+	startTime := time.Now()
 	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrs := otelAttrs
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		c.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+
+		// Record body sizes.
+		c.requestBodySize.Record(ctx, requestBody.Count(), attrOpt)
+		// Response body is consumed after return, its size is not recorded.
+
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+			c.errors.Add(ctx, 1, metric.WithAttributes(append(otelogen.ErrorAttributes(stage, err), attrs...)...))
 		}
 		span.End()
 	}()
@@ -698,12 +602,14 @@ func (c *Client) sendCreateResponse(ctx context.Context, request *CreateResponse
 	if err := reqCfg.onRequest(r); err != nil {
 		return res, errors.Wrap(err, "edit request")
 	}
+	requestBody.WrapRequest(r)
 
 	stage = "SendRequest"
 	resp, err := reqCfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
+	statusCode = resp.StatusCode
 
 	if err := c.onResponse(ctx, resp); err != nil {
 		return res, errors.Wrap(err, "client edit response")
@@ -726,7 +632,24 @@ func (c *Client) sendCreateResponse(ctx context.Context, request *CreateResponse
 	}
 	// For SSE response keep the body open for streaming.
 	if ht.MatchContentType("text/event-stream", ct) {
-		result.initSSEStream(func(reconnectCtx context.Context, lastEventID string) (*http.Response, error) {
+		result.initSSEStream(func(reconnectCtx context.Context, lastEventID string, attempt int) (_ *http.Response, rerr error) {
+			// Each reconnect attempt is traced as a child of the operation span.
+			reconnectCtx, reconnectSpan := c.cfg.Tracer.Start(trace.ContextWithSpan(reconnectCtx, span), CreateResponseOperation,
+				trace.WithAttributes(otelAttrs...),
+				trace.WithAttributes(
+					otelogen.SSELastEventID(lastEventID),
+					otelogen.SSEReconnectAttempt(attempt),
+				),
+				clientSpanKind,
+			)
+			defer func() {
+				if rerr != nil {
+					reconnectSpan.RecordError(rerr)
+					reconnectSpan.SetStatus(codes.Error, "Reconnect")
+				}
+				reconnectSpan.End()
+			}()
+
 			reconnectReq := r.Clone(reconnectCtx)
 			if r.GetBody != nil {
 				body, err := r.GetBody()
@@ -744,6 +667,7 @@ func (c *Client) sendCreateResponse(ctx context.Context, request *CreateResponse
 			} else {
 				reconnectReq.Header.Del("Last-Event-ID")
 			}
+			c.cfg.Propagator.Inject(reconnectCtx, propagation.HeaderCarrier(reconnectReq.Header))
 
 			if err := c.onRequest(reconnectCtx, reconnectReq); err != nil {
 				return nil, errors.Wrap(err, "client edit reconnect request")
@@ -756,6 +680,7 @@ func (c *Client) sendCreateResponse(ctx context.Context, request *CreateResponse
 			if err != nil {
 				return nil, errors.Wrap(err, "do reconnect request")
 			}
+			reconnectSpan.SetAttributes(semconv.HTTPResponseStatusCode(reconnectResp.StatusCode))
 
 			if err := c.onResponse(reconnectCtx, reconnectResp); err != nil {
 				_ = reconnectResp.Body.Close()
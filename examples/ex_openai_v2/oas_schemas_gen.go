@@ -5100,7 +5100,12 @@ type CreateChatCompletionOKTextEventStreamClient interface {
 }
 
 // CreateChatCompletionOKTextEventStream is a Server-Sent Events response stream.
+//
+// Servers create it using NewCreateChatCompletionOKTextEventStream or NewCreateChatCompletionOKTextEventStreamFromChan.
 type CreateChatCompletionOKTextEventStream struct {
+	// events is the server-side event source.
+	events iter.Seq2[CreateChatCompletionOKTextEventStreamEvent, error]
+
 	resp      *http.Response
 	decoder   *sse.Decoder
 	connect   sseConnectFunc
@@ -5113,6 +5118,27 @@ type CreateChatCompletionOKTextEventStream struct {
 	closeOnce sync.Once
 }
 
+// NewCreateChatCompletionOKTextEventStream creates a new CreateChatCompletionOKTextEventStream stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewCreateChatCompletionOKTextEventStream(events iter.Seq2[CreateChatCompletionOKTextEventStreamEvent, error]) *CreateChatCompletionOKTextEventStream {
+	return &CreateChatCompletionOKTextEventStream{events: events}
+}
+
+// NewCreateChatCompletionOKTextEventStreamFromChan creates a new CreateChatCompletionOKTextEventStream stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewCreateChatCompletionOKTextEventStreamFromChan(events <-chan CreateChatCompletionOKTextEventStreamEvent) *CreateChatCompletionOKTextEventStream {
+	return NewCreateChatCompletionOKTextEventStream(func(yield func(CreateChatCompletionOKTextEventStreamEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
 func (s *CreateChatCompletionOKTextEventStream) initSSEStream(
 	connect sseConnectFunc,
 	options sseClientConfig,
@@ -5329,6 +5355,49 @@ func (s *CreateChatCompletionOKTextEventStream) decodeEvent(raw sse.Event,
 	return event, nil
 }
 
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *CreateChatCompletionOKTextEventStream) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *CreateChatCompletionOKTextEventStream) encodeEvent(event CreateChatCompletionOKTextEventStreamEvent,
+) (sse.Event, error) {
+	raw := sse.Event{
+		ID:   event.ID,
+		Type: event.Type,
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Data.Encode(e)
+	data, err := sseEventData(e.Bytes())
+	if err != nil {
+		return raw, errors.Wrap(err, "data")
+	}
+	raw.Data = data
+	if v, ok := event.Retry.Get(); ok {
+		retry := time.Duration(v) * time.Millisecond
+		raw.Retry = &retry
+	}
+	return raw, nil
+}
+
 func (*CreateChatCompletionOKTextEventStream) createChatCompletionRes() {}
 
 // CreateChatCompletionOKTextEventStreamEvent is a parsed Server-Sent Event.
@@ -7349,7 +7418,12 @@ type CreateResponseOKTextEventStreamClient interface {
 }
 
 // CreateResponseOKTextEventStream is a Server-Sent Events response stream.
+//
+// Servers create it using NewCreateResponseOKTextEventStream or NewCreateResponseOKTextEventStreamFromChan.
 type CreateResponseOKTextEventStream struct {
+	// events is the server-side event source.
+	events iter.Seq2[CreateResponseOKTextEventStreamEvent, error]
+
 	resp      *http.Response
 	decoder   *sse.Decoder
 	connect   sseConnectFunc
@@ -7362,6 +7436,27 @@ type CreateResponseOKTextEventStream struct {
 	closeOnce sync.Once
 }
 
+// NewCreateResponseOKTextEventStream creates a new CreateResponseOKTextEventStream stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewCreateResponseOKTextEventStream(events iter.Seq2[CreateResponseOKTextEventStreamEvent, error]) *CreateResponseOKTextEventStream {
+	return &CreateResponseOKTextEventStream{events: events}
+}
+
+// NewCreateResponseOKTextEventStreamFromChan creates a new CreateResponseOKTextEventStream stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewCreateResponseOKTextEventStreamFromChan(events <-chan CreateResponseOKTextEventStreamEvent) *CreateResponseOKTextEventStream {
+	return NewCreateResponseOKTextEventStream(func(yield func(CreateResponseOKTextEventStreamEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
 func (s *CreateResponseOKTextEventStream) initSSEStream(
 	connect sseConnectFunc,
 	options sseClientConfig,
@@ -7572,6 +7667,49 @@ func (s *CreateResponseOKTextEventStream) decodeEvent(raw sse.Event,
 	return event, nil
 }
 
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *CreateResponseOKTextEventStream) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *CreateResponseOKTextEventStream) encodeEvent(event CreateResponseOKTextEventStreamEvent,
+) (sse.Event, error) {
+	raw := sse.Event{
+		ID:   event.ID,
+		Type: event.Type,
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Data.Encode(e)
+	data, err := sseEventData(e.Bytes())
+	if err != nil {
+		return raw, errors.Wrap(err, "data")
+	}
+	raw.Data = data
+	if v, ok := event.Retry.Get(); ok {
+		retry := time.Duration(v) * time.Millisecond
+		raw.Retry = &retry
+	}
+	return raw, nil
+}
+
 func (*CreateResponseOKTextEventStream) createResponseRes() {}
 
 // CreateResponseOKTextEventStreamEvent is a parsed Server-Sent Event.
@@ -248,15 +248,18 @@ func (s ChatCompletionRequestAssistantMessageContent) Validate() error {
 		if s.ChatCompletionRequestAssistantMessageContentPartArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestAssistantMessageContentPartArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestAssistantMessageContentPartArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -347,15 +350,18 @@ func (s ChatCompletionRequestDeveloperMessageContent) Validate() error {
 		if s.ChatCompletionRequestMessageContentPartTextArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestMessageContentPartTextArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestMessageContentPartTextArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -757,15 +763,18 @@ func (s ChatCompletionRequestSystemMessageContent) Validate() error {
 		if s.ChatCompletionRequestMessageContentPartTextArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestMessageContentPartTextArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestMessageContentPartTextArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -839,15 +848,18 @@ func (s ChatCompletionRequestToolMessageContent) Validate() error {
 		if s.ChatCompletionRequestMessageContentPartTextArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestMessageContentPartTextArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestMessageContentPartTextArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -921,15 +933,18 @@ func (s ChatCompletionRequestUserMessageContent) Validate() error {
 		if s.ChatCompletionRequestUserMessageContentPartArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestUserMessageContentPartArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestUserMessageContentPartArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -2216,15 +2231,18 @@ func (s *CreateChatCompletionRequest) Validate() error {
 		if s.Messages == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.Messages)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Messages {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -4880,15 +4898,18 @@ func (s PredictionContentContent) Validate() error {
 		if s.ChatCompletionRequestMessageContentPartTextArray == nil {
 			return errors.New("nil is invalid value")
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    1,
 			MinLengthSet: true,
 			MaxLength:    0,
 			MaxLengthSet: false,
 		}).ValidateLength(len(s.ChatCompletionRequestMessageContentPartTextArray)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.ChatCompletionRequestMessageContentPartTextArray {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
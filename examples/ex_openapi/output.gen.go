@@ -1,6 +1,6 @@
 // Code generated by ogen, DO NOT EDIT.
 
-package examples
+package output
 
 import (
 	"fmt"
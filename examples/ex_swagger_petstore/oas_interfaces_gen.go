@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AddPetReq interface {
+	addPetReq()
+}
+
 type AddPetRes interface {
 	addPetRes()
 }
@@ -69,6 +73,10 @@ type PlaceOrderRes interface {
 	placeOrderRes()
 }
 
+type UpdatePetReq interface {
+	updatePetReq()
+}
+
 type UpdatePetRes interface {
 	updatePetRes()
 }
@@ -13,6 +13,82 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// Encode encodes AddPetApplicationJSON as json.
+func (s *AddPetApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := (*Pet)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes AddPetApplicationJSON from json.
+func (s *AddPetApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AddPetApplicationJSON to nil")
+	}
+	var unwrapped Pet
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = AddPetApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AddPetApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AddPetApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AddPetApplicationXWwwFormUrlencoded as json.
+func (s *AddPetApplicationXWwwFormUrlencoded) Encode(e *jx.Encoder) {
+	unwrapped := (*Pet)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes AddPetApplicationXWwwFormUrlencoded from json.
+func (s *AddPetApplicationXWwwFormUrlencoded) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AddPetApplicationXWwwFormUrlencoded to nil")
+	}
+	var unwrapped Pet
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = AddPetApplicationXWwwFormUrlencoded(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AddPetApplicationXWwwFormUrlencoded) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AddPetApplicationXWwwFormUrlencoded) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *ApiResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -1288,6 +1364,82 @@ func (s *Tag) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UpdatePetApplicationJSON as json.
+func (s *UpdatePetApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := (*Pet)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdatePetApplicationJSON from json.
+func (s *UpdatePetApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdatePetApplicationJSON to nil")
+	}
+	var unwrapped Pet
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdatePetApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdatePetApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdatePetApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdatePetApplicationXWwwFormUrlencoded as json.
+func (s *UpdatePetApplicationXWwwFormUrlencoded) Encode(e *jx.Encoder) {
+	unwrapped := (*Pet)(s)
+
+	unwrapped.Encode(e)
+}
+
+// Decode decodes UpdatePetApplicationXWwwFormUrlencoded from json.
+func (s *UpdatePetApplicationXWwwFormUrlencoded) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdatePetApplicationXWwwFormUrlencoded to nil")
+	}
+	var unwrapped Pet
+	if err := func() error {
+		if err := unwrapped.Decode(d); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpdatePetApplicationXWwwFormUrlencoded(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdatePetApplicationXWwwFormUrlencoded) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdatePetApplicationXWwwFormUrlencoded) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes UpdateUserApplicationJSON as json.
 func (s *UpdateUserApplicationJSON) Encode(e *jx.Encoder) {
 	unwrapped := (*User)(s)
@@ -19,7 +19,7 @@ import (
 )
 
 func (s *Server) decodeAddPetRequest(r *http.Request) (
-	req *Pet,
+	req AddPetReq,
 	rawBody []byte,
 	close func() error,
 	rerr error,
@@ -39,6 +39,9 @@ func (s *Server) decodeAddPetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -64,9 +67,12 @@ func (s *Server) decodeAddPetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
-		var request Pet
+		var request AddPetApplicationJSON
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -92,6 +98,219 @@ func (s *Server) decodeAddPetRequest(r *http.Request) (
 			return req, rawBody, close, errors.Wrap(err, "validate")
 		}
 		return &request, rawBody, close, nil
+	case ct == "application/x-www-form-urlencoded":
+		if r.ContentLength == 0 {
+			return req, rawBody, close, validate.ErrBodyRequired
+		}
+		form, err := ht.ParseForm(r)
+		if err != nil {
+			return req, rawBody, close, errors.Wrap(err, "parse form")
+		}
+
+		var request AddPetApplicationXWwwFormUrlencoded
+		{
+			var unwrapped Pet
+			q := uri.NewQueryDecoder(form)
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "id",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotIDVal int64
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToInt64(val)
+							if err != nil {
+								return err
+							}
+
+							unwrappedDotIDVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						unwrapped.ID.SetTo(unwrappedDotIDVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"id\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "name",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						unwrapped.Name = c
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"name\"")
+					}
+				} else {
+					return req, rawBody, close, errors.Wrap(err, "query")
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "category",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+					Fields:  []uri.QueryParameterObjectField{{Name: "id", Required: false}, {Name: "name", Required: false}},
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotCategoryVal Category
+						if err := func() error {
+							return unwrappedDotCategoryVal.DecodeURI(d)
+						}(); err != nil {
+							return err
+						}
+						unwrapped.Category.SetTo(unwrappedDotCategoryVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"category\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "photoUrls",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						unwrapped.PhotoUrls = nil
+						return d.DecodeArray(func(d uri.Decoder) error {
+							var unwrappedDotPhotoUrlsVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								unwrappedDotPhotoUrlsVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							unwrapped.PhotoUrls = append(unwrapped.PhotoUrls, unwrappedDotPhotoUrlsVal)
+							return nil
+						})
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"photoUrls\"")
+					}
+					if err := func() error {
+						if unwrapped.PhotoUrls == nil {
+							return errors.New("nil is invalid value")
+						}
+						return nil
+					}(); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "validate")
+					}
+				} else {
+					return req, rawBody, close, errors.Wrap(err, "query")
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "tags",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						unwrapped.Tags = nil
+						return d.DecodeArray(func(d uri.Decoder) error {
+							var unwrappedDotTagsVal Tag
+							if err := func() error {
+								return unwrappedDotTagsVal.DecodeURI(d)
+							}(); err != nil {
+								return err
+							}
+							unwrapped.Tags = append(unwrapped.Tags, unwrappedDotTagsVal)
+							return nil
+						})
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"tags\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "status",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotStatusVal PetStatus
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							unwrappedDotStatusVal = PetStatus(c)
+							return nil
+						}(); err != nil {
+							return err
+						}
+						unwrapped.Status.SetTo(unwrappedDotStatusVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"status\"")
+					}
+					if err := func() error {
+						if value, ok := unwrapped.Status.Get(); ok {
+							if err := func() error {
+								if err := value.Validate(); err != nil {
+									return err
+								}
+								return nil
+							}(); err != nil {
+								return err
+							}
+						}
+						return nil
+					}(); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "validate")
+					}
+				}
+			}
+			request = AddPetApplicationXWwwFormUrlencoded(unwrapped)
+		}
+		return &request, rawBody, close, nil
 	default:
 		return req, rawBody, close, validate.InvalidContentType(ct)
 	}
@@ -119,6 +338,9 @@ func (s *Server) decodeCreateUserRequest(r *http.Request) (
 		}
 	}()
 	req = &CreateUserReqEmptyBody{}
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -147,6 +369,9 @@ func (s *Server) decodeCreateUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request CreateUserApplicationJSON
@@ -465,6 +690,9 @@ func (s *Server) decodeCreateUsersWithListInputRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -493,6 +721,9 @@ func (s *Server) decodeCreateUsersWithListInputRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request []User
@@ -548,6 +779,9 @@ func (s *Server) decodePlaceOrderRequest(r *http.Request) (
 		}
 	}()
 	req = &PlaceOrderReqEmptyBody{}
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -576,6 +810,9 @@ func (s *Server) decodePlaceOrderRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request PlaceOrderApplicationJSON
@@ -833,7 +1070,7 @@ func (s *Server) decodePlaceOrderRequest(r *http.Request) (
 }
 
 func (s *Server) decodeUpdatePetRequest(r *http.Request) (
-	req *Pet,
+	req UpdatePetReq,
 	rawBody []byte,
 	close func() error,
 	rerr error,
@@ -853,6 +1090,9 @@ func (s *Server) decodeUpdatePetRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -878,9 +1118,12 @@ func (s *Server) decodeUpdatePetRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
-		var request Pet
+		var request UpdatePetApplicationJSON
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -906,6 +1149,219 @@ func (s *Server) decodeUpdatePetRequest(r *http.Request) (
 			return req, rawBody, close, errors.Wrap(err, "validate")
 		}
 		return &request, rawBody, close, nil
+	case ct == "application/x-www-form-urlencoded":
+		if r.ContentLength == 0 {
+			return req, rawBody, close, validate.ErrBodyRequired
+		}
+		form, err := ht.ParseForm(r)
+		if err != nil {
+			return req, rawBody, close, errors.Wrap(err, "parse form")
+		}
+
+		var request UpdatePetApplicationXWwwFormUrlencoded
+		{
+			var unwrapped Pet
+			q := uri.NewQueryDecoder(form)
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "id",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotIDVal int64
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToInt64(val)
+							if err != nil {
+								return err
+							}
+
+							unwrappedDotIDVal = c
+							return nil
+						}(); err != nil {
+							return err
+						}
+						unwrapped.ID.SetTo(unwrappedDotIDVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"id\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "name",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						unwrapped.Name = c
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"name\"")
+					}
+				} else {
+					return req, rawBody, close, errors.Wrap(err, "query")
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "category",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+					Fields:  []uri.QueryParameterObjectField{{Name: "id", Required: false}, {Name: "name", Required: false}},
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotCategoryVal Category
+						if err := func() error {
+							return unwrappedDotCategoryVal.DecodeURI(d)
+						}(); err != nil {
+							return err
+						}
+						unwrapped.Category.SetTo(unwrappedDotCategoryVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"category\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "photoUrls",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						unwrapped.PhotoUrls = nil
+						return d.DecodeArray(func(d uri.Decoder) error {
+							var unwrappedDotPhotoUrlsVal string
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToString(val)
+								if err != nil {
+									return err
+								}
+
+								unwrappedDotPhotoUrlsVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							unwrapped.PhotoUrls = append(unwrapped.PhotoUrls, unwrappedDotPhotoUrlsVal)
+							return nil
+						})
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"photoUrls\"")
+					}
+					if err := func() error {
+						if unwrapped.PhotoUrls == nil {
+							return errors.New("nil is invalid value")
+						}
+						return nil
+					}(); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "validate")
+					}
+				} else {
+					return req, rawBody, close, errors.Wrap(err, "query")
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "tags",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						unwrapped.Tags = nil
+						return d.DecodeArray(func(d uri.Decoder) error {
+							var unwrappedDotTagsVal Tag
+							if err := func() error {
+								return unwrappedDotTagsVal.DecodeURI(d)
+							}(); err != nil {
+								return err
+							}
+							unwrapped.Tags = append(unwrapped.Tags, unwrappedDotTagsVal)
+							return nil
+						})
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"tags\"")
+					}
+				}
+			}
+			{
+				cfg := uri.QueryParameterDecodingConfig{
+					Name:    "status",
+					Style:   uri.QueryStyleForm,
+					Explode: true,
+				}
+				if err := q.HasParam(cfg); err == nil {
+					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+						var unwrappedDotStatusVal PetStatus
+						if err := func() error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							unwrappedDotStatusVal = PetStatus(c)
+							return nil
+						}(); err != nil {
+							return err
+						}
+						unwrapped.Status.SetTo(unwrappedDotStatusVal)
+						return nil
+					}); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "decode \"status\"")
+					}
+					if err := func() error {
+						if value, ok := unwrapped.Status.Get(); ok {
+							if err := func() error {
+								if err := value.Validate(); err != nil {
+									return err
+								}
+								return nil
+							}(); err != nil {
+								return err
+							}
+						}
+						return nil
+					}(); err != nil {
+						return req, rawBody, close, errors.Wrap(err, "validate")
+					}
+				}
+			}
+			request = UpdatePetApplicationXWwwFormUrlencoded(unwrapped)
+		}
+		return &request, rawBody, close, nil
 	default:
 		return req, rawBody, close, validate.InvalidContentType(ct)
 	}
@@ -933,6 +1389,9 @@ func (s *Server) decodeUpdateUserRequest(r *http.Request) (
 		}
 	}()
 	req = &UpdateUserReqEmptyBody{}
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -961,6 +1420,9 @@ func (s *Server) decodeUpdateUserRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request UpdateUserApplicationJSON
@@ -1279,6 +1741,9 @@ func (s *Server) decodeUploadFileRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
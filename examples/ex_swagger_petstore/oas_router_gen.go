@@ -115,7 +115,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						s.notAllowed(w, r, notAllowedParams{
 							allowedMethods: "POST,PUT",
 							allowedHeaders: rn1AllowedHeaders,
-							acceptPost:     "application/json",
+							acceptPost:     "application/json,application/x-www-form-urlencoded",
 							acceptPatch:    "",
 						})
 					}
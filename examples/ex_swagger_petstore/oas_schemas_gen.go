@@ -34,6 +34,14 @@ func (s *APIKey) SetRoles(val []string) {
 	s.Roles = val
 }
 
+type AddPetApplicationJSON Pet
+
+func (*AddPetApplicationJSON) addPetReq() {}
+
+type AddPetApplicationXWwwFormUrlencoded Pet
+
+func (*AddPetApplicationXWwwFormUrlencoded) addPetReq() {}
+
 // AddPetBadRequest is response for AddPet operation.
 type AddPetBadRequest struct{}
 
@@ -1316,6 +1324,14 @@ func (s *Tag) SetName(val OptString) {
 	s.Name = val
 }
 
+type UpdatePetApplicationJSON Pet
+
+func (*UpdatePetApplicationJSON) updatePetReq() {}
+
+type UpdatePetApplicationXWwwFormUrlencoded Pet
+
+func (*UpdatePetApplicationXWwwFormUrlencoded) updatePetReq() {}
+
 // UpdatePetBadRequest is response for UpdatePet operation.
 type UpdatePetBadRequest struct{}
 
@@ -13,7 +13,7 @@ type Handler interface {
 	// Add a new pet to the store.
 	//
 	// POST /pet
-	AddPet(ctx context.Context, req *Pet) (AddPetRes, error)
+	AddPet(ctx context.Context, req AddPetReq) (AddPetRes, error)
 	// CreateUser implements createUser operation.
 	//
 	// This can only be done by the logged in user.
@@ -104,7 +104,7 @@ type Handler interface {
 	// Update an existing pet by Id.
 	//
 	// PUT /pet
-	UpdatePet(ctx context.Context, req *Pet) (UpdatePetRes, error)
+	UpdatePet(ctx context.Context, req UpdatePetReq) (UpdatePetRes, error)
 	// UpdatePetWithForm implements updatePetWithForm operation.
 	//
 	// Updates a pet resource based on the form data.
@@ -18,7 +18,7 @@ var _ Handler = UnimplementedHandler{}
 // Add a new pet to the store.
 //
 // POST /pet
-func (UnimplementedHandler) AddPet(ctx context.Context, req *Pet) (r AddPetRes, _ error) {
+func (UnimplementedHandler) AddPet(ctx context.Context, req AddPetReq) (r AddPetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -154,7 +154,7 @@ func (UnimplementedHandler) PlaceOrder(ctx context.Context, req PlaceOrderReq) (
 // Update an existing pet by Id.
 //
 // PUT /pet
-func (UnimplementedHandler) UpdatePet(ctx context.Context, req *Pet) (r UpdatePetRes, _ error) {
+func (UnimplementedHandler) UpdatePet(ctx context.Context, req UpdatePetReq) (r UpdatePetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
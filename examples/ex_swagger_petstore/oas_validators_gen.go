@@ -9,6 +9,22 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+func (s *AddPetApplicationJSON) Validate() error {
+	alias := (*Pet)(s)
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *AddPetApplicationXWwwFormUrlencoded) Validate() error {
+	alias := (*Pet)(s)
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s FindPetsByStatusOKApplicationJSON) Validate() error {
 	alias := ([]Pet)(s)
 	if alias == nil {
@@ -184,3 +200,19 @@ func (s *PlaceOrderApplicationXWwwFormUrlencoded) Validate() error {
 	}
 	return nil
 }
+
+func (s *UpdatePetApplicationJSON) Validate() error {
+	alias := (*Pet)(s)
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UpdatePetApplicationXWwwFormUrlencoded) Validate() error {
+	alias := (*Pet)(s)
+	if err := alias.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -13,6 +13,8 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -33,6 +35,7 @@ type otelConfig struct {
 	Tracer         trace.Tracer
 	MeterProvider  metric.MeterProvider
 	Meter          metric.Meter
+	Propagator     propagation.TextMapPropagator
 	Attributes     []attribute.KeyValue
 }
 
@@ -43,6 +46,9 @@ func (cfg *otelConfig) initOTEL() {
 	if cfg.MeterProvider == nil {
 		cfg.MeterProvider = otel.GetMeterProvider()
 	}
+	if cfg.Propagator == nil {
+		cfg.Propagator = otel.GetTextMapPropagator()
+	}
 	cfg.Tracer = cfg.TracerProvider.Tracer(otelogen.Name,
 		trace.WithInstrumentationVersion(otelogen.SemVersion()),
 	)
@@ -62,6 +68,8 @@ type serverConfig struct {
 	Prefix             string
 	Middleware         Middleware
 	MaxMultipartMemory int64
+	MaxRequestBodySize int64
+	JSONLimits         ogenerrors.JSONLimits
 }
 
 // ServerOption is server config option.
@@ -97,10 +105,13 @@ func newServerConfig(opts ...ServerOption) serverConfig {
 }
 
 type baseServer struct {
-	cfg      serverConfig
-	requests metric.Int64Counter
-	errors   metric.Int64Counter
-	duration metric.Float64Histogram
+	cfg              serverConfig
+	requests         metric.Int64Counter
+	errors           metric.Int64Counter
+	duration         metric.Float64Histogram
+	activeRequests   metric.Int64UpDownCounter
+	requestBodySize  metric.Int64Histogram
+	responseBodySize metric.Int64Histogram
 }
 
 func (s baseServer) notFound(w http.ResponseWriter, r *http.Request) {
@@ -158,14 +169,27 @@ func (cfg serverConfig) baseServer() (s baseServer, err error) {
 	if s.duration, err = otelogen.ServerDurationHistogram(s.cfg.Meter); err != nil {
 		return s, err
 	}
+	if s.activeRequests, err = otelogen.ServerActiveRequestsCounter(s.cfg.Meter); err != nil {
+		return s, err
+	}
+	if s.requestBodySize, err = otelogen.ServerRequestBodySizeHistogram(s.cfg.Meter); err != nil {
+		return s, err
+	}
+	if s.responseBodySize, err = otelogen.ServerResponseBodySizeHistogram(s.cfg.Meter); err != nil {
+		return s, err
+	}
 	return s, nil
 }
 
 type clientConfig struct {
 	otelConfig
 	Client ht.Client
+	Retry  *RetryPolicy
 }
 
+// RetryPolicy defines how failed requests are retried.
+type RetryPolicy = ht.RetryPolicy
+
 // ClientOption is client config option.
 type ClientOption interface {
 	applyClient(*clientConfig)
@@ -195,10 +219,13 @@ func newClientConfig(opts ...ClientOption) clientConfig {
 }
 
 type baseClient struct {
-	cfg      clientConfig
-	requests metric.Int64Counter
-	errors   metric.Int64Counter
-	duration metric.Float64Histogram
+	cfg              clientConfig
+	requests         metric.Int64Counter
+	errors           metric.Int64Counter
+	duration         metric.Float64Histogram
+	activeRequests   metric.Int64UpDownCounter
+	requestBodySize  metric.Int64Histogram
+	responseBodySize metric.Int64Histogram
 }
 
 func (cfg clientConfig) baseClient() (c baseClient, err error) {
@@ -212,9 +239,38 @@ func (cfg clientConfig) baseClient() (c baseClient, err error) {
 	if c.duration, err = otelogen.ClientDurationHistogram(c.cfg.Meter); err != nil {
 		return c, err
 	}
+	if c.activeRequests, err = otelogen.ClientActiveRequestsCounter(c.cfg.Meter); err != nil {
+		return c, err
+	}
+	if c.requestBodySize, err = otelogen.ClientRequestBodySizeHistogram(c.cfg.Meter); err != nil {
+		return c, err
+	}
+	if c.responseBodySize, err = otelogen.ClientResponseBodySizeHistogram(c.cfg.Meter); err != nil {
+		return c, err
+	}
 	return c, nil
 }
 
+// doRetry sends the request, retrying it according to the retry policy.
+func (c baseClient) doRetry(client ht.Client, r *http.Request) (*http.Response, error) {
+	if c.cfg.Retry == nil {
+		return client.Do(r)
+	}
+	return ht.DoRetry(client, r, *c.cfg.Retry, func(a ht.RetryAttempt) {
+		attrs := []attribute.KeyValue{
+			semconv.HTTPRequestResendCount(a.Attempt),
+			attribute.Int64("ogen.retry.delay_ms", a.Delay.Milliseconds()),
+		}
+		if resp := a.Response; resp != nil {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(resp.StatusCode))
+		}
+		if err := a.Err; err != nil {
+			attrs = append(attrs, semconv.ExceptionMessage(err.Error()))
+		}
+		trace.SpanFromContext(r.Context()).AddEvent("retry", trace.WithAttributes(attrs...))
+	})
+}
+
 // Option is config option.
 type Option interface {
 	ServerOption
@@ -259,6 +315,31 @@ func WithClient(client ht.Client) ClientOption {
 	})
 }
 
+// WithRetry enables retries of failed requests.
+//
+// Only requests of safe methods (GET, HEAD, OPTIONS, TRACE, QUERY) are retried,
+// operations may opt in or out using x-ogen-retry extension. Requests with
+// a body that cannot be replayed are sent once.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return optionFunc[clientConfig](func(cfg *clientConfig) {
+		cfg.Retry = &policy
+	})
+}
+
+// WithPropagator specifies a propagator to inject trace context and baggage
+// into webhook deliveries and SSE reconnect requests.
+//
+// If none is specified, the otel.GetTextMapPropagator() is used.
+// Passing nil disables injection.
+func WithPropagator(propagator propagation.TextMapPropagator) ClientOption {
+	return optionFunc[clientConfig](func(cfg *clientConfig) {
+		if propagator == nil {
+			propagator = propagation.NewCompositeTextMapPropagator()
+		}
+		cfg.Propagator = propagator
+	})
+}
+
 // WithNotFound specifies Not Found handler to use.
 func WithNotFound(notFound http.HandlerFunc) ServerOption {
 	return optionFunc[serverConfig](func(cfg *serverConfig) {
@@ -316,3 +397,40 @@ func WithMaxMultipartMemory(max int64) ServerOption {
 		}
 	})
 }
+
+// WithMaxRequestBodySize specifies limit of request body size in bytes.
+// Operations may override it using x-ogen-max-body-size extension.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+func WithMaxRequestBodySize(max int64) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.MaxRequestBodySize = max
+	})
+}
+
+// WithMaxJSONDepth specifies limit of nesting depth of JSON request bodies.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+//
+// Limits are checked by a separate pass over the request body before decoding,
+// so the body is parsed twice.
+func WithMaxJSONDepth(max int) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.JSONLimits.MaxDepth = max
+	})
+}
+
+// WithMaxJSONArrayLength specifies limit of array length in JSON request bodies.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+//
+// Limits are checked by a separate pass over the request body before decoding,
+// so the body is parsed twice.
+func WithMaxJSONArrayLength(max int) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.JSONLimits.MaxArrayLength = max
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -29,6 +31,7 @@ type otelConfig struct {
 	Tracer         trace.Tracer
 	MeterProvider  metric.MeterProvider
 	Meter          metric.Meter
+	Propagator     propagation.TextMapPropagator
 	Attributes     []attribute.KeyValue
 }
 
@@ -39,6 +42,9 @@ func (cfg *otelConfig) initOTEL() {
 	if cfg.MeterProvider == nil {
 		cfg.MeterProvider = otel.GetMeterProvider()
 	}
+	if cfg.Propagator == nil {
+		cfg.Propagator = otel.GetTextMapPropagator()
+	}
 	cfg.Tracer = cfg.TracerProvider.Tracer(otelogen.Name,
 		trace.WithInstrumentationVersion(otelogen.SemVersion()),
 	)
@@ -55,9 +61,13 @@ type clientConfig struct {
 	// A list of callbacks for modifying response.
 	ResponseEditors []ResponseEditor
 	Client          ht.Client
+	Retry           *RetryPolicy
 	sseCfg          sseClientConfig
 }
 
+// RetryPolicy defines how failed requests are retried.
+type RetryPolicy = ht.RetryPolicy
+
 // ClientOption is client config option.
 type ClientOption interface {
 	applyClient(*clientConfig)
@@ -87,10 +97,13 @@ func newClientConfig(opts ...ClientOption) clientConfig {
 }
 
 type baseClient struct {
-	cfg      clientConfig
-	requests metric.Int64Counter
-	errors   metric.Int64Counter
-	duration metric.Float64Histogram
+	cfg              clientConfig
+	requests         metric.Int64Counter
+	errors           metric.Int64Counter
+	duration         metric.Float64Histogram
+	activeRequests   metric.Int64UpDownCounter
+	requestBodySize  metric.Int64Histogram
+	responseBodySize metric.Int64Histogram
 }
 
 func (cfg clientConfig) baseClient() (c baseClient, err error) {
@@ -104,9 +117,38 @@ func (cfg clientConfig) baseClient() (c baseClient, err error) {
 	if c.duration, err = otelogen.ClientDurationHistogram(c.cfg.Meter); err != nil {
 		return c, err
 	}
+	if c.activeRequests, err = otelogen.ClientActiveRequestsCounter(c.cfg.Meter); err != nil {
+		return c, err
+	}
+	if c.requestBodySize, err = otelogen.ClientRequestBodySizeHistogram(c.cfg.Meter); err != nil {
+		return c, err
+	}
+	if c.responseBodySize, err = otelogen.ClientResponseBodySizeHistogram(c.cfg.Meter); err != nil {
+		return c, err
+	}
 	return c, nil
 }
 
+// doRetry sends the request, retrying it according to the retry policy.
+func (c baseClient) doRetry(client ht.Client, r *http.Request) (*http.Response, error) {
+	if c.cfg.Retry == nil {
+		return client.Do(r)
+	}
+	return ht.DoRetry(client, r, *c.cfg.Retry, func(a ht.RetryAttempt) {
+		attrs := []attribute.KeyValue{
+			semconv.HTTPRequestResendCount(a.Attempt),
+			attribute.Int64("ogen.retry.delay_ms", a.Delay.Milliseconds()),
+		}
+		if resp := a.Response; resp != nil {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(resp.StatusCode))
+		}
+		if err := a.Err; err != nil {
+			attrs = append(attrs, semconv.ExceptionMessage(err.Error()))
+		}
+		trace.SpanFromContext(r.Context()).AddEvent("retry", trace.WithAttributes(attrs...))
+	})
+}
+
 // Option is config option.
 type Option interface {
 	ClientOption
@@ -150,6 +192,31 @@ func WithClient(client ht.Client) ClientOption {
 	})
 }
 
+// WithRetry enables retries of failed requests.
+//
+// Only requests of safe methods (GET, HEAD, OPTIONS, TRACE, QUERY) are retried,
+// operations may opt in or out using x-ogen-retry extension. Requests with
+// a body that cannot be replayed are sent once.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return optionFunc[clientConfig](func(cfg *clientConfig) {
+		cfg.Retry = &policy
+	})
+}
+
+// WithPropagator specifies a propagator to inject trace context and baggage
+// into webhook deliveries and SSE reconnect requests.
+//
+// If none is specified, the otel.GetTextMapPropagator() is used.
+// Passing nil disables injection.
+func WithPropagator(propagator propagation.TextMapPropagator) ClientOption {
+	return optionFunc[clientConfig](func(cfg *clientConfig) {
+		if propagator == nil {
+			propagator = propagation.NewCompositeTextMapPropagator()
+		}
+		cfg.Propagator = propagator
+	})
+}
+
 // WithSSEClientOptions configures default SSE client behavior.
 func WithSSEClientOptions(opts ...SSEClientOption) ClientOption {
 	return optionFunc[clientConfig](func(cfg *clientConfig) {
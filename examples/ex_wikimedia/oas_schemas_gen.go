@@ -1131,7 +1131,12 @@ type V2StreamRecentchangeGetOKTextEventStreamClient interface {
 }
 
 // V2StreamRecentchangeGetOKTextEventStream is a Server-Sent Events response stream.
+//
+// Servers create it using NewV2StreamRecentchangeGetOKTextEventStream or NewV2StreamRecentchangeGetOKTextEventStreamFromChan.
 type V2StreamRecentchangeGetOKTextEventStream struct {
+	// events is the server-side event source.
+	events iter.Seq2[V2StreamRecentchangeGetOKTextEventStreamEvent, error]
+
 	resp      *http.Response
 	decoder   *sse.Decoder
 	connect   sseConnectFunc
@@ -1144,6 +1149,27 @@ type V2StreamRecentchangeGetOKTextEventStream struct {
 	closeOnce sync.Once
 }
 
+// NewV2StreamRecentchangeGetOKTextEventStream creates a new V2StreamRecentchangeGetOKTextEventStream stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewV2StreamRecentchangeGetOKTextEventStream(events iter.Seq2[V2StreamRecentchangeGetOKTextEventStreamEvent, error]) *V2StreamRecentchangeGetOKTextEventStream {
+	return &V2StreamRecentchangeGetOKTextEventStream{events: events}
+}
+
+// NewV2StreamRecentchangeGetOKTextEventStreamFromChan creates a new V2StreamRecentchangeGetOKTextEventStream stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewV2StreamRecentchangeGetOKTextEventStreamFromChan(events <-chan V2StreamRecentchangeGetOKTextEventStreamEvent) *V2StreamRecentchangeGetOKTextEventStream {
+	return NewV2StreamRecentchangeGetOKTextEventStream(func(yield func(V2StreamRecentchangeGetOKTextEventStreamEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
 func (s *V2StreamRecentchangeGetOKTextEventStream) initSSEStream(
 	connect sseConnectFunc,
 	options sseClientConfig,
@@ -1354,6 +1380,49 @@ func (s *V2StreamRecentchangeGetOKTextEventStream) decodeEvent(raw sse.Event,
 	return event, nil
 }
 
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *V2StreamRecentchangeGetOKTextEventStream) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *V2StreamRecentchangeGetOKTextEventStream) encodeEvent(event V2StreamRecentchangeGetOKTextEventStreamEvent,
+) (sse.Event, error) {
+	raw := sse.Event{
+		ID:   event.ID,
+		Type: event.Type,
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Data.Encode(e)
+	data, err := sseEventData(e.Bytes())
+	if err != nil {
+		return raw, errors.Wrap(err, "data")
+	}
+	raw.Data = data
+	if v, ok := event.Retry.Get(); ok {
+		retry := time.Duration(v) * time.Millisecond
+		raw.Retry = &retry
+	}
+	return raw, nil
+}
+
 func (*V2StreamRecentchangeGetOKTextEventStream) v2StreamRecentchangeGetRes() {}
 
 // V2StreamRecentchangeGetOKTextEventStreamEvent is a parsed Server-Sent Event.
@@ -109,6 +109,14 @@ var (
 		"webhooks/server",
 		`Enables webhooks server generation`,
 	}
+	CallbacksClient = Feature{
+		"callbacks/client",
+		`Enables callbacks client generation`,
+	}
+	CallbacksServer = Feature{
+		"callbacks/server",
+		`Enables callbacks server generation`,
+	}
 	ClientSecurityReentrant = Feature{
 		"client/security/reentrant",
 		`Enables client usage in security source implementations`,
@@ -146,6 +154,27 @@ var (
 		`Applies initialism rules (ID, URL, HTTP, ...) to camelCase identifiers, ` +
 			`e.g. "userId" becomes "UserID". Opt-in, since it changes generated names.`,
 	}
+	ContentXML = Feature{
+		"content/xml",
+		`Enables XML request and response bodies generation. ` +
+			`Opt-in, since it changes generated types of operations with XML bodies.`,
+	}
+	DecodingStrict = Feature{
+		"decoding/strict",
+		`Rejects unknown fields of objects without additionalProperties during decoding. ` +
+			`Opt-in, since it makes decoders reject fields they would otherwise skip.`,
+	}
+	OgenTagGroups = Feature{
+		"ogen/tag_groups",
+		`Groups operations by the top-level parent of their first tag, ` +
+			`generating handler interface, Unimplemented stub and client for every group. ` +
+			`Opt-in, since it changes generated handler interfaces.`,
+	}
+	OgenSplitPackages = Feature{
+		"ogen/split_packages",
+		`Generates client, server and handlers of every operation group into a sub-package, ` +
+			`importing schemas from the target package. Requires generator.package_path.`,
+	}
 )
 
 // DefaultFeatures defines default ogen features.
@@ -154,6 +183,8 @@ var DefaultFeatures = []Feature{
 	PathsServer,
 	WebhooksClient,
 	WebhooksServer,
+	CallbacksClient,
+	CallbacksServer,
 	OgenOtel,
 	OgenUnimplemented,
 }
@@ -164,6 +195,8 @@ var AllFeatures = []Feature{
 	PathsServer,
 	WebhooksClient,
 	WebhooksServer,
+	CallbacksClient,
+	CallbacksServer,
 	ClientSecurityReentrant,
 	ClientRequestOptions,
 	ClientRequestValidation,
@@ -173,4 +206,8 @@ var AllFeatures = []Feature{
 	OgenUnimplemented,
 	DebugExampleTests,
 	NamingCamelInitialisms,
+	ContentXML,
+	DecodingStrict,
+	OgenTagGroups,
+	OgenSplitPackages,
 }
@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/internal/xslices"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (g *Generator) makeCallbacks(parent *ir.Operation) error {
+	for _, cb := range parent.Spec.Callbacks {
+		for _, path := range cb.Paths {
+			c := &ir.Callback{
+				Name:       cb.Name,
+				Expression: path.Expression.String(),
+			}
+			for _, spec := range path.Operations {
+				op, err := g.generateCallbackOperation(parent, cb.Name, spec)
+				if err != nil {
+					err = errors.Wrapf(err, "callback %q: %q: %s",
+						cb.Name,
+						path.Expression,
+						strings.ToLower(spec.HTTPMethod),
+					)
+					if err := g.trySkip(err, "Skipping operation", spec); err != nil {
+						return err
+					}
+					continue
+				}
+				if op != nil {
+					c.Operations = append(c.Operations, op)
+				}
+			}
+			if len(c.Operations) == 0 {
+				continue
+			}
+
+			if len(cb.Paths) > 1 {
+				g.log.Info("Callback URL helper is not generated: callback has multiple expressions",
+					zap.String("callback", cb.Name),
+					zapPosition(cb),
+				)
+			} else {
+				u, err := g.generateCallbackURL(parent, cb.Name, path.Expression)
+				if err != nil {
+					g.log.Info("Callback URL helper is not generated",
+						zap.String("callback", cb.Name),
+						zap.Error(err),
+						zapPosition(path),
+					)
+				}
+				c.URL = u
+			}
+
+			parent.Callbacks = append(parent.Callbacks, c)
+		}
+	}
+	return nil
+}
+
+// generateCallbackOperation generates callback operation.
+//
+// Operation without operationId is named after the parent operation and the callback.
+// Returns nil, if operation is filtered.
+func (g *Generator) generateCallbackOperation(parent *ir.Operation, name string, spec *openapi.Operation) (*ir.Operation, error) {
+	// Referenced callback may be used by multiple operations.
+	if op, ok := g.callbackOps[spec]; ok {
+		return op, nil
+	}
+
+	log := g.log.With(zapPosition(spec))
+	if !g.opt.Filters.accept(spec) {
+		log.Info("Skipping filtered operation")
+		return nil, nil
+	}
+
+	// Do not modify the spec, referenced callback is shared.
+	cbSpec := *spec
+	cbSpec.Parameters = xslices.Filter(slices.Clone(spec.Parameters), func(p *openapi.Parameter) bool {
+		if p.In.Path() {
+			log.Warn("Callbacks can't have path parameters",
+				zap.String("name", p.Name),
+				zap.String("in", p.In.String()),
+			)
+			return false
+		}
+		return true
+	})
+	if len(spec.Security) > 0 {
+		log.Info("Callback security is not supported, ignoring")
+		cbSpec.Security = nil
+	}
+
+	ctx := &genctx{
+		global: g.tstorage,
+		local:  newTStorage(),
+	}
+
+	opName, err := g.namer().pascalNonEmpty(parent.Name, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "operation name")
+	}
+	op, err := g.generateOperation(ctx, opName, &cbSpec)
+	if err != nil {
+		return nil, err
+	}
+	op.CallbackInfo = &ir.CallbackInfo{
+		Name:   name,
+		Parent: parent.PrettyOperationID(),
+	}
+
+	if err := fixEqualRequests(ctx, op); err != nil {
+		return nil, errors.Wrap(err, "fix requests")
+	}
+	if err := fixEqualResponses(ctx, op); err != nil {
+		return nil, errors.Wrap(err, "fix responses")
+	}
+
+	if err := g.tstorage.merge(ctx.local); err != nil {
+		return nil, err
+	}
+
+	g.callbackOps[spec] = op
+	g.callbacks = append(g.callbacks, op)
+	return op, nil
+}
+
+// generateCallbackURL generates helper to build callback URL from the parent operation request.
+func (g *Generator) generateCallbackURL(parent *ir.Operation, name string, expr openapi.Expression) (*ir.CallbackURL, error) {
+	helperName, err := g.namer().pascalNonEmpty(parent.Name, name, "CallbackURL")
+	if err != nil {
+		return nil, errors.Wrap(err, "helper name")
+	}
+
+	u := &ir.CallbackURL{
+		Name: helperName,
+	}
+	for _, part := range expr.Parts {
+		e := part.Expr
+		if e == nil {
+			u.Parts = append(u.Parts, ir.CallbackURLPart{Raw: part.Raw})
+			continue
+		}
+
+		switch e.Source {
+		case openapi.ExpressionMethod:
+			u.Parts = append(u.Parts, ir.CallbackURLPart{Raw: strings.ToUpper(parent.Spec.HTTPMethod)})
+			continue
+		case openapi.ExpressionRequest:
+		default:
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("%q callback expression", "$"+e.Source)}
+		}
+
+		path, request, err := requestValuePath(parent, e)
+		if err != nil {
+			return nil, err
+		}
+		if request {
+			u.Request = true
+		} else {
+			u.Params = true
+		}
+
+		v, err := runtimeValue(e.String(), path)
+		if err != nil {
+			return nil, err
+		}
+		switch t := v.Type; {
+		case !t.IsPrimitive() && !t.IsEnum(),
+			t.EncodeFn() == "" && !t.IsExternal():
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("callback expression value of %s kind", t.Kind)}
+		}
+		u.Parts = append(u.Parts, ir.CallbackURLPart{Value: v})
+	}
+	return u, nil
+}
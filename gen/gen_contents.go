@@ -21,7 +21,9 @@ import (
 // application/vnd.api+json, application/merge-patch+json, etc.
 // to work without explicit ContentTypeAliases.
 // Note: application/problem+json has special handling via EncodingProblemJSON.
-func normalizeContentEncoding(contentType string, aliases ContentTypeAliases,
+//
+// Likewise, if xml is true, text/xml and +xml suffix media types are normalized as XML.
+func normalizeContentEncoding(contentType string, aliases ContentTypeAliases, xml bool,
 ) (parsedContentType string, encoding ir.Encoding, err error) {
 	parsedContentType, _, err = mime.ParseMediaType(contentType)
 	if err != nil {
@@ -38,6 +40,9 @@ func normalizeContentEncoding(contentType string, aliases ContentTypeAliases,
 		encoding != ir.EncodingProblemJSON {
 		encoding = ir.EncodingJSON
 	}
+	if xml && (encoding == "text/xml" || strings.HasSuffix(string(encoding), "+xml")) {
+		encoding = ir.EncodingXML
+	}
 
 	return parsedContentType, encoding, nil
 }
@@ -134,29 +139,59 @@ func (g *Generator) generateFormContent(
 	encoding ir.Encoding,
 ) (*ir.Type, error) {
 	if s := media.Schema; s != nil &&
-		((s.AdditionalProperties != nil && s.Item != nil) ||
-			len(s.PatternProperties) > 0) ||
-		len(s.Items) > 0 {
+		(len(s.PatternProperties) > 0 || len(s.Items) > 0) {
 		return nil, &ErrNotImplemented{"complex form schema"}
 	}
 
-	getEncoding := func(f *ir.Field) (ct ir.Encoding) {
-		if e, ok := media.Encoding[f.Tag.JSON]; ok {
-			ct = ir.Encoding(e.ContentType)
+	// getEncoding returns content type and encoding of the form field.
+	getEncoding := func(f *ir.Field) (contentType string, ct ir.Encoding, _ error) {
+		if e, ok := media.Encoding[f.Tag.JSON]; ok && e.ContentType != "" {
+			// Encoding Object may define a comma-separated list of media types.
+			contentType, _, _ = strings.Cut(e.ContentType, ",")
+			contentType = strings.TrimSpace(contentType)
+
+			_, ct, err := normalizeContentEncoding(contentType, g.opt.ContentTypeAliases, g.features.Has(ContentXML))
+			if err != nil {
+				return "", "", errors.Wrapf(err, "parse content type %q", e.ContentType)
+			}
+			if strings.ContainsRune(e.ContentType, ',') || strings.ContainsRune(contentType, '*') {
+				// Part may use any of listed media types, so do not set it.
+				contentType = ""
+			}
+			return contentType, ct, nil
+		}
+		if encoding.MultipartForm() && f.Spec != nil && isComplexMultipartType(f.Spec.Schema) {
+			return "", ir.EncodingJSON, nil
+		}
+		return "", "", nil
+	}
+	addFeature := func(f *ir.Field) error {
+		if f.Inline != ir.InlineNone {
+			// Item type of inlined map is not generated yet.
+			return nil
+		}
+		_, ct, err := getEncoding(f)
+		if err != nil {
+			return err
 		}
-		if ct == "" && encoding.MultipartForm() && isComplexMultipartType(f.Spec.Schema) {
-			ct = ir.EncodingJSON
+		switch ct {
+		case "", ir.EncodingFormURLEncoded, ir.EncodingTextPlain:
+			f.Type.AddFeature("uri")
+		case ir.EncodingJSON, ir.EncodingProblemJSON:
+			f.Type.AddFeature("json")
+		default:
+			return errors.Wrapf(
+				&ErrNotImplemented{"form content encoding"},
+				"%q", ct,
+			)
 		}
-		return ct
+		return nil
 	}
 
 	var override generateSchemaOverride
 	switch encoding {
 	case ir.EncodingFormURLEncoded:
-		override.fieldMut = func(f *ir.Field) error {
-			f.Type.AddFeature("uri")
-			return nil
-		}
+		override.fieldMut = addFeature
 	case ir.EncodingMultipart:
 		// A funny moment when you have a spec that shares schema between multipart form and JSON request and
 		// at some point you made ingenious decision to keep all types in one package at the same time.
@@ -182,18 +217,7 @@ func (g *Generator) generateFormContent(
 				t.AddFeature("multipart-file")
 				return nil
 			}
-			switch ct := getEncoding(f); ct {
-			case "", ir.EncodingFormURLEncoded:
-				f.Type.AddFeature("uri")
-			case ir.EncodingJSON, ir.EncodingProblemJSON:
-				f.Type.AddFeature("json")
-			default:
-				return errors.Wrapf(
-					&ErrNotImplemented{"form content encoding"},
-					"%q", ct,
-				)
-			}
-			return nil
+			return addFeature(f)
 		}
 	}
 	t, err := g.generateSchema(ctx, typeName, media.Schema, optional, &override)
@@ -225,6 +249,13 @@ func (g *Generator) generateFormContent(
 	}
 
 	for _, f := range structType.Fields {
+		if f.Inline == ir.InlineAdditional {
+			// Additional properties are encoded as form fields, using property name as a key.
+			if err := isParamAllowed(f.Type.Item, false, false, map[*ir.Type]struct{}{}); err != nil {
+				return nil, errors.Wrap(err, "additional properties")
+			}
+			continue
+		}
 		tag := f.Tag.JSON
 
 		spec := &openapi.Parameter{
@@ -237,17 +268,56 @@ func (g *Generator) generateFormContent(
 		}
 
 		if err := func() error {
-			if e, ok := media.Encoding[tag]; ok {
+			e, ok := media.Encoding[tag]
+			if ok {
 				spec.Style = e.Style
 				spec.Explode = e.Explode
 			}
-			switch ct := getEncoding(f); ct {
-			case "", ir.EncodingFormURLEncoded:
+			contentType, ct, err := getEncoding(f)
+			if err != nil {
+				return err
+			}
+
+			var part ir.FormPart
+			if encoding.MultipartForm() && ct != ir.EncodingFormURLEncoded {
+				part.ContentType = contentType
+			}
+			if ok && len(e.Headers) > 0 {
+				if !f.Type.HasFeature("multipart-file") {
+					g.log.Warn("Headers of non-file form parts are not supported and will be ignored",
+						zapPosition(e),
+						zap.String("field", tag),
+					)
+				} else {
+					headers, err := g.generateHeaders(ctx, structType.Name+f.Name, e.Headers)
+					if err != nil {
+						return errors.Wrap(err, "headers")
+					}
+					for _, name := range xmaps.SortedKeys(headers) {
+						part.Headers = append(part.Headers, headers[name])
+					}
+				}
+			}
+			if part.ContentType != "" || len(part.Headers) > 0 {
+				f.Tag.Part = &part
+			}
+
+			if f.Type.HasFeature("multipart-file") {
+				// Files are written as is, content type is used only as a default.
+				return nil
+			}
+			// Schema may be already generated for other media type, so field mutator was not called.
+			if err := addFeature(f); err != nil {
+				return err
+			}
+
+			switch ct {
+			case "", ir.EncodingFormURLEncoded, ir.EncodingTextPlain:
 				if err := isSupportedParamStyle(spec); err != nil {
 					return err
 				}
 
-				if err := isParamAllowed(f.Type, true, map[*ir.Type]struct{}{}); err != nil {
+				if err := isParamAllowed(f.Type, true, isNestingAllowed(spec), map[*ir.Type]struct{}{}); err != nil {
 					return err
 				}
 			case ir.EncodingJSON, ir.EncodingProblemJSON:
@@ -382,6 +452,10 @@ func (g *Generator) generateSSEContent(
 	}
 
 	schema := media.Schema
+	if media.ItemSchema != nil {
+		// Item schema describes a single event.
+		schema = media.ItemSchema
+	}
 	var err error
 	switch shape {
 	case openapi.SSEEventShapeFull:
@@ -455,6 +529,54 @@ func (g *Generator) generateSSEContent(
 	return streamType, nil
 }
 
+// setSSEClient marks SSE streams as read by clients, if any client is generated.
+//
+// Otherwise, client hooks are removed, so server-only packages do not
+// depend on client SSE helpers.
+func (g *Generator) setSSEClient() {
+	client := g.features.Has(PathsClient) ||
+		(g.features.Has(WebhooksClient) && len(g.webhooks) > 0) ||
+		(g.features.Has(CallbacksClient) && len(g.callbacks) > 0)
+
+	for _, t := range g.tstorage.types {
+		if t.SSE != nil {
+			t.SSE.Client = client
+		}
+		if !client {
+			delete(t.InterfaceMethods, "initSSEStream")
+			delete(t.DeclaredMethods, "initSSEStream(sseConnectFunc, sseClientConfig)")
+		}
+	}
+}
+
+func (g *Generator) generateSequenceContent(
+	ctx *genctx,
+	typeName string,
+	media *openapi.MediaType,
+	encoding ir.Encoding,
+) (*ir.Type, error) {
+	item, err := g.generateSchema(ctx, typeName+"Item", media.ItemSchema, false, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate item schema")
+	}
+	item.AddFeature("json")
+
+	format := "SeqLines"
+	if encoding == ir.EncodingJSONSeq {
+		format = "SeqRecords"
+	}
+
+	t := ir.Stream(typeName, media.Schema)
+	t.Sequence = &ir.SequenceMetadata{
+		Format:   format,
+		ItemType: item,
+	}
+	if err := ctx.saveType(t); err != nil {
+		return nil, errors.Wrap(err, "save stream type")
+	}
+	return t, nil
+}
+
 func isComplexMultipartType(s *jsonschema.Schema) bool {
 	if s == nil {
 		return true
@@ -493,7 +615,7 @@ func (g *Generator) generateContents(
 	for _, contentType := range keys {
 		media := contents[contentType]
 
-		parsedContentType, encoding, err := normalizeContentEncoding(contentType, g.opt.ContentTypeAliases)
+		parsedContentType, encoding, err := normalizeContentEncoding(contentType, g.opt.ContentTypeAliases, g.features.Has(ContentXML))
 		if err != nil {
 			return nil, errors.Wrapf(err, "parse content type %q", contentType)
 		}
@@ -509,7 +631,9 @@ func (g *Generator) generateContents(
 
 		if err := func() error {
 			if _, ok := g.opt.ContentTypeAliases[parsedContentType]; ok {
-				if ir.Encoding(parsedContentType).MultipartForm() {
+				// Other media types (e.g. multipart/mixed) may be aliased to multipart form,
+				// but multipart form cannot be handled as something else: boundary would be lost.
+				if ir.Encoding(parsedContentType).MultipartForm() && !encoding.MultipartForm() {
 					return &ErrNotImplemented{"multipart form CT aliasing"}
 				}
 			}
@@ -537,6 +661,25 @@ func (g *Generator) generateContents(
 				)
 			}
 
+			if media.ItemSchema != nil && !media.XOgenSSEEventShape.Enabled() && !media.XOgenRawResponse {
+				if !encoding.JSONSequence() {
+					g.log.Warn(`Field "itemSchema" will be ignored for non-sequential media type`,
+						zapPosition(media),
+						zap.String("contentType", contentType),
+					)
+				} else {
+					t, err := g.generateSequenceContent(ctx, typeName, media, encoding)
+					if err != nil {
+						return errors.Wrap(err, "generate sequence content")
+					}
+					result[ir.ContentType(parsedContentType)] = ir.Media{
+						Encoding: encoding,
+						Type:     t,
+					}
+					return nil
+				}
+			}
+
 			if media.XOgenRawResponse && media.XOgenSSEEventShape.Enabled() {
 				g.log.Warn(`Extension "x-ogen-sse-event-shape" will be ignored because "x-ogen-raw-response" is enabled`,
 					zapPosition(media),
@@ -557,6 +700,21 @@ func (g *Generator) generateContents(
 				return nil
 			}
 
+			if encoding == ir.EncodingXML &&
+				g.features.Has(ContentXML) &&
+				!isStream(media.Schema) &&
+				!media.XOgenRawResponse {
+				t, err := g.generateXMLContent(ctx, typeName, media, optional, request)
+				if err != nil {
+					return errors.Wrap(err, "generate XML content")
+				}
+				result[ir.ContentType(parsedContentType)] = ir.Media{
+					Encoding: encoding,
+					Type:     t,
+				}
+				return nil
+			}
+
 			switch encoding {
 			case ir.EncodingJSON:
 				t, err := g.generateSchema(ctx, typeName, media.Schema, optional, &generateSchemaOverride{
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 
+	"github.com/ogen-go/ogen/gen/ir"
 	"github.com/ogen-go/ogen/jsonschema"
 	"github.com/ogen-go/ogen/openapi"
 )
@@ -66,6 +67,38 @@ func Test_filterMostSpecific(t *testing.T) {
 	}
 }
 
+func Test_normalizeContentEncoding(t *testing.T) {
+	tests := []struct {
+		contentType string
+		aliases     ContentTypeAliases
+		xml         bool
+		parsed      string
+		encoding    ir.Encoding
+	}{
+		{"application/json", nil, true, "application/json", ir.EncodingJSON},
+		{"application/vnd.api+json", nil, true, "application/vnd.api+json", ir.EncodingJSON},
+		{"application/problem+json", nil, true, "application/problem+json", ir.EncodingProblemJSON},
+		{"application/xml; charset=utf-8", nil, true, "application/xml", ir.EncodingXML},
+		{"text/xml", nil, true, "text/xml", ir.EncodingXML},
+		{"application/soap+xml", nil, true, "application/soap+xml", ir.EncodingXML},
+		{"application/x-custom", ContentTypeAliases{"application/x-custom": ir.EncodingXML}, true, "application/x-custom", ir.EncodingXML},
+		{"text/plain", nil, true, "text/plain", ir.EncodingTextPlain},
+		// XML normalization requires content/xml feature.
+		{"text/xml", nil, false, "text/xml", "text/xml"},
+		{"application/soap+xml", nil, false, "application/soap+xml", "application/soap+xml"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			parsed, encoding, err := normalizeContentEncoding(tt.contentType, tt.aliases, tt.xml)
+			a.NoError(err)
+			a.Equal(tt.parsed, parsed)
+			a.Equal(tt.encoding, encoding)
+		})
+	}
+}
+
 func TestGenerator_normalizeFullSSESchema_RequiredStandardFields(t *testing.T) {
 	g := &Generator{}
 
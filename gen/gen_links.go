@@ -0,0 +1,235 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/internal/xmaps"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (g *Generator) makeLinks() {
+	ops := make(map[*openapi.Operation]*ir.Operation, len(g.operations))
+	for _, op := range g.operations {
+		ops[op.Spec] = op
+	}
+
+	for _, op := range g.operations {
+		names := map[string]struct{}{}
+		g.forEachResponse(op, func(status string, resp *ir.Response) {
+			for _, spec := range resp.Spec.Links {
+				link, err := g.generateLink(op, status, resp, spec, ops)
+				if err == nil {
+					// Same link may be defined for multiple responses.
+					if _, ok := names[link.Name]; ok {
+						link.Name, err = g.namer().pascalNonEmpty(op.Name, spec.Name, status, "Link")
+					}
+				}
+				if err != nil {
+					g.log.Info("Link helper is not generated",
+						zap.String("link", spec.Name),
+						zap.Error(err),
+						zapPosition(spec),
+					)
+					continue
+				}
+				names[link.Name] = struct{}{}
+				op.Links = append(op.Links, link)
+			}
+		})
+	}
+}
+
+func (g *Generator) forEachResponse(op *ir.Operation, cb func(status string, resp *ir.Response)) {
+	responses := op.Responses
+	for _, code := range xmaps.SortedKeys(responses.StatusCode) {
+		cb(fmt.Sprintf("%d", code), responses.StatusCode[code])
+	}
+	for idx, resp := range responses.Pattern {
+		if resp != nil {
+			cb(fmt.Sprintf("%dXX", idx+1), resp)
+		}
+	}
+	if def := responses.Default; def != nil {
+		cb("default", def)
+	}
+}
+
+func (g *Generator) generateLink(
+	op *ir.Operation,
+	status string,
+	resp *ir.Response,
+	spec *openapi.Link,
+	ops map[*openapi.Operation]*ir.Operation,
+) (*ir.Link, error) {
+	if spec.Operation == nil {
+		return nil, &ErrNotImplemented{Name: "external operationRef"}
+	}
+	target, ok := ops[spec.Operation]
+	if !ok {
+		return nil, errors.New("linked operation is not generated")
+	}
+	if spec.RequestBody != nil {
+		g.log.Info("Link request body is not supported, ignoring", zapPosition(spec))
+	}
+
+	name, err := g.namer().pascalNonEmpty(op.Name, spec.Name, "Link")
+	if err != nil {
+		return nil, errors.Wrap(err, "helper name")
+	}
+
+	link := &ir.Link{
+		Name:      name,
+		Spec:      spec,
+		Status:    status,
+		Operation: target,
+	}
+	switch {
+	case resp.NoContent != nil:
+		link.Response = resp.NoContent
+	case len(resp.Contents) == 1:
+		for _, media := range resp.Contents {
+			link.Response = media.Type
+		}
+	default:
+		return nil, &ErrNotImplemented{Name: "link for multiple response content types"}
+	}
+
+	for _, p := range spec.Parameters {
+		param, ok := findLinkParam(target, p)
+		if !ok {
+			return nil, errors.Errorf("parameter %q not found", p.Name)
+		}
+
+		lp, err := g.generateLinkParam(op, link, resp, param, p.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parameter %q", p.Name)
+		}
+		link.Parameters = append(link.Parameters, lp)
+	}
+	return link, nil
+}
+
+func (g *Generator) generateLinkParam(
+	op *ir.Operation,
+	link *ir.Link,
+	resp *ir.Response,
+	param *ir.Parameter,
+	value openapi.LinkValue,
+) (*ir.LinkParameter, error) {
+	lp := &ir.LinkParameter{Param: param}
+	if value.IsConstant() {
+		if !isLinkConstCompatible(param.Type, value.Value) {
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("link constant of %T for %s", value.Value, param.Type)}
+		}
+		lp.Const = ir.Default{Value: value.Value, Set: true}
+		return lp, nil
+	}
+
+	expr := value.Expression
+	if len(expr.Parts) != 1 || expr.Parts[0].Expr == nil {
+		return nil, &ErrNotImplemented{Name: "link expression template"}
+	}
+	e := expr.Parts[0].Expr
+
+	var path runtimeValuePath
+	switch e.Source {
+	case openapi.ExpressionResponse:
+		path = runtimeValuePath{
+			Root: "response",
+			Type: link.Response,
+		}
+		if e.Body {
+			if resp.NoContent != nil {
+				return nil, errors.Errorf("%q: response has no body", e)
+			}
+			if resp.WithStatusCode || resp.WithHeaders {
+				path.Fields = []string{"Response"}
+			}
+			path.Pointer = splitPointer(e.Pointer)
+		} else {
+			h, ok := findResponseHeader(resp, e.Name)
+			if !ok {
+				return nil, errors.Errorf("%q: header not found", e)
+			}
+			path.Fields = []string{h.Name}
+		}
+	case openapi.ExpressionRequest:
+		var (
+			request bool
+			err     error
+		)
+		path, request, err = requestValuePath(op, e)
+		if err != nil {
+			return nil, err
+		}
+		if request {
+			link.Request = true
+		} else {
+			link.Params = true
+		}
+	default:
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("%q link expression", "$"+e.Source)}
+	}
+	path.Want = param.Type
+
+	v, err := runtimeValue(e.String(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := param.Type; {
+	case t.Go() == v.Type.Go():
+	case t.IsGeneric() && t.GenericOf.Go() == v.Type.Go():
+		lp.SetTo = true
+	default:
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("link parameter of %s from %s", t, v.Type)}
+	}
+	lp.Value = v
+	return lp, nil
+}
+
+func findLinkParam(op *ir.Operation, p *openapi.LinkParameter) (*ir.Parameter, bool) {
+	for _, param := range op.Params {
+		if param.Spec.Name == p.Name && (p.In == "" || param.Spec.In == p.In) {
+			return param, true
+		}
+	}
+	return nil, false
+}
+
+func findResponseHeader(resp *ir.Response, name string) (*ir.Parameter, bool) {
+	for _, key := range xmaps.SortedKeys(resp.Headers) {
+		if strings.EqualFold(key, name) {
+			return resp.Headers[key], true
+		}
+	}
+	return nil, false
+}
+
+// isLinkConstCompatible whether link constant value can be assigned to the parameter of given type.
+func isLinkConstCompatible(t *ir.Type, v any) bool {
+	if t.IsGeneric() {
+		t = t.GenericOf
+	}
+	if !t.IsPrimitive() && !t.IsEnum() {
+		return false
+	}
+
+	switch v.(type) {
+	case string:
+		return t.Primitive == ir.String
+	case int64:
+		return t.IsInteger() || t.IsFloat()
+	case float64:
+		return t.IsFloat()
+	case bool:
+		return t.Primitive == ir.Bool
+	default:
+		return false
+	}
+}
@@ -141,7 +141,31 @@ func (g *Generator) generateParameter(ctx *genctx, opName string, p *openapi.Par
 	}
 	t, err := func() (*ir.Type, error) {
 		if content := p.Content; content != nil {
-			if val := content.Name; val != "application/json" {
+			switch val := content.Name; {
+			case val == "application/json":
+			case val == "application/x-www-form-urlencoded" && p.In.QueryString():
+				// Form-encoded query string is encoded like an exploded form object.
+				t, err := generate(ctx, content.Media.Schema)
+				if err != nil {
+					return nil, err
+				}
+
+				obj := t
+				if obj.IsGeneric() {
+					obj = obj.GenericOf
+				}
+				if !obj.IsStruct() {
+					return nil, &ErrNotImplemented{"non-object form query string"}
+				}
+
+				visited := map[*ir.Type]struct{}{}
+				if err := isParamAllowed(t, true, true, visited); err != nil {
+					return nil, err
+				}
+
+				t.AddFeature("uri")
+				return t, nil
+			default:
 				return nil, errors.Wrapf(
 					&ErrNotImplemented{"parameter content encoding"},
 					"%q", val,
@@ -163,7 +187,7 @@ func (g *Generator) generateParameter(ctx *genctx, opName string, p *openapi.Par
 		}
 
 		visited := map[*ir.Type]struct{}{}
-		if err := isParamAllowed(t, true, visited); err != nil {
+		if err := isParamAllowed(t, true, isNestingAllowed(p), visited); err != nil {
 			return nil, err
 		}
 
@@ -221,7 +245,24 @@ func defaultParameterJSONTag(t *ir.Type) string {
 	}
 }
 
-func isParamAllowed(t *ir.Type, root bool, visited map[*ir.Type]struct{}) error {
+// isNestingAllowed whether parameter may contain nested arrays and objects.
+//
+// Only query parameters using bracket notation support nesting.
+func isNestingAllowed(p *openapi.Parameter) bool {
+	if !p.In.Query() {
+		return false
+	}
+	switch p.Style {
+	case openapi.QueryStyleDeepObject:
+		return true
+	case openapi.QueryStyleForm:
+		return p.Explode
+	default:
+		return false
+	}
+}
+
+func isParamAllowed(t *ir.Type, root, nested bool, visited map[*ir.Type]struct{}) error {
 	if _, ok := visited[t]; ok {
 		return nil
 	}
@@ -233,31 +274,34 @@ func isParamAllowed(t *ir.Type, root bool, visited map[*ir.Type]struct{}) error
 	case ir.KindEnum:
 		return nil
 	case ir.KindArray:
-		if !root {
+		if !root && !nested {
 			return &ErrNotImplemented{Name: "nested arrays in form parameters"}
 		}
-		return isParamAllowed(t.Item, false, visited)
+		return isParamAllowed(t.Item, false, nested, visited)
 	case ir.KindAlias:
-		return isParamAllowed(t.AliasTo, root, visited)
+		if t.EncodedContent != nil {
+			return &ErrNotImplemented{Name: "encoded content in parameters"}
+		}
+		return isParamAllowed(t.AliasTo, root, nested, visited)
 	case ir.KindPointer:
-		return isParamAllowed(t.PointerTo, root, visited)
+		return isParamAllowed(t.PointerTo, root, nested, visited)
 	case ir.KindStruct:
-		if !root {
+		if !root && !nested {
 			return &ErrNotImplemented{Name: "nested objects in form parameters"}
 		}
 		for _, field := range t.Fields {
-			if err := isParamAllowed(field.Type, false, visited); err != nil {
+			if err := isParamAllowed(field.Type, false, nested, visited); err != nil {
 				return errors.Wrapf(err, "field %q", field.Name)
 			}
 		}
 		return nil
 	case ir.KindGeneric:
-		return isParamAllowed(t.GenericOf, root, visited)
+		return isParamAllowed(t.GenericOf, root, nested, visited)
 	case ir.KindSum:
 		// Sum types are allowed in parameters.
 		// We'll try each variant in order during decoding.
 		for i, of := range t.SumOf {
-			if err := isParamAllowed(of, false, visited); err != nil {
+			if err := isParamAllowed(of, false, nested, visited); err != nil {
 				return errors.Wrapf(err, "sum[%d]", i)
 			}
 		}
@@ -274,13 +318,13 @@ func isParamAllowed(t *ir.Type, root bool, visited map[*ir.Type]struct{}) error
 func isSupportedParamStyle(param *openapi.Parameter) error {
 	switch param.Style {
 	case openapi.QueryStyleSpaceDelimited:
-		if s := param.Schema; s != nil && s.Type == jsonschema.Object {
-			return &ErrNotImplemented{Name: "spaceDelimited style for object parameters"}
+		if s := param.Schema; s != nil && s.Type == jsonschema.Object && param.Explode {
+			return &ErrNotImplemented{Name: "exploded spaceDelimited style for object parameters"}
 		}
 
 	case openapi.QueryStylePipeDelimited:
-		if s := param.Schema; s != nil && s.Type == jsonschema.Object {
-			return &ErrNotImplemented{Name: "pipeDelimited style for object parameters"}
+		if s := param.Schema; s != nil && s.Type == jsonschema.Object && param.Explode {
+			return &ErrNotImplemented{Name: "exploded pipeDelimited style for object parameters"}
 		}
 	}
 	return nil
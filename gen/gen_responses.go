@@ -318,6 +318,7 @@ func (g *Generator) responseToIR(
 		t, e := content.Type, content.Encoding
 		if e.JSON() ||
 			e.ProblemJSON() ||
+			e.XML() ||
 			e.EventStream() ||
 			t.IsStream() ||
 			isBinary(t.Schema) ||
@@ -0,0 +1,172 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+// runtimeValuePath describes how to get the value referenced by runtime expression.
+type runtimeValuePath struct {
+	// Root is a Go expression of the root value.
+	Root string
+	// Type is a type of the root value.
+	Type *ir.Type
+	// Fields is a list of Go field names to walk before the pointer.
+	Fields []string
+	// Pointer is a list of JSON pointer parts.
+	Pointer []string
+	// Want is a type of the result.
+	//
+	// If set, the value of the same type is not unwrapped.
+	Want *ir.Type
+}
+
+// runtimeValue generates steps to get the value referenced by runtime expression.
+func runtimeValue(expr string, path runtimeValuePath) (*ir.RuntimeValue, error) {
+	var (
+		v      = path.Root
+		t      = path.Type
+		fields = path.Fields
+		ptr    = path.Pointer
+		rv     = &ir.RuntimeValue{Expression: expr}
+	)
+	for {
+		var (
+			last  = len(fields) == 0 && len(ptr) == 0
+			byPtr = v == path.Root && t.DoPassByPointer()
+		)
+		if last && !byPtr && path.Want != nil && t.Go() == path.Want.Go() {
+			break
+		}
+
+		switch {
+		case t.IsGeneric():
+			step := ir.RuntimeValueStep{
+				Var:      fmt.Sprintf("v%d", len(rv.Steps)),
+				Expr:     v,
+				Optional: true,
+			}
+			rv.Steps = append(rv.Steps, step)
+			v, t = step.Var, t.GenericOf
+			continue
+		case t.IsPointer() || byPtr:
+			step := ir.RuntimeValueStep{
+				Var:  fmt.Sprintf("v%d", len(rv.Steps)),
+				Expr: v,
+			}
+			rv.Steps = append(rv.Steps, step)
+			v = step.Var
+			if t.IsPointer() {
+				t = t.PointerTo
+			}
+			continue
+		}
+		if last {
+			break
+		}
+
+		if !t.IsStruct() {
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("runtime expression value of %s kind", t.Kind)}
+		}
+
+		var (
+			field *ir.Field
+			ok    bool
+		)
+		if len(fields) > 0 {
+			field, ok = findGoField(t, fields[0])
+			if !ok {
+				return nil, errors.Errorf("%q: field %q not found", expr, fields[0])
+			}
+			fields = fields[1:]
+		} else {
+			field, ok = findJSONField(t, ptr[0])
+			if !ok {
+				return nil, errors.Errorf("%q: field %q not found", expr, ptr[0])
+			}
+			ptr = ptr[1:]
+		}
+		v, t = v+"."+field.Name, field.Type
+	}
+
+	rv.Var, rv.Type = v, t
+	return rv, nil
+}
+
+func findGoField(t *ir.Type, name string) (*ir.Field, bool) {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+func findJSONField(t *ir.Type, name string) (*ir.Field, bool) {
+	for _, f := range t.Fields {
+		if f.Inline == ir.InlineNone && f.Tag.JSON == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// findExpressionParam finds the operation parameter referenced by runtime expression.
+func findExpressionParam(op *ir.Operation, e *openapi.RuntimeExpression) (*ir.Parameter, bool) {
+	for _, p := range op.Params {
+		if p.Spec.In != e.In {
+			continue
+		}
+		if p.Spec.Name == e.Name || (e.In.Header() && strings.EqualFold(p.Spec.Name, e.Name)) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// requestValuePath returns path to the value referenced by "$request" runtime expression.
+func requestValuePath(op *ir.Operation, e *openapi.RuntimeExpression) (path runtimeValuePath, request bool, _ error) {
+	if e.Body {
+		req := op.Request
+		if req == nil {
+			return path, false, errors.Errorf("%q: operation has no request body", e)
+		}
+		if req.Type.IsInterface() {
+			return path, false, &ErrNotImplemented{Name: "runtime expression for multiple request content types"}
+		}
+		return runtimeValuePath{
+			Root:    "request",
+			Type:    req.Type,
+			Pointer: splitPointer(e.Pointer),
+		}, true, nil
+	}
+
+	param, ok := findExpressionParam(op, e)
+	if !ok {
+		return path, false, errors.Errorf("%q: parameter not found", e)
+	}
+	return runtimeValuePath{
+		Root: "params." + param.Name,
+		Type: param.Type,
+	}, false, nil
+}
+
+var pointerUnescaper = strings.NewReplacer(
+	"~1", "/",
+	"~0", "~",
+)
+
+func splitPointer(ptr string) (parts []string) {
+	if ptr == "" {
+		return nil
+	}
+	for _, part := range strings.Split(ptr, "/")[1:] {
+		parts = append(parts, pointerUnescaper.Replace(part))
+	}
+	return parts
+}
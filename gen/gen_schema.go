@@ -61,6 +61,7 @@ func (g *Generator) generateSchema(
 
 	gen := newSchemaGen(lookup)
 	gen.initialisms = g.initialisms
+	gen.strict = g.features.Has(DecodingStrict)
 	gen.rules = g.rules
 	if o := override; o != nil {
 		if n := o.nameRef; n != nil {
@@ -1,7 +1,6 @@
 package gen
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/go-faster/errors"
@@ -75,6 +74,31 @@ func (g *Generator) generateSecurityOauth2(
 	return s
 }
 
+func (g *Generator) generateSecurityOpenIDConnect(
+	s *ir.Security,
+	operationName string,
+	spec openapi.SecurityScheme,
+) *ir.Security {
+	s.Format = ir.OpenIDConnectSecurityFormat
+	s.Kind = ir.HeaderSecurity
+	s.OpenIDConnectURL = spec.Security.OpenIDConnectURL
+	s.Scopes = map[string][]string{
+		operationName: spec.Scopes,
+	}
+
+	s.Type.Fields = append(s.Type.Fields,
+		&ir.Field{
+			Name: "Token",
+			Type: ir.Primitive(ir.String, nil),
+		},
+		&ir.Field{
+			Name: "Scopes",
+			Type: ir.Array(ir.Primitive(ir.String, nil), ir.NilOptional, nil),
+		},
+	)
+	return s
+}
+
 func (g *Generator) generateSecurityHTTP(
 	s *ir.Security,
 	operationName string,
@@ -107,8 +131,36 @@ func (g *Generator) generateSecurityHTTP(
 				Type: ir.Primitive(ir.String, nil),
 			},
 		)
+	case "digest":
+		s.Format = ir.DigestHTTPSecurityFormat
+		s.Type.Fields = append(s.Type.Fields,
+			// Credentials to compute the response, used by client.
+			&ir.Field{
+				Name: "Username",
+				Type: ir.Primitive(ir.String, nil),
+			},
+			&ir.Field{
+				Name: "Password",
+				Type: ir.Primitive(ir.String, nil),
+			},
+			// Parsed response of the client, used by server.
+			&ir.Field{
+				Name: "Credentials",
+				Type: &ir.Type{
+					Kind: ir.KindStruct,
+					Name: "ht.DigestCredentials",
+				},
+			},
+		)
 	default:
-		return nil, errors.Wrapf(&ErrNotImplemented{Name: "http security scheme"}, "unsupported scheme %q", scheme)
+		s.Format = ir.GenericHTTPSecurityFormat
+		s.Scheme = security.Scheme
+		s.Type.Fields = append(s.Type.Fields,
+			&ir.Field{
+				Name: "Credentials",
+				Type: ir.Primitive(ir.String, nil),
+			},
+		)
 	}
 
 	s.Type.Fields = append(s.Type.Fields, &ir.Field{
@@ -119,6 +171,46 @@ func (g *Generator) generateSecurityHTTP(
 	return s, nil
 }
 
+func (g *Generator) generateSecurityMutualTLS(
+	s *ir.Security,
+	operationName string,
+	spec openapi.SecurityScheme,
+) *ir.Security {
+	s.Format = ir.MutualTLSSecurityFormat
+	s.Scopes = map[string][]string{
+		operationName: spec.Scopes,
+	}
+
+	certificates := ir.Array(ir.Pointer(&ir.Type{
+		Kind: ir.KindStruct,
+		Name: "x509.Certificate",
+	}, ir.NilInvalid), ir.NilOptional, nil)
+	s.Type.Fields = append(s.Type.Fields,
+		// Client certificates to present, used by client.
+		&ir.Field{
+			Name: "Certificates",
+			Type: ir.Array(&ir.Type{
+				Kind: ir.KindStruct,
+				Name: "tls.Certificate",
+			}, ir.NilOptional, nil),
+		},
+		// Peer certificates and verified chains from the TLS connection, used by server.
+		&ir.Field{
+			Name: "PeerCertificates",
+			Type: certificates,
+		},
+		&ir.Field{
+			Name: "VerifiedChains",
+			Type: ir.Array(certificates, ir.NilOptional, nil),
+		},
+		&ir.Field{
+			Name: "Roles",
+			Type: ir.Array(ir.Primitive(ir.String, nil), ir.NilOptional, nil),
+		},
+	)
+	return s
+}
+
 func (g *Generator) generateCustomSecurity(
 	s *ir.Security,
 	operationName string,
@@ -186,8 +278,10 @@ func (g *Generator) generateSecurity(ctx *genctx, operationName string, spec ope
 		return g.generateSecurityHTTP(s, operationName, spec)
 	case "oauth2":
 		return g.generateSecurityOauth2(s, operationName, spec), nil
-	case "openIdConnect", "mutualTLS":
-		return nil, &ErrNotImplemented{Name: fmt.Sprintf("%s security", typ)}
+	case "openIdConnect":
+		return g.generateSecurityOpenIDConnect(s, operationName, spec), nil
+	case "mutualTLS":
+		return g.generateSecurityMutualTLS(s, operationName, spec), nil
 	default:
 		return nil, errors.Errorf("unknown security type %q", typ)
 	}
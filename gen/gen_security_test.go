@@ -83,6 +83,29 @@ func TestGenerateSecurities(t *testing.T) {
 				},
 			},
 		},
+		{
+			Schemes: []openapi.SecurityScheme{
+				{
+					Name:   "oidcTest",
+					Scopes: []string{"openid", "profile"},
+					Security: openapi.Security{
+						Type:             "openIdConnect",
+						OpenIDConnectURL: "https://example.com/.well-known/openid-configuration",
+					},
+				},
+			},
+		},
+		{
+			Schemes: []openapi.SecurityScheme{
+				{
+					Name:   "mtlsTest",
+					Scopes: []string{"admin"},
+					Security: openapi.Security{
+						Type: "mutualTLS",
+					},
+				},
+			},
+		},
 		{
 			Schemes: []openapi.SecurityScheme{
 				{
@@ -127,6 +150,20 @@ func TestGenerateSecurities(t *testing.T) {
 				"testOp": {"scope6"},
 			},
 		},
+		{
+			Kind:   ir.HeaderSecurity,
+			Format: ir.OpenIDConnectSecurityFormat,
+			Scopes: map[string][]string{
+				"testOp": {"openid", "profile"},
+			},
+		},
+		{
+			Kind:   "",
+			Format: ir.MutualTLSSecurityFormat,
+			Scopes: map[string][]string{
+				"testOp": {"admin"},
+			},
+		},
 		{
 			Kind:   "",
 			Format: ir.CustomSecurityFormat,
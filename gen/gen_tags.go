@@ -0,0 +1,59 @@
+package gen
+
+import (
+	"github.com/ogen-go/ogen/openapi"
+)
+
+// tagIndex is an index of API tags by name.
+type tagIndex map[string]*openapi.Tag
+
+func newTagIndex(tags []openapi.Tag) tagIndex {
+	idx := make(tagIndex, len(tags))
+	for i := range tags {
+		tag := &tags[i]
+		if _, ok := idx[tag.Name]; !ok {
+			idx[tag.Name] = tag
+		}
+	}
+	return idx
+}
+
+// lookup returns tag by name.
+//
+// Tags which are not declared in the top-level "tags" field are returned as is.
+func (idx tagIndex) lookup(name string) *openapi.Tag {
+	if tag, ok := idx[name]; ok {
+		return tag
+	}
+	return &openapi.Tag{Name: name}
+}
+
+// root returns the top-level parent of the tag.
+func (idx tagIndex) root(name string) *openapi.Tag {
+	tag := idx.lookup(name)
+	// Parser rejects circular hierarchies, but do not rely on it.
+	visited := map[string]struct{}{}
+	for tag.Parent != "" {
+		if _, ok := visited[tag.Name]; ok {
+			break
+		}
+		visited[tag.Name] = struct{}{}
+		tag = idx.lookup(tag.Parent)
+	}
+	return tag
+}
+
+// groupTag returns the tag defining operation group.
+//
+// Group is defined by the top-level parent of the first operation tag.
+// Tags of "badge" and "audience" kinds do not define groups.
+func (idx tagIndex) groupTag(op *openapi.Operation) (*openapi.Tag, bool) {
+	for _, name := range op.Tags {
+		switch idx.lookup(name).Kind {
+		case openapi.TagKindBadge, openapi.TagKindAudience:
+			continue
+		}
+		return idx.root(name), true
+	}
+	return nil, false
+}
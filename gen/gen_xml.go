@@ -0,0 +1,132 @@
+package gen
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (g *Generator) generateXMLContent(
+	ctx *genctx,
+	typeName string,
+	media *openapi.MediaType,
+	optional,
+	request bool,
+) (*ir.Type, error) {
+	t, err := g.generateSchema(ctx, typeName, media.Schema, optional, &generateSchemaOverride{
+		request: request,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "generate schema")
+	}
+
+	root := t
+	if root.IsGeneric() {
+		if !root.GenericVariant.OnlyOptional() {
+			return nil, &ErrNotImplemented{"xml nullable body"}
+		}
+		root = root.GenericOf
+	}
+	if root.IsAlias() {
+		root = root.AliasTo
+	}
+	if !root.IsStruct() {
+		return nil, &ErrNotImplemented{"xml non-object body"}
+	}
+	if err := checkXMLStruct(root, map[*ir.Type]struct{}{}); err != nil {
+		return nil, err
+	}
+
+	t.AddFeature("xml")
+	return t, nil
+}
+
+// checkXMLStruct checks that structure can be encoded as XML element.
+func checkXMLStruct(t *ir.Type, visited map[*ir.Type]struct{}) error {
+	if _, ok := visited[t]; ok {
+		return nil
+	}
+	visited[t] = struct{}{}
+
+	if t.Tuple {
+		return &ErrNotImplemented{"xml tuple"}
+	}
+	for _, f := range t.Fields {
+		if f.Inline != ir.InlineNone {
+			return &ErrNotImplemented{"xml additionalProperties"}
+		}
+		if err := checkXMLField(f, visited); err != nil {
+			return errors.Wrapf(err, "field %s", f.Name)
+		}
+	}
+	return nil
+}
+
+func checkXMLField(f *ir.Field, visited map[*ir.Type]struct{}) error {
+	t := f.Type
+	if f.XML().Attribute {
+		if t.IsGeneric() && t.GenericVariant.OnlyOptional() {
+			t = t.GenericOf
+		}
+		if !isXMLText(t) {
+			return &ErrNotImplemented{"xml complex attribute"}
+		}
+		return nil
+	}
+
+	if t.IsArray() {
+		if t.NilSemantic.Null() {
+			return &ErrNotImplemented{"xml nullable array"}
+		}
+		if t.Item.IsArray() {
+			return &ErrNotImplemented{"xml nested array"}
+		}
+		t = t.Item
+	}
+	return checkXMLElement(t, visited)
+}
+
+func checkXMLElement(t *ir.Type, visited map[*ir.Type]struct{}) error {
+	switch {
+	case isXMLText(t):
+		return nil
+	case t.IsStruct():
+		return checkXMLStruct(t, visited)
+	case t.IsAlias() && t.AliasTo.IsStruct():
+		return checkXMLStruct(t.AliasTo, visited)
+	case t.IsGeneric():
+		if !t.GenericVariant.OnlyOptional() {
+			return &ErrNotImplemented{"xml nullable"}
+		}
+		return checkXMLElement(t.GenericOf, visited)
+	case t.IsPointer():
+		if t.NilSemantic.Null() {
+			return &ErrNotImplemented{"xml nullable"}
+		}
+		return checkXMLElement(t.PointerTo, visited)
+	case t.IsSum():
+		return &ErrNotImplemented{"xml sum type"}
+	case t.IsMap():
+		return &ErrNotImplemented{"xml map"}
+	case t.IsAny():
+		return &ErrNotImplemented{"xml any"}
+	default:
+		return &ErrNotImplemented{"xml " + string(t.Kind)}
+	}
+}
+
+// isXMLText whether if t can be encoded as XML character data.
+func isXMLText(t *ir.Type) bool {
+	switch {
+	case t.IsExternal():
+		return false
+	case t.IsPrimitive(), t.IsEnum():
+		if t.IsNull() {
+			return false
+		}
+		return t.JSON().TimeFormat() != "" || t.EncodeFn() != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,65 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+func Test_checkXMLStruct(t *testing.T) {
+	str := func() *ir.Type { return ir.Primitive(ir.String, nil) }
+	field := func(name string, typ *ir.Type, x *jsonschema.XML) *ir.Field {
+		return &ir.Field{
+			Name: name,
+			Type: typ,
+			Tag:  ir.Tag{JSON: name},
+			Spec: &jsonschema.Property{
+				Name:   name,
+				Schema: &jsonschema.Schema{XML: x},
+			},
+		}
+	}
+	object := func(fields ...*ir.Field) *ir.Type {
+		return &ir.Type{Kind: ir.KindStruct, Name: "Object", Fields: fields}
+	}
+
+	tests := []struct {
+		typ     *ir.Type
+		notImpl string
+	}{
+		{object(
+			field("a", str(), nil),
+			field("b", ir.Generic("OptString", str(), ir.GenericVariant{Optional: true}), &jsonschema.XML{Attribute: true}),
+			field("c", ir.Array(str(), ir.NilOptional, nil), &jsonschema.XML{Wrapped: true}),
+			field("d", ir.Pointer(object(), ir.NilOptional), nil),
+		), ""},
+		{object(
+			field("a", ir.Generic("NilString", str(), ir.GenericVariant{Nullable: true}), nil),
+		), "xml nullable"},
+		{object(
+			field("a", object(), &jsonschema.XML{Attribute: true}),
+		), "xml complex attribute"},
+		{object(
+			field("a", ir.Array(ir.Array(str(), ir.NilOptional, nil), ir.NilOptional, nil), nil),
+		), "xml nested array"},
+		{object(
+			field("a", &ir.Type{Kind: ir.KindMap, Name: "Map", Item: str()}, nil),
+		), "xml map"},
+		{object(
+			field("a", &ir.Type{Kind: ir.KindAny}, nil),
+		), "xml any"},
+	}
+	for i, tt := range tests {
+		err := checkXMLStruct(tt.typ, map[*ir.Type]struct{}{})
+		if tt.notImpl == "" {
+			require.NoError(t, err, i)
+			continue
+		}
+		var notImpl *ErrNotImplemented
+		require.ErrorAs(t, err, &notImpl, i)
+		require.Equal(t, tt.notImpl, notImpl.Name, i)
+	}
+}
@@ -30,11 +30,15 @@ type Generator struct {
 	operations        []*ir.Operation
 	defaultOperations []*ir.Operation // Operations without an operation group.
 	operationGroups   []*ir.OperationGroup
+	tagGroups         map[string]*openapi.Tag // Tags defining operation groups, by group name.
 	webhooks          []*ir.Operation
+	callbacks         []*ir.Operation
+	callbackOps       map[*openapi.Operation]*ir.Operation // Callback operations by spec, to deduplicate referenced callbacks.
 	securities        map[string]*ir.Security
 	tstorage          *tstorage
 	errType           *ir.Response
 	webhookRouter     WebhookRouter
+	callbackRouter    WebhookRouter
 	router            Router
 	imports           map[string]string
 	equalitySpecs     []*ir.EqualityMethodSpec // Types requiring Equal() methods for uniqueItems validation
@@ -113,19 +117,22 @@ func NewGenerator(spec *ogen.Spec, opts Options) (*Generator, error) {
 	}
 
 	g := &Generator{
-		opt:           opts.Generator,
-		parseOpts:     opts.Parser,
-		api:           api,
-		servers:       nil,
-		operations:    nil,
-		webhooks:      nil,
-		securities:    map[string]*ir.Security{},
-		tstorage:      newTStorage(),
-		errType:       nil,
-		webhookRouter: WebhookRouter{},
-		router:        Router{},
-		imports:       defaultImports(),
-		log:           opts.Logger,
+		opt:            opts.Generator,
+		parseOpts:      opts.Parser,
+		api:            api,
+		servers:        nil,
+		operations:     nil,
+		webhooks:       nil,
+		callbacks:      nil,
+		callbackOps:    map[*openapi.Operation]*ir.Operation{},
+		securities:     map[string]*ir.Security{},
+		tstorage:       newTStorage(),
+		errType:        nil,
+		webhookRouter:  WebhookRouter{},
+		callbackRouter: WebhookRouter{},
+		router:         Router{},
+		imports:        defaultImports(),
+		log:            opts.Logger,
 	}
 
 	// Resolve features once, here, because identifier generation during makeIR
@@ -163,6 +170,7 @@ func (g *Generator) makeIR(api *openapi.API) error {
 	if err := g.makeOps(api.Operations); err != nil {
 		return errors.Wrap(err, "operations")
 	}
+	g.setSSEClient()
 
 	// Collect types that need Equal() and Hash() methods for complex uniqueItems validation
 	g.collectEqualitySpecs()
@@ -190,6 +198,12 @@ func (g *Generator) makeOps(ops []*openapi.Operation) error {
 		return errors.Wrap(err, "reduce default")
 	}
 
+	var tags tagIndex
+	if g.features.Has(OgenTagGroups) {
+		tags = newTagIndex(g.api.Tags)
+		g.tagGroups = map[string]*openapi.Tag{}
+	}
+
 	for _, spec := range ops {
 		routePath := spec.Path.String()
 		log := g.log.With(zapPosition(spec))
@@ -216,6 +230,18 @@ func (g *Generator) makeOps(ops []*openapi.Operation) error {
 			continue
 		}
 
+		if tags != nil && op.OperationGroup == "" {
+			if tag, ok := tags.groupTag(spec); ok {
+				op.OperationGroup, err = g.namer().pascalNonEmpty(tag.Name)
+				if err != nil {
+					return errors.Wrapf(err, "operation %q: group name", op.Name)
+				}
+				if _, ok := g.tagGroups[op.OperationGroup]; !ok {
+					g.tagGroups[op.OperationGroup] = tag
+				}
+			}
+		}
+
 		if err := fixEqualRequests(ctx, op); err != nil {
 			return errors.Wrap(err, "fix requests")
 		}
@@ -228,6 +254,10 @@ func (g *Generator) makeOps(ops []*openapi.Operation) error {
 		}
 
 		g.operations = append(g.operations, op)
+
+		if err := g.makeCallbacks(op); err != nil {
+			return errors.Wrapf(err, "operation %q", op.Name)
+		}
 	}
 
 	types := g.Types()
@@ -240,7 +270,21 @@ func (g *Generator) makeOps(ops []*openapi.Operation) error {
 	}
 
 	sortOperations(g.operations)
+	sortOperations(g.callbacks)
+	g.makeLinks()
 	g.defaultOperations, g.operationGroups = groupOperations(g.operations)
+	if tags != nil {
+		for _, group := range g.operationGroups {
+			group.Tag = g.tagGroups[group.Name]
+			// Split packages have no group clients in the Client.
+			if g.features.Has(OgenSplitPackages) {
+				continue
+			}
+			if err := checkOperationGroup(group, g.operations); err != nil {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
@@ -350,6 +394,17 @@ func groupOperations(ops []*ir.Operation) (
 	return defaultOperations, operationGroups
 }
 
+// checkOperationGroup checks that group name does not conflict with operation names,
+// since group client is a field of the Client.
+func checkOperationGroup(group *ir.OperationGroup, ops []*ir.Operation) error {
+	for _, op := range ops {
+		if op.Name == group.Name {
+			return errors.Errorf("operation group %q conflicts with operation %q name", group.Name, op.PrettyOperationID())
+		}
+	}
+	return nil
+}
+
 // Types returns generated types.
 func (g *Generator) Types() map[string]*ir.Type {
 	return g.tstorage.types
@@ -4,6 +4,7 @@ package genfs
 import (
 	"go/format"
 	"os"
+	"path"
 	"path/filepath"
 )
 
@@ -23,5 +24,11 @@ func (t FormattedSource) WriteFile(name string, content []byte) error {
 		}
 		out = buf
 	}
-	return os.WriteFile(filepath.Join(t.Root, name), out, 0o644)
+	// Name may contain a sub-package directory.
+	if dir, _ := path.Split(name); dir != "" {
+		if err := os.MkdirAll(filepath.Join(t.Root, filepath.FromSlash(dir)), 0o750); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(t.Root, filepath.FromSlash(name)), out, 0o644)
 }
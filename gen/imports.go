@@ -6,6 +6,8 @@ func defaultImports() map[string]string {
 	return map[string]string{
 		"bytes":           "",
 		"context":         "",
+		"crypto/tls":      "",
+		"crypto/x509":     "",
 		"encoding/base64": "",
 		"fmt":             "",
 		"io":              "",
@@ -33,6 +35,7 @@ func defaultImports() map[string]string {
 		"go.opentelemetry.io/otel/attribute":       "",
 		"go.opentelemetry.io/otel/codes":           "",
 		"go.opentelemetry.io/otel/metric":          "",
+		"go.opentelemetry.io/otel/propagation":     "",
 		"go.opentelemetry.io/otel/semconv/v1.39.0": "semconv",
 		"go.opentelemetry.io/otel/trace":           "",
 		"go.uber.org/multierr":                     "",
@@ -42,6 +45,7 @@ func defaultImports() map[string]string {
 		"github.com/ogen-go/ogen/middleware": "",
 		"github.com/ogen-go/ogen/json":       "",
 		"github.com/ogen-go/ogen/ogenregex":  "",
+		"github.com/ogen-go/ogen/ogenxml":    "",
 		"github.com/ogen-go/ogen/ogenerrors": "",
 		"github.com/ogen-go/ogen/otelogen":   "",
 		"github.com/ogen-go/ogen/sse":        "",
@@ -0,0 +1,108 @@
+package ir
+
+import "strings"
+
+// Conditions contains conditional constraints of the object:
+// if/then/else, dependentRequired and dependentSchemas.
+type Conditions struct {
+	If   *Constraint
+	Then *Constraint
+	Else *Constraint
+
+	DependentRequired []DependentRequired
+	DependentSchemas  []DependentSchema
+}
+
+// ValidateTypes returns all types used to validate constrained fields.
+func (c *Conditions) ValidateTypes() (r []*Type) {
+	if c == nil {
+		return nil
+	}
+	add := func(c *Constraint) {
+		if c == nil {
+			return
+		}
+		for _, p := range c.Properties {
+			if p.Validate != nil {
+				r = append(r, p.Validate)
+			}
+		}
+	}
+	add(c.If)
+	add(c.Then)
+	add(c.Else)
+	for _, d := range c.DependentSchemas {
+		add(d.Constraint)
+	}
+	return r
+}
+
+// DependentRequired defines fields required when Field is present.
+type DependentRequired struct {
+	Field    *Field
+	Required []*Field
+}
+
+// DependentSchema defines constraint applied when Field is present.
+type DependentSchema struct {
+	Field      *Field
+	Constraint *Constraint
+}
+
+// Constraint is a subschema of conditional keyword, applied to fields of the object.
+type Constraint struct {
+	Required   []*Field
+	Properties []*ConstraintProperty
+}
+
+// ConstraintProperty constrains value of the field.
+//
+// Constraint is checked only if field is present.
+type ConstraintProperty struct {
+	Field *Field
+	// Values is a list of allowed values, from const or enum.
+	Values []any
+	// Validate is a field value type with validators to check. May be nil.
+	Validate *Type
+}
+
+// ValuesGo returns allowed values as Go literals, separated by comma.
+func (p *ConstraintProperty) ValuesGo() string {
+	vals := make([]string, len(p.Values))
+	for i, v := range p.Values {
+		vals[i] = PrintGoValue(v)
+	}
+	return strings.Join(vals, ", ")
+}
+
+// AbsenceCheck returns Go expression that reports whether the field of recv is not present.
+//
+// Returns empty string, if field is always present.
+func (f *Field) AbsenceCheck(recv string) string {
+	t := f.Type
+	v := recv + "." + f.Name
+	switch {
+	case t.IsGeneric() && t.GenericVariant.Optional:
+		return "!" + v + ".Set"
+	case (t.IsPointer() || t.IsArray() || t.IsMap()) && t.NilSemantic.Optional():
+		return v + " == nil"
+	default:
+		return ""
+	}
+}
+
+// PresenceCheck returns Go expression that reports whether the field of recv is present.
+//
+// Returns empty string, if field is always present.
+func (f *Field) PresenceCheck(recv string) string {
+	t := f.Type
+	v := recv + "." + f.Name
+	switch {
+	case t.IsGeneric() && t.GenericVariant.Optional:
+		return v + ".Set"
+	case (t.IsPointer() || t.IsArray() || t.IsMap()) && t.NilSemantic.Optional():
+		return v + " != nil"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,21 @@
+package ir
+
+// EncodedContent describes a string that contains encoded value of the alias type.
+//
+// See https://json-schema.org/draft/2020-12/json-schema-validation#section-8.
+type EncodedContent struct {
+	// MediaType is the contentMediaType of the string.
+	MediaType string
+	// Encoding is the contentEncoding of the string: "base64", "base64url" or empty.
+	Encoding string
+}
+
+// Base64 whether content is base64-encoded.
+func (c EncodedContent) Base64() bool {
+	return c.Encoding == "base64"
+}
+
+// Base64URL whether content is base64url-encoded.
+func (c EncodedContent) Base64URL() bool {
+	return c.Encoding == "base64url"
+}
@@ -0,0 +1,25 @@
+package ir
+
+// RuntimeValue is a value referenced by a runtime expression.
+type RuntimeValue struct {
+	// Expression is the runtime expression.
+	Expression string
+	// Steps to get the value.
+	Steps []RuntimeValueStep
+	// Var is a Go expression of the value.
+	Var string
+	// Type is a type of the value.
+	Type *Type
+}
+
+// RuntimeValueStep is a step to get the value of runtime expression.
+type RuntimeValueStep struct {
+	// Var is a name of the variable to store the result.
+	Var string
+	// Expr is a Go expression to unwrap.
+	Expr string
+	// Optional whether Expr is optional value, unwrapped using Get.
+	//
+	// Otherwise, Expr is a pointer.
+	Optional bool
+}
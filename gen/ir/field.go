@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/ogen-go/ogen/internal/xmaps"
 	"github.com/ogen-go/ogen/jsonschema"
+	"github.com/ogen-go/ogen/openapi"
 )
 
 // InlineField defines how to inline field.
@@ -15,6 +17,7 @@ const (
 	InlineAdditional
 	InlinePattern
 	InlineSum
+	InlineTupleRest
 )
 
 // Field of structure.
@@ -103,23 +106,68 @@ func (t Type) HasDefaultFields() bool {
 	})
 }
 
+// FormPart describes encoding of form body part.
+type FormPart struct {
+	// ContentType is media type of the part, defined by Encoding Object.
+	ContentType string
+	// Headers are part headers, defined by Encoding Object.
+	Headers []*Parameter
+}
+
 func (t Type) parameters(keep func(t *Type) bool) (params []Parameter) {
 	if !t.IsStruct() {
 		panic(fmt.Sprintf("unreachable: %s", t))
 	}
 	for _, f := range t.Fields {
-		if !keep(f.Type) {
+		if f.Inline != InlineNone || !keep(f.Type) {
 			continue
 		}
 		params = append(params, Parameter{
 			Name: f.Name,
 			Type: f.Type,
 			Spec: f.Tag.Form,
+			Part: f.Tag.Part,
 		})
 	}
 	return params
 }
 
+// FormAdditionalProps returns additional properties field of form body, if any.
+func (t Type) FormAdditionalProps() *Field {
+	if !t.IsStruct() {
+		panic(fmt.Sprintf("unreachable: %s", t))
+	}
+	for _, f := range t.Fields {
+		if f.Inline == InlineAdditional {
+			return f
+		}
+	}
+	return nil
+}
+
+// FormKeys returns sorted list of top-level form keys, used by form parameters.
+//
+// Exploded objects in form style are encoded using their fields as keys.
+func (t Type) FormKeys() []string {
+	keys := map[string]struct{}{}
+	for _, p := range t.FormParameters() {
+		typ := p.Type
+		if typ.IsGeneric() {
+			typ = typ.GenericOf
+		}
+		if s := p.Spec; s.Content == nil && s.Style == openapi.QueryStyleForm && s.Explode && typ.IsStruct() {
+			for _, f := range typ.Fields {
+				if f.Spec != nil {
+					keys[f.Spec.Name] = struct{}{}
+				}
+			}
+			continue
+		}
+		keys[p.Spec.Name] = struct{}{}
+	}
+	return xmaps.SortedKeys(keys)
+}
+
 func (t Type) FormParameters() (params []Parameter) {
 	return t.parameters(func(t *Type) bool {
 		return !t.HasFeature("multipart-file")
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/jsonschema"
+	"github.com/ogen-go/ogen/openapi"
 )
 
 func TestTagGetTags(t *testing.T) {
@@ -62,3 +65,35 @@ func TestTagGetTags(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeFormKeys(t *testing.T) {
+	form := func(name string, style openapi.ParameterStyle, explode bool) *openapi.Parameter {
+		return &openapi.Parameter{
+			Name:    name,
+			In:      openapi.LocationQuery,
+			Style:   style,
+			Explode: explode,
+		}
+	}
+	str := Primitive(String, nil)
+	obj := &Type{
+		Kind: KindStruct,
+		Fields: []*Field{
+			{Name: "City", Type: str, Spec: &jsonschema.Property{Name: "city"}},
+			{Name: "Zip", Type: str, Spec: &jsonschema.Property{Name: "zip"}},
+		},
+	}
+	typ := &Type{
+		Kind: KindStruct,
+		Fields: []*Field{
+			{Name: "Name", Type: str, Tag: Tag{Form: form("name", openapi.QueryStyleForm, true)}},
+			// Exploded object uses its fields as keys.
+			{Name: "Address", Type: obj, Tag: Tag{Form: form("address", openapi.QueryStyleForm, true)}},
+			{Name: "Billing", Type: obj, Tag: Tag{Form: form("billing", openapi.QueryStyleDeepObject, true)}},
+			{Name: "Shipping", Type: obj, Tag: Tag{Form: form("shipping", openapi.QueryStyleForm, false)}},
+			{Name: "AdditionalProps", Type: &Type{Kind: KindMap, Item: str}, Inline: InlineAdditional},
+		},
+	}
+	require.Equal(t, []string{"billing", "city", "name", "shipping", "zip"}, typ.FormKeys())
+	require.Equal(t, "AdditionalProps", typ.FormAdditionalProps().Name)
+}
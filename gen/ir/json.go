@@ -103,6 +103,15 @@ func (j JSONFields) HasRequired() bool {
 	})
 }
 
+// Names returns JSON names of fields.
+func (j JSONFields) Names() []string {
+	names := make([]string, len(j))
+	for i, f := range j {
+		names[i] = f.Tag.JSON
+	}
+	return names
+}
+
 // RequiredMask returns array of 64-bit bitmasks for required fields.
 func (j JSONFields) RequiredMask() []uint8 {
 	return bitset.Build(j, func(_ int, f *Field) bool {
@@ -149,6 +158,39 @@ func (j JSON) SumProps() (fields []*Field) {
 	return fields
 }
 
+// TupleItems return fields of tuple Type that should be encoded by position.
+func (j JSON) TupleItems() (fields []*Field) {
+	for _, f := range j.t.Fields {
+		if f.Inline == InlineNone {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// TupleRest return field of tuple Type that should be encoded as items after tuple items.
+func (j JSON) TupleRest() *Field {
+	f, _ := xslices.FindFunc(j.t.Fields, func(f *Field) bool {
+		return f.Inline == InlineTupleRest
+	})
+	return f
+}
+
+// TupleMinItems returns minimum number of tuple elements to decode.
+//
+// Every element of legacy items tuple is required, prefixItems elements are
+// required only by minItems.
+func (j JSON) TupleMinItems() int {
+	s := j.t.Schema
+	if s == nil || !s.PrefixItems {
+		return len(j.TupleItems())
+	}
+	if m := s.MinItems; m != nil {
+		return int(*m)
+	}
+	return 0
+}
+
 // Format returns format name for handling json encoding or decoding.
 //
 // Mostly used for encoding or decoding of string formats, like `json.EncodeUUID`,
@@ -158,6 +200,9 @@ func (j JSON) Format() string {
 	if s == nil {
 		return ""
 	}
+	if j.t.isBase64URL() {
+		return "Base64URL"
+	}
 	typePrefix := func(f string) string {
 		switch s.Type {
 		case jsonschema.String:
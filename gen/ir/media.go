@@ -31,6 +31,14 @@ const (
 	EncodingTextPlain Encoding = "text/plain"
 	// EncodingEventStream is Encoding for Server-Sent Events.
 	EncodingEventStream Encoding = "text/event-stream"
+	// EncodingXML is Encoding for XML.
+	EncodingXML Encoding = "application/xml"
+	// EncodingJSONLines is Encoding for JSON Lines.
+	EncodingJSONLines Encoding = "application/jsonl"
+	// EncodingNDJSON is Encoding for newline-delimited JSON.
+	EncodingNDJSON Encoding = "application/x-ndjson"
+	// EncodingJSONSeq is Encoding for JSON text sequences (RFC 7464).
+	EncodingJSONSeq Encoding = "application/json-seq"
 )
 
 func (t Encoding) String() string { return string(t) }
@@ -49,6 +57,18 @@ func (t Encoding) TextPlain() bool { return t == EncodingTextPlain }
 
 func (t Encoding) EventStream() bool { return t == EncodingEventStream }
 
+func (t Encoding) XML() bool { return t == EncodingXML }
+
+// JSONSequence whether encoding is a sequence of JSON texts.
+func (t Encoding) JSONSequence() bool {
+	switch t {
+	case EncodingJSONLines, EncodingNDJSON, EncodingJSONSeq:
+		return true
+	default:
+		return false
+	}
+}
+
 type Media struct {
 	// Encoding is the parsed content type used for encoding, but not for header value.
 	Encoding Encoding
@@ -0,0 +1,40 @@
+package ir
+
+import "strings"
+
+// Negation is a "not" constraint: value MUST NOT be valid against the subschema.
+type Negation struct {
+	// Values is a list of disallowed values, from const or enum.
+	Values []any
+	// Validate is a value type with validators. Value is invalid, if validation passes.
+	Validate *Type
+	// Constraint constrains fields of the struct. Struct is invalid, if constraint is satisfied.
+	Constraint *Constraint
+}
+
+// ValidateTypes returns all types used to validate the value.
+func (n *Negation) ValidateTypes() (r []*Type) {
+	if n == nil {
+		return nil
+	}
+	if n.Validate != nil {
+		r = append(r, n.Validate)
+	}
+	if c := n.Constraint; c != nil {
+		for _, p := range c.Properties {
+			if p.Validate != nil {
+				r = append(r, p.Validate)
+			}
+		}
+	}
+	return r
+}
+
+// ValuesGo returns disallowed values as Go literals, separated by comma.
+func (n *Negation) ValuesGo() string {
+	vals := make([]string, len(n.Values))
+	for i, v := range n.Values {
+		vals[i] = PrintGoValue(v)
+	}
+	return strings.Join(vals, ", ")
+}
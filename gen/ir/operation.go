@@ -2,6 +2,8 @@ package ir
 
 import (
 	"fmt"
+	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/ogen-go/ogen/jsonschema"
@@ -14,24 +16,169 @@ type WebhookInfo struct {
 	Name string
 }
 
+// CallbackInfo contains information about callback.
+type CallbackInfo struct {
+	// Name is the name of the callback.
+	Name string
+	// Parent is the operation ID of the operation declaring the callback.
+	Parent string
+}
+
+// Callback is a callback of the operation.
+type Callback struct {
+	// Name is the name of the callback.
+	Name string
+	// Expression is the callback URL expression.
+	Expression string
+	// URL is a helper to build callback URL from the parent operation request.
+	//
+	// Nil, if URL can't be built.
+	URL *CallbackURL
+	// Operations of the callback.
+	Operations []*Operation
+}
+
+// CallbackURL is a helper function to build callback URL.
+type CallbackURL struct {
+	// Name is the name of the helper function.
+	Name string
+	// Request whether helper uses the parent operation request.
+	Request bool
+	// Params whether helper uses the parent operation parameters.
+	Params bool
+	// Parts of the URL.
+	Parts []CallbackURLPart
+}
+
+// CallbackURLPart is a part of callback URL.
+type CallbackURLPart struct {
+	// Raw is a literal part of URL.
+	Raw string
+	// Value of the runtime expression part.
+	//
+	// Nil, if part is literal.
+	Value *RuntimeValue
+}
+
+// Link is a helper function to build parameters of the linked operation from the response.
+type Link struct {
+	// Name is the name of the helper function.
+	Name string
+	// Spec is the link spec.
+	Spec *openapi.Link
+	// Status is the response status pattern, e.g. "201" or "default".
+	Status string
+	// Response is a type of the response.
+	Response *Type
+	// Request whether helper uses the operation request.
+	Request bool
+	// Params whether helper uses the operation parameters.
+	Params bool
+	// Operation is the linked operation.
+	Operation *Operation
+	// Parameters of the linked operation to set.
+	Parameters []*LinkParameter
+}
+
+// ResponseGoType returns Go type of the response.
+func (l *Link) ResponseGoType() string {
+	return reqRespGoType(l.Response)
+}
+
+// GoDoc returns link description.
+func (l *Link) GoDoc() []string {
+	return prettyDoc(l.Spec.Description, "")
+}
+
+// LinkParameter is a parameter of the linked operation.
+type LinkParameter struct {
+	// Param is the linked operation parameter.
+	Param *Parameter
+	// Value of the runtime expression.
+	//
+	// Nil, if parameter is a constant.
+	Value *RuntimeValue
+	// Const is a constant value of the parameter.
+	Const Default
+	// SetTo whether value is set using SetTo method of the optional parameter.
+	SetTo bool
+}
+
 type Operation struct {
 	Name           string
 	Summary        string
 	Description    string
 	Deprecated     bool
 	WebhookInfo    *WebhookInfo
+	CallbackInfo   *CallbackInfo
 	PathParts      []*PathPart
 	Params         []*Parameter
 	Request        *Request
 	Responses      *Responses
 	Security       SecurityRequirements
+	Callbacks      []*Callback
+	Links          []*Link
 	Spec           *openapi.Operation
 	OperationGroup string
 }
 
+// HasTargetURL whether operation is sent to the target URL instead of the server URL.
+func (op Operation) HasTargetURL() bool {
+	return op.WebhookInfo != nil || op.CallbackInfo != nil
+}
+
+// Retryable whether client may retry operation requests.
+//
+// Requests of safe methods are retried by default, x-ogen-retry extension overrides it.
+func (op Operation) Retryable() bool {
+	if retry := op.Spec.XOgenRetry; retry != nil {
+		return *retry
+	}
+	switch strings.ToUpper(op.Spec.HTTPMethod) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, "QUERY":
+		return true
+	default:
+		return false
+	}
+}
+
+// TypePrefix returns prefix of operation client and server type names.
+func (op Operation) TypePrefix() string {
+	switch {
+	case op.WebhookInfo != nil:
+		return "Webhook"
+	case op.CallbackInfo != nil:
+		return "Callback"
+	default:
+		return ""
+	}
+}
+
 type OperationGroup struct {
 	Name       string
 	Operations []*Operation
+	// Tag is the tag defining the group, nil if the group is defined by x-ogen-operation-group.
+	Tag *openapi.Tag
+}
+
+// GoDoc returns documentation of the group.
+func (g OperationGroup) GoDoc() []string {
+	if g.Tag == nil {
+		return nil
+	}
+	doc := g.Tag.Description
+	if doc == "" {
+		doc = g.Tag.Summary
+	}
+	return prettyDoc(doc, "")
+}
+
+// Source returns the source of the group, as a comment.
+func (g OperationGroup) Source() string {
+	if g.Tag != nil {
+		return "tag: " + g.Tag.Name
+	}
+	return "x-ogen-operation-group: " + g.Name
 }
 
 // OTELAttribute represents OpenTelemetry attribute defined by otelogen package.
@@ -61,6 +208,12 @@ func (op Operation) OTELAttributes() (r []OTELAttribute) {
 			Value: wh.Name,
 		})
 	}
+	if cb := op.CallbackInfo; cb != nil {
+		r = append(r, OTELAttribute{
+			Key:   "CallbackName",
+			Value: cb.Name,
+		})
+	}
 	return r
 }
 
@@ -70,9 +223,12 @@ func (op Operation) PrettyOperationID() string {
 		return id
 	}
 	var route string
-	if info := op.WebhookInfo; info != nil {
-		route = info.Name
-	} else {
+	switch {
+	case op.WebhookInfo != nil:
+		route = op.WebhookInfo.Name
+	case op.CallbackInfo != nil:
+		route = op.CallbackInfo.Name
+	default:
 		route = s.Path.String()
 	}
 	return strings.ToUpper(s.HTTPMethod) + " " + route
@@ -156,6 +312,47 @@ func (op Operation) HasSSEStreamResponse() bool {
 	return checkResponse(op.Responses.Default)
 }
 
+// HasSequenceStreamResponse returns true if the operation has any response content types
+// having a JSON sequence stream response.
+func (op Operation) HasSequenceStreamResponse() bool {
+	return len(op.SequenceStreamContentTypes()) > 0
+}
+
+// SequenceStreamContentTypes returns sorted response content types
+// having a JSON sequence stream response.
+func (op Operation) SequenceStreamContentTypes() (r []ContentType) {
+	if op.Responses == nil {
+		return nil
+	}
+
+	seen := map[ContentType]struct{}{}
+	checkResponse := func(resp *Response) {
+		if resp == nil {
+			return
+		}
+		for contentType, media := range resp.Contents {
+			if !media.Encoding.JSONSequence() {
+				continue
+			}
+			if _, ok := seen[contentType]; !ok {
+				seen[contentType] = struct{}{}
+				r = append(r, contentType)
+			}
+		}
+	}
+
+	for _, resp := range op.Responses.StatusCode {
+		checkResponse(resp)
+	}
+	for _, resp := range op.Responses.Pattern {
+		checkResponse(resp)
+	}
+	checkResponse(op.Responses.Default)
+
+	slices.Sort(r)
+	return r
+}
+
 type PathPart struct {
 	Raw   string
 	Param *Parameter
@@ -173,6 +370,8 @@ type Parameter struct {
 	Type *Type
 	Spec *openapi.Parameter
 	Tag  Tag
+	// Part is form body part encoding, nil for default.
+	Part *FormPart
 }
 
 func (op Parameter) GoDoc() []string {
@@ -188,6 +387,15 @@ func (op Parameter) GoDoc() []string {
 	return prettyDoc(s.Description, notice)
 }
 
+// FormQueryString whether parameter is a form-encoded query string.
+//
+// Such parameter is encoded like an exploded form query object.
+func (op Parameter) FormQueryString() bool {
+	s := op.Spec
+	return s != nil && s.In.QueryString() &&
+		s.Content != nil && s.Content.Name == "application/x-www-form-urlencoded"
+}
+
 // Default returns default value of this field, if it is set.
 func (op Parameter) Default() Default {
 	var schema *jsonschema.Schema
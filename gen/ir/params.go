@@ -10,6 +10,9 @@ func (op *Operation) PathParams() []*Parameter   { return op.getParams(openapi.L
 func (op *Operation) QueryParams() []*Parameter  { return op.getParams(openapi.LocationQuery) }
 func (op *Operation) CookieParams() []*Parameter { return op.getParams(openapi.LocationCookie) }
 func (op *Operation) HeaderParams() []*Parameter { return op.getParams(openapi.LocationHeader) }
+func (op *Operation) QueryStringParams() []*Parameter {
+	return op.getParams(openapi.LocationQueryString)
+}
 
 func (op Operation) HasQueryParams() bool {
 	return slices.ContainsFunc(op.Params, func(p *Parameter) bool {
@@ -29,6 +32,12 @@ func (op Operation) HasCookieParams() bool {
 	})
 }
 
+func (op Operation) HasQueryStringParams() bool {
+	return slices.ContainsFunc(op.Params, func(p *Parameter) bool {
+		return p.Spec != nil && p.Spec.In.QueryString()
+	})
+}
+
 func (op Operation) PathParamsCount() (r int) {
 	for _, p := range op.PathParts {
 		if p.Param != nil {
@@ -51,12 +51,21 @@ const (
 	// BasicHTTPSecurityFormat is Basic HTTP authentication (RFC 7617) format.
 	BasicHTTPSecurityFormat SecurityFormat = "basic"
 	// DigestHTTPSecurityFormat is Digest HTTP authentication (RFC 7616) format.
-	//
-	// Unsupported yet.
 	DigestHTTPSecurityFormat SecurityFormat = "digest"
+	// GenericHTTPSecurityFormat is any other HTTP authentication scheme.
+	//
+	// Credentials following the scheme token are passed as is.
+	GenericHTTPSecurityFormat SecurityFormat = "http"
+
+	// MutualTLSSecurityFormat is mutual TLS authentication format.
+	MutualTLSSecurityFormat SecurityFormat = "mutualTLS"
 
 	// Oauth2SecurityFormat is Oauth2 security format.
 	Oauth2SecurityFormat SecurityFormat = "oauth2"
+	// OpenIDConnectSecurityFormat is OpenID Connect security format.
+	//
+	// Handled as Bearer token with OAuth2 scopes.
+	OpenIDConnectSecurityFormat SecurityFormat = "openIdConnect"
 
 	// CustomSecurityFormat is a user-defined security format.
 	CustomSecurityFormat = "x-ogen-custom-security"
@@ -82,11 +91,31 @@ func (s SecurityFormat) IsDigestHTTPSecurity() bool {
 	return s == DigestHTTPSecurityFormat
 }
 
+// IsGenericHTTPSecurity whether s is GenericHTTPSecurityFormat.
+func (s SecurityFormat) IsGenericHTTPSecurity() bool {
+	return s == GenericHTTPSecurityFormat
+}
+
+// IsMutualTLSSecurity whether s is MutualTLSSecurityFormat.
+func (s SecurityFormat) IsMutualTLSSecurity() bool {
+	return s == MutualTLSSecurityFormat
+}
+
 // IsOAuth2Security whether s is Oauth2SecurityFormat.
 func (s SecurityFormat) IsOAuth2Security() bool {
 	return s == Oauth2SecurityFormat
 }
 
+// IsOpenIDConnectSecurity whether s is OpenIDConnectSecurityFormat.
+func (s SecurityFormat) IsOpenIDConnectSecurity() bool {
+	return s == OpenIDConnectSecurityFormat
+}
+
+// HasScopes whether security requirements of s format are OAuth2 scopes, not roles.
+func (s SecurityFormat) HasScopes() bool {
+	return s == Oauth2SecurityFormat || s == OpenIDConnectSecurityFormat
+}
+
 // IsCustomSecurity whether s is CustomSecurityFormat.
 func (s SecurityFormat) IsCustomSecurity() bool {
 	return s == CustomSecurityFormat
@@ -99,6 +128,10 @@ type Security struct {
 	Description   string
 	Type          *Type
 	Scopes        map[string][]string
+	// OpenIDConnectURL is a discovery document URL of "openIdConnect" security.
+	OpenIDConnectURL string
+	// Scheme is an authentication scheme of generic "http" security.
+	Scheme string
 }
 
 func (s *Security) GoDoc() []string {
@@ -13,6 +13,7 @@ import (
 type Tag struct {
 	JSON      string             // json tag, empty for none
 	Form      *openapi.Parameter // query form parameter
+	Part      *FormPart          // form body part encoding, nil for default
 	ExtraTags map[string]string  // a map of extra struct field tags
 }
 
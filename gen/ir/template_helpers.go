@@ -39,12 +39,48 @@ func (t *Type) IsBase64Stream() bool {
 	switch s.Format {
 	case "base64", "byte":
 		return true
+	}
+	switch contentEncoding(s) {
+	case "base64", "base64url":
+		return true
 	default:
 		return false
 	}
 }
 
+// Base64Encoding returns name of encoding/base64 encoding of []byte value or stream.
+func (t *Type) Base64Encoding() string {
+	if t.isBase64URL() {
+		return "RawURLEncoding"
+	}
+	return "StdEncoding"
+}
+
+// isBase64URL whether []byte value or stream is encoded using base64url.
+func (t *Type) isBase64URL() bool {
+	if t == nil || (t.Primitive != ByteSlice && !t.IsStream()) {
+		return false
+	}
+	return contentEncoding(t.Schema) == "base64url"
+}
+
+// contentEncoding returns normalized contentEncoding of string schema.
+func contentEncoding(s *jsonschema.Schema) string {
+	if s == nil || s.Type != jsonschema.String {
+		return ""
+	}
+	return strings.ToLower(s.ContentEncoding)
+}
+
 func (t Type) uriFormat() string {
+	if t.Primitive == ByteSlice {
+		switch contentEncoding(t.Schema) {
+		case "base64":
+			return "Base64"
+		case "base64url":
+			return "Base64URL"
+		}
+	}
 	if s := t.Schema; s != nil {
 		switch f := s.Format; f {
 		case "time", "date":
@@ -184,6 +220,40 @@ func (t *Type) IsStream() bool    { return t.Is(KindStream) }
 func (t *Type) IsSSEStream() bool {
 	return t != nil && (t.SSE != nil || (t.IsPointer() && t.PointerTo.IsSSEStream()))
 }
+
+// IsSSEClientStream whether type is SSE stream read by generated clients.
+func (t *Type) IsSSEClientStream() bool {
+	if t == nil {
+		return false
+	}
+	if t.IsPointer() {
+		return t.PointerTo.IsSSEClientStream()
+	}
+	return t.SSE != nil && t.SSE.Client
+}
+
+func (t *Type) IsSequenceStream() bool {
+	return t != nil && (t.Sequence != nil || (t.IsPointer() && t.PointerTo.IsSequenceStream()))
+}
+
+// IsURIComposite whether type is encoded as nested object or array in URI.
+func (t *Type) IsURIComposite() bool {
+	switch t.Kind {
+	case KindArray, KindMap, KindStruct:
+		return true
+	case KindAlias:
+		return t.AliasTo.IsURIComposite()
+	case KindPointer:
+		return t.PointerTo.IsURIComposite()
+	case KindGeneric:
+		return t.GenericOf.IsURIComposite()
+	case KindSum:
+		return slices.ContainsFunc(t.SumOf, (*Type).IsURIComposite)
+	default:
+		return false
+	}
+}
+
 func (t *Type) IsNumeric() bool  { return t.IsInteger() || t.IsFloat() || t.IsDecimal() }
 func (t *Type) IsExternal() bool { return t.Schema != nil && t.Schema.XOgenType != "" }
 
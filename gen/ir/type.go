@@ -66,6 +66,11 @@ type SumSpec struct {
 	// Mapping is discriminator value -> variant mapping.
 	Mapping []SumSpecMap
 
+	// ParentFields are JSON names of fields of the struct containing the sum type.
+	//
+	// Only for sum types inlined into struct.
+	ParentFields []string
+
 	// TypeDiscriminator denotes to distinguish variants by type.
 	TypeDiscriminator bool
 
@@ -206,6 +211,16 @@ type SSEMetadata struct {
 	EventType *Type
 	// DataType is the SSE data field type for data-only stream schemas.
 	DataType *Type
+	// Client is whether the stream is read by generated clients.
+	Client bool
+}
+
+// SequenceMetadata marks stream type as a sequence of JSON items.
+type SequenceMetadata struct {
+	// Format is the name of json.SeqFormat constant used to frame items.
+	Format string
+	// ItemType is the type of sequence item.
+	ItemType *Type
 }
 
 type Type struct {
@@ -229,12 +244,16 @@ type Type struct {
 	GenericOf           *Type               // only for generic
 	GenericVariant      GenericVariant      // only for generic
 	MapPattern          ogenregex.Regexp    // only for map
-	DenyAdditionalProps bool                // only for map and struct
+	DenyAdditionalProps bool                // only for map, struct and sum
 	AllowedProps        map[string]struct{} // only for map and struct
 	External            ExternalType        // only for custom type
 	Validators          Validators
-	Tuple               bool         // only for struct
-	SSE                 *SSEMetadata // only for SSE stream types
+	Conditions          *Conditions       // only for struct
+	Not                 *Negation         // only for primitive and struct
+	Tuple               bool              // only for struct
+	SSE                 *SSEMetadata      // only for SSE stream types
+	Sequence            *SequenceMetadata // only for sequence stream types
+	EncodedContent      *EncodedContent   // only for alias
 	// Features contains a set of features the type must implement.
 	// Available features: 'json', 'uri'.
 	//
@@ -302,7 +321,7 @@ func (t *Type) Format() bool {
 	if t == nil {
 		return false
 	}
-	return t.Primitive == Time || t.IsExternal()
+	return t.Primitive == Time || t.IsExternal() || t.isBase64URL()
 }
 
 func (t *Type) Is(vs ...Kind) bool {
@@ -348,6 +367,9 @@ func (t *Type) NamePostfix() string {
 			// packages have a type with the same name.
 			return t.Schema.XOgenName
 		}
+		if t.isBase64URL() {
+			return "Base64URL"
+		}
 		s := t.Schema
 		typePrefix := func(f string) string {
 			switch s.Type {
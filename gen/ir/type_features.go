@@ -15,6 +15,10 @@ func (t *Type) AddFeature(feature string) {
 	switch t.Kind {
 	case KindAlias:
 		t.AliasTo.AddFeature(feature)
+		if t.EncodedContent != nil {
+			// Encoded content is always encoded as JSON.
+			t.AliasTo.AddFeature("json")
+		}
 	case KindArray:
 		t.Item.AddFeature(feature)
 	case KindGeneric:
@@ -0,0 +1,47 @@
+package ir
+
+import "slices"
+
+// EvaluatesAnyField whether variant of the sum type evaluates every field of the object.
+func (s SumSpec) EvaluatesAnyField(v *Type) bool {
+	if v.Kind != KindStruct {
+		return true
+	}
+	return slices.ContainsFunc(v.Fields, func(f *Field) bool {
+		return f.Inline != InlineNone
+	})
+}
+
+// EvaluatedFields returns JSON names of fields evaluated by the sum type, if given variant is decoded.
+func (s SumSpec) EvaluatedFields(v *Type) (r []string) {
+	r = append(r, s.ParentFields...)
+	if d := s.Discriminator; d != "" {
+		r = append(r, d)
+	}
+	for _, name := range v.JSON().Fields().Names() {
+		if !slices.Contains(r, name) {
+			r = append(r, name)
+		}
+	}
+	return r
+}
+
+// FilteredFields returns JSON names of fields evaluated by the sum type,
+// but rejected by the decoder of given variant.
+//
+// Such fields are removed from the object before decoding the variant.
+func (s SumSpec) FilteredFields(v *Type) (r []string) {
+	if v.Kind != KindStruct || !v.DenyAdditionalProps {
+		return nil
+	}
+	names := v.JSON().Fields().Names()
+	for _, name := range s.ParentFields {
+		if !slices.Contains(names, name) {
+			r = append(r, name)
+		}
+	}
+	if d := s.Discriminator; d != "" && !slices.Contains(names, d) && !slices.Contains(r, d) {
+		r = append(r, d)
+	}
+	return r
+}
@@ -235,6 +235,9 @@ func (t *Type) needValidation(path *walkpath) (result bool) {
 
 	switch t.Kind {
 	case KindPrimitive:
+		if t.Not != nil {
+			return true
+		}
 		if t.IsFloat() {
 			// NaN, Inf, float validators.
 			return true
@@ -279,7 +282,7 @@ func (t *Type) needValidation(path *walkpath) (result bool) {
 		}
 		return t.Item.needValidation(path)
 	case KindStruct:
-		if len(t.Validators.Ogen) > 0 {
+		if len(t.Validators.Ogen) > 0 || t.Conditions != nil || t.Not != nil {
 			return true
 		}
 		return slices.ContainsFunc(t.Fields, func(f *Field) bool {
@@ -0,0 +1,145 @@
+package ir
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// XMLName is a qualified XML name of element or attribute.
+type XMLName struct {
+	Space  string
+	Prefix string
+	Local  string
+}
+
+// Go returns Go literal of ogenxml.Name.
+func (n XMLName) Go() string {
+	var b strings.Builder
+	b.WriteString("ogenxml.Name{")
+	if n.Space != "" {
+		fmt.Fprintf(&b, "Space: %q, ", n.Space)
+	}
+	if n.Prefix != "" {
+		fmt.Fprintf(&b, "Prefix: %q, ", n.Prefix)
+	}
+	fmt.Fprintf(&b, "Local: %q}", n.Local)
+	return b.String()
+}
+
+func xmlName(s *jsonschema.Schema, fallback string) XMLName {
+	n := XMLName{Local: fallback}
+	if s == nil || s.XML == nil {
+		return n
+	}
+	x := s.XML
+	if x.Name != "" {
+		n.Local = x.Name
+	}
+	n.Space = x.Namespace
+	n.Prefix = x.Prefix
+	return n
+}
+
+// XMLRoot returns name of root element for t.
+//
+// Name is taken from "xml" object of schema, if any. Otherwise, name of
+// referenced component is used.
+func (t *Type) XMLRoot() XMLName {
+	switch t.Kind {
+	case KindAlias:
+		return t.AliasTo.XMLRoot()
+	case KindGeneric:
+		return t.GenericOf.XMLRoot()
+	case KindPointer:
+		return t.PointerTo.XMLRoot()
+	}
+
+	fallback := t.Name
+	if s := t.Schema; s != nil && !s.Ref.IsZero() {
+		ptr := s.Ref.Ptr
+		if idx := strings.LastIndexByte(ptr, '/'); idx >= 0 {
+			ptr = ptr[idx+1:]
+		}
+		fallback = strings.NewReplacer("~1", "/", "~0", "~").Replace(ptr)
+	}
+	return xmlName(t.Schema, fallback)
+}
+
+// XML specifies XML representation of Field.
+type XML struct {
+	// Name is a name of element or attribute.
+	//
+	// For wrapped arrays, it is a name of wrapping element.
+	Name XMLName
+	// Item is a name of array item elements.
+	Item XMLName
+	// Attribute whether if field is encoded as attribute.
+	Attribute bool
+	// Wrapped whether if array items are wrapped.
+	Wrapped bool
+	// Required whether if element or attribute must be present.
+	//
+	// Arrays are never required, since unwrapped empty array has no elements.
+	Required bool
+}
+
+// Items returns XML encoding rules for array items.
+func (x XML) Items() XML {
+	return XML{Name: x.Item}
+}
+
+// XML returns XML encoding rules for field.
+func (f Field) XML() XML {
+	var (
+		name     = f.Tag.JSON
+		schema   *jsonschema.Schema
+		required bool
+	)
+	if spec := f.Spec; spec != nil {
+		name = spec.Name
+		schema = spec.Schema
+		required = spec.Required
+	}
+
+	r := XML{
+		Name:     xmlName(schema, name),
+		Required: required && !f.Type.IsArray(),
+	}
+	if schema == nil {
+		return r
+	}
+	if x := schema.XML; x != nil {
+		r.Attribute = x.Attribute
+		r.Wrapped = x.Wrapped
+	}
+	if schema.Type == jsonschema.Array {
+		if !r.Wrapped {
+			// Name of unwrapped array is ignored, items are named after property.
+			r.Name = XMLName{Local: name}
+		}
+		r.Item = xmlName(schema.Item, name)
+	}
+	return r
+}
+
+// XMLAttributes returns fields encoded as XML attributes.
+func (t *Type) XMLAttributes() (r []*Field) {
+	for _, f := range t.Fields {
+		if f.XML().Attribute {
+			r = append(r, f)
+		}
+	}
+	return r
+}
+
+// XMLElements returns fields encoded as XML elements.
+func (t *Type) XMLElements() (r []*Field) {
+	for _, f := range t.Fields {
+		if !f.XML().Attribute {
+			r = append(r, f)
+		}
+	}
+	return r
+}
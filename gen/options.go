@@ -200,6 +200,11 @@ type GenerateOptions struct {
 	// an error unless explicitly mapped via ContentTypeAliases.
 	WildcardContentTypeDefault ir.Encoding `json:"wildcard_content_type_default" yaml:"wildcard_content_type_default"`
 
+	// PackagePath is the import path of the target package.
+	//
+	// Used by sub-packages generated by the ogen/split_packages feature to import shared types.
+	PackagePath string `json:"package_path" yaml:"package_path"`
+
 	// Initialisms customizes the initialism rules used when generating Go
 	// identifiers (e.g. "id" -> "ID", "url" -> "URL"). See [Initialisms].
 	Initialisms Initialisms `json:"initialisms" yaml:"initialisms"`
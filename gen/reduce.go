@@ -71,7 +71,7 @@ func (g *Generator) reduceDefault(ops []*openapi.Operation) error {
 			contentType = key
 			break
 		}
-		_, encoding, err := normalizeContentEncoding(contentType, g.opt.ContentTypeAliases)
+		_, encoding, err := normalizeContentEncoding(contentType, g.opt.ContentTypeAliases, g.features.Has(ContentXML))
 		if err != nil {
 			return reduceFailed(`response content type is invalid`, d)
 		}
@@ -125,6 +125,8 @@ func (c responseComparator) compare(a, b *openapi.Response) bool {
 		Description string
 		Headers     map[string]*openapi.Header
 		Content     map[string]*openapi.MediaType
+		// Links do not affect the error type.
+		Links []*openapi.Link
 
 		location.Pointer `json:"-" yaml:"-"`
 	}
@@ -187,6 +189,7 @@ func (c responseComparator) compareMediaType(a, b *openapi.MediaType) bool {
 	}
 
 	return c.compareSchema(a.Schema, b.Schema) &&
+		c.compareSchema(a.ItemSchema, b.ItemSchema) &&
 		maps.EqualFunc(a.Encoding, b.Encoding, c.compareEncoding) &&
 		a.XOgenJSONStreaming == b.XOgenJSONStreaming
 }
@@ -237,9 +240,12 @@ func (c responseComparator) compareSchema(a, b *jsonschema.Schema) bool {
 		a.Format == b.Format &&
 		a.ContentEncoding == b.ContentEncoding &&
 		a.ContentMediaType == b.ContentMediaType &&
+		c.compareSchema(a.ContentSchema, b.ContentSchema) &&
 		c.compareSchema(a.Item, b.Item) &&
 		slices.EqualFunc(a.Items, b.Items, c.compareSchema) &&
 		reflect.DeepEqual(a.AdditionalProperties, b.AdditionalProperties) &&
+		reflect.DeepEqual(a.UnevaluatedProperties, b.UnevaluatedProperties) &&
+		c.compareSchema(a.UnevaluatedItem, b.UnevaluatedItem) &&
 		slices.EqualFunc(a.PatternProperties, b.PatternProperties, c.comparePatternProperty) &&
 		slices.EqualFunc(a.Enum, b.Enum, reflect.DeepEqual) &&
 		slices.EqualFunc(a.Properties, b.Properties, c.compareProperty) &&
@@ -248,6 +254,12 @@ func (c responseComparator) compareSchema(a, b *jsonschema.Schema) bool {
 		slices.EqualFunc(a.OneOf, b.OneOf, c.compareSchema) &&
 		slices.EqualFunc(a.AnyOf, b.AnyOf, c.compareSchema) &&
 		slices.EqualFunc(a.AllOf, b.AllOf, c.compareSchema) &&
+		c.compareSchema(a.Not, b.Not) &&
+		c.compareSchema(a.If, b.If) &&
+		c.compareSchema(a.Then, b.Then) &&
+		c.compareSchema(a.Else, b.Else) &&
+		maps.EqualFunc(a.DependentRequired, b.DependentRequired, slices.Equal) &&
+		maps.EqualFunc(a.DependentSchemas, b.DependentSchemas, c.compareSchema) &&
 		c.compareDiscriminator(a.Discriminator, b.Discriminator) &&
 		c.compareXML(a.XML, b.XML) &&
 		c.compareNum(a.Maximum, b.Maximum) &&
@@ -213,7 +213,7 @@ func (r WebhookRoutes) methodContentTypes(method string) string {
 	return strings.Join(types, ",")
 }
 
-// WebhookRouter contains routing information for webhooks.
+// WebhookRouter contains routing information for webhooks and callbacks.
 type WebhookRouter struct {
 	Webhooks map[string]WebhookRoutes
 	idSeq    idSeq
@@ -266,29 +266,37 @@ func checkRoutePath(p openapi.Path) error {
 	return nil
 }
 
-func (g *Generator) route() error {
-	var maxParametersCount int
-	for _, op := range g.operations {
+// buildRouter builds router of given operations.
+func buildRouter(ops []*ir.Operation) (r Router, _ error) {
+	for _, op := range ops {
 		path := op.Spec.Path
 
 		if err := func() error {
 			if err := checkRoutePath(path); err != nil {
 				return err
 			}
-			return g.router.Add(Route{
+			return r.Add(Route{
 				Method:    strings.ToUpper(op.Spec.HTTPMethod),
 				Path:      path.String(),
 				Operation: op,
 			})
 		}(); err != nil {
-			return errors.Wrapf(err, "add route %q (op %q)", path, op.Name)
+			return r, errors.Wrapf(err, "add route %q (op %q)", path, op.Name)
 		}
 
-		if count := op.PathParamsCount(); maxParametersCount < count {
-			maxParametersCount = count
+		if count := op.PathParamsCount(); r.MaxParametersCount < count {
+			r.MaxParametersCount = count
 		}
 	}
-	g.router.MaxParametersCount = maxParametersCount
+	return r, nil
+}
+
+func (g *Generator) route() error {
+	router, err := buildRouter(g.operations)
+	if err != nil {
+		return err
+	}
+	g.router = router
 	for _, op := range g.webhooks {
 		webhookName := op.WebhookInfo.Name
 		nr := WebhookRoute{
@@ -299,5 +307,28 @@ func (g *Generator) route() error {
 			return errors.Wrap(err, "add route")
 		}
 	}
+	// Callbacks of different operations may have the same name,
+	// such callbacks are routed by "<operationId>/<name>".
+	callbackParents := map[string]map[string]struct{}{}
+	for _, op := range g.callbacks {
+		cb := op.CallbackInfo
+		if callbackParents[cb.Name] == nil {
+			callbackParents[cb.Name] = map[string]struct{}{}
+		}
+		callbackParents[cb.Name][cb.Parent] = struct{}{}
+	}
+	for _, op := range g.callbacks {
+		callbackName := op.CallbackInfo.Name
+		if len(callbackParents[callbackName]) > 1 {
+			callbackName = op.CallbackInfo.Parent + "/" + callbackName
+		}
+		nr := WebhookRoute{
+			Method:    strings.ToUpper(op.Spec.HTTPMethod),
+			Operation: op,
+		}
+		if err := g.callbackRouter.Add(callbackName, nr); err != nil {
+			return errors.Wrap(err, "add callback route")
+		}
+	}
 	return nil
 }
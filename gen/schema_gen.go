@@ -35,6 +35,7 @@ type schemaGen struct {
 
 	request     bool            // true if generating for request body
 	initialisms bool            // NamingCamelInitialisms feature: apply initialism rules to camelCase identifiers
+	strict      bool            // DecodingStrict feature: deny unknown fields of objects
 	rules       *naming.Ruleset // custom initialism ruleset, nil means package default
 
 	log *zap.Logger
@@ -255,6 +256,19 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 		}
 	}
 
+	if hasConditionals(schema) && schema.Type != jsonschema.Object && len(schema.AllOf) == 0 {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional constraints on %q type", schema.Type)}
+	}
+
+	schema, err = narrowNot(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "not")
+	}
+	schema, err = normalizeUnevaluated(schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "unevaluatedProperties")
+	}
+
 	var (
 		oneOf                   *ir.Type
 		anyOf                   *ir.Type
@@ -357,6 +371,8 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 		if p := schema.AdditionalProperties; p != nil {
 			hasAdditionalProps = *p
 			denyAdditionalProps = !*p
+		} else {
+			denyAdditionalProps = g.denyUnevaluated(schema)
 		}
 		hasPatternProps := len(schema.PatternProperties) > 0
 		isPatternSingle := len(schema.PatternProperties) == 1
@@ -567,9 +583,35 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 				return nil, err
 			}
 		}
+		for _, sum := range []*ir.Type{anyOf, oneOf} {
+			if sum == nil {
+				continue
+			}
+			// Fields of the struct are evaluated by the struct itself.
+			sum.SumSpec.ParentFields = s.JSON().Fields().Names()
+			sum.DenyAdditionalProps = s.DenyAdditionalProps
+		}
+		if hasConditionals(schema) {
+			if s.Kind != ir.KindStruct {
+				return nil, &ErrNotImplemented{Name: "conditional constraints on map"}
+			}
+			s.Conditions, err = g.conditions(s, schema)
+			if err != nil {
+				return nil, errors.Wrap(err, "conditions")
+			}
+		}
+		if schema.Not != nil {
+			s.Not, err = g.negation(s, schema)
+			if err != nil {
+				return nil, errors.Wrap(err, "not")
+			}
+		}
 
 		return s, nil
 	case jsonschema.Array:
+		if schema.Not != nil {
+			return nil, &ErrNotImplemented{Name: "not on array"}
+		}
 		if tuple := schema.Items; len(tuple) > 0 {
 			ret := g.regtype(name, &ir.Type{
 				Kind:   ir.KindStruct,
@@ -595,6 +637,19 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 				})
 			}
 
+			if item := schema.Item; item != nil {
+				// Items after prefixItems.
+				rest, err := g.generate(name+"RestItem", item, false)
+				if err != nil {
+					return nil, errors.Wrap(err, "rest items")
+				}
+				ret.Fields = append(ret.Fields, &ir.Field{
+					Name:   "Rest",
+					Type:   ir.Array(rest, ir.NilOptional, nil),
+					Inline: ir.InlineTupleRest,
+				})
+			}
+
 			return ret, nil
 		}
 		array := &ir.Type{
@@ -618,6 +673,13 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 		return ret, nil
 
 	case jsonschema.String, jsonschema.Integer, jsonschema.Number, jsonschema.Boolean, jsonschema.Null:
+		if hasEncodedContent(schema) {
+			if schema.Not != nil {
+				return nil, &ErrNotImplemented{Name: "not on encoded content"}
+			}
+			return g.encodedContent(name, schema)
+		}
+
 		t, err := g.primitive(name, schema)
 		if err != nil {
 			return nil, errors.Wrap(err, "primitive")
@@ -663,6 +725,12 @@ func (g *schemaGen) generate2(name string, schema *jsonschema.Schema) (ret *ir.T
 			}
 		}
 		t.Validators.SetOgenValidate(schema)
+		if schema.Not != nil {
+			t.Not, err = g.negation(t, schema)
+			if err != nil {
+				return nil, errors.Wrap(err, "not")
+			}
+		}
 
 		return g.regtype(name, t), nil
 	case jsonschema.Empty:
@@ -0,0 +1,357 @@
+package gen
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// hasConditionals reports whether schema has if/then/else, dependentRequired or dependentSchemas.
+func hasConditionals(s *jsonschema.Schema) bool {
+	return s != nil && (s.If != nil ||
+		len(s.DependentRequired) > 0 ||
+		len(s.DependentSchemas) > 0)
+}
+
+// mergeConditionals merges conditional keywords of s1 and s2 into r.
+func mergeConditionals(r, s1, s2 *jsonschema.Schema) error {
+	switch {
+	case s1.If != nil && s2.If != nil:
+		return &ErrNotImplemented{Name: "allOf with multiple if/then/else"}
+	case s1.If != nil:
+		r.If, r.Then, r.Else = s1.If, s1.Then, s1.Else
+	case s2.If != nil:
+		r.If, r.Then, r.Else = s2.If, s2.Then, s2.Else
+	}
+
+	if len(s1.DependentRequired)+len(s2.DependentRequired) > 0 {
+		r.DependentRequired = map[string][]string{}
+		for _, dr := range []map[string][]string{s1.DependentRequired, s2.DependentRequired} {
+			for prop, required := range dr {
+				for _, name := range required {
+					if !slices.Contains(r.DependentRequired[prop], name) {
+						r.DependentRequired[prop] = append(r.DependentRequired[prop], name)
+					}
+				}
+			}
+		}
+	}
+
+	if len(s1.DependentSchemas)+len(s2.DependentSchemas) > 0 {
+		r.DependentSchemas = maps.Clone(s1.DependentSchemas)
+		if r.DependentSchemas == nil {
+			r.DependentSchemas = map[string]*jsonschema.Schema{}
+		}
+		for prop, dep := range s2.DependentSchemas {
+			if _, ok := r.DependentSchemas[prop]; ok {
+				return &ErrNotImplemented{Name: "allOf with multiple dependentSchemas of the same property"}
+			}
+			r.DependentSchemas[prop] = dep
+		}
+	}
+	return nil
+}
+
+// conditions generates conditional constraints of the struct.
+//
+// Constraints may refer only to the properties of the struct.
+func (g *schemaGen) conditions(t *ir.Type, schema *jsonschema.Schema) (*ir.Conditions, error) {
+	lookup := fieldLookup(t)
+
+	var (
+		c   = new(ir.Conditions)
+		err error
+	)
+	// "then" and "else" are ignored, if "if" is not present.
+	if schema.If != nil && (schema.Then != nil || schema.Else != nil) {
+		for _, sub := range []struct {
+			keyword string
+			schema  *jsonschema.Schema
+			to      **ir.Constraint
+		}{
+			{"if", schema.If, &c.If},
+			{"then", schema.Then, &c.Then},
+			{"else", schema.Else, &c.Else},
+		} {
+			if sub.schema == nil {
+				continue
+			}
+			*sub.to, err = g.constraint(sub.schema, lookup)
+			if err != nil {
+				return nil, errors.Wrap(err, sub.keyword)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(schema.DependentRequired)) {
+		trigger, err := lookup(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "dependentRequired %q", name)
+		}
+
+		dep := ir.DependentRequired{Field: trigger}
+		for _, required := range schema.DependentRequired[name] {
+			f, err := lookup(required)
+			if err != nil {
+				return nil, errors.Wrapf(err, "dependentRequired %q", name)
+			}
+			if f.PresenceCheck("s") == "" {
+				// Field is always present.
+				continue
+			}
+			dep.Required = append(dep.Required, f)
+		}
+		if len(dep.Required) > 0 {
+			c.DependentRequired = append(c.DependentRequired, dep)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(schema.DependentSchemas)) {
+		trigger, err := lookup(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "dependentSchemas %q", name)
+		}
+
+		constraint, err := g.constraint(schema.DependentSchemas[name], lookup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "dependentSchemas %q", name)
+		}
+		c.DependentSchemas = append(c.DependentSchemas, ir.DependentSchema{
+			Field:      trigger,
+			Constraint: constraint,
+		})
+	}
+
+	if c.If == nil && len(c.DependentRequired) == 0 && len(c.DependentSchemas) == 0 {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// fieldLookup returns function to find field of the struct by property name.
+func fieldLookup(t *ir.Type) func(name string) (*ir.Field, error) {
+	fields := map[string]*ir.Field{}
+	for _, f := range t.Fields {
+		if f.Spec != nil {
+			fields[f.Spec.Name] = f
+		}
+	}
+	return func(name string) (*ir.Field, error) {
+		f, ok := fields[name]
+		if !ok {
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional constraint on undefined property %q", name)}
+		}
+		return f, nil
+	}
+}
+
+// constraint generates constraint from subschema of conditional keyword.
+func (g *schemaGen) constraint(
+	schema *jsonschema.Schema,
+	lookup func(name string) (*ir.Field, error),
+) (*ir.Constraint, error) {
+	if schema == nil {
+		return new(ir.Constraint), nil
+	}
+	switch schema.Type {
+	case jsonschema.Object, jsonschema.Empty:
+	default:
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional subschema of type %q", schema.Type)}
+	}
+	if keyword := unsupportedConstraintKeyword(schema); keyword != "" {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("%s in conditional subschema", keyword)}
+	}
+
+	c := new(ir.Constraint)
+	for _, name := range schema.Required {
+		f, err := lookup(name)
+		if err != nil {
+			return nil, errors.Wrap(err, "required")
+		}
+		if f.PresenceCheck("s") == "" {
+			// Field is always present.
+			continue
+		}
+		c.Required = append(c.Required, f)
+	}
+	for _, prop := range schema.Properties {
+		f, err := lookup(prop.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "properties")
+		}
+
+		p, err := g.constraintProperty(f, prop.Schema)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q", prop.Name)
+		}
+		if p != nil {
+			c.Properties = append(c.Properties, p)
+		}
+	}
+	return c, nil
+}
+
+// constraintProperty generates constraint of the field value.
+//
+// Returns nil, if schema does not constrain the value.
+func (g *schemaGen) constraintProperty(f *ir.Field, schema *jsonschema.Schema) (*ir.ConstraintProperty, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	if keyword := unsupportedConstraintKeyword(schema); keyword != "" {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("%s in conditional property", keyword)}
+	}
+	if len(schema.Properties) > 0 || len(schema.Required) > 0 {
+		return nil, &ErrNotImplemented{Name: "nested conditional property"}
+	}
+
+	base := f.Type
+	switch {
+	case base.IsGeneric():
+		base = base.GenericOf
+	case base.IsPointer():
+		base = base.PointerTo
+	}
+	if !base.IsPrimitive() && !base.IsEnum() {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional property of kind %q", base.Kind)}
+	}
+	if base.Schema != nil && !isConstraintTypeCompatible(base.Schema.Type, schema.Type) {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional property of type %q", schema.Type)}
+	}
+
+	p := &ir.ConstraintProperty{Field: f}
+
+	var values []any
+	switch {
+	case schema.ConstSet:
+		values = []any{schema.Const}
+	case len(schema.Enum) > 0:
+		values = schema.Enum
+	}
+	for _, v := range values {
+		if !isConstraintValueCompatible(base, v) {
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional value %v of type %T", v, v)}
+		}
+		if !slices.Contains(p.Values, v) {
+			p.Values = append(p.Values, v)
+		}
+	}
+
+	validated, err := valueValidators(base, schema)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case validated != nil:
+		if base.IsEnum() {
+			return nil, &ErrNotImplemented{Name: "conditional enum validation"}
+		}
+		p.Validate = validated
+	case hasValueValidators(schema):
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("conditional validation of %s", base.Go())}
+	}
+
+	if len(p.Values) == 0 && p.Validate == nil {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// valueValidators returns copy of the primitive type with validators from schema.
+//
+// Returns nil, if schema does not define validators applicable to the type.
+func valueValidators(base *ir.Type, schema *jsonschema.Schema) (*ir.Type, error) {
+	var (
+		v   ir.Validators
+		err error
+	)
+	switch {
+	case base.IsString():
+		err = v.SetString(schema)
+	case base.IsInteger():
+		err = v.SetInt(schema)
+	case base.IsFloat():
+		err = v.SetFloat(schema)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "validator")
+	}
+	if !v.String.Set() && !v.Int.Set() && !v.Float.Set() {
+		return nil, nil
+	}
+	validated := *base
+	validated.Validators = v
+	validated.Not = nil
+	return &validated, nil
+}
+
+// unsupportedConstraintKeyword returns name of the keyword that cannot be used
+// in conditional subschema, if any.
+func unsupportedConstraintKeyword(s *jsonschema.Schema) string {
+	switch {
+	case len(s.AllOf) > 0:
+		return "allOf"
+	case len(s.AnyOf) > 0:
+		return "anyOf"
+	case len(s.OneOf) > 0:
+		return "oneOf"
+	case hasConditionals(s):
+		return "nested conditional"
+	case s.Not != nil:
+		return "not"
+	case s.AdditionalProperties != nil:
+		return "additionalProperties"
+	case len(s.PatternProperties) > 0:
+		return "patternProperties"
+	case s.MinProperties != nil || s.MaxProperties != nil:
+		return "minProperties/maxProperties"
+	case s.Item != nil || len(s.Items) > 0:
+		return "items"
+	case s.MinItems != nil || s.MaxItems != nil || s.UniqueItems:
+		return "array validation"
+	default:
+		return ""
+	}
+}
+
+func hasValueValidators(s *jsonschema.Schema) bool {
+	return s.MaxLength != nil ||
+		s.MinLength != nil ||
+		s.Pattern != "" ||
+		len(s.Maximum) > 0 ||
+		len(s.Minimum) > 0 ||
+		len(s.MultipleOf) > 0 ||
+		s.ExclusiveMaximum ||
+		s.ExclusiveMinimum
+}
+
+// isConstraintTypeCompatible whether subschema type can be applied to the property type.
+//
+// Type of subschema may be inferred from validators, so integer and number are compatible.
+func isConstraintTypeCompatible(prop, sub jsonschema.SchemaType) bool {
+	isNumeric := func(t jsonschema.SchemaType) bool {
+		return t == jsonschema.Integer || t == jsonschema.Number
+	}
+	return sub == jsonschema.Empty || prop == sub || (isNumeric(prop) && isNumeric(sub))
+}
+
+// isConstraintValueCompatible whether value can be compared with value of given type.
+func isConstraintValueCompatible(t *ir.Type, v any) bool {
+	switch v.(type) {
+	case string:
+		return t.Primitive == ir.String
+	case int64:
+		return t.IsInteger() || t.IsFloat()
+	case float64:
+		return t.IsFloat()
+	case bool:
+		return t.Primitive == ir.Bool
+	default:
+		return false
+	}
+}
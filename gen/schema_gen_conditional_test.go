@@ -0,0 +1,129 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+func TestSchemaGenConditionals(t *testing.T) {
+	object := func(s *jsonschema.Schema) *jsonschema.Schema {
+		s.Type = jsonschema.Object
+		s.Properties = []jsonschema.Property{
+			{Name: "kind", Schema: &jsonschema.Schema{Type: jsonschema.String}},
+			{Name: "size", Schema: &jsonschema.Schema{Type: jsonschema.Integer}},
+			{Name: "tags", Schema: &jsonschema.Schema{
+				Type: jsonschema.Array,
+				Item: &jsonschema.Schema{Type: jsonschema.String},
+			}},
+		}
+		return s
+	}
+	generate := func(s *jsonschema.Schema) (*ir.Type, error) {
+		g := newSchemaGen(func(ref jsonschema.Ref) (*ir.Type, bool) {
+			return nil, false
+		})
+		return g.generate("Test", s, false)
+	}
+
+	t.Run("Supported", func(t *testing.T) {
+		a := require.New(t)
+
+		typ, err := generate(object(&jsonschema.Schema{
+			If: &jsonschema.Schema{Properties: []jsonschema.Property{
+				{Name: "kind", Schema: &jsonschema.Schema{Enum: []any{"a", "b", "a"}}},
+			}},
+			Then: &jsonschema.Schema{Required: []string{"size"}},
+			DependentRequired: map[string][]string{
+				"tags": {"kind"},
+			},
+		}))
+		a.NoError(err)
+		a.True(typ.NeedValidation())
+
+		c := typ.Conditions
+		a.NotNil(c)
+		a.Len(c.If.Properties, 1)
+		a.Equal([]any{"a", "b"}, c.If.Properties[0].Values)
+		a.Len(c.Then.Required, 1)
+		a.Nil(c.Else)
+		a.Len(c.DependentRequired, 1)
+		a.Equal("tags", c.DependentRequired[0].Field.ValidationName())
+	})
+	t.Run("ThenWithoutIf", func(t *testing.T) {
+		a := require.New(t)
+
+		typ, err := generate(object(&jsonschema.Schema{
+			Then: &jsonschema.Schema{Required: []string{"size"}},
+		}))
+		a.NoError(err)
+		a.Nil(typ.Conditions)
+	})
+	for _, tt := range []struct {
+		Name   string
+		Schema *jsonschema.Schema
+	}{
+		{"UndefinedProperty", object(&jsonschema.Schema{
+			DependentRequired: map[string][]string{"kind": {"unknown"}},
+		})},
+		{"ArrayProperty", object(&jsonschema.Schema{
+			DependentSchemas: map[string]*jsonschema.Schema{
+				"kind": {Properties: []jsonschema.Property{
+					{Name: "tags", Schema: &jsonschema.Schema{MinItems: ptrTo(uint64(1))}},
+				}},
+			},
+		})},
+		{"IncompatibleValue", object(&jsonschema.Schema{
+			If: &jsonschema.Schema{Properties: []jsonschema.Property{
+				{Name: "kind", Schema: &jsonschema.Schema{Const: int64(1), ConstSet: true}},
+			}},
+			Then: &jsonschema.Schema{Required: []string{"size"}},
+		})},
+		{"NestedConditional", object(&jsonschema.Schema{
+			If: &jsonschema.Schema{Required: []string{"kind"}},
+			Then: &jsonschema.Schema{
+				DependentRequired: map[string][]string{"kind": {"size"}},
+			},
+		})},
+		{"NotObject", &jsonschema.Schema{
+			Type: jsonschema.String,
+			If:   &jsonschema.Schema{MinLength: ptrTo(uint64(1))},
+			Then: &jsonschema.Schema{MaxLength: ptrTo(uint64(2))},
+		}},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := generate(tt.Schema)
+			var implErr *ErrNotImplemented
+			require.True(t, errors.As(err, &implErr), "%+v", err)
+		})
+	}
+}
+
+func TestMergeConditionals(t *testing.T) {
+	a := require.New(t)
+
+	r, err := mergeSchemes(
+		&jsonschema.Schema{
+			Type:              jsonschema.Object,
+			DependentRequired: map[string][]string{"a": {"b"}},
+		},
+		&jsonschema.Schema{
+			Type:              jsonschema.Object,
+			DependentRequired: map[string][]string{"a": {"b", "c"}, "d": {"e"}},
+			If:                &jsonschema.Schema{Required: []string{"a"}},
+		},
+	)
+	a.NoError(err)
+	a.Equal(map[string][]string{"a": {"b", "c"}, "d": {"e"}}, r.DependentRequired)
+	a.NotNil(r.If)
+
+	_, err = mergeSchemes(
+		&jsonschema.Schema{Type: jsonschema.Object, If: &jsonschema.Schema{}},
+		&jsonschema.Schema{Type: jsonschema.Object, If: &jsonschema.Schema{}},
+	)
+	a.Error(err)
+}
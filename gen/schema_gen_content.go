@@ -0,0 +1,55 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// hasEncodedContent reports whether string schema contains value of contentSchema.
+func hasEncodedContent(s *jsonschema.Schema) bool {
+	return s != nil &&
+		s.Type == jsonschema.String &&
+		s.ContentSchema != nil &&
+		s.ContentMediaType != ""
+}
+
+// encodedContent generates alias type for string containing encoded value of contentSchema.
+func (g *schemaGen) encodedContent(name string, schema *jsonschema.Schema) (*ir.Type, error) {
+	_, mediaType, err := normalizeContentEncoding(schema.ContentMediaType, nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse contentMediaType")
+	}
+	if !mediaType.JSON() {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("contentMediaType %q", schema.ContentMediaType)}
+	}
+
+	encoding := strings.ToLower(schema.ContentEncoding)
+	switch encoding {
+	case "", "base64", "base64url":
+	default:
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("contentEncoding %q", schema.ContentEncoding)}
+	}
+
+	content, err := g.generate(name+"Content", schema.ContentSchema, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "contentSchema")
+	}
+
+	t := ir.Alias(name, content)
+	t.Schema = schema
+	t.EncodedContent = &ir.EncodedContent{
+		MediaType: schema.ContentMediaType,
+		Encoding:  encoding,
+	}
+	if ref := schema.Ref; !ref.IsZero() {
+		g.localRefs[ref] = t
+		return t, nil
+	}
+	g.side = append(g.side, t)
+	return t, nil
+}
@@ -0,0 +1,218 @@
+package gen
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// narrowNot applies "not" keyword to the schema during generation, if possible.
+//
+// If "not" is fully applied, narrowNot returns a copy of the schema without it:
+// excluded variants of the sum type or values of the enum are removed.
+// Otherwise, the schema is returned as is and "not" is checked during validation.
+func narrowNot(schema *jsonschema.Schema) (*jsonschema.Schema, error) {
+	not := schema.Not
+	if not == nil || len(schema.AllOf) > 0 {
+		// "not" is applied to the merged schema.
+		return schema, nil
+	}
+	narrowed := func() *jsonschema.Schema {
+		s := shallowSchemaCopy(schema)
+		s.Not = nil
+		return s
+	}
+
+	switch {
+	case len(schema.OneOf) > 0:
+		variants, err := narrowVariants(schema.OneOf, not)
+		if err != nil {
+			return nil, errors.Wrap(err, "oneOf")
+		}
+		s := narrowed()
+		s.OneOf = variants
+		return s, nil
+	case len(schema.AnyOf) > 0:
+		variants, err := narrowVariants(schema.AnyOf, not)
+		if err != nil {
+			return nil, errors.Wrap(err, "anyOf")
+		}
+		s := narrowed()
+		s.AnyOf = variants
+		return s, nil
+	case schema.Type == jsonschema.Empty:
+		return nil, &ErrNotImplemented{Name: "not on schema without type"}
+	}
+
+	if not.Type != jsonschema.Empty && !isConstraintTypeCompatible(schema.Type, not.Type) {
+		// Values of the schema are never valid against "not".
+		if not.Type == jsonschema.Null && schema.Nullable {
+			return nil, &ErrNotImplemented{Name: "not excluding null"}
+		}
+		return narrowed(), nil
+	}
+	if isTypeOnly(not) {
+		if schema.Type == jsonschema.Number && not.Type == jsonschema.Integer {
+			return nil, &ErrNotImplemented{Name: "not excluding integer numbers"}
+		}
+		return nil, errors.Errorf("not excludes all values of type %q", schema.Type)
+	}
+
+	values, ok := negatedValues(not)
+	if !ok {
+		return schema, nil
+	}
+	contains := func(v any) bool {
+		return slices.ContainsFunc(values, func(excluded any) bool {
+			return reflect.DeepEqual(v, excluded)
+		})
+	}
+	switch {
+	case schema.ConstSet:
+		if contains(schema.Const) {
+			return nil, errors.Errorf("not excludes const value %v", schema.Const)
+		}
+		return narrowed(), nil
+	case len(schema.Enum) > 0:
+		s := narrowed()
+		s.Enum = slices.DeleteFunc(slices.Clone(schema.Enum), contains)
+		if len(s.Enum) == 0 {
+			return nil, errors.New("not excludes all enum values")
+		}
+		return s, nil
+	default:
+		return schema, nil
+	}
+}
+
+// narrowVariants removes sum type variants excluded by "not" subschema.
+func narrowVariants(variants []*jsonschema.Schema, not *jsonschema.Schema) ([]*jsonschema.Schema, error) {
+	typeOnly := isTypeOnly(not)
+	if !typeOnly && !slices.Contains(variants, not) {
+		return nil, &ErrNotImplemented{Name: "not in sum type"}
+	}
+
+	r := make([]*jsonschema.Schema, 0, len(variants))
+	for _, v := range variants {
+		switch {
+		case v == not:
+			// Same referenced schema.
+			continue
+		case !typeOnly:
+		case v.Type == jsonschema.Empty,
+			v.Type == jsonschema.Number && not.Type == jsonschema.Integer,
+			v.Nullable && not.Type == jsonschema.Null:
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("not %q in sum type", not.Type)}
+		case isConstraintTypeCompatible(v.Type, not.Type):
+			continue
+		}
+		r = append(r, v)
+	}
+	if len(r) == 0 {
+		return nil, errors.New("not excludes all variants")
+	}
+	return r, nil
+}
+
+// isTypeOnly reports whether schema defines only the type.
+func isTypeOnly(s *jsonschema.Schema) bool {
+	return s.Type != jsonschema.Empty && !hasSiblingConstraints(s) && len(s.AllOf) == 0
+}
+
+// negatedValues returns values excluded by "not" subschema, if it
+// contains only const or enum.
+func negatedValues(not *jsonschema.Schema) ([]any, bool) {
+	values := not.Enum
+	if not.ConstSet {
+		values = []any{not.Const}
+	}
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	rest := shallowSchemaCopy(not)
+	rest.Enum, rest.Const, rest.ConstSet = nil, nil, false
+	if hasSiblingConstraints(rest) || len(rest.AllOf) > 0 {
+		return nil, false
+	}
+	return values, true
+}
+
+// negation generates "not" constraint checked during validation.
+func (g *schemaGen) negation(t *ir.Type, schema *jsonschema.Schema) (*ir.Negation, error) {
+	not := schema.Not
+	if keyword := unsupportedConstraintKeyword(not); keyword != "" {
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("%s in not", keyword)}
+	}
+
+	switch {
+	case t.IsStruct():
+		if schema.Nullable && not.Type != jsonschema.Object {
+			// Null is valid against subschema without type, so it must be excluded too.
+			return nil, &ErrNotImplemented{Name: "not on nullable object"}
+		}
+		c, err := g.constraint(not, fieldLookup(t))
+		if err != nil {
+			return nil, err
+		}
+		return &ir.Negation{Constraint: c}, nil
+	case t.IsPrimitive() && !t.IsEnum():
+		switch {
+		case len(not.Properties) > 0 || len(not.Required) > 0:
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("not with properties on %s", t.Go())}
+		case not.Format != "":
+			return nil, &ErrNotImplemented{Name: "not with format"}
+		}
+
+		n := new(ir.Negation)
+		var values []any
+		switch {
+		case not.ConstSet:
+			values = []any{not.Const}
+		case len(not.Enum) > 0:
+			values = not.Enum
+		}
+		for _, v := range values {
+			if v == nil && schema.Nullable {
+				return nil, &ErrNotImplemented{Name: "not excluding null"}
+			}
+			if !isConstraintValueCompatible(t, v) {
+				// Value of other type is never equal to the value.
+				continue
+			}
+			if !slices.Contains(n.Values, v) {
+				n.Values = append(n.Values, v)
+			}
+		}
+		if len(values) > 0 && len(n.Values) == 0 {
+			// None of excluded values can be represented by the type.
+			return nil, nil
+		}
+
+		validated, err := valueValidators(t, not)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case validated != nil:
+			if schema.Nullable && not.Type == jsonschema.Empty {
+				// Null is valid against validators of other types, so it must be excluded too.
+				return nil, &ErrNotImplemented{Name: "not with validators on nullable value"}
+			}
+			n.Validate = validated
+		case hasValueValidators(not):
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("not validation of %s", t.Go())}
+		}
+		if len(n.Values) == 0 && n.Validate == nil {
+			return nil, &ErrNotImplemented{Name: fmt.Sprintf("not on %s", t.Go())}
+		}
+		return n, nil
+	default:
+		return nil, &ErrNotImplemented{Name: fmt.Sprintf("not on %s", t.Kind)}
+	}
+}
@@ -0,0 +1,195 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/gen/ir"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+func TestNarrowNot(t *testing.T) {
+	var (
+		str = &jsonschema.Schema{Type: jsonschema.String}
+		num = &jsonschema.Schema{Type: jsonschema.Integer}
+		obj = &jsonschema.Schema{Type: jsonschema.Object}
+	)
+
+	t.Run("Variants", func(t *testing.T) {
+		a := require.New(t)
+
+		s, err := narrowNot(&jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{str, num, obj},
+			Not:   &jsonschema.Schema{Type: jsonschema.Number},
+		})
+		a.NoError(err)
+		a.Nil(s.Not)
+		a.Equal([]*jsonschema.Schema{str, obj}, s.OneOf)
+
+		s, err = narrowNot(&jsonschema.Schema{
+			AnyOf: []*jsonschema.Schema{str, num, obj},
+			Not:   obj,
+		})
+		a.NoError(err)
+		a.Nil(s.Not)
+		a.Equal([]*jsonschema.Schema{str, num}, s.AnyOf)
+	})
+	t.Run("Enum", func(t *testing.T) {
+		a := require.New(t)
+
+		schema := &jsonschema.Schema{
+			Type: jsonschema.String,
+			Enum: []any{"a", "b", "c"},
+			Not:  &jsonschema.Schema{Enum: []any{"b", int64(1)}},
+		}
+		s, err := narrowNot(schema)
+		a.NoError(err)
+		a.Nil(s.Not)
+		a.Equal([]any{"a", "c"}, s.Enum)
+		// Original schema is not modified.
+		a.Equal([]any{"a", "b", "c"}, schema.Enum)
+	})
+	t.Run("OtherType", func(t *testing.T) {
+		a := require.New(t)
+
+		s, err := narrowNot(&jsonschema.Schema{
+			Type: jsonschema.Object,
+			Not:  &jsonschema.Schema{Type: jsonschema.Integer},
+		})
+		a.NoError(err)
+		a.Nil(s.Not)
+	})
+	t.Run("Runtime", func(t *testing.T) {
+		a := require.New(t)
+
+		schema := &jsonschema.Schema{
+			Type: jsonschema.String,
+			Not:  &jsonschema.Schema{Const: "admin", ConstSet: true},
+		}
+		s, err := narrowNot(schema)
+		a.NoError(err)
+		a.Same(schema, s)
+	})
+	for _, tt := range []struct {
+		Name   string
+		Schema *jsonschema.Schema
+	}{
+		{"SameType", &jsonschema.Schema{
+			Type: jsonschema.String,
+			Not:  &jsonschema.Schema{Type: jsonschema.String},
+		}},
+		{"Const", &jsonschema.Schema{
+			Type:     jsonschema.String,
+			Const:    "a",
+			ConstSet: true,
+			Not:      &jsonschema.Schema{Enum: []any{"a"}},
+		}},
+		{"AllEnumValues", &jsonschema.Schema{
+			Type: jsonschema.String,
+			Enum: []any{"a"},
+			Not:  &jsonschema.Schema{Const: "a", ConstSet: true},
+		}},
+		{"AllVariants", &jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{num},
+			Not:   &jsonschema.Schema{Type: jsonschema.Integer},
+		}},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := narrowNot(tt.Schema)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSchemaGenNot(t *testing.T) {
+	generate := func(s *jsonschema.Schema) (*ir.Type, error) {
+		g := newSchemaGen(func(ref jsonschema.Ref) (*ir.Type, bool) {
+			return nil, false
+		})
+		return g.generate("Test", s, false)
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		a := require.New(t)
+
+		typ, err := generate(&jsonschema.Schema{
+			Type: jsonschema.String,
+			Not:  &jsonschema.Schema{Enum: []any{"root", "admin", "root"}},
+		})
+		a.NoError(err)
+		a.True(typ.NeedValidation())
+		a.Equal([]any{"root", "admin"}, typ.Not.Values)
+		a.Nil(typ.Not.Validate)
+	})
+	t.Run("Validators", func(t *testing.T) {
+		a := require.New(t)
+
+		typ, err := generate(&jsonschema.Schema{
+			Type:      jsonschema.String,
+			MaxLength: ptrTo(uint64(10)),
+			Not:       &jsonschema.Schema{Pattern: "^_"},
+		})
+		a.NoError(err)
+		a.NotNil(typ.Not.Validate)
+		a.Nil(typ.Not.Validate.Not)
+		a.True(typ.Not.Validate.Validators.String.Set())
+		a.False(typ.Not.Validate.Validators.String.MaxLengthSet)
+	})
+	t.Run("Struct", func(t *testing.T) {
+		a := require.New(t)
+
+		typ, err := generate(&jsonschema.Schema{
+			Type: jsonschema.Object,
+			Properties: []jsonschema.Property{
+				{Name: "a", Schema: &jsonschema.Schema{Type: jsonschema.String}},
+				{Name: "b", Schema: &jsonschema.Schema{Type: jsonschema.String}},
+			},
+			Not: &jsonschema.Schema{Required: []string{"a", "b"}},
+		})
+		a.NoError(err)
+		a.True(typ.NeedValidation())
+		a.Len(typ.Not.Constraint.Required, 2)
+	})
+	for _, tt := range []struct {
+		Name   string
+		Schema *jsonschema.Schema
+	}{
+		{"Array", &jsonschema.Schema{
+			Type: jsonschema.Array,
+			Item: &jsonschema.Schema{Type: jsonschema.String},
+			Not:  &jsonschema.Schema{MinItems: ptrTo(uint64(1))},
+		}},
+		{"Empty", &jsonschema.Schema{
+			Not: &jsonschema.Schema{Type: jsonschema.Null},
+		}},
+		{"Variants", &jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{
+				{Type: jsonschema.String},
+				{Type: jsonschema.Integer},
+			},
+			Not: &jsonschema.Schema{MinLength: ptrTo(uint64(1))},
+		}},
+		{"Enum", &jsonschema.Schema{
+			Type: jsonschema.String,
+			Enum: []any{"a", "b"},
+			Not:  &jsonschema.Schema{MinLength: ptrTo(uint64(1))},
+		}},
+		{"Format", &jsonschema.Schema{
+			Type: jsonschema.String,
+			Not:  &jsonschema.Schema{Format: "email"},
+		}},
+		{"NullableValidators", &jsonschema.Schema{
+			Type:     jsonschema.String,
+			Nullable: true,
+			Not:      &jsonschema.Schema{MinLength: ptrTo(uint64(1))},
+		}},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := generate(tt.Schema)
+			var implErr *ErrNotImplemented
+			require.True(t, errors.As(err, &implErr), "%+v", err)
+		})
+	}
+}
@@ -156,12 +156,15 @@ func (g *schemaGen) validateEnumValues(s *jsonschema.Schema) error {
 func (g *schemaGen) parseSimple(schema *jsonschema.Schema) *ir.Type {
 	mapping := TypeFormatMapping()
 
-	// TODO(tdakkota): check ContentEncoding field
 	t, found := mapping[schema.Type][schema.Format]
 	if !found {
 		// Fallback to default.
 		t = mapping[schema.Type][""]
 	}
+	if t == ir.String && isBase64Encoding(schema) {
+		// JSON Schema 2020-12 replacement of "format: byte".
+		t = ir.ByteSlice
+	}
 
 	return ir.Primitive(t, schema)
 }
@@ -476,6 +476,8 @@ func (g *schemaGen) anyOf(name string, schema *jsonschema.Schema, side bool) (*i
 		Name:   name,
 		Kind:   ir.KindSum,
 		Schema: regSchema,
+		// Side sum type inherits it from the struct.
+		DenyAdditionalProps: !side && unevaluatedDenied(schema),
 	})
 	{
 		variants, err := g.collectSumVariants(name, schema.AnyOf)
@@ -607,6 +609,8 @@ func (g *schemaGen) oneOf(name string, schema *jsonschema.Schema, side bool) (*i
 		Name:   name,
 		Kind:   ir.KindSum,
 		Schema: regSchema,
+		// Side sum type inherits it from the struct.
+		DenyAdditionalProps: !side && unevaluatedDenied(schema),
 	})
 	sum.SumOf = variants
 
@@ -1279,7 +1283,10 @@ func hasSiblingConstraints(s *jsonschema.Schema) bool {
 		s.Discriminator != nil:
 		return true
 	case len(s.OneOf) > 0,
-		len(s.AnyOf) > 0:
+		len(s.AnyOf) > 0,
+		s.Not != nil,
+		s.UnevaluatedProperties != nil,
+		hasConditionals(s):
 		return true
 	case s.Item != nil,
 		len(s.Items) > 0,
@@ -1551,7 +1558,10 @@ func mergeSchemes(s1, s2 *jsonschema.Schema) (_ *jsonschema.Schema, err error) {
 			len(s.Required) > 0 {
 			return true
 		}
-		if len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) > 0 {
+		if len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) > 0 || s.Not != nil || hasConditionals(s) {
+			return true
+		}
+		if s.UnevaluatedProperties != nil {
 			return true
 		}
 		if s.Discriminator != nil || s.XML != nil {
@@ -1788,6 +1798,21 @@ func mergeSchemes(s1, s2 *jsonschema.Schema) (_ *jsonschema.Schema, err error) {
 		return nil, errors.Wrap(err, "merge anyOf")
 	}
 
+	switch {
+	case s1.Not != nil && s2.Not != nil:
+		return nil, &ErrNotImplemented{Name: "allOf with multiple not"}
+	case s1.Not != nil:
+		r.Not = s1.Not
+	case s2.Not != nil:
+		r.Not = s2.Not
+	}
+	if err := mergeUnevaluated(r, s1, s2); err != nil {
+		return nil, errors.Wrap(err, "merge unevaluatedProperties")
+	}
+	if err := mergeConditionals(r, s1, s2); err != nil {
+		return nil, errors.Wrap(err, "merge conditionals")
+	}
+
 	return r, nil
 }
 
@@ -0,0 +1,84 @@
+package gen
+
+import (
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// normalizeUnevaluated applies "unevaluatedProperties" keyword to the schema during generation.
+//
+// Object without subschemas evaluates the same properties as "additionalProperties" does,
+// so the keyword is replaced with "additionalProperties". Keyword without effect is removed.
+// Otherwise, unevaluatedProperties: false is kept to deny fields not evaluated by the sum type variant.
+func normalizeUnevaluated(schema *jsonschema.Schema) (*jsonschema.Schema, error) {
+	u := schema.UnevaluatedProperties
+	if u == nil || len(schema.AllOf) > 0 {
+		// "unevaluatedProperties" is applied to the merged schema.
+		return schema, nil
+	}
+	normalized := func() *jsonschema.Schema {
+		s := shallowSchemaCopy(schema)
+		s.UnevaluatedProperties = nil
+		s.UnevaluatedItem = nil
+		return s
+	}
+
+	switch {
+	case schema.AdditionalProperties != nil:
+		// All properties are evaluated by "additionalProperties".
+		return normalized(), nil
+	case len(schema.OneOf)+len(schema.AnyOf) > 0:
+		if schema.UnevaluatedItem != nil {
+			return nil, &ErrNotImplemented{Name: "unevaluatedProperties schema with oneOf/anyOf"}
+		}
+		if *u {
+			return normalized(), nil
+		}
+		return schema, nil
+	case schema.Type == jsonschema.Object:
+		s := normalized()
+		s.AdditionalProperties = u
+		s.Item = schema.UnevaluatedItem
+		return s, nil
+	default:
+		// Keyword applies only to objects.
+		return normalized(), nil
+	}
+}
+
+// unevaluatedDenied whether the schema explicitly denies unevaluated properties.
+func unevaluatedDenied(schema *jsonschema.Schema) bool {
+	u := schema.UnevaluatedProperties
+	return u != nil && !*u
+}
+
+// denyUnevaluated whether the object schema without "additionalProperties" denies unknown fields.
+func (g *schemaGen) denyUnevaluated(schema *jsonschema.Schema) bool {
+	if schema.UnevaluatedProperties != nil {
+		return unevaluatedDenied(schema)
+	}
+	// Objects without properties are free-form.
+	return g.strict && len(schema.Properties)+len(schema.OneOf)+len(schema.AnyOf) > 0
+}
+
+// mergeUnevaluated merges "unevaluatedProperties" of allOf parts.
+//
+// Properties of all parts are evaluated by the merged schema, so
+// the part denying unevaluated properties denies them for the whole allOf.
+func mergeUnevaluated(r, s1, s2 *jsonschema.Schema) error {
+	u1, u2 := s1.UnevaluatedProperties, s2.UnevaluatedProperties
+	switch {
+	case u1 == nil && u2 == nil:
+		return nil
+	case s1.UnevaluatedItem != nil && u2 != nil,
+		s2.UnevaluatedItem != nil && u1 != nil:
+		return &ErrNotImplemented{Name: "allOf unevaluatedProperties schema merging"}
+	case u1 == nil:
+		r.UnevaluatedProperties, r.UnevaluatedItem = u2, s2.UnevaluatedItem
+	case u2 == nil:
+		r.UnevaluatedProperties, r.UnevaluatedItem = u1, s1.UnevaluatedItem
+	default:
+		allow := *u1 && *u2
+		r.UnevaluatedProperties = &allow
+	}
+	return nil
+}
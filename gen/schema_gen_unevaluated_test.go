@@ -0,0 +1,104 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+func TestNormalizeUnevaluated(t *testing.T) {
+	var (
+		str   = &jsonschema.Schema{Type: jsonschema.String}
+		num   = &jsonschema.Schema{Type: jsonschema.Integer}
+		allow = ptrTo(true)
+		deny  = ptrTo(false)
+	)
+
+	t.Run("Object", func(t *testing.T) {
+		a := require.New(t)
+
+		schema := &jsonschema.Schema{
+			Type:                  jsonschema.Object,
+			UnevaluatedProperties: allow,
+			UnevaluatedItem:       str,
+		}
+		s, err := normalizeUnevaluated(schema)
+		a.NoError(err)
+		a.Nil(s.UnevaluatedProperties)
+		a.Equal(allow, s.AdditionalProperties)
+		a.Same(str, s.Item)
+		// Original schema is not modified.
+		a.Nil(schema.AdditionalProperties)
+	})
+	t.Run("AdditionalProperties", func(t *testing.T) {
+		a := require.New(t)
+
+		s, err := normalizeUnevaluated(&jsonschema.Schema{
+			Type:                  jsonschema.Object,
+			AdditionalProperties:  allow,
+			UnevaluatedProperties: deny,
+		})
+		a.NoError(err)
+		a.Nil(s.UnevaluatedProperties)
+		a.Equal(allow, s.AdditionalProperties)
+	})
+	t.Run("Variants", func(t *testing.T) {
+		a := require.New(t)
+
+		schema := &jsonschema.Schema{
+			OneOf:                 []*jsonschema.Schema{str, num},
+			UnevaluatedProperties: deny,
+		}
+		s, err := normalizeUnevaluated(schema)
+		a.NoError(err)
+		a.Same(schema, s)
+
+		s, err = normalizeUnevaluated(&jsonschema.Schema{
+			AnyOf:                 []*jsonschema.Schema{str, num},
+			UnevaluatedProperties: allow,
+		})
+		a.NoError(err)
+		a.Nil(s.UnevaluatedProperties)
+
+		_, err = normalizeUnevaluated(&jsonschema.Schema{
+			AnyOf:                 []*jsonschema.Schema{str, num},
+			UnevaluatedProperties: allow,
+			UnevaluatedItem:       str,
+		})
+		var implErr *ErrNotImplemented
+		a.True(errors.As(err, &implErr))
+	})
+}
+
+func TestMergeUnevaluated(t *testing.T) {
+	var (
+		str   = &jsonschema.Schema{Type: jsonschema.String}
+		allow = ptrTo(true)
+		deny  = ptrTo(false)
+	)
+	for i, tt := range []struct {
+		S1, S2 *jsonschema.Schema
+		Result *bool
+	}{
+		{&jsonschema.Schema{}, &jsonschema.Schema{}, nil},
+		{&jsonschema.Schema{UnevaluatedProperties: deny}, &jsonschema.Schema{}, deny},
+		{&jsonschema.Schema{}, &jsonschema.Schema{UnevaluatedProperties: allow}, allow},
+		{&jsonschema.Schema{UnevaluatedProperties: allow}, &jsonschema.Schema{UnevaluatedProperties: deny}, deny},
+		{&jsonschema.Schema{UnevaluatedProperties: allow}, &jsonschema.Schema{UnevaluatedProperties: allow}, allow},
+	} {
+		r := &jsonschema.Schema{}
+		require.NoError(t, mergeUnevaluated(r, tt.S1, tt.S2), "test %d", i+1)
+		require.Equal(t, tt.Result, r.UnevaluatedProperties, "test %d", i+1)
+	}
+
+	err := mergeUnevaluated(
+		&jsonschema.Schema{},
+		&jsonschema.Schema{UnevaluatedProperties: allow, UnevaluatedItem: str},
+		&jsonschema.Schema{UnevaluatedProperties: deny},
+	)
+	var implErr *ErrNotImplemented
+	require.True(t, errors.As(err, &implErr))
+}
@@ -142,11 +142,29 @@ func (e Elem) NextVar() string {
 	return e.Var + "Elem"
 }
 
+// XMLElem is variable helper for XML element or attribute encoding or decoding.
+type XMLElem struct {
+	// Type is type of this XMLElem.
+	Type *ir.Type
+	// Var is decoding/encoding variable Go name (obj) or selector (obj.Field).
+	Var string
+	// XML contains element or attribute names.
+	XML ir.XML
+}
+
 type ResponseElem struct {
 	Response *ir.Response
 	Ptr      bool
 }
 
+// RuntimeValueElem is variable helper for getting runtime expression value.
+type RuntimeValueElem struct {
+	// Value is a runtime expression value.
+	Value *ir.RuntimeValue
+	// Zero is a Go expression of result returned on error.
+	Zero string
+}
+
 // templateFunctions returns functions which used in templates.
 func templateFunctions() template.FuncMap {
 	return template.FuncMap{
@@ -211,12 +229,25 @@ func templateFunctions() template.FuncMap {
 				First: true,
 			}
 		},
+		"xml_elem": func(t *ir.Type, v string, x ir.XML) XMLElem {
+			return XMLElem{
+				Type: t,
+				Var:  v,
+				XML:  x,
+			}
+		},
 		"response_elem": func(r *ir.Response, ptr bool) ResponseElem {
 			return ResponseElem{
 				Response: r,
 				Ptr:      ptr,
 			}
 		},
+		"runtime_value_elem": func(v *ir.RuntimeValue, zero string) RuntimeValueElem {
+			return RuntimeValueElem{
+				Value: v,
+				Zero:  zero,
+			}
+		},
 		"router_elem": func(child *RouteNode, currentIdx int) RouterElem {
 			if child.IsParam() {
 				currentIdx++
@@ -254,7 +285,6 @@ func templateFunctions() template.FuncMap {
 				Config:    cfg,
 			}
 		},
-		"sse_server_response_encoding": sseServerResponseEncoding,
 		"ir_media": func(e ir.Encoding, t *ir.Type) ir.Media {
 			return ir.Media{
 				Encoding: e,
@@ -321,7 +351,7 @@ func vendoredTemplates() *template.Template {
 }
 
 func isObjectParam(p *ir.Parameter) bool {
-	if p.Spec != nil && p.Spec.Content != nil {
+	if p.Spec != nil && p.Spec.Content != nil && !p.FormQueryString() {
 		// "content" encoding.
 		return false
 	}
@@ -3,6 +3,7 @@ package gen
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -51,8 +52,25 @@ func isStream(s *jsonschema.Schema) bool {
 	default:
 		return false
 	}
-	// TODO(tdakkota): check ContentEncoding field
-	return true
+	switch strings.ToLower(s.ContentEncoding) {
+	case "", "7bit", "8bit", "binary", "base64", "base64url":
+		return true
+	default:
+		return false
+	}
+}
+
+// isBase64Encoding whether string schema has base64 or base64url contentEncoding.
+func isBase64Encoding(s *jsonschema.Schema) bool {
+	if s == nil || s.Type != jsonschema.String {
+		return false
+	}
+	switch strings.ToLower(s.ContentEncoding) {
+	case "base64", "base64url":
+		return true
+	default:
+		return false
+	}
 }
 
 // isMultipartFile tries to map field to multipart file.
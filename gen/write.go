@@ -27,6 +27,7 @@ type TemplateConfig struct {
 	DefaultOperations []*ir.Operation
 	OperationGroups   []*ir.OperationGroup
 	Webhooks          []*ir.Operation
+	Callbacks         []*ir.Operation
 	Types             map[string]*ir.Type
 	Interfaces        map[string]*ir.Type
 	Error             *ir.Response
@@ -35,30 +36,87 @@ type TemplateConfig struct {
 	Securities        map[string]*ir.Security
 	Router            Router
 	WebhookRouter     WebhookRouter
+	CallbackRouter    WebhookRouter
 	Imports           map[string]string
 
 	PathsClientEnabled        bool
 	PathsServerEnabled        bool
 	WebhookClientEnabled      bool
 	WebhookServerEnabled      bool
+	CallbackClientEnabled     bool
+	CallbackServerEnabled     bool
 	OpenTelemetryEnabled      bool
 	SecurityReentrantEnabled  bool
 	RequestOptionsEnabled     bool
 	RequestValidationEnabled  bool
 	ResponseValidationEnabled bool
 	EditorsEnabled            bool
+	TagGroupsEnabled          bool
 
 	skipTestRegex *regexp.Regexp
+	// subPackage is true for operation group sub-packages, see OgenSplitPackages.
+	subPackage bool
 }
 
-// AnyClientEnabled returns true, if webhooks or paths client is enabled.
+// AnyClientEnabled returns true, if webhooks, callbacks or paths client is enabled.
 func (t TemplateConfig) AnyClientEnabled() bool {
-	return t.PathsClientEnabled || t.WebhookClientEnabled
+	return t.PathsClientEnabled || t.WebhookClientEnabled || t.CallbackClientEnabled
 }
 
-// AnyServerEnabled returns true, if webhooks or paths server is enabled.
+// AnyServerEnabled returns true, if webhooks, callbacks or paths server is enabled.
 func (t TemplateConfig) AnyServerEnabled() bool {
-	return t.PathsServerEnabled || t.WebhookServerEnabled
+	return t.PathsServerEnabled || t.WebhookServerEnabled || t.CallbackServerEnabled
+}
+
+// AnyLinks returns true, if any link helper should be generated.
+func (t TemplateConfig) AnyLinks() bool {
+	if !t.PathsClientEnabled {
+		return false
+	}
+	for _, op := range t.Operations {
+		if len(t.OperationLinks(op)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// OperationLinks returns links of given operation to operations of this package.
+func (t TemplateConfig) OperationLinks(op *ir.Operation) (r []*ir.Link) {
+	for _, l := range op.Links {
+		if slices.Contains(t.Operations, l.Operation) {
+			r = append(r, l)
+		}
+	}
+	return r
+}
+
+// AnyCallbackURL returns true, if any callback URL helper should be generated.
+func (t TemplateConfig) AnyCallbackURL() bool {
+	if !t.CallbackClientEnabled {
+		return false
+	}
+	for _, op := range t.Operations {
+		for _, cb := range op.Callbacks {
+			if cb.URL != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AnyClientDigestSecurity returns true, if client should perform Digest authentication.
+func (t TemplateConfig) AnyClientDigestSecurity() bool {
+	if !t.AnyClientEnabled() {
+		return false
+	}
+	for _, s := range t.Securities {
+		if s.Format.IsDigestHTTPSecurity() {
+			return true
+		}
+	}
+	return false
 }
 
 // AnyInstrumentable returns true, if OpenTelemetry integration enabled and there is client/server to instrument.
@@ -66,8 +124,8 @@ func (t TemplateConfig) AnyInstrumentable() bool {
 	return t.OpenTelemetryEnabled && (t.AnyClientEnabled() || t.AnyServerEnabled())
 }
 
-// AnyClientSSEEnabled returns true if any generated client operation may return SSE.
-func (t TemplateConfig) AnyClientSSEEnabled() bool {
+// AnySSEEnabled returns true if any generated operation may return SSE.
+func (t TemplateConfig) AnySSEEnabled() bool {
 	for _, op := range t.Operations {
 		if op.HasSSEStreamResponse() {
 			return true
@@ -78,9 +136,56 @@ func (t TemplateConfig) AnyClientSSEEnabled() bool {
 			return true
 		}
 	}
+	for _, op := range t.Callbacks {
+		if op.HasSSEStreamResponse() {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyClientSSEEnabled returns true if any generated client operation may return SSE.
+func (t TemplateConfig) AnyClientSSEEnabled() bool {
+	return t.AnyClientEnabled() && t.AnySSEEnabled()
+}
+
+// AnyServerSSEEnabled returns true if any generated server operation may return SSE.
+func (t TemplateConfig) AnyServerSSEEnabled() bool {
+	if t.PathsServerEnabled {
+		for _, op := range t.Operations {
+			if op.HasSSEStreamResponse() {
+				return true
+			}
+		}
+	}
+	if t.WebhookServerEnabled {
+		for _, op := range t.Webhooks {
+			if op.HasSSEStreamResponse() {
+				return true
+			}
+		}
+	}
+	if t.CallbackServerEnabled {
+		for _, op := range t.Callbacks {
+			if op.HasSSEStreamResponse() {
+				return true
+			}
+		}
+	}
 	return false
 }
 
+// OperationGroupOf returns name of the operation group of given operation,
+// if the group is generated in this package.
+func (t TemplateConfig) OperationGroupOf(op *ir.Operation) string {
+	for _, group := range t.OperationGroups {
+		if group.Name == op.OperationGroup {
+			return group.Name
+		}
+	}
+	return ""
+}
+
 // ErrorGoType returns Go type of error.
 func (t TemplateConfig) ErrorGoType() string {
 	typ := t.ErrorType
@@ -113,6 +218,11 @@ func (t TemplateConfig) collectStrings(cb func(typ *ir.Type) []string) []string
 
 		for _, f := range typ.Fields {
 			add(f.Type)
+			if part := f.Tag.Part; part != nil {
+				for _, h := range part.Headers {
+					add(h.Type)
+				}
+			}
 		}
 		for _, f := range typ.SumOf {
 			add(f)
@@ -121,6 +231,12 @@ func (t TemplateConfig) collectStrings(cb func(typ *ir.Type) []string) []string
 		add(typ.PointerTo)
 		add(typ.GenericOf)
 		add(typ.Item)
+		for _, v := range typ.Conditions.ValidateTypes() {
+			add(v)
+		}
+		for _, v := range typ.Not.ValidateTypes() {
+			add(v)
+		}
 	}
 
 	for _, typ := range t.Types {
@@ -145,6 +261,10 @@ func (t TemplateConfig) collectStrings(cb func(typ *ir.Type) []string) []string
 		add(t)
 		return nil
 	})
+	_ = walkOpTypes(t.Callbacks, func(t *ir.Type) error {
+		add(t)
+		return nil
+	})
 
 	return xmaps.SortedKeys(m)
 }
@@ -224,8 +344,11 @@ func (w *writer) Generate(templateName, fileName string, cfg TemplateConfig) (re
 	if err := w.t.ExecuteTemplate(buf, templateName, cfg); err != nil {
 		return errors.Wrap(err, "execute")
 	}
+	return w.write(fileName, buf.Bytes())
+}
 
-	generated := buf.Bytes()
+// write formats generated source and writes it to file.
+func (w *writer) write(fileName string, generated []byte) (rerr error) {
 	defer func() {
 		if rerr != nil {
 			_ = os.WriteFile(fileName+".dump", generated, 0o644)
@@ -283,6 +406,7 @@ func (g *Generator) WriteSource(fs FileSystem, pkgName string) error {
 		DefaultOperations:         g.defaultOperations,
 		OperationGroups:           g.operationGroups,
 		Webhooks:                  g.webhooks,
+		Callbacks:                 g.callbacks,
 		Types:                     types,
 		Interfaces:                interfaces,
 		Error:                     g.errType,
@@ -291,17 +415,21 @@ func (g *Generator) WriteSource(fs FileSystem, pkgName string) error {
 		Securities:                g.securities,
 		Router:                    g.router,
 		WebhookRouter:             g.webhookRouter,
+		CallbackRouter:            g.callbackRouter,
 		Imports:                   g.imports,
 		PathsClientEnabled:        features.Has(PathsClient),
 		PathsServerEnabled:        features.Has(PathsServer),
 		WebhookClientEnabled:      features.Has(WebhooksClient) && len(g.webhooks) > 0,
 		WebhookServerEnabled:      features.Has(WebhooksServer) && len(g.webhooks) > 0,
+		CallbackClientEnabled:     features.Has(CallbacksClient) && len(g.callbacks) > 0,
+		CallbackServerEnabled:     features.Has(CallbacksServer) && len(g.callbacks) > 0,
 		OpenTelemetryEnabled:      features.Has(OgenOtel),
 		SecurityReentrantEnabled:  features.Has(ClientSecurityReentrant),
 		RequestOptionsEnabled:     features.Has(ClientRequestOptions),
 		RequestValidationEnabled:  features.Has(ClientRequestValidation),
 		ResponseValidationEnabled: features.Has(ServerResponseValidation),
 		EditorsEnabled:            features.Has(ClientEditors),
+		TagGroupsEnabled:          features.Has(OgenTagGroups),
 		// Unused for now.
 		skipTestRegex: nil,
 	}
@@ -320,53 +448,98 @@ func (g *Generator) WriteSource(fs FileSystem, pkgName string) error {
 		}
 	}
 
+	var (
+		subs  []subPackage
+		decls *declFS
+	)
+	if features.Has(OgenSplitPackages) && len(cfg.OperationGroups) > 0 {
+		var err error
+		cfg, subs, err = g.splitPackages(cfg)
+		if err != nil {
+			return errors.Wrap(err, "split packages")
+		}
+		// Collect declarations of the target package to qualify them in sub-packages.
+		decls = newDeclFS(fs)
+		fs = decls
+		w.fs = decls
+	}
+
 	grp, ctx := errgroup.WithContext(context.Background())
 	grp.SetLimit(runtime.GOMAXPROCS(0))
-	generate := func(fileName, templateName string) {
-		grp.Go(func() (err error) {
-			labels := pprof.Labels("template", templateName)
-			pprof.Do(ctx, labels, func(ctx context.Context) {
-				err = w.Generate(templateName, fileName, cfg)
-			})
-			if err != nil {
-				return errors.Wrapf(err, "template %q", templateName)
-			}
-			return nil
+	g.generatePackage(ctx, grp, w, cfg, features)
+
+	// Generate Equal() and Hash() methods for complex uniqueItems validation
+	if len(g.equalitySpecs) > 0 {
+		grp.Go(func() error {
+			return g.generateEqualityMethodsWithFS(fs, pkgName)
 		})
+		// Generate validateUnique[TypeName]() functions for runtime validation
+		grp.Go(func() error {
+			return g.generateUniqueValidators(fs, pkgName)
+		})
+	}
+
+	if err := grp.Wait(); err != nil {
+		return err
 	}
+	if len(subs) > 0 {
+		w.fs = decls.FileSystem
+		return g.writeSubPackages(w, subs, decls.names, features)
+	}
+	return nil
+}
+
+// templateFile is a generated file of the package.
+type templateFile struct {
+	name    string
+	enabled bool
+}
+
+// packageFiles returns list of files to generate for given package config.
+//
+// Schemas are generated only to the target package, sub-packages
+// of the ogen/split_packages feature contain only operations.
+func (g *Generator) packageFiles(cfg TemplateConfig, features FeatureSet) []templateFile {
 	var (
 		genClient = cfg.AnyClientEnabled()
 		genServer = cfg.AnyServerEnabled()
+		schemas   = !cfg.subPackage
 	)
-	for _, t := range []struct {
-		name    string
-		enabled bool
-	}{
-		{"schemas", true},
-		{"uri", g.hasURIObjectParams()},
-		{"json", g.hasJSON()},
-		{"interfaces", len(interfaces) > 0},
-		{"parameters", g.hasParams()},
+	return []templateFile{
+		{"schemas", schemas},
+		{"uri", schemas && g.hasURIObjectParams()},
+		{"json", schemas && g.hasJSON()},
+		{"xml", schemas && g.hasXML()},
+		{"interfaces", len(cfg.Interfaces) > 0},
+		{"parameters", cfg.hasParams()},
 		{"handlers", genServer},
 		{"request_encoders", genClient},
 		{"request_decoders", genServer},
 		{"response_encoders", genServer},
 		{"response_decoders", genClient},
-		{"validators", g.hasValidators()},
+		{"validators", schemas && g.hasValidators()},
 		{"middleware", genServer},
 		{"server", genServer},
 		{"client", genClient},
+		{"callbacks", cfg.AnyCallbackURL()},
+		{"links", cfg.AnyLinks()},
+		{"sse", cfg.AnySSEEnabled()},
 		{"cfg", true},
-		{"servers", len(g.servers) > 0},
+		{"servers", schemas && len(g.servers) > 0},
 		{"router", genServer},
-		{"defaults", g.hasDefaultFields()},
-		{"security", (genClient || genServer) && len(g.securities) > 0},
-		{"test_examples", features.Has(DebugExampleTests)},
-		{"faker", features.Has(DebugExampleTests)},
+		{"defaults", schemas && g.hasDefaultFields()},
+		{"security", (genClient || genServer) && len(cfg.Securities) > 0},
+		{"test_examples", schemas && features.Has(DebugExampleTests)},
+		{"faker", schemas && features.Has(DebugExampleTests)},
 		{"unimplemented", features.Has(OgenUnimplemented) && genServer},
 		{"labeler", features.Has(OgenOtel) && genServer},
 		{"operations", (genClient || genServer)},
-	} {
+	}
+}
+
+// generatePackage generates files of the target package.
+func (g *Generator) generatePackage(ctx context.Context, grp *errgroup.Group, w *writer, cfg TemplateConfig, features FeatureSet) {
+	for _, t := range g.packageFiles(cfg, features) {
 		if !t.enabled {
 			continue
 		}
@@ -376,21 +549,18 @@ func (g *Generator) WriteSource(fs FileSystem, pkgName string) error {
 			fileName = fmt.Sprintf("oas_%s_gen_test.go", t.name)
 		}
 
-		generate(fileName, t.name)
-	}
-
-	// Generate Equal() and Hash() methods for complex uniqueItems validation
-	if len(g.equalitySpecs) > 0 {
-		grp.Go(func() error {
-			return g.generateEqualityMethodsWithFS(fs, pkgName)
-		})
-		// Generate validateUnique[TypeName]() functions for runtime validation
-		grp.Go(func() error {
-			return g.generateUniqueValidators(fs, pkgName)
+		templateName := t.name
+		grp.Go(func() (err error) {
+			labels := pprof.Labels("template", templateName)
+			pprof.Do(ctx, labels, func(ctx context.Context) {
+				err = w.Generate(templateName, fileName, cfg)
+			})
+			if err != nil {
+				return errors.Wrapf(err, "template %q", templateName)
+			}
+			return nil
 		})
 	}
-
-	return grp.Wait()
 }
 
 func (g *Generator) hasAnyType(check func(t *ir.Type) bool) bool {
@@ -412,16 +582,23 @@ func (g *Generator) hasJSON() bool {
 	})
 }
 
+func (g *Generator) hasXML() bool {
+	return g.hasAnyType(func(t *ir.Type) bool {
+		return t.HasFeature("xml")
+	})
+}
+
 func (g *Generator) hasValidators() bool {
 	return g.hasAnyType((*ir.Type).NeedValidation)
 }
 
-func (g *Generator) hasParams() bool {
+func (t TemplateConfig) hasParams() bool {
 	hasParams := func(op *ir.Operation) bool {
 		return len(op.Params) > 0
 	}
-	return slices.ContainsFunc(g.operations, hasParams) ||
-		slices.ContainsFunc(g.webhooks, hasParams)
+	return slices.ContainsFunc(t.Operations, hasParams) ||
+		slices.ContainsFunc(t.Webhooks, hasParams) ||
+		slices.ContainsFunc(t.Callbacks, hasParams)
 }
 
 func (g *Generator) hasURIObjectParams() bool {
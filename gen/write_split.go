@@ -0,0 +1,299 @@
+package gen
+
+import (
+	"bytes"
+	"context"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"maps"
+	"path"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/go-faster/errors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ogen-go/ogen/gen/ir"
+)
+
+// subPackage is an operation group package, generated by OgenSplitPackages feature.
+type subPackage struct {
+	// dir is a directory of package, relative to the target package.
+	dir string
+	cfg TemplateConfig
+}
+
+// splitPackages moves operation groups from the target package config to sub-packages.
+func (g *Generator) splitPackages(cfg TemplateConfig) (root TemplateConfig, subs []subPackage, _ error) {
+	if g.opt.PackagePath == "" {
+		return root, nil, errors.Errorf("%s: package path is required", OgenSplitPackages.Name)
+	}
+
+	root = cfg
+	root.Operations = cfg.DefaultOperations
+	root.OperationGroups = nil
+	root.Securities = packageSecurities(cfg.Securities, root.Operations, root.Webhooks, root.Callbacks)
+	if len(root.Operations) == 0 {
+		root.PathsClientEnabled = false
+		root.PathsServerEnabled = false
+	}
+	router, err := buildRouter(root.Operations)
+	if err != nil {
+		return root, nil, errors.Wrap(err, "build router")
+	}
+	root.Router = router
+
+	for _, op := range cfg.Operations {
+		for _, l := range op.Links {
+			if l.Operation.OperationGroup != op.OperationGroup {
+				g.log.Warn("Link helper is skipped, since linked operation is generated to another package",
+					zap.String("link", l.Name),
+					zap.String("operation", op.PrettyOperationID()),
+				)
+			}
+		}
+	}
+
+	imports := maps.Clone(cfg.Imports)
+	imports[g.opt.PackagePath] = cfg.Package
+
+	groups := map[string]string{}
+	for _, group := range cfg.OperationGroups {
+		name := strings.ToLower(group.Name)
+		if token.IsKeyword(name) {
+			return root, nil, errors.Errorf("operation group %q: package name %q is a keyword", group.Name, name)
+		}
+		if other, ok := groups[name]; ok {
+			return root, nil, errors.Errorf("operation groups %q and %q: same package name %q", other, group.Name, name)
+		}
+		groups[name] = group.Name
+
+		for _, op := range group.Operations {
+			// SSE stream types are declared in the target package and use its unexported helpers.
+			if op.HasSSEStreamResponse() {
+				return root, nil, errors.Errorf(
+					"operation %q: SSE stream responses are not supported in split packages",
+					op.PrettyOperationID(),
+				)
+			}
+		}
+
+		router, err := buildRouter(group.Operations)
+		if err != nil {
+			return root, nil, errors.Wrapf(err, "operation group %q: build router", group.Name)
+		}
+
+		sub := cfg
+		sub.Package = name
+		sub.Operations = group.Operations
+		sub.DefaultOperations = group.Operations
+		sub.OperationGroups = nil
+		sub.Webhooks = nil
+		sub.Callbacks = nil
+		sub.Types = nil
+		sub.Interfaces = nil
+		sub.Servers = nil
+		sub.Securities = packageSecurities(cfg.Securities, group.Operations)
+		sub.Router = router
+		sub.WebhookRouter = WebhookRouter{}
+		sub.CallbackRouter = WebhookRouter{}
+		sub.Imports = imports
+		sub.WebhookClientEnabled = false
+		sub.WebhookServerEnabled = false
+		// Callback client is generated to the target package,
+		// but callback URL helpers use parameters of operation.
+		sub.CallbackServerEnabled = false
+		sub.subPackage = true
+
+		subs = append(subs, subPackage{
+			dir: name,
+			cfg: sub,
+		})
+	}
+	return root, subs, nil
+}
+
+// packageSecurities returns securities used by given operations.
+//
+// Scopes of other operations are omitted, since their operation names are not defined in the package.
+func packageSecurities(securities map[string]*ir.Security, opLists ...[]*ir.Operation) map[string]*ir.Security {
+	r := map[string]*ir.Security{}
+	for name, s := range securities {
+		var (
+			used   bool
+			scopes = map[string][]string{}
+		)
+		for _, ops := range opLists {
+			for _, op := range ops {
+				for _, opSec := range op.Security.Securities {
+					if opSec == s {
+						used = true
+					}
+				}
+				if v, ok := s.Scopes[op.Name]; ok {
+					scopes[op.Name] = v
+				}
+			}
+		}
+		if !used {
+			continue
+		}
+
+		copied := *s
+		copied.Scopes = scopes
+		r[name] = &copied
+	}
+	return r
+}
+
+// declFS is a FileSystem that collects exported top-level declarations of written sources.
+type declFS struct {
+	FileSystem
+
+	mux   sync.Mutex
+	names map[string]struct{}
+}
+
+func newDeclFS(fs FileSystem) *declFS {
+	return &declFS{
+		FileSystem: fs,
+		names:      map[string]struct{}{},
+	}
+}
+
+// WriteFile implements FileSystem.
+func (fs *declFS) WriteFile(baseName string, source []byte) error {
+	file, err := parser.ParseFile(token.NewFileSet(), baseName, source, parser.SkipObjectResolution)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+
+	fs.mux.Lock()
+	collectDecls(file, func(name *ast.Ident) {
+		if name.IsExported() {
+			fs.names[name.Name] = struct{}{}
+		}
+	})
+	fs.mux.Unlock()
+
+	return fs.FileSystem.WriteFile(baseName, source)
+}
+
+// collectDecls calls cb for every package-level declaration of file.
+func collectDecls(file *ast.File, cb func(name *ast.Ident)) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				cb(decl.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					cb(spec.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						cb(name)
+					}
+				}
+			}
+		}
+	}
+}
+
+// writeSubPackages generates operation group packages.
+//
+// Identifiers declared by the target package are qualified by its name.
+func (g *Generator) writeSubPackages(w *writer, subs []subPackage, rootNames map[string]struct{}, features FeatureSet) error {
+	grp, ctx := errgroup.WithContext(context.Background())
+	grp.SetLimit(runtime.GOMAXPROCS(0))
+	for _, sub := range subs {
+		grp.Go(func() error {
+			if err := g.writeSubPackage(ctx, w, sub, rootNames, features); err != nil {
+				return errors.Wrapf(err, "package %q", sub.dir)
+			}
+			return nil
+		})
+	}
+	return grp.Wait()
+}
+
+func (g *Generator) writeSubPackage(ctx context.Context, w *writer, sub subPackage, rootNames map[string]struct{}, features FeatureSet) error {
+	type source struct {
+		name string
+		file *ast.File
+	}
+	var (
+		fset    = token.NewFileSet()
+		sources []source
+		// local contains declarations of the sub-package, which shadow the target package ones.
+		local = map[string]struct{}{}
+	)
+	for _, t := range g.packageFiles(sub.cfg, features) {
+		if !t.enabled {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		fileName := path.Join(sub.dir, "oas_"+t.name+"_gen.go")
+		file, err := w.parse(fset, t.name, fileName, sub.cfg)
+		if err != nil {
+			return errors.Wrapf(err, "template %q", t.name)
+		}
+		collectDecls(file, func(name *ast.Ident) {
+			local[name.Name] = struct{}{}
+		})
+		sources = append(sources, source{
+			name: fileName,
+			file: file,
+		})
+	}
+
+	qualifier := sub.cfg.Imports[g.opt.PackagePath]
+	for _, s := range sources {
+		for _, id := range s.file.Unresolved {
+			if _, ok := local[id.Name]; ok {
+				continue
+			}
+			if _, ok := rootNames[id.Name]; !ok {
+				continue
+			}
+			// Printer writes identifier name as-is, so it becomes a qualified identifier.
+			id.Name = qualifier + "." + id.Name
+		}
+
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, s.file); err != nil {
+			return errors.Wrapf(err, "print %q", s.name)
+		}
+		if err := w.write(s.name, buf.Bytes()); err != nil {
+			return errors.Wrapf(err, "write %q", s.name)
+		}
+	}
+	return nil
+}
+
+// parse executes template and parses generated source.
+func (w *writer) parse(fset *token.FileSet, templateName, fileName string, cfg TemplateConfig) (*ast.File, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	if err := w.t.ExecuteTemplate(buf, templateName, cfg); err != nil {
+		return nil, errors.Wrap(err, "execute")
+	}
+
+	file, err := parser.ParseFile(fset, fileName, buf.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, &ErrGoFormat{
+			err: err,
+		}
+	}
+	return file, nil
+}
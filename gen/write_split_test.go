@@ -0,0 +1,101 @@
+package gen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen"
+)
+
+type memFS struct {
+	mux   sync.Mutex
+	files map[string]string
+}
+
+func (m *memFS) WriteFile(baseName string, source []byte) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if m.files == nil {
+		m.files = map[string]string{}
+	}
+	m.files[baseName] = string(source)
+	return nil
+}
+
+func TestWriteSplitPackages(t *testing.T) {
+	pet := &ogen.Schema{
+		Type:     "object",
+		Required: []string{"name"},
+		Properties: []ogen.Property{
+			{Name: "name", Schema: &ogen.Schema{Type: "string"}},
+		},
+	}
+	op := func(id string, tags ...string) *ogen.Operation {
+		return &ogen.Operation{
+			OperationID: id,
+			Tags:        tags,
+			Responses: ogen.Responses{
+				"200": &ogen.Response{
+					Description: "ok",
+					Content: map[string]ogen.Media{
+						"application/json": {Schema: &ogen.Schema{Ref: "#/components/schemas/Pet"}},
+					},
+				},
+			},
+		}
+	}
+	spec := &ogen.Spec{
+		OpenAPI: "3.0.3",
+		Info:    ogen.Info{Title: "test", Version: "1.0.0"},
+		Paths: ogen.Paths{
+			"/pet":    &ogen.PathItem{Get: op("getPet", "pets")},
+			"/health": &ogen.PathItem{Get: op("health")},
+		},
+		Components: &ogen.Components{
+			Schemas: map[string]*ogen.Schema{"Pet": pet},
+		},
+	}
+
+	newGenerator := func(t *testing.T, pkgPath string) *Generator {
+		g, err := NewGenerator(spec, Options{
+			Generator: GenerateOptions{
+				Features: &FeatureOptions{
+					Enable: FeatureSet{
+						OgenTagGroups.Name:     {},
+						OgenSplitPackages.Name: {},
+					},
+				},
+				PackagePath: pkgPath,
+			},
+		})
+		require.NoError(t, err)
+		return g
+	}
+
+	t.Run("Write", func(t *testing.T) {
+		a := require.New(t)
+
+		fs := &memFS{}
+		a.NoError(newGenerator(t, "example.com/api").WriteSource(fs, "api"))
+
+		// Schemas and ungrouped operations are in the target package.
+		a.Contains(fs.files, "oas_schemas_gen.go")
+		a.Contains(fs.files["oas_server_gen.go"], "Health(ctx context.Context)")
+		a.NotContains(fs.files["oas_server_gen.go"], "GetPet")
+
+		// Group operations are in the sub-package.
+		a.NotContains(fs.files, "pets/oas_schemas_gen.go")
+		server := fs.files["pets/oas_server_gen.go"]
+		a.Contains(server, "package pets")
+		a.Contains(server, `api "example.com/api"`)
+		a.Contains(server, "GetPet(ctx context.Context) (*api.Pet, error)")
+		// Declarations of the sub-package are not qualified.
+		a.Contains(server, "func NewServer(h Handler, opts ...ServerOption)")
+	})
+	t.Run("NoPackagePath", func(t *testing.T) {
+		require.Error(t, newGenerator(t, "").WriteSource(&memFS{}, "api"))
+	})
+}
@@ -43,15 +43,6 @@ func testGenerate(t *testing.T, dir, filename string, data []byte, aliases ctAli
 		Logger: log,
 	}
 
-	if filename == "wikimedia.openapi.yaml" || filename == "openai-2.3.0.openapi.yaml" {
-		// TODO: remove when SSE server generation feature is implemented.
-		opt.Generator.Features = &gen.FeatureOptions{
-			Disable: gen.FeatureSet{
-				gen.PathsServer.Name:    {},
-				gen.WebhooksServer.Name: {},
-			},
-		}
-	}
 	if filename == "file_reference.yml" { // HACK
 		opt.Parser.AllowRemote = true
 		opt.Parser.RootURL = &url.URL{
@@ -75,6 +66,12 @@ func testGenerate(t *testing.T, dir, filename string, data []byte, aliases ctAli
 		}
 	}
 
+	if filename == "xml.yml" {
+		opt.Generator.Features = &gen.FeatureOptions{
+			Enable: gen.FeatureSet{gen.ContentXML.Name: {}},
+		}
+	}
+
 	if path.Base(dir) == "convenient_errors" {
 		require.NoError(t, opt.Generator.ConvenientErrors.Set("on"))
 	}
@@ -134,7 +131,12 @@ func runPositive(root string,
 }
 
 func TestGenerate(t *testing.T) {
-	t.Run("Positive", runPositive("_testdata/positive", nil,
+	t.Run("Positive", runPositive("_testdata/positive",
+		map[string]ctAliases{
+			"form_complex.yml": {
+				"multipart/mixed": ir.EncodingMultipart,
+			},
+		},
 		map[string][]string{
 			"sample.json": {
 				"enum format",
@@ -142,9 +144,6 @@ func TestGenerate(t *testing.T) {
 			"content_header_response.json": {
 				"parameter content encoding",
 			},
-			"issue1710.yml": {
-				"sse server response encoding",
-			},
 		}))
 
 	t.Run("Examples", runPositive("_testdata/examples",
@@ -190,12 +189,9 @@ func TestGenerate(t *testing.T) {
 			"k8s.json":                  {},
 			"petstore-expanded.yml":     {},
 			"problemjson.yml":           {},
-			"openai-2.3.0.openapi.yaml": {}, // NOTE: remove condition branch on top of test.
-			"wikimedia.openapi.yaml":    {}, // NOTE: remove condition branch on top of test.
+			"openai-2.3.0.openapi.yaml": {},
+			"wikimedia.openapi.yaml":    {},
 			"redoc/discriminator.json":  {},
-			"swagger-petstore-1.0.27.yaml": {
-				"nested objects in form parameters",
-			},
 		}))
 }
 
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// BodyCounter counts bytes read from bodies wrapped by it.
+//
+// BodyCounter is safe for concurrent use.
+type BodyCounter struct {
+	n atomic.Int64
+}
+
+// Count returns the number of bytes read.
+func (c *BodyCounter) Count() int64 {
+	return c.n.Load()
+}
+
+// Wrap returns body that counts bytes read.
+func (c *BodyCounter) Wrap(body io.ReadCloser) io.ReadCloser {
+	if body == nil || body == http.NoBody {
+		return body
+	}
+	return &countingBody{ReadCloser: body, c: c}
+}
+
+// WrapRequest wraps request body, including bodies returned by GetBody.
+func (c *BodyCounter) WrapRequest(r *http.Request) {
+	r.Body = c.Wrap(r.Body)
+	if getBody := r.GetBody; getBody != nil {
+		r.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			return c.Wrap(body), nil
+		}
+	}
+}
+
+type countingBody struct {
+	io.ReadCloser
+	c *BodyCounter
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.c.n.Add(int64(n))
+	return n, err
+}
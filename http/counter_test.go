@@ -0,0 +1,34 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyCounter(t *testing.T) {
+	a := require.New(t)
+
+	var c BodyCounter
+	a.Equal(http.NoBody, c.Wrap(http.NoBody))
+	a.Nil(c.Wrap(nil))
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("hello"))
+	a.NoError(err)
+	c.WrapRequest(req)
+
+	data, err := io.ReadAll(req.Body)
+	a.NoError(err)
+	a.Equal("hello", string(data))
+	a.EqualValues(5, c.Count())
+
+	// Replayed body is counted too.
+	body, err := req.GetBody()
+	a.NoError(err)
+	_, err = io.Copy(io.Discard, body)
+	a.NoError(err)
+	a.EqualValues(10, c.Count())
+}
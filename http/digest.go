@@ -0,0 +1,653 @@
+package http
+
+import (
+	"context"
+	"crypto/md5" // #nosec G501
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-faster/errors"
+)
+
+// Digest authentication algorithms (RFC 7616).
+const (
+	DigestMD5           = "MD5"
+	DigestMD5Sess       = "MD5-sess"
+	DigestSHA256        = "SHA-256"
+	DigestSHA256Sess    = "SHA-256-sess"
+	DigestSHA512256     = "SHA-512-256"
+	DigestSHA512256Sess = "SHA-512-256-sess"
+)
+
+// digestHash returns hash function of Digest algorithm and whether it is a session variant.
+func digestHash(algorithm string) (func() hash.Hash, bool, bool) {
+	base, sess := strings.CutSuffix(strings.ToUpper(algorithm), "-SESS")
+	switch base {
+	case "", "MD5":
+		return md5.New, sess, true
+	case "SHA-256":
+		return sha256.New, sess, true
+	case "SHA-512-256":
+		return sha512.New512_256, sess, true
+	default:
+		return nil, false, false
+	}
+}
+
+// digestStrength ranks supported algorithms, the stronger the better.
+func digestStrength(algorithm string) int {
+	base, _ := strings.CutSuffix(strings.ToUpper(algorithm), "-SESS")
+	switch base {
+	case "SHA-512-256":
+		return 3
+	case "SHA-256":
+		return 2
+	case "", "MD5":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func digestSum(h func() hash.Hash, parts ...string) string {
+	d := h()
+	_, _ = io.WriteString(d, strings.Join(parts, ":"))
+	return hex.EncodeToString(d.Sum(nil))
+}
+
+// DigestChallenge is a Digest authentication challenge sent by server in
+// WWW-Authenticate header.
+type DigestChallenge struct {
+	Realm     string
+	Domain    string
+	Nonce     string
+	Opaque    string
+	Stale     bool
+	Algorithm string
+	// QOP is a list of supported quality of protection values.
+	QOP      []string
+	Userhash bool
+}
+
+// String returns WWW-Authenticate header value of challenge.
+func (c DigestChallenge) String() string {
+	b := newAuthHeader("Digest")
+	b.param("realm", c.Realm, true)
+	if c.Domain != "" {
+		b.param("domain", c.Domain, true)
+	}
+	b.param("nonce", c.Nonce, true)
+	if c.Opaque != "" {
+		b.param("opaque", c.Opaque, true)
+	}
+	if c.Stale {
+		b.param("stale", "true", false)
+	}
+	if c.Algorithm != "" {
+		b.param("algorithm", c.Algorithm, false)
+	}
+	if len(c.QOP) > 0 {
+		b.param("qop", strings.Join(c.QOP, ", "), true)
+	}
+	if c.Userhash {
+		b.param("userhash", "true", false)
+	}
+	return b.String()
+}
+
+// DigestCredentials is a Digest authentication response sent by client in
+// Authorization header.
+type DigestCredentials struct {
+	// Method is the request method.
+	//
+	// It is not transmitted, DigestAuth sets it from the request.
+	Method    string
+	Username  string
+	Realm     string
+	URI       string
+	Algorithm string
+	Nonce     string
+	CNonce    string
+	NC        string
+	QOP       string
+	Response  string
+	Opaque    string
+	Userhash  bool
+}
+
+// String returns Authorization header value of credentials.
+func (c DigestCredentials) String() string {
+	b := newAuthHeader("Digest")
+	b.param("username", c.Username, true)
+	b.param("realm", c.Realm, true)
+	b.param("uri", c.URI, true)
+	if c.Algorithm != "" {
+		b.param("algorithm", c.Algorithm, false)
+	}
+	b.param("nonce", c.Nonce, true)
+	if c.QOP != "" {
+		b.param("nc", c.NC, false)
+		b.param("cnonce", c.CNonce, true)
+		b.param("qop", c.QOP, false)
+	}
+	b.param("response", c.Response, true)
+	if c.Opaque != "" {
+		b.param("opaque", c.Opaque, true)
+	}
+	if c.Userhash {
+		b.param("userhash", "true", false)
+	}
+	return b.String()
+}
+
+// Verify reports whether credentials are computed using given username and password.
+//
+// Only "auth" quality of protection is supported. Caller is responsible for
+// checking nonce and realm.
+func (c DigestCredentials) Verify(username, password string) bool {
+	h, _, ok := digestHash(c.Algorithm)
+	if !ok {
+		return false
+	}
+	if c.QOP != "" && c.QOP != "auth" {
+		return false
+	}
+	expectedUser := username
+	if c.Userhash {
+		expectedUser = digestSum(h, username, c.Realm)
+	}
+	if subtle.ConstantTimeCompare([]byte(expectedUser), []byte(c.Username)) != 1 {
+		return false
+	}
+	expected := c.response(username, password)
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(c.Response))) == 1
+}
+
+// response computes request-digest.
+func (c DigestCredentials) response(username, password string) string {
+	h, sess, _ := digestHash(c.Algorithm)
+	ha1 := digestSum(h, username, c.Realm, password)
+	if sess {
+		ha1 = digestSum(h, ha1, c.Nonce, c.CNonce)
+	}
+	ha2 := digestSum(h, c.Method, c.URI)
+	if c.QOP == "" {
+		// RFC 2069 compatibility.
+		return digestSum(h, ha1, c.Nonce, ha2)
+	}
+	return digestSum(h, ha1, c.Nonce, c.NC, c.CNonce, c.QOP, ha2)
+}
+
+// DigestAuth returns Digest credentials of the request.
+//
+// If request has no Digest Authorization header, ok is false.
+func DigestAuth(req *http.Request) (c DigestCredentials, ok bool, _ error) {
+	for _, v := range req.Header.Values("Authorization") {
+		challenges, err := parseAuthChallenges(v)
+		if err != nil || len(challenges) != 1 || !strings.EqualFold(challenges[0].scheme, "Digest") {
+			continue
+		}
+		params := challenges[0].params
+		c = DigestCredentials{
+			Method:    req.Method,
+			Username:  params["username"],
+			Realm:     params["realm"],
+			URI:       params["uri"],
+			Algorithm: params["algorithm"],
+			Nonce:     params["nonce"],
+			CNonce:    params["cnonce"],
+			NC:        params["nc"],
+			QOP:       params["qop"],
+			Response:  params["response"],
+			Opaque:    params["opaque"],
+			Userhash:  strings.EqualFold(params["userhash"], "true"),
+		}
+		switch {
+		case c.Username == "":
+			return c, true, errors.New("username is empty")
+		case c.Nonce == "":
+			return c, true, errors.New("nonce is empty")
+		case c.Response == "":
+			return c, true, errors.New("response is empty")
+		case c.QOP != "" && (c.CNonce == "" || c.NC == ""):
+			return c, true, errors.New("cnonce and nc are required with qop")
+		}
+		if _, _, ok := digestHash(c.Algorithm); !ok {
+			return c, true, errors.Errorf("unsupported algorithm %q", c.Algorithm)
+		}
+		return c, true, nil
+	}
+	return c, false, nil
+}
+
+// ParseDigestChallenge finds the strongest supported Digest challenge in
+// WWW-Authenticate headers.
+func ParseDigestChallenge(h http.Header) (c DigestChallenge, ok bool) {
+	var best int
+	for _, v := range h.Values("WWW-Authenticate") {
+		challenges, err := parseAuthChallenges(v)
+		if err != nil {
+			continue
+		}
+		for _, ch := range challenges {
+			if !strings.EqualFold(ch.scheme, "Digest") {
+				continue
+			}
+			p := ch.params
+			strength := digestStrength(p["algorithm"])
+			if strength <= best || p["nonce"] == "" {
+				continue
+			}
+			var qop []string
+			for q := range strings.SplitSeq(p["qop"], ",") {
+				if q = strings.TrimSpace(q); q != "" {
+					qop = append(qop, q)
+				}
+			}
+			if len(qop) > 0 && !containsFold(qop, "auth") {
+				// Only "auth" is supported.
+				continue
+			}
+			best = strength
+			c = DigestChallenge{
+				Realm:     p["realm"],
+				Domain:    p["domain"],
+				Nonce:     p["nonce"],
+				Opaque:    p["opaque"],
+				Stale:     strings.EqualFold(p["stale"], "true"),
+				Algorithm: p["algorithm"],
+				QOP:       qop,
+				Userhash:  strings.EqualFold(p["userhash"], "true"),
+			}
+			ok = true
+		}
+	}
+	return c, ok
+}
+
+func containsFold(s []string, v string) bool {
+	for _, e := range s {
+		if strings.EqualFold(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize computes credentials for given request and challenge.
+//
+// nc is the number of requests sent with the challenge nonce, starting from 1.
+func (c DigestChallenge) Authorize(req *http.Request, username, password string, nc uint32) (DigestCredentials, error) {
+	creds := DigestCredentials{
+		Method:    req.Method,
+		Username:  username,
+		Realm:     c.Realm,
+		URI:       req.URL.RequestURI(),
+		Algorithm: c.Algorithm,
+		Nonce:     c.Nonce,
+		Opaque:    c.Opaque,
+		Userhash:  c.Userhash,
+	}
+	h, _, ok := digestHash(c.Algorithm)
+	if !ok {
+		return creds, errors.Errorf("unsupported algorithm %q", c.Algorithm)
+	}
+	if len(c.QOP) > 0 {
+		var cnonce [16]byte
+		if _, err := rand.Read(cnonce[:]); err != nil {
+			return creds, errors.Wrap(err, "generate cnonce")
+		}
+		creds.QOP = "auth"
+		creds.CNonce = hex.EncodeToString(cnonce[:])
+		creds.NC = fmt.Sprintf("%08x", nc)
+	}
+	creds.Response = creds.response(username, password)
+	if c.Userhash {
+		creds.Username = digestSum(h, username, c.Realm)
+	}
+	return creds, nil
+}
+
+type digestAuthKey struct{}
+
+type digestUser struct {
+	username string
+	password string
+}
+
+// SetDigestAuth sets Digest authentication credentials for the request.
+//
+// Credentials are used only if request is sent using transport created by
+// NewDigestTransport or client created by NewDigestClient. Generated clients
+// of specs with Digest security do the latter automatically.
+func SetDigestAuth(req *http.Request, username, password string) {
+	ctx := context.WithValue(req.Context(), digestAuthKey{}, digestUser{
+		username: username,
+		password: password,
+	})
+	*req = *req.WithContext(ctx)
+}
+
+// DigestTransport is http.RoundTripper that performs Digest authentication
+// (RFC 7616) for requests with credentials set by SetDigestAuth.
+//
+// Request is sent without credentials first. If server responds with a
+// Digest challenge, request is sent again with the computed response.
+// Challenges are cached per host, so subsequent requests are authorized
+// immediately.
+type DigestTransport struct {
+	base http.RoundTripper
+
+	mux      sync.Mutex
+	sessions map[string]*digestSession // guarded by mux
+}
+
+type digestSession struct {
+	challenge DigestChallenge
+	nc        uint32
+}
+
+// NewDigestTransport creates new DigestTransport.
+//
+// If base is nil, http.DefaultTransport is used.
+func NewDigestTransport(base http.RoundTripper) *DigestTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &DigestTransport{
+		base:     base,
+		sessions: map[string]*digestSession{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DigestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	user, ok := req.Context().Value(digestAuthKey{}).(digestUser)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	host := req.URL.Host
+
+	first := req
+	if challenge, nc, ok := t.next(host); ok {
+		r, err := t.authorize(req, challenge, user, nc, false)
+		if err != nil {
+			return nil, err
+		}
+		first = r
+	}
+	resp, err := t.base.RoundTrip(first)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	challenge, ok := ParseDigestChallenge(resp.Header)
+	if !ok {
+		return resp, nil
+	}
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if !replayable {
+		return resp, nil
+	}
+	// Drain the body, so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+	_ = resp.Body.Close()
+
+	nc := t.store(host, challenge)
+	r, err := t.authorize(req, challenge, user, nc, true)
+	if err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(r)
+}
+
+// CloseIdleConnections closes idle connections of underlying transport.
+func (t *DigestTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if c, ok := t.base.(closeIdler); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+func (t *DigestTransport) authorize(req *http.Request, c DigestChallenge, user digestUser, nc uint32, replay bool) (*http.Request, error) {
+	creds, err := c.Authorize(req, user.username, user.password, nc)
+	if err != nil {
+		return nil, errors.Wrap(err, "digest auth")
+	}
+	r := req.Clone(req.Context())
+	if replay && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, errors.Wrap(err, "replay body")
+		}
+		r.Body = body
+	}
+	r.Header.Set("Authorization", creds.String())
+	return r, nil
+}
+
+// next returns cached challenge of host and increments nonce count.
+func (t *DigestTransport) next(host string) (DigestChallenge, uint32, bool) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	s, ok := t.sessions[host]
+	if !ok {
+		return DigestChallenge{}, 0, false
+	}
+	s.nc++
+	return s.challenge, s.nc, true
+}
+
+// store caches challenge of host and returns the first nonce count.
+func (t *DigestTransport) store(host string, c DigestChallenge) uint32 {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.sessions[host] = &digestSession{challenge: c, nc: 1}
+	return 1
+}
+
+type authChallenge struct {
+	scheme string
+	params map[string]string
+}
+
+// parseAuthChallenges parses WWW-Authenticate or Authorization header value
+// with auth-param lists (RFC 9110, Section 11).
+//
+// token68 credentials are not supported.
+func parseAuthChallenges(v string) (r []authChallenge, _ error) {
+	p := authParser{s: v}
+	for {
+		p.skip(", \t")
+		if p.eof() {
+			return r, nil
+		}
+		scheme := p.token()
+		if scheme == "" {
+			return nil, errors.Errorf("expected scheme at %d", p.pos)
+		}
+		ch := authChallenge{scheme: scheme, params: map[string]string{}}
+		for {
+			p.skip(" \t")
+			// Either next auth-param or next challenge.
+			start := p.pos
+			name := p.token()
+			p.skip(" \t")
+			if name == "" || !p.consume('=') {
+				p.pos = start
+				break
+			}
+			p.skip(" \t")
+			var value string
+			if p.peek() == '"' {
+				s, err := p.quoted()
+				if err != nil {
+					return nil, err
+				}
+				value = s
+			} else {
+				value = p.token()
+			}
+			ch.params[strings.ToLower(name)] = value
+			p.skip(" \t")
+			if !p.consume(',') {
+				break
+			}
+		}
+		r = append(r, ch)
+	}
+}
+
+type authParser struct {
+	s   string
+	pos int
+}
+
+func (p *authParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *authParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *authParser) consume(c byte) bool {
+	if p.peek() == c && !p.eof() {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *authParser) skip(chars string) {
+	for !p.eof() && strings.IndexByte(chars, p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func isTokenChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	default:
+		return strings.IndexByte("!#$%&'*+-.^_`|~/", c) >= 0
+	}
+}
+
+func (p *authParser) token() string {
+	start := p.pos
+	for !p.eof() && isTokenChar(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *authParser) quoted() (string, error) {
+	var b strings.Builder
+	p.pos++ // opening quote
+	for !p.eof() {
+		c := p.s[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.eof() {
+				return "", errors.New("unterminated quoted string")
+			}
+			b.WriteByte(p.s[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated quoted string")
+}
+
+// authHeader builds auth-param list of WWW-Authenticate or Authorization header.
+type authHeader struct {
+	b strings.Builder
+	n int
+}
+
+func newAuthHeader(scheme string) *authHeader {
+	h := new(authHeader)
+	h.b.WriteString(scheme)
+	h.b.WriteByte(' ')
+	return h
+}
+
+func (h *authHeader) String() string {
+	return h.b.String()
+}
+
+func (h *authHeader) param(name, value string, quote bool) {
+	b := &h.b
+	if h.n > 0 {
+		b.WriteString(", ")
+	}
+	h.n++
+	b.WriteString(name)
+	b.WriteByte('=')
+	if !quote {
+		b.WriteString(value)
+		return
+	}
+	b.WriteByte('"')
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(value[i])
+	}
+	b.WriteByte('"')
+}
+
+// NewDigestClient wraps given client to perform Digest authentication
+// for requests with credentials set by SetDigestAuth.
+//
+// If c is *http.Client already using DigestTransport, c is returned as is.
+func NewDigestClient(c Client) Client {
+	if hc, ok := c.(*http.Client); ok {
+		if _, ok := hc.Transport.(*DigestTransport); ok {
+			return c
+		}
+	}
+	return &digestClient{
+		transport: NewDigestTransport(clientRoundTripper{client: c}),
+	}
+}
+
+type digestClient struct {
+	transport *DigestTransport
+}
+
+// Do implements Client.
+func (c *digestClient) Do(req *http.Request) (*http.Response, error) {
+	return c.transport.RoundTrip(req)
+}
+
+type clientRoundTripper struct {
+	client Client
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t clientRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.client.Do(req)
+}
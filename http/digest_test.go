@@ -0,0 +1,252 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestCredentials(t *testing.T) {
+	// Examples from RFC 7616, Section 3.9.1.
+	for _, tt := range []struct {
+		algorithm string
+		response  string
+	}{
+		{DigestMD5, "8ca523f5e9506fed4657c9700eebdbec"},
+		{DigestSHA256, "753927fa0e85d155564e2e272a28d1802ca10daf4496794697cf8db5856cb6c1"},
+	} {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			a := require.New(t)
+
+			creds := DigestCredentials{
+				Method:    http.MethodGet,
+				Username:  "Mufasa",
+				Realm:     "http-auth@example.org",
+				URI:       "/dir/index.html",
+				Algorithm: tt.algorithm,
+				Nonce:     "7ypf/xlj9XXwfDPEoM4URrv/xwf94BcCAzFZH4GiTo0v",
+				CNonce:    "f2/wE4q74E6zIJEtWaHKaf5wv/H5QzzpXusqGemxURZJ",
+				NC:        "00000001",
+				QOP:       "auth",
+				Opaque:    "FQhe/qaU925kfnzjCev0ciny7QMkPqMAFRtzCUYo5tdS",
+			}
+			a.Equal(tt.response, creds.response("Mufasa", "Circle of Life"))
+			creds.Response = tt.response
+			a.True(creds.Verify("Mufasa", "Circle of Life"))
+			a.False(creds.Verify("Mufasa", "Circle of Death"))
+			a.False(creds.Verify("Simba", "Circle of Life"))
+
+			req := httptest.NewRequest(http.MethodGet, "/dir/index.html", http.NoBody)
+			req.Header.Set("Authorization", creds.String())
+			got, ok, err := DigestAuth(req)
+			a.NoError(err)
+			a.True(ok)
+			a.Equal(creds, got)
+		})
+	}
+}
+
+func TestDigestAuth(t *testing.T) {
+	for _, tt := range []struct {
+		header string
+		ok     bool
+		err    bool
+	}{
+		{"", false, false},
+		{"Basic dXNlcjpwYXNz", false, false},
+		{`Digest username="u", nonce="n", response="r"`, true, false},
+		{`Digest nonce="n", response="r"`, true, true},
+		{`Digest username="u", response="r"`, true, true},
+		{`Digest username="u", nonce="n"`, true, true},
+		{`Digest username="u", nonce="n", response="r", qop=auth`, true, true},
+		{`Digest username="u", nonce="n", response="r", algorithm=SHA-1`, true, true},
+	} {
+		a := require.New(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		if tt.header != "" {
+			req.Header.Set("Authorization", tt.header)
+		}
+		_, ok, err := DigestAuth(req)
+		a.Equal(tt.ok, ok, tt.header)
+		if tt.err {
+			a.Error(err, tt.header)
+		} else {
+			a.NoError(err, tt.header)
+		}
+	}
+}
+
+func TestParseDigestChallenge(t *testing.T) {
+	a := require.New(t)
+
+	h := http.Header{}
+	h.Add("WWW-Authenticate", `Basic realm="basic"`)
+	h.Add("WWW-Authenticate", `Digest realm="md5", qop="auth, auth-int", nonce="n1", opaque="o", `+
+		`Digest realm="sha", qop="auth", algorithm=SHA-256, nonce="n2", userhash=true, `+
+		`Digest realm="unknown", algorithm=SHA-1, nonce="n3"`)
+
+	c, ok := ParseDigestChallenge(h)
+	a.True(ok)
+	a.Equal(DigestChallenge{
+		Realm:     "sha",
+		Nonce:     "n2",
+		Algorithm: DigestSHA256,
+		QOP:       []string{"auth"},
+		Userhash:  true,
+	}, c)
+
+	parsed, ok := ParseDigestChallenge(http.Header{"Www-Authenticate": {c.String()}})
+	a.True(ok)
+	a.Equal(c, parsed)
+
+	_, ok = ParseDigestChallenge(http.Header{"Www-Authenticate": {`Digest realm="r", qop="auth-int", nonce="n"`}})
+	a.False(ok)
+	_, ok = ParseDigestChallenge(http.Header{"Www-Authenticate": {`Bearer realm="r"`}})
+	a.False(ok)
+}
+
+func TestDigestTransport(t *testing.T) {
+	const (
+		username = "Mufasa"
+		password = "Circle of Life"
+	)
+	for _, algorithm := range []string{
+		DigestMD5,
+		DigestMD5Sess,
+		DigestSHA256,
+		DigestSHA256Sess,
+		DigestSHA512256,
+		DigestSHA512256Sess,
+	} {
+		t.Run(algorithm, func(t *testing.T) {
+			a := require.New(t)
+
+			var (
+				requests atomic.Int64
+				nonce    atomic.Int64
+			)
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+
+				challenge := DigestChallenge{
+					Realm:     "test",
+					Nonce:     "nonce" + string(rune('0'+nonce.Load())),
+					Algorithm: algorithm,
+					QOP:       []string{"auth"},
+				}
+				creds, ok, err := DigestAuth(r)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if !ok || creds.Nonce != challenge.Nonce || creds.URI != r.URL.RequestURI() || !creds.Verify(username, password) {
+					challenge.Stale = ok && creds.Nonce != challenge.Nonce
+					w.Header().Set("WWW-Authenticate", challenge.String())
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				_, _ = w.Write(body)
+			}))
+			t.Cleanup(s.Close)
+
+			tr := NewDigestTransport(nil)
+			t.Cleanup(tr.CloseIdleConnections)
+			client := &http.Client{Transport: tr}
+
+			send := func(user, pass string) *http.Response {
+				req, err := http.NewRequest(http.MethodPost, s.URL+"/dir/index.html?q=1", strings.NewReader("body"))
+				a.NoError(err)
+				SetDigestAuth(req, user, pass)
+				resp, err := client.Do(req)
+				a.NoError(err)
+				t.Cleanup(func() { _ = resp.Body.Close() })
+				return resp
+			}
+			expectOK := func(resp *http.Response) {
+				a.Equal(http.StatusOK, resp.StatusCode)
+				body, err := io.ReadAll(resp.Body)
+				a.NoError(err)
+				a.Equal("body", string(body))
+			}
+
+			// Challenge, then authorized request.
+			expectOK(send(username, password))
+			a.EqualValues(2, requests.Load())
+
+			// Cached challenge is used.
+			expectOK(send(username, password))
+			a.EqualValues(3, requests.Load())
+
+			// Nonce is rotated.
+			nonce.Add(1)
+			expectOK(send(username, password))
+			a.EqualValues(5, requests.Load())
+
+			// Wrong password.
+			resp := send(username, "wrong")
+			a.Equal(http.StatusUnauthorized, resp.StatusCode)
+
+			// No credentials, request is sent as is.
+			requests.Store(0)
+			req, err := http.NewRequest(http.MethodGet, s.URL, http.NoBody)
+			a.NoError(err)
+			resp, err = client.Do(req)
+			a.NoError(err)
+			_ = resp.Body.Close()
+			a.Equal(http.StatusUnauthorized, resp.StatusCode)
+			a.EqualValues(1, requests.Load())
+		})
+	}
+}
+
+func TestNewDigestClient(t *testing.T) {
+	const (
+		username = "Mufasa"
+		password = "Circle of Life"
+	)
+	a := require.New(t)
+
+	var requests atomic.Int64
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		challenge := DigestChallenge{
+			Realm:     "test",
+			Nonce:     "nonce",
+			Algorithm: DigestSHA256,
+			QOP:       []string{"auth"},
+		}
+		creds, ok, err := DigestAuth(r)
+		if err != nil || !ok || !creds.Verify(username, password) {
+			w.Header().Set("WWW-Authenticate", challenge.String())
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(s.Close)
+
+	client := NewDigestClient(s.Client())
+	for i := range 2 {
+		req, err := http.NewRequest(http.MethodGet, s.URL, http.NoBody)
+		a.NoError(err)
+		SetDigestAuth(req, username, password)
+		resp, err := client.Do(req)
+		a.NoError(err)
+		_ = resp.Body.Close()
+		a.Equal(http.StatusOK, resp.StatusCode)
+		// Challenge is cached by the client.
+		a.EqualValues(2+i, requests.Load())
+	}
+
+	// Client already using DigestTransport is not wrapped.
+	hc := &http.Client{Transport: NewDigestTransport(nil)}
+	a.Same(hc, NewDigestClient(hc))
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-faster/errors"
+)
+
+// WriteFlush copies r to w, flushing w after every read chunk.
+//
+// It is used to stream responses, e.g. sequences of JSON texts, where every
+// chunk should be sent to the client as soon as it is produced. Writers which
+// do not support flushing are written without flushing.
+func WriteFlush(w http.ResponseWriter, r io.Reader) error {
+	rc := http.NewResponseController(w)
+	flush := func() error {
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}
+
+	// Send headers immediately, the first chunk may take a while.
+	if err := flush(); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
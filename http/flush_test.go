@@ -0,0 +1,61 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type chunkReader struct {
+	chunks []string
+	err    error
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		return 0, io.EOF
+	}
+	n := copy(p, r.chunks[0])
+	r.chunks = r.chunks[1:]
+	return n, nil
+}
+
+// noFlushWriter hides Flush method of the wrapped writer.
+type noFlushWriter struct {
+	http.ResponseWriter
+}
+
+func TestWriteFlush(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		a := require.New(t)
+
+		w := httptest.NewRecorder()
+		a.NoError(WriteFlush(w, &chunkReader{chunks: []string{"1\n", "2\n"}}))
+		a.True(w.Flushed)
+		a.Equal("1\n2\n", w.Body.String())
+	})
+	t.Run("Error", func(t *testing.T) {
+		a := require.New(t)
+
+		testErr := errors.New("test")
+		w := httptest.NewRecorder()
+		a.ErrorIs(WriteFlush(w, &chunkReader{chunks: []string{"1\n"}, err: testErr}), testErr)
+		a.Equal("1\n", w.Body.String())
+	})
+	t.Run("NoFlusher", func(t *testing.T) {
+		a := require.New(t)
+
+		rec := httptest.NewRecorder()
+		a.NoError(WriteFlush(noFlushWriter{rec}, strings.NewReader("1\n")))
+		a.False(rec.Flushed)
+		a.Equal("1\n", rec.Body.String())
+	})
+}
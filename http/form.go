@@ -2,6 +2,8 @@ package http
 
 import (
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
@@ -23,3 +25,33 @@ func ParseForm(r *http.Request) (url.Values, error) {
 	}
 	return url.ParseQuery(sb.String())
 }
+
+// ParseMultipartForm is a wrapper for http.Request.ParseMultipartForm.
+//
+// Difference from http.Request.ParseMultipartForm:
+//   - This function accepts any media type with boundary parameter, not only multipart/form-data.
+//     This allows to alias multipart/mixed or vendor media types to multipart form.
+func ParseMultipartForm(r *http.Request, maxMemory int64) error {
+	if r.MultipartForm != nil {
+		return nil
+	}
+
+	ct, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return err
+	}
+	if ct == "multipart/form-data" {
+		return r.ParseMultipartForm(maxMemory)
+	}
+
+	boundary, ok := params["boundary"]
+	if !ok {
+		return http.ErrMissingBoundary
+	}
+	form, err := multipart.NewReader(r.Body, boundary).ReadForm(maxMemory)
+	if err != nil {
+		return err
+	}
+	r.MultipartForm = form
+	return nil
+}
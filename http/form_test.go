@@ -1,8 +1,11 @@
 package http
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strings"
@@ -57,6 +60,49 @@ func TestParseForm(t *testing.T) {
 	})
 }
 
+func TestParseMultipartForm(t *testing.T) {
+	body, boundary := CreateMultipartBody(func(mw *multipart.Writer) error {
+		return mw.WriteField("a", "b")
+	})
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		contentType string
+		wantErr     bool
+	}{
+		{"multipart/form-data", false},
+		{"multipart/mixed", false},
+		{"application/vnd.custom", false},
+		// Missing boundary.
+		{"", true},
+	} {
+		t.Run(tt.contentType, func(t *testing.T) {
+			a := require.New(t)
+			r := &http.Request{
+				Method: http.MethodPost,
+				Body:   io.NopCloser(bytes.NewReader(data)),
+				Header: http.Header{},
+			}
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", mime.FormatMediaType(tt.contentType, map[string]string{
+					"boundary": boundary,
+				}))
+			} else {
+				r.Header.Set("Content-Type", "multipart/mixed")
+			}
+
+			err := ParseMultipartForm(r, 1024)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(map[string][]string{"a": {"b"}}, r.MultipartForm.Value)
+		})
+	}
+}
+
 func BenchmarkParseForm(b *testing.B) {
 	bench := func(body string, parse func(r *http.Request) error) func(*testing.B) {
 		return func(b *testing.B) {
@@ -68,6 +68,17 @@ func SetCloserBody(req *http.Request, body io.ReadCloser, contentType string) {
 	req.Body = body
 }
 
+// SetReplayableBody sets request body created by produce function.
+//
+// Function is called again to replay the body, e.g. when request is retried.
+func SetReplayableBody(req *http.Request, produce func() io.ReadCloser, contentType string) {
+	initRequest(req, contentType)
+	req.Body = produce()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return produce(), nil
+	}
+}
+
 // CreateBodyWriter is a helper to create a reader from a writer body.
 func CreateBodyWriter(cb func(w io.Writer) error) io.ReadCloser {
 	piper, pipew := io.Pipe()
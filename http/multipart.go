@@ -17,16 +17,27 @@ func randomBoundary() string {
 
 // CreateMultipartBody is helper for streaming multipart/form-data.
 func CreateMultipartBody(cb func(mw *multipart.Writer) error) (body io.ReadCloser, boundary string) {
+	produce, boundary := CreateMultipartBodyProducer(cb)
+	return produce(), boundary
+}
+
+// CreateMultipartBodyProducer is like CreateMultipartBody, but returns a function
+// producing the body, so the body can be replayed.
+//
+// Every produced body uses the same boundary.
+func CreateMultipartBodyProducer(cb func(mw *multipart.Writer) error) (produce func() io.ReadCloser, boundary string) {
 	boundary = randomBoundary()
-	body = CreateBodyWriter(func(w io.Writer) error {
-		mw := multipart.NewWriter(w)
-		if err := mw.SetBoundary(boundary); err != nil {
-			return err
-		}
-		defer func() {
-			_ = mw.Close()
-		}()
-		return cb(mw)
-	})
-	return body, boundary
+	produce = func() io.ReadCloser {
+		return CreateBodyWriter(func(w io.Writer) error {
+			mw := multipart.NewWriter(w)
+			if err := mw.SetBoundary(boundary); err != nil {
+				return err
+			}
+			defer func() {
+				_ = mw.Close()
+			}()
+			return cb(mw)
+		})
+	}
+	return produce, boundary
 }
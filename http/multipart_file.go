@@ -25,7 +25,7 @@ func escapeQuotes(s string) string {
 
 // headers generates headers for multipart form file, similar to CreateFormFile, but this function does not
 // overwrite Content-Type if it is already set.
-func (m MultipartFile) headers(fieldName string) (h textproto.MIMEHeader) {
+func (m MultipartFile) headers(fieldName, contentType string) (h textproto.MIMEHeader) {
 	h = make(textproto.MIMEHeader, len(m.Header)+2)
 	for k, v := range m.Header {
 		h[k] = slices.Clone(v)
@@ -35,14 +35,21 @@ func (m MultipartFile) headers(fieldName string) (h textproto.MIMEHeader) {
 		escapeQuotes(fieldName), escapeQuotes(m.Name))
 	h.Set("Content-Disposition", disposition)
 	if _, ok := h["Content-Type"]; !ok {
-		h.Set("Content-Type", "application/octet-stream")
+		h.Set("Content-Type", contentType)
 	}
 	return h
 }
 
 // WriteMultipart writes data from reader to given multipart.Writer as a form file.
 func (m MultipartFile) WriteMultipart(fieldName string, w *multipart.Writer) error {
-	p, err := w.CreatePart(m.headers(fieldName))
+	return m.WriteMultipartAs(fieldName, "application/octet-stream", w)
+}
+
+// WriteMultipartAs writes data from reader to given multipart.Writer as a form file.
+//
+// Given content type is used if file header does not define one.
+func (m MultipartFile) WriteMultipartAs(fieldName, contentType string, w *multipart.Writer) error {
+	p, err := w.CreatePart(m.headers(fieldName, contentType))
 	if err != nil {
 		return err
 	}
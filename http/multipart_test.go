@@ -1,9 +1,11 @@
 package http
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/textproto"
 	"strings"
 	"testing"
 
@@ -44,3 +46,30 @@ func TestCreateMultipartBody(t *testing.T) {
 		a.Equal(expected, s.String())
 	})
 }
+
+func TestMultipartFileWriteMultipartAs(t *testing.T) {
+	for _, tt := range []struct {
+		header textproto.MIMEHeader
+		want   string
+	}{
+		{nil, "image/png"},
+		{textproto.MIMEHeader{"Content-Type": {"image/jpeg"}}, "image/jpeg"},
+	} {
+		a := require.New(t)
+		file := MultipartFile{
+			Name:   "file.png",
+			File:   strings.NewReader("data"),
+			Header: tt.header,
+		}
+
+		var buf bytes.Buffer
+		mw := multipart.NewWriter(&buf)
+		a.NoError(file.WriteMultipartAs("file", "image/png", mw))
+		a.NoError(mw.Close())
+
+		p, err := multipart.NewReader(&buf, mw.Boundary()).NextPart()
+		a.NoError(err)
+		a.Equal(tt.want, p.Header.Get("Content-Type"))
+		a.Equal("file.png", p.FileName())
+	}
+}
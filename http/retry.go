@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default maximum number of attempts.
+	DefaultRetryMaxAttempts = 3
+	// DefaultRetryMinDelay is the default delay before the first retry.
+	DefaultRetryMinDelay = 100 * time.Millisecond
+	// DefaultRetryMaxDelay is the default maximum delay between attempts.
+	DefaultRetryMaxDelay = 10 * time.Second
+)
+
+// RetryPolicy defines how failed requests are retried.
+//
+// Zero fields are set to defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinDelay is the delay before the first retry.
+	//
+	// Delay is doubled after each attempt, a random jitter is added.
+	MinDelay time.Duration
+	// MaxDelay is the maximum delay between attempts.
+	//
+	// If Retry-After header of the response requests longer delay, the response
+	// is returned as is.
+	MaxDelay time.Duration
+	// ShouldRetry reports whether the request should be retried.
+	//
+	// If nil, DefaultShouldRetry is used.
+	ShouldRetry func(resp *http.Response, err error) bool
+}
+
+// DefaultShouldRetry retries network errors and 429, 502, 503, 504 responses.
+//
+// Cancellation of the request context is not retried.
+func DefaultShouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultRetryMaxAttempts
+	}
+	if p.MinDelay <= 0 {
+		p.MinDelay = DefaultRetryMinDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = DefaultRetryMaxDelay
+	}
+	if p.MaxDelay < p.MinDelay {
+		p.MaxDelay = p.MinDelay
+	}
+	if p.ShouldRetry == nil {
+		p.ShouldRetry = DefaultShouldRetry
+	}
+	return p
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MinDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	// Add up to 20% of jitter.
+	delay += rand.N(delay/5 + 1) // #nosec G404
+	return min(delay, p.MaxDelay)
+}
+
+// RetryAttempt describes a failed attempt which is going to be retried.
+type RetryAttempt struct {
+	// Attempt is the number of the next attempt, starting from 1 for the first retry.
+	Attempt int
+	// Delay before the next attempt.
+	Delay time.Duration
+	// Response of the failed attempt, if any.
+	//
+	// Body is already closed.
+	Response *http.Response
+	// Err is the error of the failed attempt, if any.
+	Err error
+}
+
+// DoRetry sends the request using client, retrying it according to policy.
+//
+// Request body is replayed using GetBody. Requests with a body that cannot be
+// replayed are sent once. onRetry, if not nil, is called before every retry.
+func DoRetry(client Client, r *http.Request, p RetryPolicy, onRetry func(RetryAttempt)) (*http.Response, error) {
+	p = p.withDefaults()
+	replayable := r.Body == nil || r.Body == http.NoBody || r.GetBody != nil
+
+	req := r
+	for attempt := 1; ; attempt++ {
+		resp, err := client.Do(req)
+		if attempt >= p.MaxAttempts || !replayable || !p.ShouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if after, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				if after > p.MaxDelay {
+					// Server asks to wait longer than allowed.
+					return resp, err
+				}
+				delay = max(delay, after)
+			}
+			// Drain the body, so the connection can be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			_ = resp.Body.Close()
+		}
+		if onRetry != nil {
+			onRetry(RetryAttempt{
+				Attempt:  attempt,
+				Delay:    delay,
+				Response: resp,
+				Err:      err,
+			})
+		}
+
+		if err := waitRetry(r.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		req = r.Clone(r.Context())
+		if r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, errors.Wrap(err, "replay body")
+			}
+			req.Body = body
+		}
+	}
+}
+
+func waitRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses Retry-After header value.
+//
+// Value is either a number of seconds or HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
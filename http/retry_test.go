@@ -0,0 +1,200 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type clientFunc func(r *http.Request) (*http.Response, error)
+
+func (f clientFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// retryClient responds with given status codes, then with 200.
+func retryClient(t *testing.T, header http.Header, codes ...int) (Client, *[]string) {
+	var bodies []string
+	return clientFunc(func(r *http.Request) (*http.Response, error) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(data))
+
+		code := http.StatusOK
+		if len(codes) > 0 {
+			code, codes = codes[0], codes[1:]
+		}
+		rec := httptest.NewRecorder()
+		for k, v := range header {
+			rec.Header()[k] = v
+		}
+		rec.WriteHeader(code)
+		return rec.Result(), nil
+	}), &bodies
+}
+
+func TestDoRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MinDelay: time.Millisecond,
+		MaxDelay: 10 * time.Millisecond,
+	}
+	newRequest := func(t *testing.T, body io.Reader) *http.Request {
+		r, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost", http.NoBody)
+		require.NoError(t, err)
+		if body != nil {
+			SetBody(r, body, "text/plain")
+		}
+		return r
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+
+		var attempts []RetryAttempt
+		resp, err := DoRetry(client, newRequest(t, strings.NewReader("body")), policy, func(a RetryAttempt) {
+			attempts = append(attempts, a)
+		})
+		a.NoError(err)
+		a.Equal(http.StatusOK, resp.StatusCode)
+		// Body is replayed.
+		a.Equal([]string{"body", "body", "body"}, *bodies)
+		a.Len(attempts, 2)
+		a.Equal(1, attempts[0].Attempt)
+		a.Equal(http.StatusServiceUnavailable, attempts[0].Response.StatusCode)
+		a.Equal(2, attempts[1].Attempt)
+		a.Equal(http.StatusTooManyRequests, attempts[1].Response.StatusCode)
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, 503, 503, 503, 503)
+
+		p := policy
+		p.MaxAttempts = 2
+		resp, err := DoRetry(client, newRequest(t, nil), p, nil)
+		a.NoError(err)
+		a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		a.Len(*bodies, 2)
+	})
+	t.Run("NotRetryable", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, http.StatusInternalServerError)
+
+		resp, err := DoRetry(client, newRequest(t, nil), policy, nil)
+		a.NoError(err)
+		a.Equal(http.StatusInternalServerError, resp.StatusCode)
+		a.Len(*bodies, 1)
+	})
+	t.Run("StreamBody", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, http.StatusServiceUnavailable)
+
+		// Body cannot be replayed.
+		body := io.MultiReader(strings.NewReader("stream"))
+		resp, err := DoRetry(client, newRequest(t, body), policy, nil)
+		a.NoError(err)
+		a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		a.Equal([]string{"stream"}, *bodies)
+	})
+	t.Run("ReplayableBody", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, http.StatusServiceUnavailable)
+
+		r := newRequest(t, nil)
+		SetReplayableBody(r, func() io.ReadCloser {
+			return CreateBodyWriter(func(w io.Writer) error {
+				_, err := io.WriteString(w, "produced")
+				return err
+			})
+		}, "text/plain")
+		resp, err := DoRetry(client, r, policy, nil)
+		a.NoError(err)
+		a.Equal(http.StatusOK, resp.StatusCode)
+		a.Equal([]string{"produced", "produced"}, *bodies)
+	})
+	t.Run("RetryAfter", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, http.Header{"Retry-After": {"0"}}, http.StatusServiceUnavailable)
+
+		var attempts []RetryAttempt
+		resp, err := DoRetry(client, newRequest(t, nil), policy, func(a RetryAttempt) {
+			attempts = append(attempts, a)
+		})
+		a.NoError(err)
+		a.Equal(http.StatusOK, resp.StatusCode)
+		a.Len(*bodies, 2)
+		a.Len(attempts, 1)
+	})
+	t.Run("RetryAfterTooLong", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, http.Header{"Retry-After": {"60"}}, http.StatusServiceUnavailable)
+
+		resp, err := DoRetry(client, newRequest(t, nil), policy, nil)
+		a.NoError(err)
+		a.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		a.Len(*bodies, 1)
+	})
+	t.Run("Error", func(t *testing.T) {
+		a := require.New(t)
+
+		var calls int
+		testErr := errors.New("connection reset")
+		client := clientFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			return nil, testErr
+		})
+		_, err := DoRetry(client, newRequest(t, nil), policy, nil)
+		a.ErrorIs(err, testErr)
+		a.Equal(DefaultRetryMaxAttempts, calls)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		a := require.New(t)
+		client, bodies := retryClient(t, nil, http.StatusServiceUnavailable)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		r := newRequest(t, nil).WithContext(ctx)
+		_, err := DoRetry(client, r, RetryPolicy{MinDelay: time.Hour}, func(RetryAttempt) {
+			cancel()
+		})
+		a.ErrorIs(err, context.Canceled)
+		a.Len(*bodies, 1)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, tt := range []struct {
+		Value  string
+		Result time.Duration
+		OK     bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Mon, 01 Jan 2024 00:00:30 GMT", 30 * time.Second, true},
+		// Date in the past.
+		{"Sun, 31 Dec 2023 00:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	} {
+		result, ok := parseRetryAfter(tt.Value, now)
+		require.Equal(t, tt.OK, ok, "test %d", i+1)
+		require.Equal(t, tt.Result, result, "test %d", i+1)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{MinDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}.withDefaults()
+	for attempt, lower := range []time.Duration{10, 20, 40, 50, 50} {
+		delay := p.backoff(attempt + 1)
+		require.GreaterOrEqual(t, delay, lower*time.Millisecond)
+		require.LessOrEqual(t, delay, p.MaxDelay)
+	}
+}
@@ -0,0 +1,867 @@
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// HTTP message signature algorithms (RFC 9421, Section 3.3).
+const (
+	SignatureRSAPSSSHA512    = "rsa-pss-sha512"
+	SignatureRSAV15SHA256    = "rsa-v1_5-sha256"
+	SignatureHMACSHA256      = "hmac-sha256"
+	SignatureECDSAP256SHA256 = "ecdsa-p256-sha256"
+	SignatureECDSAP384SHA384 = "ecdsa-p384-sha384"
+	SignatureEd25519         = "ed25519"
+)
+
+// MessageSigner signs HTTP message signature base.
+type MessageSigner interface {
+	// Algorithm returns the signature algorithm name.
+	Algorithm() string
+	// SignMessage returns the signature of the signature base.
+	SignMessage(base []byte) ([]byte, error)
+}
+
+// MessageVerifier verifies HTTP message signature.
+type MessageVerifier interface {
+	// Algorithm returns the signature algorithm name.
+	Algorithm() string
+	// VerifyMessage verifies the signature of the signature base.
+	VerifyMessage(base, signature []byte) error
+}
+
+type messageKey struct {
+	alg string
+	key any
+}
+
+// NewMessageSigner creates MessageSigner for given algorithm.
+//
+// Key type depends on algorithm:
+//
+//   - "hmac-sha256": []byte
+//   - "ed25519": ed25519.PrivateKey
+//   - "ecdsa-p256-sha256", "ecdsa-p384-sha384": *ecdsa.PrivateKey
+//   - "rsa-pss-sha512", "rsa-v1_5-sha256": *rsa.PrivateKey
+func NewMessageSigner(alg string, key any) (MessageSigner, error) {
+	if err := checkMessageKey(alg, key, true); err != nil {
+		return nil, err
+	}
+	return messageKey{alg: alg, key: key}, nil
+}
+
+// NewMessageVerifier creates MessageVerifier for given algorithm.
+//
+// Key type depends on algorithm:
+//
+//   - "hmac-sha256": []byte
+//   - "ed25519": ed25519.PublicKey
+//   - "ecdsa-p256-sha256", "ecdsa-p384-sha384": *ecdsa.PublicKey
+//   - "rsa-pss-sha512", "rsa-v1_5-sha256": *rsa.PublicKey
+func NewMessageVerifier(alg string, key any) (MessageVerifier, error) {
+	if err := checkMessageKey(alg, key, false); err != nil {
+		return nil, err
+	}
+	return messageKey{alg: alg, key: key}, nil
+}
+
+func checkMessageKey(alg string, key any, private bool) error {
+	var ok bool
+	switch alg {
+	case SignatureHMACSHA256:
+		_, ok = key.([]byte)
+	case SignatureEd25519:
+		if private {
+			_, ok = key.(ed25519.PrivateKey)
+		} else {
+			_, ok = key.(ed25519.PublicKey)
+		}
+	case SignatureECDSAP256SHA256, SignatureECDSAP384SHA384:
+		curve := elliptic.P256()
+		if alg == SignatureECDSAP384SHA384 {
+			curve = elliptic.P384()
+		}
+		switch k := key.(type) {
+		case *ecdsa.PrivateKey:
+			ok = private && k.Curve == curve
+		case *ecdsa.PublicKey:
+			ok = !private && k.Curve == curve
+		}
+	case SignatureRSAPSSSHA512, SignatureRSAV15SHA256:
+		if private {
+			_, ok = key.(*rsa.PrivateKey)
+		} else {
+			_, ok = key.(*rsa.PublicKey)
+		}
+	default:
+		return errors.Errorf("unsupported signature algorithm %q", alg)
+	}
+	if !ok {
+		return errors.Errorf("invalid %q key type %T", alg, key)
+	}
+	return nil
+}
+
+func (k messageKey) Algorithm() string {
+	return k.alg
+}
+
+func (k messageKey) digest(base []byte) (crypto.Hash, []byte) {
+	var h crypto.Hash
+	switch k.alg {
+	case SignatureRSAPSSSHA512:
+		h = crypto.SHA512
+	case SignatureECDSAP384SHA384:
+		h = crypto.SHA384
+	default:
+		h = crypto.SHA256
+	}
+	d := h.New()
+	_, _ = d.Write(base)
+	return h, d.Sum(nil)
+}
+
+func (k messageKey) SignMessage(base []byte) ([]byte, error) {
+	switch key := k.key.(type) {
+	case []byte:
+		m := hmac.New(sha256.New, key)
+		_, _ = m.Write(base)
+		return m.Sum(nil), nil
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, base), nil
+	case *ecdsa.PrivateKey:
+		_, digest := k.digest(base)
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+		// Signature is a concatenation of fixed-size r and s.
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig := make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+		return sig, nil
+	case *rsa.PrivateKey:
+		h, digest := k.digest(base)
+		if k.alg == SignatureRSAPSSSHA512 {
+			return rsa.SignPSS(rand.Reader, key, h, digest, &rsa.PSSOptions{
+				SaltLength: sha512.Size,
+			})
+		}
+		return rsa.SignPKCS1v15(rand.Reader, key, h, digest)
+	default:
+		return nil, errors.Errorf("cannot sign with %T", k.key)
+	}
+}
+
+// errInvalidSignature is returned when signature does not match.
+var errInvalidSignature = errors.New("invalid signature")
+
+func (k messageKey) VerifyMessage(base, signature []byte) error {
+	switch key := k.key.(type) {
+	case []byte:
+		m := hmac.New(sha256.New, key)
+		_, _ = m.Write(base)
+		if !hmac.Equal(m.Sum(nil), signature) {
+			return errInvalidSignature
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, base, signature) {
+			return errInvalidSignature
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		_, digest := k.digest(base)
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errInvalidSignature
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return errInvalidSignature
+		}
+		return nil
+	case *rsa.PublicKey:
+		h, digest := k.digest(base)
+		var err error
+		if k.alg == SignatureRSAPSSSHA512 {
+			err = rsa.VerifyPSS(key, h, digest, signature, &rsa.PSSOptions{
+				SaltLength: rsa.PSSSaltLengthAuto,
+			})
+		} else {
+			err = rsa.VerifyPKCS1v15(key, h, digest, signature)
+		}
+		if err != nil {
+			return errInvalidSignature
+		}
+		return nil
+	default:
+		return errors.Errorf("cannot verify with %T", k.key)
+	}
+}
+
+// SignatureParams describes HTTP message signature (RFC 9421).
+type SignatureParams struct {
+	// Label is the signature label in Signature and Signature-Input headers.
+	//
+	// Defaults to "sig1".
+	Label string
+	// Components is a list of covered components.
+	//
+	// Derived components are "@method", "@target-uri", "@authority",
+	// "@scheme", "@request-target", "@path" and "@query". Other components
+	// are header field names. Component parameters are not supported.
+	Components []string
+	// Created is the signature creation time.
+	//
+	// Defaults to the current time.
+	Created time.Time
+	// Expires is the signature expiration time, if any.
+	Expires time.Time
+	// Nonce is a unique value of the signature, if any.
+	Nonce string
+	// Algorithm is the "alg" parameter, if any.
+	Algorithm string
+	// KeyID is the "keyid" parameter, if any.
+	KeyID string
+	// Tag is the "tag" parameter, if any.
+	Tag string
+
+	// serialized is the original Signature-Input member, if parsed.
+	serialized string
+}
+
+func (p SignatureParams) label() string {
+	if p.Label == "" {
+		return "sig1"
+	}
+	return p.Label
+}
+
+// String returns serialized signature parameters, as used in
+// Signature-Input header and signature base.
+func (p SignatureParams) String() string {
+	if p.serialized != "" {
+		return p.serialized
+	}
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, c := range p.Components {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		writeSFString(&b, strings.ToLower(c))
+	}
+	b.WriteByte(')')
+	if !p.Created.IsZero() {
+		b.WriteString(";created=")
+		b.WriteString(strconv.FormatInt(p.Created.Unix(), 10))
+	}
+	if !p.Expires.IsZero() {
+		b.WriteString(";expires=")
+		b.WriteString(strconv.FormatInt(p.Expires.Unix(), 10))
+	}
+	for _, param := range [...]struct {
+		name, value string
+	}{
+		{"keyid", p.KeyID},
+		{"nonce", p.Nonce},
+		{"alg", p.Algorithm},
+		{"tag", p.Tag},
+	} {
+		if param.value == "" {
+			continue
+		}
+		b.WriteByte(';')
+		b.WriteString(param.name)
+		b.WriteByte('=')
+		writeSFString(&b, param.value)
+	}
+	return b.String()
+}
+
+// SignatureBase returns the signature base of the request (RFC 9421, Section 2.5).
+func SignatureBase(req *http.Request, p SignatureParams) ([]byte, error) {
+	var b strings.Builder
+	seen := make(map[string]struct{}, len(p.Components))
+	for _, c := range p.Components {
+		name := strings.ToLower(c)
+		if _, ok := seen[name]; ok {
+			return nil, errors.Errorf("duplicate component %q", c)
+		}
+		seen[name] = struct{}{}
+
+		value, err := signatureComponent(req, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "component %q", c)
+		}
+		writeSFString(&b, name)
+		b.WriteString(": ")
+		b.WriteString(value)
+		b.WriteByte('\n')
+	}
+	b.WriteString(`"@signature-params": `)
+	b.WriteString(p.String())
+	return []byte(b.String()), nil
+}
+
+func signatureComponent(req *http.Request, name string) (string, error) {
+	scheme := func() string {
+		switch {
+		case req.URL.Scheme != "":
+			return strings.ToLower(req.URL.Scheme)
+		case req.TLS != nil:
+			return "https"
+		default:
+			return "http"
+		}
+	}
+	authority := func() string {
+		host := req.Host
+		if host == "" {
+			host = req.URL.Host
+		}
+		host = strings.ToLower(host)
+		switch s := scheme(); {
+		case s == "http" && strings.HasSuffix(host, ":80"):
+			host = strings.TrimSuffix(host, ":80")
+		case s == "https" && strings.HasSuffix(host, ":443"):
+			host = strings.TrimSuffix(host, ":443")
+		}
+		return host
+	}
+
+	switch name {
+	case "@method":
+		return req.Method, nil
+	case "@target-uri":
+		return scheme() + "://" + authority() + req.URL.RequestURI(), nil
+	case "@authority":
+		return authority(), nil
+	case "@scheme":
+		return scheme(), nil
+	case "@request-target":
+		return req.URL.RequestURI(), nil
+	case "@path":
+		if p := req.URL.EscapedPath(); p != "" {
+			return p, nil
+		}
+		return "/", nil
+	case "@query":
+		return "?" + req.URL.RawQuery, nil
+	}
+	if strings.HasPrefix(name, "@") || strings.ContainsAny(name, "; ") {
+		return "", errors.New("unsupported component")
+	}
+
+	values := req.Header.Values(name)
+	if len(values) == 0 && name == "content-length" && req.ContentLength > 0 {
+		// Client requests keep length out of headers.
+		values = []string{strconv.FormatInt(req.ContentLength, 10)}
+	}
+	if len(values) == 0 {
+		return "", errors.New("header is not present")
+	}
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+	return strings.Join(trimmed, ", "), nil
+}
+
+// SignRequest signs the request and sets Signature-Input and Signature headers.
+//
+// Existing signatures with other labels are preserved. Covered headers,
+// e.g. Content-Digest, must be set before signing.
+func SignRequest(req *http.Request, p SignatureParams, signer MessageSigner) error {
+	if p.Created.IsZero() {
+		p.Created = time.Now()
+	}
+	p.serialized = ""
+
+	base, err := SignatureBase(req, p)
+	if err != nil {
+		return errors.Wrap(err, "signature base")
+	}
+	sig, err := signer.SignMessage(base)
+	if err != nil {
+		return errors.Wrap(err, "sign")
+	}
+
+	label := p.label()
+	req.Header.Add("Signature-Input", label+"="+p.String())
+	req.Header.Add("Signature", label+"=:"+base64.StdEncoding.EncodeToString(sig)+":")
+	return nil
+}
+
+// VerifyRequest verifies the request signature with given label.
+//
+// If label is empty, the first signature is verified. key is called to
+// select verifier by signature parameters, e.g. by KeyID. Expired
+// signatures are rejected, other checks like required components or
+// signature age are left to the caller.
+func VerifyRequest(
+	req *http.Request,
+	label string,
+	key func(p SignatureParams) (MessageVerifier, error),
+) (SignatureParams, error) {
+	inputs, err := parseSFDictionary(strings.Join(req.Header.Values("Signature-Input"), ", "))
+	if err != nil {
+		return SignatureParams{}, errors.Wrap(err, "parse Signature-Input")
+	}
+	signatures, err := parseSFDictionary(strings.Join(req.Header.Values("Signature"), ", "))
+	if err != nil {
+		return SignatureParams{}, errors.Wrap(err, "parse Signature")
+	}
+
+	var input sfMember
+	if label == "" {
+		if len(inputs) == 0 {
+			return SignatureParams{}, errors.New("no signatures")
+		}
+		input = inputs[0]
+	} else {
+		m, ok := findSFMember(inputs, label)
+		if !ok {
+			return SignatureParams{}, errors.Errorf("signature %q not found", label)
+		}
+		input = m
+	}
+	signature, ok := findSFMember(signatures, input.key)
+	if !ok || signature.value.kind != sfBytes {
+		return SignatureParams{}, errors.Errorf("signature %q not found", input.key)
+	}
+
+	p, err := signatureParams(input)
+	if err != nil {
+		return p, errors.Wrapf(err, "signature %q", input.key)
+	}
+	if !p.Expires.IsZero() && time.Now().After(p.Expires) {
+		return p, errors.Errorf("signature %q expired", input.key)
+	}
+
+	verifier, err := key(p)
+	if err != nil {
+		return p, errors.Wrap(err, "get key")
+	}
+	if p.Algorithm != "" && p.Algorithm != verifier.Algorithm() {
+		return p, errors.Errorf("algorithm mismatch: %q, expected %q", p.Algorithm, verifier.Algorithm())
+	}
+	base, err := SignatureBase(req, p)
+	if err != nil {
+		return p, errors.Wrap(err, "signature base")
+	}
+	if err := verifier.VerifyMessage(base, signature.value.bytes); err != nil {
+		return p, errors.Wrapf(err, "signature %q", input.key)
+	}
+	return p, nil
+}
+
+func signatureParams(m sfMember) (p SignatureParams, _ error) {
+	if !m.inner {
+		return p, errors.New("expected inner list")
+	}
+	p.Label = m.key
+	for _, item := range m.items {
+		if item.kind != sfString {
+			return p, errors.New("component identifier must be a string")
+		}
+		if len(item.params) > 0 {
+			return p, errors.Errorf("component %q: parameters are not supported", item.str)
+		}
+		p.Components = append(p.Components, item.str)
+	}
+	for _, param := range m.params {
+		v := param.value
+		switch param.key {
+		case "created", "expires":
+			if v.kind != sfInteger {
+				return p, errors.Errorf("%q must be an integer", param.key)
+			}
+			t := time.Unix(v.integer, 0)
+			if param.key == "created" {
+				p.Created = t
+			} else {
+				p.Expires = t
+			}
+		case "nonce", "alg", "keyid", "tag":
+			if v.kind != sfString {
+				return p, errors.Errorf("%q must be a string", param.key)
+			}
+			switch param.key {
+			case "nonce":
+				p.Nonce = v.str
+			case "alg":
+				p.Algorithm = v.str
+			case "keyid":
+				p.KeyID = v.str
+			case "tag":
+				p.Tag = v.str
+			}
+		}
+	}
+	p.serialized = m.serializeValue()
+	return p, nil
+}
+
+// Structured field values (RFC 8941), as much as needed for signatures.
+
+type sfKind int
+
+const (
+	sfInteger sfKind = iota + 1
+	sfDecimal
+	sfString
+	sfToken
+	sfBytes
+	sfBoolean
+)
+
+type sfItem struct {
+	kind    sfKind
+	integer int64
+	decimal string
+	str     string
+	bytes   []byte
+	boolean bool
+	params  []sfParam
+}
+
+type sfParam struct {
+	key   string
+	value sfItem
+}
+
+type sfMember struct {
+	key    string
+	inner  bool
+	value  sfItem   // if not inner
+	items  []sfItem // if inner
+	params []sfParam
+}
+
+func findSFMember(dict []sfMember, key string) (sfMember, bool) {
+	// Last value wins.
+	for i := len(dict) - 1; i >= 0; i-- {
+		if dict[i].key == key {
+			return dict[i], true
+		}
+	}
+	return sfMember{}, false
+}
+
+func (m sfMember) serializeValue() string {
+	var b strings.Builder
+	if !m.inner {
+		m.value.serialize(&b)
+		return b.String()
+	}
+	b.WriteByte('(')
+	for i, item := range m.items {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		item.serialize(&b)
+	}
+	b.WriteByte(')')
+	serializeSFParams(&b, m.params)
+	return b.String()
+}
+
+func (i sfItem) serialize(b *strings.Builder) {
+	switch i.kind {
+	case sfInteger:
+		b.WriteString(strconv.FormatInt(i.integer, 10))
+	case sfDecimal:
+		b.WriteString(i.decimal)
+	case sfString:
+		writeSFString(b, i.str)
+	case sfToken:
+		b.WriteString(i.str)
+	case sfBytes:
+		b.WriteByte(':')
+		b.WriteString(base64.StdEncoding.EncodeToString(i.bytes))
+		b.WriteByte(':')
+	case sfBoolean:
+		if i.boolean {
+			b.WriteString("?1")
+		} else {
+			b.WriteString("?0")
+		}
+	}
+	serializeSFParams(b, i.params)
+}
+
+func serializeSFParams(b *strings.Builder, params []sfParam) {
+	for _, p := range params {
+		b.WriteByte(';')
+		b.WriteString(p.key)
+		if p.value.kind == sfBoolean && p.value.boolean {
+			continue
+		}
+		b.WriteByte('=')
+		p.value.serialize(b)
+	}
+}
+
+func writeSFString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+}
+
+type sfParser struct {
+	s   string
+	pos int
+}
+
+func (p *sfParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *sfParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *sfParser) skipSP() {
+	for !p.eof() && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *sfParser) skipOWS() {
+	for !p.eof() && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *sfParser) errorf(format string, args ...any) error {
+	return errors.Errorf("at %d: "+format, append([]any{p.pos}, args...)...)
+}
+
+func parseSFDictionary(s string) (r []sfMember, _ error) {
+	p := &sfParser{s: s}
+	p.skipSP()
+	for !p.eof() {
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+		m := sfMember{key: key}
+		if p.peek() == '=' {
+			p.pos++
+			if p.peek() == '(' {
+				items, params, err := p.innerList()
+				if err != nil {
+					return nil, err
+				}
+				m.inner, m.items, m.params = true, items, params
+			} else {
+				item, err := p.item()
+				if err != nil {
+					return nil, err
+				}
+				m.value = item
+			}
+		} else {
+			params, err := p.params()
+			if err != nil {
+				return nil, err
+			}
+			m.value = sfItem{kind: sfBoolean, boolean: true, params: params}
+		}
+		r = append(r, m)
+
+		p.skipOWS()
+		if p.eof() {
+			break
+		}
+		if p.peek() != ',' {
+			return nil, p.errorf("expected comma")
+		}
+		p.pos++
+		p.skipOWS()
+		if p.eof() {
+			return nil, p.errorf("trailing comma")
+		}
+	}
+	return r, nil
+}
+
+func (p *sfParser) key() (string, error) {
+	start := p.pos
+	if c := p.peek(); !(c >= 'a' && c <= 'z' || c == '*') {
+		return "", p.errorf("invalid key")
+	}
+	for !p.eof() {
+		c := p.s[p.pos]
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.IndexByte("_-.*", c) >= 0) {
+			break
+		}
+		p.pos++
+	}
+	return p.s[start:p.pos], nil
+}
+
+func (p *sfParser) innerList() (items []sfItem, params []sfParam, _ error) {
+	p.pos++ // (
+	for {
+		p.skipSP()
+		if p.peek() == ')' {
+			p.pos++
+			params, err := p.params()
+			return items, params, err
+		}
+		item, err := p.item()
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+		if c := p.peek(); c != ' ' && c != ')' {
+			return nil, nil, p.errorf("expected space or ')'")
+		}
+	}
+}
+
+func (p *sfParser) item() (sfItem, error) {
+	item, err := p.bareItem()
+	if err != nil {
+		return item, err
+	}
+	item.params, err = p.params()
+	return item, err
+}
+
+func (p *sfParser) params() (r []sfParam, _ error) {
+	for p.peek() == ';' {
+		p.pos++
+		p.skipSP()
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+		value := sfItem{kind: sfBoolean, boolean: true}
+		if p.peek() == '=' {
+			p.pos++
+			value, err = p.bareItem()
+			if err != nil {
+				return nil, err
+			}
+		}
+		r = append(r, sfParam{key: key, value: value})
+	}
+	return r, nil
+}
+
+func (p *sfParser) bareItem() (sfItem, error) {
+	switch c := p.peek(); {
+	case c == '-' || c >= '0' && c <= '9':
+		return p.number()
+	case c == '"':
+		return p.string()
+	case c == '*' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		start := p.pos
+		for !p.eof() && (isTokenChar(p.s[p.pos]) || p.s[p.pos] == ':' || p.s[p.pos] == '/') {
+			p.pos++
+		}
+		return sfItem{kind: sfToken, str: p.s[start:p.pos]}, nil
+	case c == ':':
+		p.pos++
+		end := strings.IndexByte(p.s[p.pos:], ':')
+		if end < 0 {
+			return sfItem{}, p.errorf("unterminated byte sequence")
+		}
+		data, err := base64.StdEncoding.DecodeString(p.s[p.pos : p.pos+end])
+		if err != nil {
+			return sfItem{}, p.errorf("invalid byte sequence: %w", err)
+		}
+		p.pos += end + 1
+		return sfItem{kind: sfBytes, bytes: data}, nil
+	case c == '?':
+		p.pos++
+		switch p.peek() {
+		case '0', '1':
+			v := p.peek() == '1'
+			p.pos++
+			return sfItem{kind: sfBoolean, boolean: v}, nil
+		default:
+			return sfItem{}, p.errorf("invalid boolean")
+		}
+	default:
+		return sfItem{}, p.errorf("unexpected character %q", c)
+	}
+}
+
+func (p *sfParser) number() (sfItem, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	decimal := false
+	for !p.eof() {
+		c := p.s[p.pos]
+		if c == '.' && !decimal {
+			decimal = true
+		} else if c < '0' || c > '9' {
+			break
+		}
+		p.pos++
+	}
+	s := p.s[start:p.pos]
+	if decimal {
+		return sfItem{kind: sfDecimal, decimal: s}, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return sfItem{}, p.errorf("invalid integer: %w", err)
+	}
+	return sfItem{kind: sfInteger, integer: v}, nil
+}
+
+func (p *sfParser) string() (sfItem, error) {
+	var b strings.Builder
+	p.pos++ // opening quote
+	for !p.eof() {
+		c := p.s[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return sfItem{kind: sfString, str: b.String()}, nil
+		case '\\':
+			if c := p.peek(); c != '"' && c != '\\' {
+				return sfItem{}, p.errorf("invalid escape")
+			}
+			b.WriteByte(p.s[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return sfItem{}, p.errorf("unterminated string")
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// newSignatureTestRequest returns request from RFC 9421, Appendix B.2.
+func newSignatureTestRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "http://example.com/foo?param=Value&Pet=dog", strings.NewReader(`{"hello": "world"}`))
+	require.NoError(t, err)
+	req.Header.Set("Date", "Tue, 20 Apr 2021 02:07:55 GMT")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Digest", "sha-512=:WZDPaVn/7XgHaAy8pmojAkGWoRx2UFChF41A2svX+TaPm+AbwAgBWnrIiYllu7BNNyealdVLvRwEmTHWXvJwew==:")
+	return req
+}
+
+func TestSignatureBase(t *testing.T) {
+	a := require.New(t)
+
+	req := newSignatureTestRequest(t)
+	base, err := SignatureBase(req, SignatureParams{
+		Components: []string{"@method", "@target-uri", "@authority", "@scheme", "@request-target", "@path", "@query", "Content-Length"},
+		Created:    time.Unix(1618884473, 0),
+		KeyID:      "test-key",
+	})
+	a.NoError(err)
+	a.Equal(`"@method": POST
+"@target-uri": http://example.com/foo?param=Value&Pet=dog
+"@authority": example.com
+"@scheme": http
+"@request-target": /foo?param=Value&Pet=dog
+"@path": /foo
+"@query": ?param=Value&Pet=dog
+"content-length": 18
+"@signature-params": ("@method" "@target-uri" "@authority" "@scheme" "@request-target" "@path" "@query" "content-length");created=1618884473;keyid="test-key"`, string(base))
+
+	for _, components := range [][]string{
+		{"@status"},
+		{"x-missing"},
+		{"date", "Date"},
+	} {
+		_, err := SignatureBase(req, SignatureParams{Components: components})
+		a.Error(err, components)
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	// Test vectors from RFC 9421, Appendix B.2.
+	ed25519Key := func() ed25519.PrivateKey {
+		der, err := base64.StdEncoding.DecodeString("MC4CAQAwBQYDK2VwBCIEIJ+DYvh6SEqVTm50DFtMDoQikTmiCqirVv9mWG9qfSnF")
+		require.NoError(t, err)
+		key, err := x509.ParsePKCS8PrivateKey(der)
+		require.NoError(t, err)
+		return key.(ed25519.PrivateKey)
+	}()
+	hmacKey, err := base64.StdEncoding.DecodeString("uzvJfB4u3N0Jy4T7NZ75MDVcr8zSTInedJtkgcu46YW4XByzNJjxBdtjUkdJPBtbmHhIDi6pcl8jsasjlTMtDQ==")
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name      string
+		alg       string
+		key       any
+		params    SignatureParams
+		input     string
+		signature string
+	}{
+		{
+			"HMAC",
+			SignatureHMACSHA256,
+			hmacKey,
+			SignatureParams{
+				Label:      "sig-b25",
+				Components: []string{"date", "@authority", "content-type"},
+				Created:    time.Unix(1618884473, 0),
+				KeyID:      "test-shared-secret",
+			},
+			`sig-b25=("date" "@authority" "content-type");created=1618884473;keyid="test-shared-secret"`,
+			`sig-b25=:pxcQw6G3AjtMBQjwo8XzkZf/bws5LelbaMk5rGIGtE8=:`,
+		},
+		{
+			"Ed25519",
+			SignatureEd25519,
+			ed25519Key,
+			SignatureParams{
+				Label:      "sig-b26",
+				Components: []string{"date", "@method", "@path", "@authority", "content-type", "content-length"},
+				Created:    time.Unix(1618884473, 0),
+				KeyID:      "test-key-ed25519",
+			},
+			`sig-b26=("date" "@method" "@path" "@authority" "content-type" "content-length");created=1618884473;keyid="test-key-ed25519"`,
+			`sig-b26=:wqcAqbmYJ2ji2glfAMaRy4gruYYnx2nEFN2HN6jrnDnQCK1u02Gb04v9EDgwUPiu4A0w6vuQv5lIp5WPpBKRCw==:`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+
+			signer, err := NewMessageSigner(tt.alg, tt.key)
+			a.NoError(err)
+			req := newSignatureTestRequest(t)
+			a.NoError(SignRequest(req, tt.params, signer))
+			a.Equal(tt.input, req.Header.Get("Signature-Input"))
+			a.Equal(tt.signature, req.Header.Get("Signature"))
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecdsa384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	secret := []byte("secret")
+
+	for _, tt := range []struct {
+		alg     string
+		private any
+		public  any
+	}{
+		{SignatureHMACSHA256, secret, secret},
+		{SignatureEd25519, edKey, edPub},
+		{SignatureECDSAP256SHA256, ecdsaKey, &ecdsaKey.PublicKey},
+		{SignatureECDSAP384SHA384, ecdsa384Key, &ecdsa384Key.PublicKey},
+		{SignatureRSAPSSSHA512, rsaKey, &rsaKey.PublicKey},
+		{SignatureRSAV15SHA256, rsaKey, &rsaKey.PublicKey},
+	} {
+		t.Run(tt.alg, func(t *testing.T) {
+			a := require.New(t)
+
+			signer, err := NewMessageSigner(tt.alg, tt.private)
+			a.NoError(err)
+			verifier, err := NewMessageVerifier(tt.alg, tt.public)
+			a.NoError(err)
+			keys := func(p SignatureParams) (MessageVerifier, error) {
+				if p.KeyID != "key" {
+					return nil, errors.Errorf("unknown key %q", p.KeyID)
+				}
+				return verifier, nil
+			}
+
+			// Sign on client side, verify on server side.
+			client := newSignatureTestRequest(t)
+			client.Header.Add("X-List", "a")
+			client.Header.Add("X-List", " b ")
+			a.NoError(SignRequest(client, SignatureParams{
+				Components: []string{"@method", "@target-uri", "@authority", "content-digest", "content-length", "x-list"},
+				KeyID:      "key",
+				Algorithm:  tt.alg,
+				Tag:        "app",
+				Nonce:      "nonce",
+				Expires:    time.Now().Add(time.Minute),
+			}, signer))
+			// Another signature is preserved.
+			a.NoError(SignRequest(client, SignatureParams{
+				Label:      "proxy",
+				Components: []string{"@method"},
+				KeyID:      "key",
+			}, signer))
+
+			server := httptest.NewRequest(http.MethodPost, "/foo?param=Value&Pet=dog", strings.NewReader(`{"hello": "world"}`))
+			server.Host = "example.com:80"
+			server.Header = client.Header.Clone()
+			server.Header.Set("Content-Length", "18")
+
+			p, err := VerifyRequest(server, "sig1", keys)
+			a.NoError(err)
+			a.Equal("sig1", p.Label)
+			a.Equal("key", p.KeyID)
+			a.Equal("app", p.Tag)
+			a.Equal("nonce", p.Nonce)
+			a.Equal(tt.alg, p.Algorithm)
+			a.Equal([]string{"@method", "@target-uri", "@authority", "content-digest", "content-length", "x-list"}, p.Components)
+
+			p, err = VerifyRequest(server, "", keys)
+			a.NoError(err)
+			a.Equal("sig1", p.Label)
+			_, err = VerifyRequest(server, "proxy", keys)
+			a.NoError(err)
+
+			// Tampered request.
+			tampered := server.Clone(server.Context())
+			tampered.Method = http.MethodPut
+			_, err = VerifyRequest(tampered, "sig1", keys)
+			a.Error(err)
+
+			_, err = VerifyRequest(server, "unknown", keys)
+			a.Error(err)
+			_, err = VerifyRequest(server, "sig1", func(p SignatureParams) (MessageVerifier, error) {
+				return NewMessageVerifier(SignatureHMACSHA256, []byte("other"))
+			})
+			a.Error(err)
+		})
+	}
+}
+
+func TestVerifyRequestErrors(t *testing.T) {
+	signer, err := NewMessageSigner(SignatureHMACSHA256, []byte("secret"))
+	require.NoError(t, err)
+	keys := func(p SignatureParams) (MessageVerifier, error) {
+		return NewMessageVerifier(SignatureHMACSHA256, []byte("secret"))
+	}
+
+	t.Run("Expired", func(t *testing.T) {
+		a := require.New(t)
+
+		req := newSignatureTestRequest(t)
+		a.NoError(SignRequest(req, SignatureParams{
+			Components: []string{"@method"},
+			Expires:    time.Now().Add(-time.Minute),
+		}, signer))
+		_, err := VerifyRequest(req, "sig1", keys)
+		a.ErrorContains(err, "expired")
+	})
+	t.Run("Malformed", func(t *testing.T) {
+		for _, tt := range []struct {
+			input     string
+			signature string
+		}{
+			{"", ""},
+			{`sig1=("@method");created=1`, ""},
+			{`sig1=("@method";bs);created=1`, `sig1=:AA==:`},
+			{`sig1=(@method);created=1`, `sig1=:AA==:`},
+			{`sig1=("@method");created="1"`, `sig1=:AA==:`},
+			{`sig1="@method"`, `sig1=:AA==:`},
+			{`sig1=("@method"`, `sig1=:AA==:`},
+			{`sig1=("@method");created=1`, `sig1="AA=="`},
+			{`sig1=("@method");created=1`, `sig1=:AA==`},
+			{`sig1=("@method");created=1,`, `sig1=:AA==:`},
+		} {
+			req := newSignatureTestRequest(t)
+			req.Header.Set("Signature-Input", tt.input)
+			req.Header.Set("Signature", tt.signature)
+			_, err := VerifyRequest(req, "", keys)
+			require.Error(t, err, tt)
+		}
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		a := require.New(t)
+
+		_, err := NewMessageSigner("hmac-sha1", []byte("secret"))
+		a.Error(err)
+		_, err = NewMessageSigner(SignatureEd25519, []byte("secret"))
+		a.Error(err)
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		a.NoError(err)
+		_, err = NewMessageSigner(SignatureECDSAP256SHA256, key)
+		a.Error(err)
+		_, err = NewMessageVerifier(SignatureECDSAP384SHA384, key)
+		a.Error(err)
+	})
+}
+
+func TestParseSFDictionary(t *testing.T) {
+	a := require.New(t)
+
+	const input = `a=1, b;x=?0;y, c=("s" tok 1.5 :AQI=:);p="q\"\\", d=?1;z=-2`
+	dict, err := parseSFDictionary(input)
+	a.NoError(err)
+	a.Len(dict, 4)
+
+	var serialized []string
+	for _, m := range dict {
+		serialized = append(serialized, m.key+"="+m.serializeValue())
+	}
+	a.Equal([]string{
+		`a=1`,
+		`b=?1;x=?0;y`,
+		`c=("s" tok 1.5 :AQI=:);p="q\"\\"`,
+		`d=?1;z=-2`,
+	}, serialized)
+}
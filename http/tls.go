@@ -0,0 +1,98 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"net/http"
+	"sync"
+)
+
+type clientCertificatesKey struct{}
+
+// SetClientCertificates sets TLS client certificates to present for the request.
+//
+// Certificates are used only if request is sent using transport created by
+// NewClientCertificateTransport.
+func SetClientCertificates(req *http.Request, certs []tls.Certificate) {
+	ctx := context.WithValue(req.Context(), clientCertificatesKey{}, certs)
+	*req = *req.WithContext(ctx)
+}
+
+// ClientCertificates returns TLS client certificates set by SetClientCertificates.
+func ClientCertificates(ctx context.Context) []tls.Certificate {
+	certs, _ := ctx.Value(clientCertificatesKey{}).([]tls.Certificate)
+	return certs
+}
+
+// ClientCertificateTransport is http.RoundTripper that presents per-request
+// TLS client certificates.
+//
+// Connections are not shared between requests with different certificates.
+type ClientCertificateTransport struct {
+	base *http.Transport
+
+	mux        sync.Mutex
+	transports map[[sha256.Size]byte]*http.Transport // guarded by mux
+}
+
+// NewClientCertificateTransport creates new ClientCertificateTransport.
+//
+// If base is nil, http.DefaultTransport is used.
+func NewClientCertificateTransport(base *http.Transport) *ClientCertificateTransport {
+	if base == nil {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	return &ClientCertificateTransport{
+		base:       base,
+		transports: map[[sha256.Size]byte]*http.Transport{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ClientCertificateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	certs := ClientCertificates(req.Context())
+	if len(certs) == 0 {
+		return t.base.RoundTrip(req)
+	}
+	return t.transport(certs).RoundTrip(req)
+}
+
+// CloseIdleConnections closes idle connections of all underlying transports.
+func (t *ClientCertificateTransport) CloseIdleConnections() {
+	t.base.CloseIdleConnections()
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	for _, tr := range t.transports {
+		tr.CloseIdleConnections()
+	}
+}
+
+func (t *ClientCertificateTransport) transport(certs []tls.Certificate) *http.Transport {
+	h := sha256.New()
+	for _, cert := range certs {
+		for _, der := range cert.Certificate {
+			_, _ = h.Write(der)
+		}
+		// Separate certificates.
+		_, _ = h.Write([]byte{0})
+	}
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if tr, ok := t.transports[key]; ok {
+		return tr
+	}
+	tr := t.base.Clone()
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = new(tls.Config)
+	}
+	tr.TLSClientConfig.Certificates = certs
+	tr.TLSClientConfig.GetClientCertificate = nil
+	t.transports[key] = tr
+	return tr
+}
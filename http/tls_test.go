@@ -0,0 +1,36 @@
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCertificateTransport(t *testing.T) {
+	a := require.New(t)
+
+	var (
+		cert1 = tls.Certificate{Certificate: [][]byte{{1}}}
+		cert2 = tls.Certificate{Certificate: [][]byte{{2}}}
+	)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
+	a.NoError(err)
+	a.Empty(ClientCertificates(req.Context()))
+	SetClientCertificates(req, []tls.Certificate{cert1})
+	a.Equal([]tls.Certificate{cert1}, ClientCertificates(req.Context()))
+
+	base := &http.Transport{TLSClientConfig: &tls.Config{ServerName: "example.com"}}
+	tr := NewClientCertificateTransport(base)
+
+	t1 := tr.transport([]tls.Certificate{cert1})
+	a.Same(t1, tr.transport([]tls.Certificate{cert1}))
+	a.NotSame(t1, tr.transport([]tls.Certificate{cert2}))
+	a.NotSame(t1, tr.transport([]tls.Certificate{cert1, cert2}))
+
+	a.Equal([]tls.Certificate{cert1}, t1.TLSClientConfig.Certificates)
+	a.Equal("example.com", t1.TLSClientConfig.ServerName)
+	a.Nil(base.TLSClientConfig.Certificates)
+}
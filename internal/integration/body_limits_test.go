@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_body_limits"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+type bodyLimitsHandler struct{}
+
+var _ api.Handler = bodyLimitsHandler{}
+
+func (bodyLimitsHandler) CreateItems(ctx context.Context, req []api.Item) error {
+	return nil
+}
+
+func (bodyLimitsHandler) StreamItems(ctx context.Context, req []api.Item) error {
+	return nil
+}
+
+func (bodyLimitsHandler) Upload(ctx context.Context, req api.UploadReq) (int, error) {
+	data, err := io.ReadAll(req)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func TestBodyLimits(t *testing.T) {
+	srv, err := api.NewServer(bodyLimitsHandler{},
+		api.WithMaxRequestBodySize(64),
+		api.WithMaxJSONDepth(4),
+		api.WithMaxJSONArrayLength(2),
+	)
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	defer s.Close()
+
+	send := func(t *testing.T, path, contentType string, body io.Reader) (int, string) {
+		t.Helper()
+
+		resp, err := s.Client().Post(s.URL+path, contentType, body)
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+
+	for _, tt := range []struct {
+		Name        string
+		Path        string
+		ContentType string
+		Body        string
+		Code        int
+		Error       string
+	}{
+		{"JSON", "/items", "application/json", `[{"name":"a","children":[{"name":"b"}]}]`, http.StatusNoContent, ""},
+		{"BodySize", "/items", "application/json", `[{"name":"` + strings.Repeat("a", 64) + `"}]`, http.StatusRequestEntityTooLarge, "request body size exceeds limit of 64"},
+		{"JSONDepth", "/items", "application/json", `[{"name":"a","children":[{"name":"b","children":[]}]}]`, http.StatusRequestEntityTooLarge, "request JSON depth exceeds limit of 4"},
+		{"JSONArrayLength", "/items", "application/json", `[{"name":"a"},{"name":"b"},{"name":"c"}]`, http.StatusRequestEntityTooLarge, "request JSON array length exceeds limit of 2"},
+		{"InvalidJSON", "/items", "application/json", `[{"name":"a"},`, http.StatusBadRequest, ""},
+		// Streaming decoder checks limits while reading.
+		{"StreamJSON", "/items/stream", "application/json", `[{"name":"a","children":[{"name":"b"}]}]`, http.StatusNoContent, ""},
+		{"StreamJSONDepth", "/items/stream", "application/json", `[{"name":"a","children":[{"name":"b","children":[]}]}]`, http.StatusRequestEntityTooLarge, "request JSON depth exceeds limit of 4"},
+		{"StreamJSONArrayLength", "/items/stream", "application/json", `[{"name":"a"},{"name":"b"},{"name":"c"}]`, http.StatusRequestEntityTooLarge, "request JSON array length exceeds limit of 2"},
+		// Operation limit overrides server limit.
+		{"Upload", "/upload", "application/octet-stream", strings.Repeat("a", 16), http.StatusOK, ""},
+		{"UploadExceeded", "/upload", "application/octet-stream", strings.Repeat("a", 17), http.StatusRequestEntityTooLarge, "request body size exceeds limit of 16"},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			code, body := send(t, tt.Path, tt.ContentType, strings.NewReader(tt.Body))
+			require.Equal(t, tt.Code, code, body)
+			if tt.Error != "" {
+				require.Contains(t, body, tt.Error)
+			}
+		})
+	}
+
+	t.Run("UnknownLength", func(t *testing.T) {
+		// Body without Content-Length is limited while reading.
+		body := io.MultiReader(strings.NewReader(strings.Repeat("a", 32)))
+		code, resp := send(t, "/upload", "application/octet-stream", body)
+		require.Equal(t, http.StatusRequestEntityTooLarge, code, resp)
+	})
+	t.Run("Client", func(t *testing.T) {
+		client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+		require.NoError(t, err)
+
+		n, err := client.Upload(context.Background(), api.UploadReq{Data: strings.NewReader("hello")})
+		require.NoError(t, err)
+		require.Equal(t, 5, n)
+
+		err = client.CreateItems(context.Background(), []api.Item{{Name: "a"}, {Name: "b"}, {Name: "c"}})
+		require.Error(t, err)
+	})
+	t.Run("ErrorCode", func(t *testing.T) {
+		err := &ogenerrors.DecodeRequestError{
+			Err: &ogenerrors.LimitError{Kind: ogenerrors.LimitBodySize, Limit: 1},
+		}
+		require.Equal(t, http.StatusRequestEntityTooLarge, ogenerrors.ErrorCode(err))
+	})
+}
@@ -0,0 +1,213 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_callbacks"
+)
+
+// callbackProvider implements API provider side: it calls subscriber back
+// using URLs taken from the original request.
+type callbackProvider struct {
+	client *api.CallbackClient
+}
+
+var _ api.Handler = (*callbackProvider)(nil)
+
+func (p *callbackProvider) Subscribe(ctx context.Context, req *api.Subscription, params api.SubscribeParams) (*api.SubscriptionID, error) {
+	eventURL, err := api.SubscribeOnEventCallbackURL(req)
+	if err != nil {
+		return nil, err
+	}
+	ack, err := p.client.EventNotification(ctx, eventURL, &api.Event{
+		Topic:   req.Topic.Or("default"),
+		Payload: "subscribed",
+	}, api.EventNotificationParams{XEventID: 1})
+	if err != nil {
+		return nil, errors.Wrap(err, "notify event")
+	}
+	if !ack.Received {
+		return nil, errors.New("event is not received")
+	}
+
+	statusURL, err := api.SubscribeOnStatusCallbackURL(req, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.client.SubscriptionStatus(ctx, statusURL, api.SubscriptionStatusParams{
+		State: "active",
+	}); err != nil {
+		return nil, errors.Wrap(err, "notify status")
+	}
+	return &api.SubscriptionID{ID: "sub-1"}, nil
+}
+
+func (p *callbackProvider) Resubscribe(ctx context.Context, req *api.Subscription) error {
+	eventURL, err := api.ResubscribeOnEventCallbackURL(req)
+	if err != nil {
+		return err
+	}
+	_, err = p.client.EventNotification(ctx, eventURL, &api.Event{
+		Topic:   req.Topic.Or("default"),
+		Payload: "resubscribed",
+	}, api.EventNotificationParams{XEventID: 2})
+	return err
+}
+
+// callbackSubscriber implements subscriber side.
+type callbackSubscriber struct {
+	mux    sync.Mutex
+	events []api.Event
+	ids    []int64
+	states []string
+	query  []url.Values
+}
+
+var _ api.CallbackHandler = (*callbackSubscriber)(nil)
+
+func (s *callbackSubscriber) EventNotification(ctx context.Context, req *api.Event, params api.EventNotificationParams) (*api.EventAck, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.events = append(s.events, *req)
+	s.ids = append(s.ids, params.XEventID)
+	return &api.EventAck{Received: true}, nil
+}
+
+func (s *callbackSubscriber) SubscriptionStatus(ctx context.Context, params api.SubscriptionStatusParams) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.states = append(s.states, params.State)
+	return nil
+}
+
+func TestCallbacks(t *testing.T) {
+	ctx := context.Background()
+
+	sub := &callbackSubscriber{}
+	cbServer, err := api.NewCallbackServer(sub)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/hooks", cbServer.Handler("onEvent"))
+	mux.Handle("/hooks/events/status", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sub.mux.Lock()
+		sub.query = append(sub.query, r.URL.Query())
+		sub.mux.Unlock()
+		cbServer.Handler("onStatus").ServeHTTP(w, r)
+	}))
+	subscriber := httptest.NewServer(mux)
+	defer subscriber.Close()
+
+	cbClient, err := api.NewCallbackClient(api.WithClient(subscriber.Client()))
+	require.NoError(t, err)
+
+	apiServer, err := api.NewServer(&callbackProvider{client: cbClient})
+	require.NoError(t, err)
+	provider := httptest.NewServer(apiServer)
+	defer provider.Close()
+
+	client, err := api.NewClient(provider.URL, api.WithClient(provider.Client()))
+	require.NoError(t, err)
+
+	callbackURL, err := url.Parse(subscriber.URL + "/hooks")
+	require.NoError(t, err)
+
+	t.Run("Subscribe", func(t *testing.T) {
+		a := require.New(t)
+
+		res, err := client.Subscribe(ctx, &api.Subscription{
+			CallbackUrl: *callbackURL,
+			Topic:       api.NewOptString("orders"),
+		}, api.SubscribeParams{
+			Tenant:            api.NewOptString("acme"),
+			XSubscriptionKind: api.SubscriptionKindEvents,
+		})
+		a.NoError(err)
+		a.Equal("sub-1", res.ID)
+
+		sub.mux.Lock()
+		defer sub.mux.Unlock()
+		a.Equal([]api.Event{{Topic: "orders", Payload: "subscribed"}}, sub.events)
+		a.Equal([]int64{1}, sub.ids)
+		a.Equal([]string{"active"}, sub.states)
+		a.Equal([]url.Values{{
+			"tenant": {"acme"},
+			"method": {"POST"},
+			"state":  {"active"},
+		}}, sub.query)
+	})
+	t.Run("Resubscribe", func(t *testing.T) {
+		a := require.New(t)
+
+		a.NoError(client.Resubscribe(ctx, &api.Subscription{
+			CallbackUrl: *callbackURL,
+		}))
+
+		sub.mux.Lock()
+		defer sub.mux.Unlock()
+		a.Equal(api.Event{Topic: "default", Payload: "resubscribed"}, sub.events[len(sub.events)-1])
+		a.Equal(int64(2), sub.ids[len(sub.ids)-1])
+	})
+}
+
+func TestCallbackURL(t *testing.T) {
+	a := require.New(t)
+
+	u, err := url.Parse("https://example.com/hooks")
+	a.NoError(err)
+	req := &api.Subscription{CallbackUrl: *u}
+
+	got, err := api.SubscribeOnStatusCallbackURL(req, api.SubscribeParams{
+		Tenant:            api.NewOptString("acme"),
+		XSubscriptionKind: api.SubscriptionKindAudit,
+	})
+	a.NoError(err)
+	a.Equal("https://example.com/hooks/audit/status?tenant=acme&method=POST", got)
+
+	// Optional value is not set.
+	_, err = api.SubscribeOnStatusCallbackURL(req, api.SubscribeParams{
+		XSubscriptionKind: api.SubscriptionKindAudit,
+	})
+	a.ErrorContains(err, "$request.query.tenant")
+
+	// Request is nil.
+	_, err = api.SubscribeOnEventCallbackURL(nil)
+	a.ErrorContains(err, "$request.body#/callbackUrl")
+}
+
+func TestCallbackServerHandler(t *testing.T) {
+	a := require.New(t)
+
+	s, err := api.NewCallbackServer(&callbackSubscriber{})
+	a.NoError(err)
+
+	// Unknown callback.
+	{
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		a.False(s.Handle("unknown", w, r))
+
+		w = httptest.NewRecorder()
+		s.Handler("unknown").ServeHTTP(w, r)
+		a.Equal(http.StatusNotFound, w.Code)
+	}
+	// Method is not allowed.
+	{
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		a.False(s.Handle("onEvent", w, r))
+
+		w = httptest.NewRecorder()
+		s.Handler("onEvent").ServeHTTP(w, r)
+		a.Equal(http.StatusMethodNotAllowed, w.Code)
+		a.Equal("POST", w.Header().Get("Allow"))
+	}
+}
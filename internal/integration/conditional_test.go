@@ -0,0 +1,166 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_conditional"
+	"github.com/ogen-go/ogen/validate"
+)
+
+type conditionalHandler struct{}
+
+var _ api.Handler = conditionalHandler{}
+
+func (conditionalHandler) UpdateBilling(ctx context.Context, req *api.Billing) error {
+	return nil
+}
+
+func (conditionalHandler) UpdatePayment(ctx context.Context, req *api.Payment) error {
+	return nil
+}
+
+func (conditionalHandler) UpdateShipping(ctx context.Context, req *api.Shipping) error {
+	return nil
+}
+
+func TestConditional(t *testing.T) {
+	t.Run("DependentRequired", func(t *testing.T) {
+		for i, tt := range []struct {
+			Input  api.Billing
+			Failed []string
+		}{
+			{api.Billing{Name: "a"}, nil},
+			{api.Billing{
+				Name:       "a",
+				Street:     api.NewOptString("Main St"),
+				City:       api.NewOptString("Springfield"),
+				PostalCode: api.NewOptString("12345"),
+			}, nil},
+			{api.Billing{
+				Name:   "a",
+				Street: api.NewOptString("Main St"),
+				City:   api.NewOptString("Springfield"),
+			}, []string{"postal_code"}},
+			{api.Billing{
+				Name:    "a",
+				Street:  api.NewOptString("Main St"),
+				Company: api.NewOptString("ACME"),
+			}, []string{"tax_id", "city", "postal_code"}},
+			// Dependency is not present.
+			{api.Billing{
+				Name:  "a",
+				City:  api.NewOptString("Springfield"),
+				TaxID: api.NewOptString("123"),
+			}, nil},
+		} {
+			checkConditional(t, &tt.Input, tt.Failed, "test %d", i+1)
+		}
+	})
+	t.Run("IfThenElse", func(t *testing.T) {
+		for i, tt := range []struct {
+			Input  api.Shipping
+			Failed []string
+		}{
+			{api.Shipping{
+				Country:    api.ShippingCountryUS,
+				State:      api.NewOptString("CA"),
+				PostalCode: api.NewOptString("12345-6789"),
+			}, nil},
+			{api.Shipping{
+				Country: api.ShippingCountryUS,
+			}, []string{"state"}},
+			{api.Shipping{
+				Country:    api.ShippingCountryUS,
+				State:      api.NewOptString("CA"),
+				PostalCode: api.NewOptString("1234AB"),
+			}, []string{"postal_code"}},
+			{api.Shipping{
+				Country:    api.ShippingCountryNL,
+				PostalCode: api.NewOptString("1234AB"),
+			}, nil},
+			{api.Shipping{
+				Country:    api.ShippingCountryNL,
+				PostalCode: api.NewOptString("123"),
+			}, []string{"postal_code"}},
+		} {
+			checkConditional(t, &tt.Input, tt.Failed, "test %d", i+1)
+		}
+	})
+	t.Run("DependentSchemas", func(t *testing.T) {
+		for i, tt := range []struct {
+			Input  api.Payment
+			Failed []string
+		}{
+			{api.Payment{}, nil},
+			{api.Payment{
+				Method:     api.NewOptString("card"),
+				CardNumber: api.NewOptString("4242"),
+				Amount:     api.NewOptInt(100),
+			}, nil},
+			{api.Payment{
+				CardNumber: api.NewOptString("4242"),
+			}, []string{"method"}},
+			{api.Payment{
+				Method:     api.NewOptString("sepa"),
+				CardNumber: api.NewOptString("4242"),
+				Amount:     api.NewOptInt(20000),
+			}, []string{"method", "amount"}},
+			{api.Payment{
+				Method: api.NewOptString("wire"),
+				Iban:   api.NewOptString("NL00"),
+			}, nil},
+			{api.Payment{
+				Method: api.NewOptString("card"),
+				Iban:   api.NewOptString("NL00"),
+			}, []string{"method"}},
+		} {
+			checkConditional(t, &tt.Input, tt.Failed, "test %d", i+1)
+		}
+	})
+	t.Run("Server", func(t *testing.T) {
+		srv, err := api.NewServer(conditionalHandler{})
+		require.NoError(t, err)
+
+		s := httptest.NewServer(srv)
+		defer s.Close()
+
+		resp, err := s.Client().Post(s.URL+"/billing", "application/json",
+			strings.NewReader(`{"name":"a","street":"Main St","city":"Springfield"}`))
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), `postal_code (field required when \"street\" is present)`)
+	})
+}
+
+func checkConditional(t *testing.T, input interface{ Validate() error }, failed []string, msgAndArgs ...any) {
+	t.Helper()
+
+	err := input.Validate()
+	if len(failed) == 0 {
+		require.NoError(t, err, msgAndArgs...)
+		return
+	}
+
+	var validateErr *validate.Error
+	require.True(t, errors.As(err, &validateErr), msgAndArgs...)
+
+	var names []string
+	for _, f := range validateErr.Fields {
+		names = append(names, f.Name)
+	}
+	require.ElementsMatch(t, failed, names, msgAndArgs...)
+}
@@ -0,0 +1,139 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_content_encoding"
+)
+
+type contentEncodingHandler struct{}
+
+var _ api.Handler = contentEncodingHandler{}
+
+func (contentEncodingHandler) Upload(ctx context.Context, req api.UploadReq, params api.UploadParams) (*api.Upload, error) {
+	switch req := req.(type) {
+	case *api.Upload:
+		return req, nil
+	case *api.UploadForm:
+		return &api.Upload{
+			Data:   req.Data,
+			Meta:   api.UploadMeta{Name: string(params.ID)},
+			Labels: api.UploadLabels{string(params.Signature), string(req.Tag)},
+		}, nil
+	default:
+		return nil, errors.Errorf("unexpected request %T", req)
+	}
+}
+
+func TestContentEncodingJSON(t *testing.T) {
+	for i, tc := range []struct {
+		Input     string
+		Expected  api.Upload
+		ExpectErr bool
+	}{
+		{
+			`{
+				"data": "aGVsbG8=",
+				"thumbnail": "_-8",
+				"checksum": "AQI",
+				"meta": "eyJuYW1lIjoiYSJ9",
+				"labels": "[\"x\",\"y\"]",
+				"token": "eyJzdWIiOiJ1In0"
+			}`,
+			api.Upload{
+				Data:      []byte("hello"),
+				Thumbnail: []byte{0xff, 0xef},
+				Checksum:  api.NewOptNilBase64URL([]byte{1, 2}),
+				Meta:      api.UploadMeta{Name: "a"},
+				Labels:    api.UploadLabels{"x", "y"},
+				Token:     api.NewOptToken(api.Token{Sub: "u"}),
+			},
+			false,
+		},
+		// Invalid base64.
+		{`{"data": "!", "thumbnail": "", "meta": "eyJuYW1lIjoiYSJ9"}`, api.Upload{}, true},
+		// Invalid base64url.
+		{`{"data": "", "thumbnail": "/+8=", "meta": "eyJuYW1lIjoiYSJ9"}`, api.Upload{}, true},
+		// Encoded content is not JSON.
+		{`{"data": "", "thumbnail": "", "meta": "aGVsbG8="}`, api.Upload{}, true},
+		// Encoded content does not match the schema.
+		{`{"data": "", "thumbnail": "", "meta": "e30="}`, api.Upload{}, true},
+	} {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			var r api.Upload
+			err := r.Decode(jx.DecodeStr(tc.Input))
+			if tc.ExpectErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tc.Expected, r)
+			testEncode(t, &r, tc.Input)
+		})
+	}
+}
+
+func TestContentEncodingValidate(t *testing.T) {
+	a := require.New(t)
+
+	r := api.Upload{
+		Data: []byte("hello"),
+		Meta: api.UploadMeta{Name: ""},
+	}
+	err := r.Validate()
+	a.Error(err)
+	a.Contains(err.Error(), "meta")
+
+	r.Meta.Name = "a"
+	a.NoError(r.Validate())
+
+	r.Data = make([]byte, 17)
+	a.Error(r.Validate())
+}
+
+func TestContentEncodingServer(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	h, err := api.NewServer(contentEncodingHandler{})
+	a.NoError(err)
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	a.NoError(err)
+
+	params := api.UploadParams{
+		ID:        []byte{0xff, 0xef, 0x00},
+		Signature: []byte("sign"),
+	}
+
+	req := &api.Upload{
+		Data:      []byte("hello"),
+		Thumbnail: []byte{0xff, 0xef},
+		Meta:      api.UploadMeta{Name: "a", Size: api.NewOptInt(10)},
+		Labels:    api.UploadLabels{"x"},
+		Token:     api.NewOptToken(api.Token{Sub: "u", Exp: api.NewOptInt(1)}),
+	}
+	resp, err := client.Upload(ctx, req, params)
+	a.NoError(err)
+	a.Equal(req, resp)
+
+	resp, err = client.Upload(ctx, &api.UploadForm{
+		Data: []byte{0x00, 0xfb, 0xff},
+		Tag:  []byte("tag"),
+	}, params)
+	a.NoError(err)
+	a.Equal([]byte{0x00, 0xfb, 0xff}, resp.Data)
+	a.Equal(string(params.ID), resp.Meta.Name)
+	a.Equal(api.UploadLabels{"sign", "tag"}, resp.Labels)
+}
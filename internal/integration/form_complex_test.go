@@ -0,0 +1,218 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_form_complex"
+)
+
+type formComplexHandler struct{}
+
+var _ api.Handler = formComplexHandler{}
+
+func (formComplexHandler) UpdateProfile(ctx context.Context, req *api.Profile) (*api.Profile, error) {
+	return req, nil
+}
+
+func (formComplexHandler) Upload(ctx context.Context, req *api.UploadMultipart) (*api.UploadResult, error) {
+	data, err := io.ReadAll(req.File.File)
+	if err != nil {
+		return nil, err
+	}
+	r := &api.UploadResult{
+		Title:       req.Metadata.Title,
+		Note:        req.Note,
+		Size:        len(data),
+		ContentType: req.File.Header.Get("Content-Type"),
+		Checksum:    req.File.Header.Get("X-Checksum"),
+	}
+	if options, ok := req.Options.Get(); ok {
+		if quality, ok := options.Quality.Get(); ok {
+			r.Level = quality.Level
+		}
+	}
+	return r, nil
+}
+
+func (formComplexHandler) UploadBatch(ctx context.Context, req *api.UploadBatchReq) ([]api.Item, error) {
+	items := req.Items
+	if file, ok := req.Attachment.Get(); ok {
+		items = append(items, api.Item{Name: file.Name})
+	}
+	return items, nil
+}
+
+func TestFormComplex(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := api.NewServer(formComplexHandler{})
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+
+	t.Run("URLEncoded", func(t *testing.T) {
+		a := require.New(t)
+
+		profile := &api.Profile{
+			Name: "alice",
+			Address: api.NewOptAddress(api.Address{
+				City: "Berlin",
+				Zip:  api.NewOptString("10115"),
+			}),
+			Items: []api.Item{
+				{Name: "shirt", Qty: api.NewOptInt(2)},
+				{Name: "hat"},
+			},
+			AdditionalProps: api.ProfileAdditional{
+				"color": "red",
+			},
+		}
+		result, err := client.UpdateProfile(ctx, profile)
+		a.NoError(err)
+		a.Equal(profile, result)
+
+		form := url.Values{
+			"name":             {"bob"},
+			"address[city]":    {"Paris"},
+			"items[0][name]":   {"shirt"},
+			"items[1][name]":   {"hat"},
+			"items[1][qty]":    {"3"},
+			"nickname":         {"b"},
+			"unrelated[field]": {"x"},
+		}
+		resp, err := s.Client().Post(s.URL+"/profile", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal(http.StatusOK, resp.StatusCode)
+
+		var got api.Profile
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.NoError(got.Decode(jx.DecodeBytes(data)))
+		a.Equal(api.Profile{
+			Name:    "bob",
+			Address: api.NewOptAddress(api.Address{City: "Paris"}),
+			Items: []api.Item{
+				{Name: "shirt"},
+				{Name: "hat", Qty: api.NewOptInt(3)},
+			},
+			AdditionalProps: api.ProfileAdditional{
+				"nickname":         "b",
+				"unrelated[field]": "x",
+			},
+		}, got)
+	})
+	t.Run("Multipart", func(t *testing.T) {
+		a := require.New(t)
+
+		result, err := client.Upload(ctx, &api.UploadMultipart{
+			Metadata: api.Meta{Title: "cat"},
+			Note:     api.NewOptString("cute"),
+			Options: api.NewOptUploadMultipartOptions(api.UploadMultipartOptions{
+				Quality: api.NewOptUploadMultipartOptionsQuality(api.UploadMultipartOptionsQuality{
+					Level: api.NewOptInt(3),
+				}),
+			}),
+			File: ht.MultipartFile{
+				Name:   "cat.png",
+				File:   strings.NewReader("meow"),
+				Header: textproto.MIMEHeader{"X-Checksum": {"0123abcd"}},
+			},
+		})
+		a.NoError(err)
+		a.Equal(&api.UploadResult{
+			Title:       "cat",
+			Note:        api.NewOptString("cute"),
+			Level:       api.NewOptInt(3),
+			Size:        4,
+			ContentType: "image/png",
+			Checksum:    "0123abcd",
+		}, result)
+
+		// Client validates part headers.
+		_, err = client.Upload(ctx, &api.UploadMultipart{
+			Metadata: api.Meta{Title: "cat"},
+			File: ht.MultipartFile{
+				Name: "cat.png",
+				File: strings.NewReader("meow"),
+			},
+		})
+		a.Error(err)
+	})
+	t.Run("MultipartServer", func(t *testing.T) {
+		send := func(t *testing.T, fileHeader textproto.MIMEHeader) *http.Response {
+			t.Helper()
+			a := require.New(t)
+
+			var body bytes.Buffer
+			w := multipart.NewWriter(&body)
+
+			part, err := w.CreatePart(textproto.MIMEHeader{
+				"Content-Disposition": {`form-data; name="metadata"`},
+				"Content-Type":        {"application/vnd.meta+json"},
+			})
+			a.NoError(err)
+			_, err = io.WriteString(part, `{"title":"dog"}`)
+			a.NoError(err)
+
+			fileHeader.Set("Content-Disposition", `form-data; name="file"; filename="dog.png"`)
+			part, err = w.CreatePart(fileHeader)
+			a.NoError(err)
+			_, err = io.WriteString(part, "woof")
+			a.NoError(err)
+			a.NoError(w.Close())
+
+			resp, err := s.Client().Post(s.URL+"/upload", w.FormDataContentType(), &body)
+			a.NoError(err)
+			t.Cleanup(func() { _ = resp.Body.Close() })
+			return resp
+		}
+
+		for _, tt := range []struct {
+			name   string
+			header textproto.MIMEHeader
+			status int
+		}{
+			{"Valid", textproto.MIMEHeader{"X-Checksum": {"deadbeef"}, "X-Page": {"2"}}, http.StatusOK},
+			{"MissingRequired", textproto.MIMEHeader{"X-Page": {"2"}}, http.StatusBadRequest},
+			{"InvalidPattern", textproto.MIMEHeader{"X-Checksum": {"nope"}}, http.StatusBadRequest},
+			{"InvalidMinimum", textproto.MIMEHeader{"X-Checksum": {"deadbeef"}, "X-Page": {"0"}}, http.StatusBadRequest},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				resp := send(t, tt.header)
+				require.Equal(t, tt.status, resp.StatusCode)
+			})
+		}
+	})
+	t.Run("AliasedMultipart", func(t *testing.T) {
+		a := require.New(t)
+
+		items := []api.Item{{Name: "a", Qty: api.NewOptInt(1)}}
+		result, err := client.UploadBatch(ctx, &api.UploadBatchReq{
+			Items: items,
+			Attachment: api.NewOptMultipartFile(ht.MultipartFile{
+				Name: "b.txt",
+				File: strings.NewReader("b"),
+			}),
+		})
+		a.NoError(err)
+		a.Equal(append(items, api.Item{Name: "b.txt"}), result)
+	})
+}
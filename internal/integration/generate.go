@@ -12,10 +12,22 @@ package integration
 //go:generate go run ../../cmd/ogen -v --clean --config _config/techempower.yml --package techempower --target techempower ../../_testdata/examples/techempower.json
 
 //go:generate go run ../../cmd/ogen -v --clean --config _config/security_reentrant.yml --target security_reentrant ../../_testdata/positive/security.json
+//go:generate go run ../../cmd/ogen -v --clean --config _config/decoding_strict.yml --target test_decoding_strict ../../_testdata/positive/unevaluated.yml
+//go:generate go run ../../cmd/ogen -v --clean --config _config/form_complex.yml --target test_form_complex ../../_testdata/positive/form_complex.yml
 
 // Tests
 //
 //go:generate go run ../../cmd/ogen -v --clean --target test_webhooks         ../../_testdata/positive/webhooks.json
+//go:generate go run ../../cmd/ogen -v --clean --target test_callbacks        ../../_testdata/positive/callbacks.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_links            ../../_testdata/positive/links.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_body_limits      ../../_testdata/positive/body_limits.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_retry            ../../_testdata/positive/retry.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_conditional      ../../_testdata/positive/conditional.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_prefix_items     ../../_testdata/positive/prefix_items.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_content_encoding ../../_testdata/positive/content_encoding.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_not              ../../_testdata/positive/not.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_unevaluated      ../../_testdata/positive/unevaluated.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_nested_params    ../../_testdata/positive/nested_params.yml
 //go:generate go run ../../cmd/ogen -v --clean --target test_servers          ../../_testdata/positive/servers.json
 //go:generate go run ../../cmd/ogen -v --clean --target test_single_endpoint  ../../_testdata/positive/single_endpoint.json
 //go:generate go run ../../cmd/ogen -v --clean --target test_span_status      ../../_testdata/positive/span_status.yml
@@ -24,6 +36,9 @@ package integration
 //go:generate go run ../../cmd/ogen -v --clean --target test_form             ../../_testdata/positive/form.json
 //go:generate go run ../../cmd/ogen -v --clean --target test_parameters       ../../_testdata/positive/parameters.json
 //go:generate go run ../../cmd/ogen -v --clean --target test_security         ../../_testdata/positive/security.json
+//go:generate go run ../../cmd/ogen -v --clean --target test_security_oidc    ../../_testdata/positive/security_oidc.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_security_mtls    ../../_testdata/positive/security_mtls.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_security_http    ../../_testdata/positive/security_http.yml
 //
 //
 //go:generate go run ../../cmd/ogen -v --clean --target referenced_path_item ../../_testdata/positive/referenced_pathItem.json
@@ -42,6 +57,14 @@ package integration
 //go:generate go run ../../cmd/ogen -v --clean --config _config/client_options.yml --target test_client_options ../../_testdata/positive/client_options.json
 //go:generate go run ../../cmd/ogen -v --clean --target test_cors ../../_testdata/positive/cors.yaml
 //go:generate go run ../../cmd/ogen -v --clean --target test_additional_operations ../../_testdata/positive/additional_operations.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_sse ../../_testdata/positive/sse.yml
+//go:generate go run ../../cmd/ogen -v --clean --config _config/sse_server.yml --target test_sse_server ../../_testdata/positive/sse.yml
+//go:generate go run ../../cmd/ogen -v --clean --config _config/xml.yml --target test_xml ../../_testdata/positive/xml.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_swagger2 ../../_testdata/positive/swagger2.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_item_schema ../../_testdata/positive/item_schema.yml
+//go:generate go run ../../cmd/ogen -v --clean --target test_querystring ../../_testdata/positive/querystring.yml
+//go:generate go run ../../cmd/ogen -v --clean --config _config/tag_groups.yml --target test_tag_groups ../../_testdata/positive/tag_groups.yml
+//go:generate go run ../../cmd/ogen -v --clean --config _config/split_packages.yml --target test_split_packages ../../_testdata/positive/tag_groups.yml
 //
 //go:generate go run ../../cmd/ogen -v --clean -target test_enum_naming       ../../_testdata/positive/enum_naming.yml
 //go:generate go run ../../cmd/ogen -v --clean -target test_naming_extensions ../../_testdata/positive/naming_extensions.json
@@ -0,0 +1,253 @@
+package integration_test
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_item_schema"
+)
+
+type testItemSchema struct {
+	// received is signaled by the client after every received log entry.
+	received chan struct{}
+	// invalid makes the server send an entry which fails validation.
+	invalid bool
+}
+
+var _ api.Handler = (*testItemSchema)(nil)
+
+func (t *testItemSchema) StreamEvents(ctx context.Context) (*api.StreamEventsOK, error) {
+	return api.NewStreamEventsOK(func(yield func(api.StreamEventsOKEvent, error) bool) {
+		for i := 1; i <= 2; i++ {
+			if !yield(api.StreamEventsOKEvent{
+				Event: "log",
+				Data:  api.LogEntry{Seq: i, Message: "event"},
+			}, nil) {
+				return
+			}
+		}
+	}), nil
+}
+
+func (t *testItemSchema) StreamLogs(ctx context.Context, params api.StreamLogsParams) (api.StreamLogsRes, error) {
+	if params.Count < 0 {
+		return &api.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response:   api.Error{Message: "negative count"},
+		}, nil
+	}
+	res := api.NewStreamLogsOK(func(yield func(api.LogEntry, error) bool) {
+		for i := 1; i <= params.Count; i++ {
+			entry := api.LogEntry{Seq: i, Message: "entry"}
+			if t.invalid {
+				entry.Message = ""
+			}
+			if !yield(entry, nil) {
+				return
+			}
+			if t.received != nil && i < params.Count {
+				// Do not produce the next entry until the previous one is received.
+				select {
+				case <-t.received:
+				case <-ctx.Done():
+					yield(api.LogEntry{}, ctx.Err())
+					return
+				}
+			}
+		}
+	})
+	return &res, nil
+}
+
+func (t *testItemSchema) StreamRecords(ctx context.Context) (*api.StreamRecordsOKHeaders, error) {
+	records := []api.StreamRecordsOKItem{
+		{Key: "a", Value: api.NewOptInt(1)},
+		{Key: "b"},
+	}
+	return &api.StreamRecordsOKHeaders{
+		XTotal: api.NewOptInt(len(records)),
+		Response: api.NewStreamRecordsOK(func(yield func(api.StreamRecordsOKItem, error) bool) {
+			for _, r := range records {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}),
+	}, nil
+}
+
+func (t *testItemSchema) UploadLogs(ctx context.Context, req api.UploadLogsReq) (*api.UploadResult, error) {
+	var count int
+	for entry, err := range req.All() {
+		if err != nil {
+			return nil, err
+		}
+		if entry.Seq != count+1 {
+			return nil, errors.Errorf("unexpected seq %d", entry.Seq)
+		}
+		count++
+	}
+	return &api.UploadResult{Count: count}, nil
+}
+
+func testItemSchemaServer(t *testing.T, h *testItemSchema) (*httptest.Server, *api.Client) {
+	t.Helper()
+
+	srv, err := api.NewServer(h)
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+	return s, client
+}
+
+func TestItemSchemaJSONLines(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Stream", func(t *testing.T) {
+		a := require.New(t)
+		h := &testItemSchema{received: make(chan struct{})}
+		_, client := testItemSchemaServer(t, h)
+
+		res, err := client.StreamLogs(ctx, api.StreamLogsParams{Count: 3})
+		a.NoError(err)
+		stream, ok := res.(*api.StreamLogsOK)
+		a.True(ok)
+		defer stream.Close()
+
+		// Server produces the next entry only after the previous one is received,
+		// so every entry must be flushed separately.
+		var seqs []int
+		for entry, err := range stream.All() {
+			a.NoError(err)
+			seqs = append(seqs, entry.Seq)
+			if entry.Seq < 3 {
+				h.received <- struct{}{}
+			}
+		}
+		a.Equal([]int{1, 2, 3}, seqs)
+
+		_, err = stream.Next()
+		a.ErrorIs(err, io.EOF)
+	})
+	t.Run("Error", func(t *testing.T) {
+		a := require.New(t)
+		_, client := testItemSchemaServer(t, &testItemSchema{})
+
+		res, err := client.StreamLogs(ctx, api.StreamLogsParams{Count: -1})
+		a.NoError(err)
+		a.Equal(&api.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response:   api.Error{Message: "negative count"},
+		}, res)
+	})
+	t.Run("Validation", func(t *testing.T) {
+		a := require.New(t)
+		_, client := testItemSchemaServer(t, &testItemSchema{invalid: true})
+
+		res, err := client.StreamLogs(ctx, api.StreamLogsParams{Count: 1})
+		a.NoError(err)
+		stream := res.(*api.StreamLogsOK)
+		defer stream.Close()
+
+		_, err = stream.Next()
+		a.ErrorContains(err, "validate item")
+	})
+	t.Run("Wire", func(t *testing.T) {
+		a := require.New(t)
+		s, _ := testItemSchemaServer(t, &testItemSchema{})
+
+		resp, err := s.Client().Get(s.URL + "/logs?count=2")
+		a.NoError(err)
+		defer resp.Body.Close()
+
+		a.Equal("application/jsonl", resp.Header.Get("Content-Type"))
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.Equal("{\"seq\":1,\"message\":\"entry\"}\n{\"seq\":2,\"message\":\"entry\"}\n", string(data))
+	})
+}
+
+func TestItemSchemaRequest(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+	_, client := testItemSchemaServer(t, &testItemSchema{})
+
+	entries := func(n int) iter.Seq2[api.LogEntry, error] {
+		return func(yield func(api.LogEntry, error) bool) {
+			for i := 1; i <= n; i++ {
+				if !yield(api.LogEntry{Seq: i, Message: "upload"}, nil) {
+					return
+				}
+			}
+		}
+	}
+
+	res, err := client.UploadLogs(ctx, api.NewUploadLogsReq(entries(5)))
+	a.NoError(err)
+	a.Equal(5, res.Count)
+
+	ch := make(chan api.LogEntry, 2)
+	ch <- api.LogEntry{Seq: 1, Message: "chan"}
+	ch <- api.LogEntry{Seq: 2, Message: "chan"}
+	close(ch)
+	res, err = client.UploadLogs(ctx, api.NewUploadLogsReqFromChan(ch))
+	a.NoError(err)
+	a.Equal(2, res.Count)
+}
+
+func TestItemSchemaJSONSeq(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+	s, client := testItemSchemaServer(t, &testItemSchema{})
+
+	res, err := client.StreamRecords(ctx)
+	a.NoError(err)
+	a.Equal(api.NewOptInt(2), res.XTotal)
+	defer res.Response.Close()
+
+	var records []api.StreamRecordsOKItem
+	for record, err := range res.Response.All() {
+		a.NoError(err)
+		records = append(records, record)
+	}
+	a.Equal([]api.StreamRecordsOKItem{
+		{Key: "a", Value: api.NewOptInt(1)},
+		{Key: "b"},
+	}, records)
+
+	resp, err := s.Client().Get(s.URL + "/records")
+	a.NoError(err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	a.NoError(err)
+	a.Equal("\x1e{\"key\":\"a\",\"value\":1}\n\x1e{\"key\":\"b\"}\n", string(data))
+}
+
+func TestItemSchemaSSE(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+	_, client := testItemSchemaServer(t, &testItemSchema{})
+
+	stream, err := client.StreamEvents(ctx)
+	a.NoError(err)
+	defer stream.Close()
+
+	for i := 1; i <= 2; i++ {
+		event, err := stream.Next(ctx)
+		a.NoError(err)
+		a.Equal("log", event.Event)
+		a.Equal(api.LogEntry{Seq: i, Message: "event"}, event.Data)
+	}
+}
@@ -0,0 +1,190 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_links"
+)
+
+type linksHandler struct {
+	mux   sync.Mutex
+	users []api.User
+}
+
+var _ api.Handler = (*linksHandler)(nil)
+
+func (h *linksHandler) CreateUser(ctx context.Context, req *api.NewUser, params api.CreateUserParams) (*api.UserHeaders, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	u := api.User{
+		ID:   int64(len(h.users) + 1),
+		Name: req.Name,
+		Team: req.Team,
+	}
+	h.users = append(h.users, u)
+	return &api.UserHeaders{
+		Location: fmt.Sprintf("/users/%d", u.ID),
+		Response: u,
+	}, nil
+}
+
+func (h *linksHandler) GetUser(ctx context.Context, params api.GetUserParams) (api.GetUserRes, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for _, u := range h.users {
+		if u.ID == params.ID {
+			return &u, nil
+		}
+	}
+	return &api.GetUserNoContent{}, nil
+}
+
+func (h *linksHandler) GetUserByLocation(ctx context.Context, params api.GetUserByLocationParams) (*api.User, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	for _, u := range h.users {
+		if params.Location == fmt.Sprintf("/users/%d", u.ID) {
+			return &u, nil
+		}
+	}
+	return nil, errors.Errorf("user %q not found", params.Location)
+}
+
+func (h *linksHandler) ListUsers(ctx context.Context, params api.ListUsersParams) (*api.UserPageHeaders, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+
+	var (
+		limit = params.Limit.Or(1)
+		start = 0
+	)
+	if c, ok := params.Cursor.Get(); ok {
+		n, err := strconv.Atoi(c)
+		if err != nil {
+			return nil, err
+		}
+		start = n
+	}
+
+	var page api.UserPageHeaders
+	for i := start; i < len(h.users); i++ {
+		u := h.users[i]
+		if team, ok := params.Team.Get(); ok && u.Team.Or("") != team {
+			continue
+		}
+		if len(page.Response.Users) == limit {
+			next := strconv.Itoa(i)
+			page.XNextCursor.SetTo(next)
+			page.Response.Next.SetTo(next)
+			break
+		}
+		page.Response.Users = append(page.Response.Users, u)
+	}
+	return &page, nil
+}
+
+func TestLinks(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := api.NewServer(&linksHandler{})
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+
+	t.Run("CreateThenFetch", func(t *testing.T) {
+		a := require.New(t)
+
+		req := &api.NewUser{Name: "alice", Team: api.NewOptString("core")}
+		created, err := client.CreateUser(ctx, req, api.CreateUserParams{XTenant: "acme"})
+		a.NoError(err)
+
+		params, err := api.CreateUserGetUserLink(created)
+		a.NoError(err)
+		a.Equal(created.Response.ID, params.ID)
+
+		got, err := client.GetUser(ctx, params)
+		a.NoError(err)
+		a.Equal(&created.Response, got)
+
+		byLocation, err := api.CreateUserGetUserByLocationLink(created)
+		a.NoError(err)
+		a.Equal(created.Location, byLocation.Location)
+
+		user, err := client.GetUserByLocation(ctx, byLocation)
+		a.NoError(err)
+		a.Equal(created.Response, *user)
+
+		// Link parameters may be taken from the original request.
+		team, err := api.CreateUserListTeamLink(created, req, api.CreateUserParams{XTenant: "acme"})
+		a.NoError(err)
+		a.Equal(api.ListUsersParams{
+			Team:   api.NewOptString("core"),
+			Tenant: api.NewOptString("acme"),
+			Limit:  api.NewOptInt(10),
+		}, team)
+	})
+	t.Run("Pagination", func(t *testing.T) {
+		a := require.New(t)
+
+		for _, name := range []string{"bob", "carol", "dave"} {
+			_, err := client.CreateUser(ctx, &api.NewUser{
+				Name: name,
+				Team: api.NewOptString("infra"),
+			}, api.CreateUserParams{XTenant: "acme"})
+			a.NoError(err)
+		}
+
+		var (
+			names  []string
+			params = api.ListUsersParams{Team: api.NewOptString("infra")}
+		)
+		for {
+			page, err := client.ListUsers(ctx, params)
+			a.NoError(err)
+			for _, u := range page.Response.Users {
+				names = append(names, u.Name)
+			}
+			if !page.XNextCursor.IsSet() {
+				break
+			}
+
+			// Both links must point to the same page.
+			byBody, err := api.ListUsersNextPageByBodyLink(page)
+			a.NoError(err)
+			a.Equal(page.Response.Next, byBody.Cursor)
+
+			params, err = api.ListUsersNextPageLink(page, params)
+			a.NoError(err)
+			a.Equal(byBody.Cursor, params.Cursor)
+		}
+		a.Equal([]string{"bob", "carol", "dave"}, names)
+	})
+	t.Run("NoContent", func(t *testing.T) {
+		a := require.New(t)
+
+		params, err := api.GetUserGetUserByIDLink(&api.GetUserNoContent{}, api.GetUserParams{ID: 10})
+		a.NoError(err)
+		a.Equal(api.GetUserParams{ID: 10}, params)
+	})
+	t.Run("NilResponse", func(t *testing.T) {
+		a := require.New(t)
+
+		_, err := api.CreateUserGetUserLink(nil)
+		a.EqualError(err, "$response.body#/id: value is nil")
+	})
+}
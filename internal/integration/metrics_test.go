@@ -21,6 +21,7 @@ import (
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/ogen-go/ogen/otelogen"
+	"github.com/ogen-go/ogen/otelogen/metrictest"
 )
 
 type metricsTestHandler struct {
@@ -35,6 +36,14 @@ func (h metricsTestHandler) PetGetByName(ctx context.Context, params api.PetGetB
 	return nil, context.DeadlineExceeded
 }
 
+func (h metricsTestHandler) PetUpdateNamePost(ctx context.Context, req api.OptString) (*api.PetUpdateNamePostDef, error) {
+	return &api.PetUpdateNamePostDef{StatusCode: http.StatusOK}, nil
+}
+
+func (h metricsTestHandler) SecurityTest(ctx context.Context) (string, error) {
+	return "ok", nil
+}
+
 func (h metricsTestHandler) HandleAPIKey(ctx context.Context, operationName string, t api.APIKey) (context.Context, error) {
 	return ctx, nil
 }
@@ -47,6 +56,14 @@ func (h metricsTestHandler) APIKey(ctx context.Context, operationName string) (a
 
 var _ api.Handler = metricsTestHandler{}
 
+type metricsDenyHandler struct {
+	metricsTestHandler
+}
+
+func (h metricsDenyHandler) HandleAPIKey(ctx context.Context, operationName string, t api.APIKey) (context.Context, error) {
+	return ctx, errors.New("access denied")
+}
+
 var (
 	_ api.SecurityHandler = metricsTestHandler{}
 	_ api.SecuritySource  = metricsTestHandler{}
@@ -144,6 +161,17 @@ func TestServerMetrics(t *testing.T) {
 						Temporality: metricdata.CumulativeTemporality,
 					},
 				},
+				activeRequestsMetric("ogen.server.active_requests", "Incoming requests in flight",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/name/{id}"),
+				),
+				bodySizeMetric("ogen.server.response_body_size", "Outgoing response body size",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/name/{id}"),
+					attribute.Int("http.response.status_code", 200),
+				),
 			},
 		},
 		{
@@ -204,6 +232,7 @@ func TestServerMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petGetByName"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("http.route", "/pet/{name}"),
+									attribute.String("error.type", "timeout"),
 									attribute.Int("http.response.status_code", 500),
 								),
 							},
@@ -212,6 +241,17 @@ func TestServerMetrics(t *testing.T) {
 						IsMonotonic: true,
 					},
 				},
+				activeRequestsMetric("ogen.server.active_requests", "Incoming requests in flight",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/{name}"),
+				),
+				bodySizeMetric("ogen.server.response_body_size", "Outgoing response body size",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/{name}"),
+					attribute.Int("http.response.status_code", 500),
+				),
 			},
 		},
 		{
@@ -264,6 +304,18 @@ func TestServerMetrics(t *testing.T) {
 						Temporality: metricdata.CumulativeTemporality,
 					},
 				},
+				activeRequestsMetric("ogen.server.active_requests", "Incoming requests in flight",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/name/{id}"),
+				),
+				bodySizeMetric("ogen.server.response_body_size", "Outgoing response body size",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/name/{id}"),
+					attribute.Int("pet_id", 1),
+					attribute.Int("http.response.status_code", 200),
+				),
 			},
 		},
 		{
@@ -340,6 +392,19 @@ func TestServerMetrics(t *testing.T) {
 						IsMonotonic: true,
 					},
 				},
+				activeRequestsMetric("ogen.server.active_requests", "Incoming requests in flight",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/{name}"),
+				),
+				bodySizeMetric("ogen.server.response_body_size", "Outgoing response body size",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("http.route", "/pet/{name}"),
+					attribute.String("pet_name", "Fluffy"),
+					attribute.String("error.type", "timeout"),
+					attribute.Int("http.response.status_code", 500),
+				),
 			},
 		},
 	}
@@ -507,6 +572,7 @@ func TestServerMetricsWithAttributes(t *testing.T) {
 										attribute.String("http.route", "/pet/name/{id}"),
 										// Custom attribute from WithAttributes - must be present!
 										attribute.String("server_name", "test-server"),
+										attribute.String("error.type", "decode_params"),
 										attribute.Int("http.response.status_code", 400),
 									),
 								},
@@ -515,6 +581,19 @@ func TestServerMetricsWithAttributes(t *testing.T) {
 							IsMonotonic: true,
 						},
 					},
+					activeRequestsMetric("ogen.server.active_requests", "Incoming requests in flight",
+						attribute.String("oas.operation", "petNameByID"),
+						attribute.String("http.request.method", "GET"),
+						attribute.String("http.route", "/pet/name/{id}"),
+						attribute.String("server_name", "test-server"),
+					),
+					bodySizeMetric("ogen.server.response_body_size", "Outgoing response body size",
+						attribute.String("oas.operation", "petNameByID"),
+						attribute.String("http.request.method", "GET"),
+						attribute.String("http.route", "/pet/name/{id}"),
+						attribute.String("server_name", "test-server"),
+						attribute.Int("http.response.status_code", 400),
+					),
 				},
 			},
 		},
@@ -555,6 +634,7 @@ func TestClientMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petNameByID"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("url.template", "/pet/name/{id}"),
+									attribute.Int("http.response.status_code", 200),
 								),
 							},
 						},
@@ -573,12 +653,24 @@ func TestClientMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petNameByID"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("url.template", "/pet/name/{id}"),
+									attribute.Int("http.response.status_code", 200),
 								),
 							},
 						},
 						Temporality: metricdata.CumulativeTemporality,
 					},
 				},
+				activeRequestsMetric("ogen.client.active_requests", "Outgoing requests in flight",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("url.template", "/pet/name/{id}"),
+				),
+				bodySizeMetric("ogen.client.response_body_size", "Incoming response body size",
+					attribute.String("oas.operation", "petNameByID"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("url.template", "/pet/name/{id}"),
+					attribute.Int("http.response.status_code", 200),
+				),
 			},
 		},
 		{
@@ -602,6 +694,7 @@ func TestClientMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petGetByName"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("url.template", "/pet/{name}"),
+									attribute.Int("http.response.status_code", 500),
 								),
 							},
 						},
@@ -620,6 +713,7 @@ func TestClientMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petGetByName"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("url.template", "/pet/{name}"),
+									attribute.Int("http.response.status_code", 500),
 								),
 							},
 						},
@@ -637,6 +731,8 @@ func TestClientMetrics(t *testing.T) {
 									attribute.String("oas.operation", "petGetByName"),
 									attribute.String("http.request.method", "GET"),
 									attribute.String("url.template", "/pet/{name}"),
+									attribute.String("error.type", "unexpected_status_code"),
+									attribute.Int("http.response.status_code", 500),
 								),
 							},
 						},
@@ -644,6 +740,17 @@ func TestClientMetrics(t *testing.T) {
 						IsMonotonic: true,
 					},
 				},
+				activeRequestsMetric("ogen.client.active_requests", "Outgoing requests in flight",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("url.template", "/pet/{name}"),
+				),
+				bodySizeMetric("ogen.client.response_body_size", "Incoming response body size",
+					attribute.String("oas.operation", "petGetByName"),
+					attribute.String("http.request.method", "GET"),
+					attribute.String("url.template", "/pet/{name}"),
+					attribute.Int("http.response.status_code", 500),
+				),
 			},
 		},
 	}
@@ -705,3 +812,120 @@ func TestClientMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, sec api.SecurityHandler) (server, client *metrictest.Reader, c *api.Client) {
+		server = metrictest.NewReader(t)
+		client = metrictest.NewReader(t)
+
+		h, err := api.NewServer(metricsTestHandler{}, sec,
+			api.WithMeterProvider(server.MeterProvider()),
+		)
+		require.NoError(t, err)
+
+		s := httptest.NewServer(h)
+		t.Cleanup(s.Close)
+
+		c, err = api.NewClient(s.URL, metricsTestHandler{},
+			api.WithClient(s.Client()),
+			api.WithMeterProvider(client.MeterProvider()),
+		)
+		require.NoError(t, err)
+		return server, client, c
+	}
+
+	t.Run("BodySize", func(t *testing.T) {
+		a := require.New(t)
+		server, client, c := setup(t, metricsTestHandler{})
+
+		_, err := c.PetUpdateNamePost(ctx, api.NewOptString("Fluffy"))
+		a.NoError(err)
+
+		route := attribute.String("http.route", "/pet/updateName")
+		sm := server.Collect(t)
+		a.Equal(int64(1), sm.Sum(otelogen.ServerRequestCount, route, attribute.Int("http.response.status_code", 200)))
+		a.Equal(uint64(1), sm.HistogramCount(otelogen.ServerDuration, route))
+		a.Equal(float64(len(`"Fluffy"`)), sm.HistogramSum(otelogen.ServerRequestBodySize, route))
+		a.Equal(uint64(1), sm.HistogramCount(otelogen.ServerResponseBodySize, route))
+		a.Zero(sm.Sum(otelogen.ServerActiveRequests, route))
+		a.False(sm.Has(otelogen.ServerErrorsCount))
+
+		template := attribute.String("url.template", "/pet/updateName")
+		cm := client.Collect(t)
+		a.Equal(int64(1), cm.Sum(otelogen.ClientRequestCount, template, attribute.Int("http.response.status_code", 200)))
+		a.Equal(float64(len(`"Fluffy"`)), cm.HistogramSum(otelogen.ClientRequestBodySize, template))
+		a.Equal(uint64(1), cm.HistogramCount(otelogen.ClientResponseBodySize, template))
+		a.Zero(cm.Sum(otelogen.ClientActiveRequests, template))
+		a.False(cm.Has(otelogen.ClientErrorsCount))
+	})
+	t.Run("Validation", func(t *testing.T) {
+		a := require.New(t)
+		server, client, c := setup(t, metricsTestHandler{})
+
+		// Name is too short, request is rejected before sending.
+		_, err := c.PetUpdateNamePost(ctx, api.NewOptString("Fluf"))
+		a.Error(err)
+
+		a.False(server.Collect(t).Has(otelogen.ServerRequestCount))
+
+		cm := client.Collect(t)
+		a.Equal(int64(1), cm.Sum(otelogen.ClientErrorsCount,
+			attribute.String("url.template", "/pet/updateName"),
+			attribute.String("error.type", "validation"),
+		))
+		a.Zero(cm.HistogramCount(otelogen.ClientResponseBodySize))
+	})
+	t.Run("Security", func(t *testing.T) {
+		a := require.New(t)
+		server, client, c := setup(t, metricsDenyHandler{})
+
+		_, err := c.SecurityTest(ctx)
+		a.Error(err)
+
+		route := attribute.String("http.route", "/securityTest")
+		sm := server.Collect(t)
+		a.Equal(int64(1), sm.Sum(otelogen.ServerErrorsCount,
+			route,
+			attribute.String("error.type", "security"),
+			attribute.String("oas.security.scheme", "APIKey"),
+			attribute.Int("http.response.status_code", 401),
+		))
+
+		cm := client.Collect(t)
+		a.Equal(int64(1), cm.Sum(otelogen.ClientErrorsCount,
+			attribute.String("url.template", "/securityTest"),
+			attribute.String("error.type", "unexpected_status_code"),
+			attribute.Int("http.response.status_code", 401),
+		))
+	})
+}
+
+func activeRequestsMetric(name, description string, attrs ...attribute.KeyValue) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name:        name,
+		Description: description,
+		Unit:        "{request}",
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(attrs...)},
+			},
+			Temporality: metricdata.CumulativeTemporality,
+		},
+	}
+}
+
+func bodySizeMetric(name, description string, attrs ...attribute.KeyValue) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name:        name,
+		Description: description,
+		Unit:        "By",
+		Data: metricdata.Histogram[int64]{
+			DataPoints: []metricdata.HistogramDataPoint[int64]{
+				{Attributes: attribute.NewSet(attrs...)},
+			},
+			Temporality: metricdata.CumulativeTemporality,
+		},
+	}
+}
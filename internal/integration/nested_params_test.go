@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_nested_params"
+)
+
+type nestedParamsHandler struct{}
+
+var _ api.Handler = nestedParamsHandler{}
+
+func (nestedParamsHandler) ListProducts(ctx context.Context, params api.ListProductsParams) (*api.Query, error) {
+	return &api.Query{
+		Filter: params.Filter,
+		Sort:   params.Sort,
+		Point:  params.Point,
+		Area:   params.Area,
+	}, nil
+}
+
+func TestNestedParams(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := api.NewServer(nestedParamsHandler{})
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	defer s.Close()
+
+	t.Run("Client", func(t *testing.T) {
+		a := require.New(t)
+
+		client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+		a.NoError(err)
+
+		params := api.ListProductsParams{
+			Filter: api.NewOptFilter(api.Filter{
+				Price: api.NewOptRange(api.Range{
+					Gte: api.NewOptInt(10),
+					Lte: api.NewOptInt(20),
+				}),
+				Tags: []string{"a", "b"},
+				Items: []api.Item{
+					{Name: "shirt", Sizes: []int{1, 2}},
+					{Name: "hat"},
+				},
+				Attrs: api.NewOptFilterAttrs(api.FilterAttrs{
+					"color": {"red", "blue"},
+				}),
+			}),
+			Sort: api.NewOptSort(api.Sort{
+				By:    []string{"price", "name"},
+				Order: api.NewOptOrder(api.Order{Desc: api.NewOptBool(true)}),
+			}),
+			Point: api.NewOptPoint(api.Point{X: 1, Y: 2}),
+			Area:  api.NewOptPoint(api.Point{X: 3, Y: 4}),
+		}
+		result, err := client.ListProducts(ctx, params)
+		a.NoError(err)
+		a.Equal(params.Filter, result.Filter)
+		a.Equal(params.Sort, result.Sort)
+		a.Equal(params.Point, result.Point)
+		a.Equal(params.Area, result.Area)
+	})
+	t.Run("Server", func(t *testing.T) {
+		for i, tt := range []struct {
+			Query  string
+			Status int
+		}{
+			// Rails convention.
+			{"filter[price][gte]=10&filter[tags][]=a&filter[tags][]=b&filter[items][0][name]=shirt", http.StatusOK},
+			// PHP convention.
+			{"filter[tags][0]=a&filter[tags][1]=b&filter[items][0][name]=shirt&filter[items][0][sizes][]=1", http.StatusOK},
+			{"sort[by][]=price&sort[order][desc]=true", http.StatusOK},
+			{"point=x+1+y+2&area=x|1|y|2", http.StatusOK},
+			// Missing required field of the array item.
+			{"filter[items][0][sizes][]=1", http.StatusBadRequest},
+			// Object instead of value.
+			{"filter[price][gte][eq]=10", http.StatusBadRequest},
+		} {
+			resp, err := s.Client().Get(s.URL + "/products?" + tt.Query)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			require.Equal(t, tt.Status, resp.StatusCode, "test %d: %s", i+1, tt.Query)
+		}
+	})
+}
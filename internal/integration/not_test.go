@@ -0,0 +1,101 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_not"
+	"github.com/ogen-go/ogen/validate"
+)
+
+type notHandler struct{}
+
+var _ api.Handler = notHandler{}
+
+func (notHandler) CreateUser(ctx context.Context, req *api.User) (api.Value, error) {
+	return api.NewStringValue(req.Login), nil
+}
+
+func TestNot(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		for i, tt := range []struct {
+			Input  api.User
+			Failed []string
+		}{
+			{api.User{Login: "alice", Role: api.UserRoleUser}, nil},
+			{api.User{
+				Login:    "alice",
+				Nickname: api.NewOptString("ally"),
+				Role:     api.UserRoleGuest,
+				Age:      api.NewOptInt(30),
+				Email:    api.NewOptString("alice@example.com"),
+			}, nil},
+			{api.User{Login: "root", Role: api.UserRoleUser}, []string{"/login"}},
+			{api.User{Login: "alice", Role: "admin"}, []string{"/role"}},
+			{api.User{
+				Login:    "alice",
+				Nickname: api.NewOptString("_ally"),
+				Role:     api.UserRoleUser,
+				Age:      api.NewOptInt(12),
+			}, []string{"/nickname", "/age"}},
+			{api.User{
+				Login: "alice",
+				Role:  api.UserRoleUser,
+				Email: api.NewOptString("alice@example.com"),
+				Phone: api.NewOptString("+1 555 0100"),
+			}, []string{""}},
+		} {
+			err := tt.Input.Validate()
+			if len(tt.Failed) == 0 {
+				require.NoError(t, err, "test %d", i+1)
+				continue
+			}
+			var pointers []string
+			for _, f := range validate.Failures(err) {
+				pointers = append(pointers, f.Pointer)
+			}
+			require.Equal(t, tt.Failed, pointers, "test %d", i+1)
+		}
+
+		failures := validate.Failures((&api.User{Login: "admin", Role: api.UserRoleUser}).Validate())
+		require.Len(t, failures, 1)
+		require.ErrorIs(t, failures[0].Err, validate.ErrNotAllowed)
+		require.EqualError(t, failures[0].Err, `value admin: not allowed by "not" schema`)
+	})
+	t.Run("Variants", func(t *testing.T) {
+		for _, input := range []string{`"a"`, `1`, `true`} {
+			var v api.Value
+			require.NoError(t, v.Decode(jx.DecodeStr(input)), input)
+		}
+
+		var v api.Value
+		require.Error(t, v.Decode(jx.DecodeStr(`{"id":1}`)))
+	})
+	t.Run("Server", func(t *testing.T) {
+		srv, err := api.NewServer(notHandler{})
+		require.NoError(t, err)
+
+		s := httptest.NewServer(srv)
+		defer s.Close()
+
+		for _, tt := range []struct {
+			Body   string
+			Status int
+		}{
+			{`{"login":"alice","role":"user"}`, http.StatusOK},
+			{`{"login":"root","role":"user"}`, http.StatusBadRequest},
+			{`{"login":"alice","role":"admin"}`, http.StatusBadRequest},
+		} {
+			resp, err := s.Client().Post(s.URL+"/users", "application/json", strings.NewReader(tt.Body))
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			require.Equal(t, tt.Status, resp.StatusCode, tt.Body)
+		}
+	})
+}
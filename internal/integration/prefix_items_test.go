@@ -0,0 +1,189 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_prefix_items"
+	"github.com/ogen-go/ogen/validate"
+)
+
+type prefixItemsHandler struct{}
+
+var _ api.Handler = prefixItemsHandler{}
+
+func (prefixItemsHandler) Track(ctx context.Context, req *api.Track) (*api.Track, error) {
+	return req, nil
+}
+
+func TestPrefixItemsJSON(t *testing.T) {
+	t.Run("Point", func(t *testing.T) {
+		for i, tc := range []struct {
+			Input     string
+			Expected  api.Point
+			ExpectErr bool
+		}{
+			{`[52.5, 13.4]`, api.Point{Lat: 52.5, Lon: 13.4}, false},
+			{`[]`, api.Point{}, true},
+			{`[52.5]`, api.Point{}, true},
+			// Additional items are not allowed.
+			{`[52.5, 13.4, 1]`, api.Point{}, true},
+			{`["52.5", 13.4]`, api.Point{}, true},
+		} {
+			t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+				r := api.Point{}
+				err := r.Decode(jx.DecodeStr(tc.Input))
+				if tc.ExpectErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.Expected, r)
+				testEncode(t, &r, tc.Input)
+			})
+		}
+	})
+	t.Run("Sample", func(t *testing.T) {
+		ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		for i, tc := range []struct {
+			Input     string
+			Expected  api.Sample
+			ExpectErr bool
+		}{
+			{`["2024-01-02T03:04:05Z", 1.5]`, api.Sample{V0: ts, V1: 1.5}, false},
+			{`["2024-01-02T03:04:05Z", 1.5, "a", "b"]`, api.Sample{
+				V0:   ts,
+				V1:   1.5,
+				Rest: []string{"a", "b"},
+			}, false},
+			// minItems requires both prefix items.
+			{`["2024-01-02T03:04:05Z"]`, api.Sample{}, true},
+			{`["2024-01-02T03:04:05Z", 1.5, 1]`, api.Sample{}, true},
+		} {
+			t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+				r := api.Sample{}
+				err := r.Decode(jx.DecodeStr(tc.Input))
+				if tc.ExpectErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.Expected, r)
+				testEncode(t, &r, tc.Input)
+			})
+		}
+	})
+	t.Run("Label", func(t *testing.T) {
+		for i, tc := range []struct {
+			Input     string
+			Expected  api.Label
+			ExpectErr bool
+		}{
+			{`["env", 1]`, api.Label{V0: "env", V1: 1}, false},
+			// Absent items allows any additional items.
+			{`["env", 1, true, {"a":1}]`, api.Label{
+				V0:   "env",
+				V1:   1,
+				Rest: []jx.Raw{jx.Raw(`true`), jx.Raw(`{"a":1}`)},
+			}, false},
+			// Prefix items are not required without minItems.
+			{`["env"]`, api.Label{V0: "env"}, false},
+			{`[]`, api.Label{}, false},
+			{`[1, 1]`, api.Label{}, true},
+		} {
+			t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+				r := api.Label{}
+				err := r.Decode(jx.DecodeStr(tc.Input))
+				if tc.ExpectErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.Expected, r)
+			})
+		}
+	})
+	t.Run("ResetRest", func(t *testing.T) {
+		r := api.Sample{Rest: []string{"stale"}}
+		require.NoError(t, r.Decode(jx.DecodeStr(`["2024-01-02T03:04:05Z", 1.5]`)))
+		require.Empty(t, r.Rest)
+
+		l := api.Label{V0: "stale", V1: 1}
+		require.NoError(t, l.Decode(jx.DecodeStr(`["env"]`)))
+		require.Equal(t, api.Label{V0: "env"}, l)
+	})
+}
+
+func TestPrefixItemsValidate(t *testing.T) {
+	for i, tc := range []struct {
+		Input interface{ Validate() error }
+		Error bool
+	}{
+		{&api.Point{Lat: 52.5, Lon: 13.4}, false},
+		{&api.Point{Lat: 91, Lon: 13.4}, true},
+		{&api.Point{Lat: 52.5, Lon: -181}, true},
+		{&api.Sample{V1: 1, Rest: []string{"short"}}, false},
+		{&api.Sample{V1: 1, Rest: []string{"too long value"}}, true},
+		{&api.Track{Points: []api.Point{{Lat: 100}}}, true},
+	} {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			err := tc.Input.Validate()
+			if tc.Error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrefixItemsServer(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := api.NewServer(prefixItemsHandler{})
+	require.NoError(t, err)
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+
+	req := &api.Track{
+		Name:   api.NewOptString("morning run"),
+		Points: []api.Point{{Lat: 52.5, Lon: 13.4}, {Lat: 52.6, Lon: 13.5}},
+		Samples: []api.Sample{{
+			V0:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			V1:   72,
+			Rest: []string{"bpm"},
+		}},
+	}
+	resp, err := client.Track(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, req, resp)
+}
+
+func TestPrefixItemsValidationFailures(t *testing.T) {
+	input := &api.Track{
+		Points: []api.Point{
+			{Lat: 52.5, Lon: 13.4},
+			{Lat: 100, Lon: 200},
+		},
+		Samples: []api.Sample{{V1: 1, Rest: []string{"ok", "too long value"}}},
+	}
+
+	var pointers []string
+	for _, f := range validate.Failures(input.Validate()) {
+		pointers = append(pointers, f.Pointer)
+	}
+	require.Equal(t, []string{
+		"/points/1/0",
+		"/points/1/1",
+		"/samples/0/3",
+	}, pointers)
+}
@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_conditional"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+func TestProblemErrorHandler(t *testing.T) {
+	srv, err := api.NewServer(conditionalHandler{},
+		api.WithErrorHandler(ogenerrors.ProblemErrorHandler()),
+	)
+	require.NoError(t, err)
+
+	s := httptest.NewServer(srv)
+	defer s.Close()
+
+	resp, err := s.Client().Post(s.URL+"/billing", "application/json",
+		strings.NewReader(`{"name":"a","street":"Main St","city":"Springfield"}`))
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, ogenerrors.ProblemContentType, resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"title": "Bad Request",
+		"status": 400,
+		"detail": "operation UpdateBilling: decode request: validate: invalid: postal_code (field required when \"street\" is present)",
+		"instance": "/billing",
+		"invalid_params": [
+			{
+				"name": "postal_code",
+				"in": "body",
+				"pointer": "/postal_code",
+				"reason": "field required when \"street\" is present"
+			}
+		],
+		"operation": "UpdateBilling"
+	}`, string(body))
+}
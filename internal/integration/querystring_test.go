@@ -0,0 +1,188 @@
+package integration_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_querystring"
+)
+
+type testQueryString struct {
+	// rawQuery is the last received raw query string.
+	rawQuery string
+}
+
+var _ api.Handler = (*testQueryString)(nil)
+
+func (t *testQueryString) Lookup(ctx context.Context, params api.LookupParams) (*api.LookupResult, error) {
+	return &api.LookupResult{
+		Kind:  params.Kind,
+		Query: params.Query,
+	}, nil
+}
+
+func (t *testQueryString) Search(ctx context.Context, params api.SearchParams) (*api.SearchResult, error) {
+	return &api.SearchResult{
+		Filter:    params.Filter,
+		RequestID: params.XRequestID,
+	}, nil
+}
+
+func testQueryStringServer(t *testing.T) (*httptest.Server, *api.Client, *testQueryString) {
+	t.Helper()
+
+	h := &testQueryString{}
+	srv, err := api.NewServer(h)
+	require.NoError(t, err)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.rawQuery = r.URL.RawQuery
+		srv.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+	return s, client, h
+}
+
+func TestQueryStringForm(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Client", func(t *testing.T) {
+		a := require.New(t)
+		_, client, h := testQueryStringServer(t)
+
+		filter := api.SearchFilter{
+			Q:     "ogen go",
+			Limit: api.NewOptInt(10),
+			Tags:  []string{"a", "b"},
+			Range: api.NewOptSearchFilterRange(api.SearchFilterRange{
+				Min: api.NewOptInt(1),
+			}),
+		}
+		res, err := client.Search(ctx, api.SearchParams{
+			Filter:     filter,
+			XRequestID: api.NewOptString("id"),
+		})
+		a.NoError(err)
+		a.Equal(filter, res.Filter)
+		a.Equal(api.NewOptString("id"), res.RequestID)
+
+		query, err := url.ParseQuery(h.rawQuery)
+		a.NoError(err)
+		a.Equal(url.Values{
+			"q":          {"ogen go"},
+			"limit":      {"10"},
+			"tags[]":     {"a", "b"},
+			"range[min]": {"1"},
+		}, query)
+	})
+	t.Run("Server", func(t *testing.T) {
+		a := require.New(t)
+		s, _, _ := testQueryStringServer(t)
+
+		resp, err := s.Client().Get(s.URL + "/search?q=foo&tags=a&tags=b&range[max]=5")
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal(http.StatusOK, resp.StatusCode)
+
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.JSONEq(`{"filter":{"q":"foo","tags":["a","b"],"range":{"max":5}}}`, string(data))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		s, _, _ := testQueryStringServer(t)
+
+		for _, query := range []string{
+			// Query string is required.
+			"",
+			// Required field is missing.
+			"?limit=1",
+			// Validation error.
+			"?q=foo&limit=0",
+		} {
+			t.Run(query, func(t *testing.T) {
+				a := require.New(t)
+
+				resp, err := s.Client().Get(s.URL + "/search" + query)
+				a.NoError(err)
+				defer resp.Body.Close()
+				a.Equal(http.StatusBadRequest, resp.StatusCode)
+			})
+		}
+	})
+}
+
+func TestQueryStringJSON(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Client", func(t *testing.T) {
+		a := require.New(t)
+		_, client, h := testQueryStringServer(t)
+
+		query := api.LookupQuery{
+			Ids:  []int{1, 2},
+			Name: api.NewOptString("a&b c+d"),
+		}
+		res, err := client.Lookup(ctx, api.LookupParams{
+			Kind:  "user",
+			Query: api.NewOptLookupQuery(query),
+		})
+		a.NoError(err)
+		a.Equal("user", res.Kind)
+		a.Equal(api.NewOptLookupQuery(query), res.Query)
+
+		a.NotContains(h.rawQuery, "+")
+		raw, err := url.PathUnescape(h.rawQuery)
+		a.NoError(err)
+		a.JSONEq(`{"ids":[1,2],"name":"a&b c+d"}`, raw)
+	})
+	t.Run("Optional", func(t *testing.T) {
+		a := require.New(t)
+		_, client, h := testQueryStringServer(t)
+
+		res, err := client.Lookup(ctx, api.LookupParams{Kind: "user"})
+		a.NoError(err)
+		a.False(res.Query.Set)
+		a.Empty(h.rawQuery)
+	})
+	t.Run("Server", func(t *testing.T) {
+		a := require.New(t)
+		s, _, _ := testQueryStringServer(t)
+
+		resp, err := s.Client().Get(s.URL + "/lookup/user?" + url.QueryEscape(`{"ids":[3]}`))
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal(http.StatusOK, resp.StatusCode)
+
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.JSONEq(`{"kind":"user","query":{"ids":[3]}}`, string(data))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		s, _, _ := testQueryStringServer(t)
+
+		for _, query := range []string{
+			// Not a JSON.
+			"ids=1",
+			// Validation error.
+			url.QueryEscape(`{"ids":[1,2,3,4]}`),
+		} {
+			t.Run(query, func(t *testing.T) {
+				a := require.New(t)
+
+				resp, err := s.Client().Get(s.URL + "/lookup/user?" + query)
+				a.NoError(err)
+				defer resp.Body.Close()
+				a.Equal(http.StatusBadRequest, resp.StatusCode)
+			})
+		}
+	})
+}
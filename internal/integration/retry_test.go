@@ -0,0 +1,212 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_retry"
+)
+
+type retryHandler struct {
+	mux   sync.Mutex
+	items []api.Item
+}
+
+var _ api.Handler = (*retryHandler)(nil)
+
+func (h *retryHandler) CountItems(ctx context.Context) (int, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return len(h.items), nil
+}
+
+func (h *retryHandler) CreateItem(ctx context.Context, req *api.Item) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.items = append(h.items, *req)
+	return nil
+}
+
+func (h *retryHandler) ImportItems(ctx context.Context, req *api.ImportItemsReq) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.items = append(h.items, api.Item{Name: req.Source})
+	return nil
+}
+
+func (h *retryHandler) ListItems(ctx context.Context) ([]api.Item, error) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return h.items, nil
+}
+
+func (h *retryHandler) ReplaceItems(ctx context.Context, req []api.Item) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.items = req
+	return nil
+}
+
+// flakyServer fails first requests of every operation with 503.
+type flakyServer struct {
+	next     http.Handler
+	failures int
+
+	mux      sync.Mutex
+	attempts map[string]int
+	bodies   map[string][]string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := r.Method + " " + r.URL.Path
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mux.Lock()
+	s.attempts[key]++
+	s.bodies[key] = append(s.bodies[key], string(body))
+	attempt := s.attempts[key]
+	s.mux.Unlock()
+
+	if attempt <= s.failures {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	s.next.ServeHTTP(w, r)
+}
+
+func (s *flakyServer) reset(failures int) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.failures = failures
+	s.attempts = map[string]int{}
+	s.bodies = map[string][]string{}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := api.NewServer(&retryHandler{})
+	require.NoError(t, err)
+
+	flaky := &flakyServer{next: srv}
+	s := httptest.NewServer(flaky)
+	defer s.Close()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	defer func() {
+		require.NoError(t, tp.Shutdown(ctx))
+	}()
+
+	client, err := api.NewClient(s.URL,
+		api.WithClient(s.Client()),
+		api.WithTracerProvider(tp),
+		api.WithRetry(api.RetryPolicy{
+			MaxAttempts: 3,
+			MinDelay:    time.Millisecond,
+			MaxDelay:    10 * time.Millisecond,
+		}),
+	)
+	require.NoError(t, err)
+
+	t.Run("SafeMethod", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(2)
+		exp.Reset()
+
+		_, err := client.ListItems(ctx)
+		a.NoError(err)
+		a.Equal(3, flaky.attempts["GET /items"])
+
+		spans := exp.GetSpans()
+		a.Len(spans, 1)
+		events := spans[0].Events
+		a.Len(events, 2)
+		for i, e := range events {
+			a.Equal("retry", e.Name)
+			a.Contains(e.Attributes, semconv.HTTPRequestResendCount(i+1))
+			a.Contains(e.Attributes, semconv.HTTPResponseStatusCode(http.StatusServiceUnavailable))
+		}
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(3)
+
+		_, err := client.ListItems(ctx)
+		a.Error(err)
+		a.Equal(3, flaky.attempts["GET /items"])
+	})
+	t.Run("UnsafeMethod", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(1)
+
+		err := client.CreateItem(ctx, &api.Item{Name: "a"})
+		a.Error(err)
+		a.Equal(1, flaky.attempts["POST /items"])
+	})
+	t.Run("OptOut", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(1)
+
+		_, err := client.CountItems(ctx)
+		a.Error(err)
+		a.Equal(1, flaky.attempts["GET /items/count"])
+	})
+	t.Run("StreamingBody", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(1)
+
+		err := client.ReplaceItems(ctx, []api.Item{{Name: "a"}, {Name: "b"}})
+		a.NoError(err)
+
+		bodies := flaky.bodies["PUT /items"]
+		a.Len(bodies, 2)
+		a.JSONEq(`[{"name":"a"},{"name":"b"}]`, bodies[0])
+		a.Equal(bodies[0], bodies[1])
+	})
+	t.Run("MultipartBody", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(1)
+
+		err := client.ImportItems(ctx, &api.ImportItemsReq{Source: "c"})
+		a.NoError(err)
+
+		bodies := flaky.bodies["POST /items/import"]
+		a.Len(bodies, 2)
+		a.Contains(bodies[0], `name="source"`)
+		a.Equal(bodies[0], bodies[1])
+
+		items, err := client.ListItems(ctx)
+		a.NoError(err)
+		a.Equal([]api.Item{{Name: "a"}, {Name: "b"}, {Name: "c"}}, items)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		a := require.New(t)
+		flaky.reset(1)
+
+		client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+		a.NoError(err)
+
+		_, err = client.ListItems(ctx)
+		a.Error(err)
+		a.Equal(1, flaky.attempts["GET /items"])
+	})
+}
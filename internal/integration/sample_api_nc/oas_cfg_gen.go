@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -55,6 +56,7 @@ type otelConfig struct {
 	Tracer         trace.Tracer
 	MeterProvider  metric.MeterProvider
 	Meter          metric.Meter
+	Propagator     propagation.TextMapPropagator
 	Attributes     []attribute.KeyValue
 }
 
@@ -65,6 +67,9 @@ func (cfg *otelConfig) initOTEL() {
 	if cfg.MeterProvider == nil {
 		cfg.MeterProvider = otel.GetMeterProvider()
 	}
+	if cfg.Propagator == nil {
+		cfg.Propagator = otel.GetTextMapPropagator()
+	}
 	cfg.Tracer = cfg.TracerProvider.Tracer(otelogen.Name,
 		trace.WithInstrumentationVersion(otelogen.SemVersion()),
 	)
@@ -84,6 +89,8 @@ type serverConfig struct {
 	Prefix             string
 	Middleware         Middleware
 	MaxMultipartMemory int64
+	MaxRequestBodySize int64
+	JSONLimits         ogenerrors.JSONLimits
 }
 
 // ServerOption is server config option.
@@ -119,10 +126,13 @@ func newServerConfig(opts ...ServerOption) serverConfig {
 }
 
 type baseServer struct {
-	cfg      serverConfig
-	requests metric.Int64Counter
-	errors   metric.Int64Counter
-	duration metric.Float64Histogram
+	cfg              serverConfig
+	requests         metric.Int64Counter
+	errors           metric.Int64Counter
+	duration         metric.Float64Histogram
+	activeRequests   metric.Int64UpDownCounter
+	requestBodySize  metric.Int64Histogram
+	responseBodySize metric.Int64Histogram
 }
 
 func (s baseServer) notFound(w http.ResponseWriter, r *http.Request) {
@@ -180,6 +190,15 @@ func (cfg serverConfig) baseServer() (s baseServer, err error) {
 	if s.duration, err = otelogen.ServerDurationHistogram(s.cfg.Meter); err != nil {
 		return s, err
 	}
+	if s.activeRequests, err = otelogen.ServerActiveRequestsCounter(s.cfg.Meter); err != nil {
+		return s, err
+	}
+	if s.requestBodySize, err = otelogen.ServerRequestBodySizeHistogram(s.cfg.Meter); err != nil {
+		return s, err
+	}
+	if s.responseBodySize, err = otelogen.ServerResponseBodySizeHistogram(s.cfg.Meter); err != nil {
+		return s, err
+	}
 	return s, nil
 }
 
@@ -274,3 +293,40 @@ func WithMaxMultipartMemory(max int64) ServerOption {
 		}
 	})
 }
+
+// WithMaxRequestBodySize specifies limit of request body size in bytes.
+// Operations may override it using x-ogen-max-body-size extension.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+func WithMaxRequestBodySize(max int64) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.MaxRequestBodySize = max
+	})
+}
+
+// WithMaxJSONDepth specifies limit of nesting depth of JSON request bodies.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+//
+// Limits are checked by a separate pass over the request body before decoding,
+// so the body is parsed twice.
+func WithMaxJSONDepth(max int) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.JSONLimits.MaxDepth = max
+	})
+}
+
+// WithMaxJSONArrayLength specifies limit of array length in JSON request bodies.
+//
+// Server responds with 413 status code, if limit is exceeded.
+// Zero or negative value disables the limit.
+//
+// Limits are checked by a separate pass over the request body before decoding,
+// so the body is parsed twice.
+func WithMaxJSONArrayLength(max int) ServerOption {
+	return optionFunc[serverConfig](func(cfg *serverConfig) {
+		cfg.JSONLimits.MaxArrayLength = max
+	})
+}
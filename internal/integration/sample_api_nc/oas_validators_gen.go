@@ -913,15 +913,18 @@ func (s *Pet) Validate() error {
 		if s.Friends == nil {
 			return nil // optional
 		}
+		var failures []validate.FieldError
 		if err := (validate.Array{
 			MinLength:    0,
 			MinLengthSet: false,
 			MaxLength:    255,
 			MaxLengthSet: true,
 		}).ValidateLength(len(s.Friends)); err != nil {
-			return errors.Wrap(err, "array")
+			// Failure of the array itself.
+			failures = append(failures, validate.FieldError{
+				Error: errors.Wrap(err, "array"),
+			})
 		}
-		var failures []validate.FieldError
 		for i, elem := range s.Friends {
 			if err := func() error {
 				if err := elem.Validate(); err != nil {
@@ -1026,15 +1029,18 @@ func (s *Pet) Validate() error {
 				if elem == nil {
 					return errors.New("nil is invalid value")
 				}
+				var failures []validate.FieldError
 				if err := (validate.Array{
 					MinLength:    0,
 					MinLengthSet: false,
 					MaxLength:    16,
 					MaxLengthSet: true,
 				}).ValidateLength(len(elem)); err != nil {
-					return errors.Wrap(err, "array")
+					// Failure of the array itself.
+					failures = append(failures, validate.FieldError{
+						Error: errors.Wrap(err, "array"),
+					})
 				}
-				var failures []validate.FieldError
 				for i, elem := range elem {
 					if err := func() error {
 						if err := (validate.String{
@@ -1416,7 +1422,7 @@ func (s *TupleNamedTest) Validate() error {
 		return nil
 	}(); err != nil {
 		failures = append(failures, validate.FieldError{
-			Name:  "V3",
+			Name:  "[3]",
 			Error: err,
 		})
 	}
@@ -1456,7 +1462,7 @@ func (s *TupleTest) Validate() error {
 		return nil
 	}(); err != nil {
 		failures = append(failures, validate.FieldError{
-			Name:  "V3",
+			Name:  "[3]",
 			Error: err,
 		})
 	}
@@ -10906,12 +10906,12 @@ func (s *TupleNamedTest) Decode(d *jx.Decoder) error {
 			}
 			return nil
 		default:
-			return errors.Errorf("expected 5 elements, got %d", n)
+			return errors.Errorf("expected 5 elements, got %d", n+1)
 		}
 	}); err != nil {
 		return err
 	}
-	if n == 0 {
+	if n < 5 {
 		return errors.Errorf("expected 5 elements, got %d", n)
 	}
 	return nil
@@ -11145,12 +11145,12 @@ func (s *TupleTest) Decode(d *jx.Decoder) error {
 			}
 			return nil
 		default:
-			return errors.Errorf("expected 5 elements, got %d", n)
+			return errors.Errorf("expected 5 elements, got %d", n+1)
 		}
 	}); err != nil {
 		return err
 	}
-	if n == 0 {
+	if n < 5 {
 		return errors.Errorf("expected 5 elements, got %d", n)
 	}
 	return nil
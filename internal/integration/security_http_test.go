@@ -0,0 +1,196 @@
+package integration_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_security_http"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+const testDigestNonce = "dcd98b7102dd2f0e8b11d0f600bfb0c093"
+
+var testDigestChallenge = ht.DigestChallenge{
+	Realm:     "api@example.com",
+	Nonce:     testDigestNonce,
+	Algorithm: ht.DigestSHA256,
+	QOP:       []string{"auth"},
+}
+
+type testHTTPAuth struct {
+	signatureKey ht.MessageVerifier
+}
+
+type httpAuthUserKey struct{}
+
+func (testHTTPAuth) DigestEcho(ctx context.Context, req string) (string, error) {
+	return ctx.Value(httpAuthUserKey{}).(string) + ": " + req, nil
+}
+
+func (testHTTPAuth) HobaWhoami(ctx context.Context) (string, error) {
+	return ctx.Value(httpAuthUserKey{}).(string), nil
+}
+
+func (testHTTPAuth) SignedEcho(ctx context.Context, req string) (string, error) {
+	return ctx.Value(httpAuthUserKey{}).(string) + ": " + req, nil
+}
+
+func (testHTTPAuth) HandleDigest(ctx context.Context, operationName api.OperationName, t api.Digest) (context.Context, error) {
+	creds := t.Credentials
+	if creds.Nonce != testDigestNonce || creds.Realm != testDigestChallenge.Realm {
+		return nil, errors.New("invalid nonce")
+	}
+	if !creds.Verify("Mufasa", "Circle of Life") {
+		return nil, errors.Errorf("invalid credentials of %q", t.Username)
+	}
+	return context.WithValue(ctx, httpAuthUserKey{}, t.Username), nil
+}
+
+func (testHTTPAuth) HandleHoba(ctx context.Context, operationName api.OperationName, t api.Hoba) (context.Context, error) {
+	if t.Credentials == "" {
+		return nil, errors.New("empty credentials")
+	}
+	return context.WithValue(ctx, httpAuthUserKey{}, t.Credentials+" "+t.Roles[0]), nil
+}
+
+func (h testHTTPAuth) HandleSignature(ctx context.Context, operationName api.OperationName, t api.Signature) (context.Context, error) {
+	p, err := ht.VerifyRequest(t.Request, "sig1", func(p ht.SignatureParams) (ht.MessageVerifier, error) {
+		if p.KeyID != "client" {
+			return nil, errors.Errorf("unknown key %q", p.KeyID)
+		}
+		return h.signatureKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, httpAuthUserKey{}, p.KeyID), nil
+}
+
+type testHTTPAuthSource struct {
+	username string
+	password string
+	hoba     string
+	signer   ht.MessageSigner
+}
+
+func (s testHTTPAuthSource) Digest(ctx context.Context, operationName api.OperationName) (api.Digest, error) {
+	return api.Digest{Username: s.username, Password: s.password}, nil
+}
+
+func (s testHTTPAuthSource) Hoba(ctx context.Context, operationName api.OperationName) (api.Hoba, error) {
+	return api.Hoba{Credentials: s.hoba}, nil
+}
+
+func (s testHTTPAuthSource) Signature(ctx context.Context, operationName api.OperationName, req *http.Request) error {
+	if s.signer == nil {
+		return ogenerrors.ErrSkipClientSecurity
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, body); err != nil {
+		return err
+	}
+	req.Header.Set("Content-Digest", "sha-256=:"+base64.StdEncoding.EncodeToString(h.Sum(nil))+":")
+	return ht.SignRequest(req, ht.SignatureParams{
+		Components: []string{"@method", "@target-uri", "content-type", "content-digest"},
+		KeyID:      "client",
+	}, s.signer)
+}
+
+func TestSecurityHTTP(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	a.NoError(err)
+	verifier, err := ht.NewMessageVerifier(ht.SignatureEd25519, pub)
+	a.NoError(err)
+	signer, err := ht.NewMessageSigner(ht.SignatureEd25519, priv)
+	a.NoError(err)
+
+	handler := testHTTPAuth{signatureKey: verifier}
+	h, err := api.NewServer(handler, handler,
+		api.WithErrorHandler(func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+			if ogenerrors.ErrorCode(err) == http.StatusUnauthorized {
+				w.Header().Set("WWW-Authenticate", testDigestChallenge.String())
+			}
+			ogenerrors.DefaultErrorHandler(ctx, w, r, err)
+		}),
+	)
+	a.NoError(err)
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	newClient := func(src testHTTPAuthSource) *api.Client {
+		client, err := api.NewClient(s.URL, src, api.WithClient(s.Client()))
+		a.NoError(err)
+		return client
+	}
+
+	t.Run("Digest", func(t *testing.T) {
+		a := require.New(t)
+
+		client := newClient(testHTTPAuthSource{username: "Mufasa", password: "Circle of Life"})
+		for range 2 {
+			got, err := client.DigestEcho(ctx, "hello")
+			a.NoError(err)
+			a.Equal("Mufasa: hello", got)
+		}
+
+		_, err := newClient(testHTTPAuthSource{username: "Mufasa", password: "wrong"}).DigestEcho(ctx, "hello")
+		a.Error(err)
+
+		// Client explicitly using the transport.
+		transport := ht.NewDigestTransport(nil)
+		t.Cleanup(transport.CloseIdleConnections)
+		client, err = api.NewClient(s.URL,
+			testHTTPAuthSource{username: "Mufasa", password: "Circle of Life"},
+			api.WithClient(&http.Client{Transport: transport}),
+		)
+		a.NoError(err)
+		got, err := client.DigestEcho(ctx, "hello")
+		a.NoError(err)
+		a.Equal("Mufasa: hello", got)
+	})
+	t.Run("Generic", func(t *testing.T) {
+		a := require.New(t)
+
+		got, err := newClient(testHTTPAuthSource{hoba: "kid.challenge.nonce.sig"}).HobaWhoami(ctx)
+		a.NoError(err)
+		a.Equal("kid.challenge.nonce.sig admin", got)
+
+		_, err = newClient(testHTTPAuthSource{}).HobaWhoami(ctx)
+		a.Error(err)
+	})
+	t.Run("Signature", func(t *testing.T) {
+		a := require.New(t)
+
+		got, err := newClient(testHTTPAuthSource{signer: signer}).SignedEcho(ctx, "hello")
+		a.NoError(err)
+		a.Equal("client: hello", got)
+
+		_, other, err := ed25519.GenerateKey(rand.Reader)
+		a.NoError(err)
+		otherSigner, err := ht.NewMessageSigner(ht.SignatureEd25519, other)
+		a.NoError(err)
+		_, err = newClient(testHTTPAuthSource{signer: otherSigner}).SignedEcho(ctx, "hello")
+		a.Error(err)
+
+		_, err = newClient(testHTTPAuthSource{}).SignedEcho(ctx, "hello")
+		a.ErrorIs(err, ogenerrors.ErrSecurityRequirementIsNotSatisfied)
+	})
+}
@@ -0,0 +1,237 @@
+package integration_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_security_mtls"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) testCA {
+	a := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	a.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	a.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	a.NoError(err)
+	return testCA{cert: cert, key: key}
+}
+
+func (ca testCA) issue(t *testing.T, cn string, usage x509.ExtKeyUsage) tls.Certificate {
+	a := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	a.NoError(err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	a.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	a.NoError(err)
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+type testMTLS struct{}
+
+type mtlsSubjectKey struct{}
+
+func (testMTLS) Whoami(ctx context.Context) (string, error) {
+	return ctx.Value(mtlsSubjectKey{}).(string), nil
+}
+
+func (testMTLS) AdminAction(ctx context.Context) error {
+	return nil
+}
+
+func (testMTLS) HandleMtls(ctx context.Context, operationName api.OperationName, t api.Mtls) (context.Context, error) {
+	cn := t.PeerCertificates[0].Subject.CommonName
+	for _, role := range t.Roles {
+		if role != cn {
+			return nil, errors.Errorf("role %q required", role)
+		}
+	}
+	return context.WithValue(ctx, mtlsSubjectKey{}, cn), nil
+}
+
+func (testMTLS) HandleToken(ctx context.Context, operationName api.OperationName, t api.Token) (context.Context, error) {
+	if t.Token != "token" {
+		return nil, errors.Errorf("invalid token %q", t.Token)
+	}
+	return ctx, nil
+}
+
+func (testMTLS) HandleRootKey(ctx context.Context, operationName api.OperationName, t api.RootKey) (context.Context, error) {
+	if t.APIKey != "root" {
+		return nil, errors.Errorf("invalid key %q", t.APIKey)
+	}
+	return ctx, nil
+}
+
+type testMTLSSource struct {
+	cert    *tls.Certificate
+	token   string
+	rootKey string
+}
+
+func (s testMTLSSource) Mtls(ctx context.Context, operationName api.OperationName) (api.Mtls, error) {
+	if s.cert == nil {
+		return api.Mtls{}, ogenerrors.ErrSkipClientSecurity
+	}
+	return api.Mtls{Certificates: []tls.Certificate{*s.cert}}, nil
+}
+
+func (s testMTLSSource) Token(ctx context.Context, operationName api.OperationName) (api.Token, error) {
+	if s.token == "" {
+		return api.Token{}, ogenerrors.ErrSkipClientSecurity
+	}
+	return api.Token{Token: s.token}, nil
+}
+
+func (s testMTLSSource) RootKey(ctx context.Context, operationName api.OperationName) (api.RootKey, error) {
+	if s.rootKey == "" {
+		return api.RootKey{}, ogenerrors.ErrSkipClientSecurity
+	}
+	return api.RootKey{APIKey: s.rootKey}, nil
+}
+
+func TestSecurityMutualTLS(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	ca := newTestCA(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	h, err := api.NewServer(testMTLS{}, testMTLS{})
+	a.NoError(err)
+	s := httptest.NewUnstartedServer(h)
+	s.TLS = &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    pool,
+	}
+	s.StartTLS()
+	t.Cleanup(s.Close)
+
+	transport := ht.NewClientCertificateTransport(&http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: pool},
+	})
+	t.Cleanup(transport.CloseIdleConnections)
+	httpClient := &http.Client{Transport: transport}
+
+	var (
+		alice = ca.issue(t, "alice", x509.ExtKeyUsageClientAuth)
+		admin = ca.issue(t, "admin", x509.ExtKeyUsageClientAuth)
+	)
+	newClient := func(src testMTLSSource) *api.Client {
+		client, err := api.NewClient(s.URL, src, api.WithClient(httpClient))
+		a.NoError(err)
+		return client
+	}
+
+	t.Run("PeerCertificate", func(t *testing.T) {
+		a := require.New(t)
+
+		// Certificate is selected per request, connections are not shared.
+		for _, cn := range []string{"alice", "admin", "alice"} {
+			cert := alice
+			if cn == "admin" {
+				cert = admin
+			}
+			got, err := newClient(testMTLSSource{cert: &cert}).Whoami(ctx)
+			a.NoError(err)
+			a.Equal(cn, got)
+		}
+
+		_, err := newClient(testMTLSSource{}).Whoami(ctx)
+		a.ErrorIs(err, ogenerrors.ErrSecurityRequirementIsNotSatisfied)
+	})
+	t.Run("NoCertificate", func(t *testing.T) {
+		a := require.New(t)
+
+		// Server must reject request if client did not present certificate.
+		client, err := api.NewClient(s.URL, testMTLSSource{cert: &alice}, api.WithClient(s.Client()))
+		a.NoError(err)
+		_, err = client.Whoami(ctx)
+		a.Error(err)
+	})
+	t.Run("UnverifiedCertificate", func(t *testing.T) {
+		a := require.New(t)
+
+		// Server requests, but does not verify certificate.
+		s := httptest.NewUnstartedServer(h)
+		s.TLS = &tls.Config{
+			Certificates: []tls.Certificate{ca.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+			ClientAuth:   tls.RequestClientCert,
+		}
+		s.StartTLS()
+		t.Cleanup(s.Close)
+
+		other := newTestCA(t).issue(t, "alice", x509.ExtKeyUsageClientAuth)
+		client, err := api.NewClient(s.URL, testMTLSSource{cert: &other}, api.WithClient(httpClient))
+		a.NoError(err)
+		_, err = client.Whoami(ctx)
+		a.Error(err)
+	})
+	t.Run("Requirements", func(t *testing.T) {
+		for _, tt := range []struct {
+			src testMTLSSource
+			ok  bool
+		}{
+			{testMTLSSource{cert: &admin, token: "token"}, true},
+			{testMTLSSource{rootKey: "root"}, true},
+			{testMTLSSource{cert: &alice, token: "token"}, false},
+			{testMTLSSource{cert: &admin}, false},
+			{testMTLSSource{token: "token"}, false},
+		} {
+			err := newClient(tt.src).AdminAction(ctx)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		}
+	})
+}
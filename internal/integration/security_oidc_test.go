@@ -0,0 +1,159 @@
+package integration_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_security_oidc"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/ogenoidc"
+)
+
+type oidcClaimsKey struct{}
+
+type testOIDC struct {
+	provider *ogenoidc.Provider
+}
+
+func (t testOIDC) GetProfile(ctx context.Context) (*api.Profile, error) {
+	claims := ctx.Value(oidcClaimsKey{}).(*ogenoidc.Claims)
+	return &api.Profile{Subject: claims.Subject}, nil
+}
+
+func (t testOIDC) AdminAction(ctx context.Context) error {
+	return nil
+}
+
+func (t testOIDC) HandleApiKey(ctx context.Context, operationName api.OperationName, v api.ApiKey) (context.Context, error) {
+	return nil, ogenerrors.ErrSkipServerSecurity
+}
+
+func (t testOIDC) HandleOidc(ctx context.Context, operationName api.OperationName, v api.Oidc) (context.Context, error) {
+	claims, err := t.provider.Verify(ctx, v.Token)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.HasScopes(v.Scopes...) {
+		return nil, errors.Errorf("insufficient scope: %q required", v.Scopes)
+	}
+	return context.WithValue(ctx, oidcClaimsKey{}, claims), nil
+}
+
+type testOIDCSource struct {
+	token string
+}
+
+func (s testOIDCSource) ApiKey(ctx context.Context, operationName api.OperationName) (api.ApiKey, error) {
+	return api.ApiKey{}, ogenerrors.ErrSkipClientSecurity
+}
+
+func (s testOIDCSource) Oidc(ctx context.Context, operationName api.OperationName) (api.Oidc, error) {
+	return api.Oidc{Token: s.token}, nil
+}
+
+func TestSecurityOpenIDConnect(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	b64 := base64.RawURLEncoding.EncodeToString
+
+	mux := http.NewServeMux()
+	issuer := httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ogenoidc.Metadata{
+			Issuer:  issuer.URL,
+			JWKSURI: issuer.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test",
+				"alg": "RS256",
+				"n":   b64(key.N.Bytes()),
+				"e":   b64(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	sign := func(scope string) string {
+		header := b64([]byte(`{"alg":"RS256","kid":"test"}`))
+		payload, err := json.Marshal(map[string]any{
+			"iss":   issuer.URL,
+			"sub":   "alice",
+			"aud":   "api",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": scope,
+		})
+		a.NoError(err)
+		signed := header + "." + b64(payload)
+		digest := sha256.Sum256([]byte(signed))
+		sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		a.NoError(err)
+		return signed + "." + b64(sig)
+	}
+
+	a.Equal("https://auth.example.com/.well-known/openid-configuration", api.OpenIDConnectURLForOidc)
+	a.Equal([]string{"profile", "admin"}, api.GetOAuth2ScopesForOidc(api.AdminActionOperation))
+
+	provider, err := ogenoidc.NewProvider(ctx, issuer.URL+"/.well-known/openid-configuration",
+		ogenoidc.WithClient(issuer.Client()),
+		ogenoidc.WithAudience("api"),
+	)
+	a.NoError(err)
+
+	h, err := api.NewServer(testOIDC{provider: provider}, testOIDC{provider: provider})
+	a.NoError(err)
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+
+	newClient := func(token string) *api.Client {
+		client, err := api.NewClient(s.URL, testOIDCSource{token: token}, api.WithClient(s.Client()))
+		a.NoError(err)
+		return client
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		a := require.New(t)
+		client := newClient(sign("openid profile admin"))
+
+		profile, err := client.GetProfile(ctx)
+		a.NoError(err)
+		a.Equal("alice", profile.Subject)
+
+		a.NoError(client.AdminAction(ctx))
+	})
+	t.Run("InsufficientScope", func(t *testing.T) {
+		a := require.New(t)
+		client := newClient(sign("openid profile"))
+
+		_, err := client.GetProfile(ctx)
+		a.NoError(err)
+
+		err = client.AdminAction(ctx)
+		a.Error(err)
+	})
+	t.Run("InvalidToken", func(t *testing.T) {
+		a := require.New(t)
+		client := newClient("invalid")
+
+		_, err := client.GetProfile(ctx)
+		a.Error(err)
+	})
+}
@@ -0,0 +1,109 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+	"github.com/ogen-go/ogen/internal/integration/test_split_packages/admin"
+	"github.com/ogen-go/ogen/internal/integration/test_split_packages/billing"
+	"github.com/ogen-go/ogen/internal/integration/test_split_packages/users"
+	"github.com/ogen-go/ogen/validate"
+)
+
+type testSplitBilling struct{}
+
+var _ billing.Handler = testSplitBilling{}
+
+func (testSplitBilling) CreatePayment(ctx context.Context, req *api.Payment) (*api.Payment, error) {
+	return req, nil
+}
+
+func (testSplitBilling) GetInvoice(ctx context.Context, params billing.GetInvoiceParams) (*api.Invoice, error) {
+	return &api.Invoice{ID: params.ID, Amount: 100}, nil
+}
+
+type testSplitUsers struct{}
+
+var _ users.Handler = testSplitUsers{}
+
+func (testSplitUsers) GetUser(ctx context.Context, params users.GetUserParams) (*api.User, error) {
+	return &api.User{ID: params.ID}, nil
+}
+
+type testSplitRoot struct{}
+
+var _ api.Handler = testSplitRoot{}
+
+func (testSplitRoot) Health(ctx context.Context) error {
+	return nil
+}
+
+func TestSplitPackages(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	root, err := api.NewServer(testSplitRoot{})
+	a.NoError(err)
+	billingServer, err := billing.NewServer(testSplitBilling{})
+	a.NoError(err)
+	usersServer, err := users.NewServer(testSplitUsers{})
+	a.NoError(err)
+	// Admin group is left unimplemented.
+	adminServer, err := admin.NewServer(admin.UnimplementedHandler{})
+	a.NoError(err)
+
+	// Every package has its own router, so dispatch requests by path.
+	mux := http.NewServeMux()
+	mux.Handle("/health", root)
+	mux.Handle("/invoices/", billingServer)
+	mux.Handle("/payments", billingServer)
+	mux.Handle("/users/", usersServer)
+	mux.Handle("/admin/", adminServer)
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	_, ok := billingServer.FindRoute(http.MethodGet, "/invoices/1")
+	a.True(ok)
+	_, ok = billingServer.FindRoute(http.MethodGet, "/users/1")
+	a.False(ok)
+
+	rootClient, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	a.NoError(err)
+	a.NoError(rootClient.Health(ctx))
+
+	billingClient, err := billing.NewClient(s.URL, billing.WithClient(s.Client()))
+	a.NoError(err)
+
+	invoice, err := billingClient.GetInvoice(ctx, billing.GetInvoiceParams{ID: 10})
+	a.NoError(err)
+	a.Equal(&api.Invoice{ID: 10, Amount: 100}, invoice)
+
+	payment, err := billingClient.CreatePayment(ctx, &api.Payment{Invoice: 10, Amount: 5})
+	a.NoError(err)
+	a.Equal(&api.Payment{Invoice: 10, Amount: 5}, payment)
+
+	// Server validates request using validators of the target package.
+	_, err = billingClient.CreatePayment(ctx, &api.Payment{Invoice: 10})
+	var serr *validate.UnexpectedStatusCodeError
+	a.ErrorAs(err, &serr)
+	a.Equal(http.StatusBadRequest, serr.StatusCode)
+
+	usersClient, err := users.NewClient(s.URL, users.WithClient(s.Client()))
+	a.NoError(err)
+
+	user, err := usersClient.GetUser(ctx, users.GetUserParams{ID: "u1"})
+	a.NoError(err)
+	a.Equal(&api.User{ID: "u1"}, user)
+
+	adminClient, err := admin.NewClient(s.URL, admin.WithClient(s.Client()))
+	a.NoError(err)
+
+	_, err = adminClient.GetStats(ctx)
+	a.ErrorAs(err, &serr)
+	a.Equal(http.StatusNotImplemented, serr.StatusCode)
+}
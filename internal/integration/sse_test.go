@@ -0,0 +1,293 @@
+package integration
+
+import (
+	"bufio"
+	"context"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_sse"
+	srvapi "github.com/ogen-go/ogen/internal/integration/test_sse_server"
+	"github.com/ogen-go/ogen/sse"
+)
+
+type sseHandler struct {
+	fullErr   bool
+	keepAlive chan struct{}
+	stopped   chan struct{}
+}
+
+var _ api.Handler = (*sseHandler)(nil)
+
+func (h *sseHandler) DataEvents(ctx context.Context, params api.DataEventsParams) (*api.DataEventsOK, error) {
+	return api.NewDataEventsOK(func(yield func(api.DataEventsOKEvent, error) bool) {
+		for i := range params.Count {
+			event := api.DataEventsOKEvent{
+				Data: api.Tick{Seq: i},
+			}
+			if i == 0 {
+				event.ID = "first"
+				event.Retry = api.NewOptInt(1500)
+				event.Data.Note = api.NewOptString("multi\nline")
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}), nil
+}
+
+func (h *sseHandler) HeaderEvents(ctx context.Context) (*api.HeaderEventsOKHeaders, error) {
+	ch := make(chan api.HeaderEventsOKEvent)
+	go func() {
+		defer close(ch)
+		if h.stopped != nil {
+			defer close(h.stopped)
+		}
+		for i := 0; ; i++ {
+			if h.keepAlive != nil && i == 1 {
+				// Wait for keep-alive comment.
+				select {
+				case <-h.keepAlive:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case ch <- api.HeaderEventsOKEvent{Type: "tick", Data: api.Tick{Seq: i}}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &api.HeaderEventsOKHeaders{
+		XStreamID: "stream-1",
+		Response:  api.NewHeaderEventsOKFromChan(ch),
+	}, nil
+}
+
+func (h *sseHandler) FullEvents(ctx context.Context) (api.FullEventsRes, error) {
+	if h.fullErr {
+		return &api.ErrorStatusCode{
+			StatusCode: http.StatusConflict,
+			Response:   api.Error{Message: "conflict"},
+		}, nil
+	}
+	events := []api.FullEventsOKEvent{
+		api.NewCreatedEventFullEventsOKEvent(api.CreatedEvent{
+			ID:    "1",
+			Event: "created",
+			Data:  api.Tick{Seq: 1},
+		}),
+		api.NewDeletedEventFullEventsOKEvent(api.DeletedEvent{
+			ID:    "2",
+			Event: "deleted",
+			Data:  "bye",
+		}),
+		api.NewDeletedEventFullEventsOKEvent(api.DeletedEvent{
+			ID:    "3",
+			Event: "deleted",
+			Data:  `{"json":"string"}`,
+		}),
+	}
+	return api.NewFullEventsOK(func(yield func(api.FullEventsOKEvent, error) bool) {
+		for _, event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}), nil
+}
+
+func collectSSE[E any](t *testing.T, seq iter.Seq2[E, error], n int) []E {
+	t.Helper()
+
+	var events []E
+	for event, err := range seq {
+		require.NoError(t, err)
+		events = append(events, event)
+		if len(events) == n {
+			break
+		}
+	}
+	return events
+}
+
+func TestServerSentEvents(t *testing.T) {
+	ctx := context.Background()
+
+	newServer := func(t *testing.T, h *sseHandler, opts ...api.ServerOption) *httptest.Server {
+		t.Helper()
+
+		srv, err := api.NewServer(h, opts...)
+		require.NoError(t, err)
+		s := httptest.NewServer(srv)
+		t.Cleanup(s.Close)
+		return s
+	}
+	newClient := func(t *testing.T, s *httptest.Server) *api.Client {
+		t.Helper()
+
+		client, err := api.NewClient(s.URL,
+			api.WithClient(s.Client()),
+			api.WithSSEClientOptions(api.WithSSEMaxRetries(1)),
+		)
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("DataOnly", func(t *testing.T) {
+		a := require.New(t)
+		s := newServer(t, &sseHandler{})
+
+		resp, err := http.Get(s.URL + "/events/data?count=2")
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+		a.Equal("no-cache", resp.Header.Get("Cache-Control"))
+
+		dec := sse.NewDecoder(resp.Body, 0, 0, "", nil)
+		first, err := dec.Decode()
+		a.NoError(err)
+		a.Equal("first", first.ID)
+		a.Equal(sse.DefaultEventType, first.Type)
+		a.JSONEq(`{"seq":0,"note":"multi\nline"}`, first.Data)
+		a.NotNil(first.Retry)
+		a.Equal(1500*time.Millisecond, *first.Retry)
+
+		client := newClient(t, s)
+		stream, err := client.DataEvents(ctx, api.DataEventsParams{Count: 3})
+		a.NoError(err)
+		defer stream.Close()
+
+		events := collectSSE(t, stream.All(ctx), 3)
+		a.Len(events, 3)
+		a.Equal("first", events[0].ID)
+		a.Equal(api.NewOptInt(1500), events[0].Retry)
+		a.Equal(api.Tick{Seq: 0, Note: api.NewOptString("multi\nline")}, events[0].Data)
+		a.Equal(api.Tick{Seq: 2}, events[2].Data)
+	})
+	t.Run("Full", func(t *testing.T) {
+		a := require.New(t)
+		s := newServer(t, &sseHandler{})
+		client := newClient(t, s)
+
+		res, err := client.FullEvents(ctx)
+		a.NoError(err)
+		stream, ok := res.(*api.FullEventsOK)
+		a.Truef(ok, "unexpected response %T", res)
+		defer stream.Close()
+
+		events := collectSSE(t, stream.All(ctx), 3)
+		a.Len(events, 3)
+
+		created, ok := events[0].GetCreatedEvent()
+		a.True(ok)
+		a.Equal(api.Tick{Seq: 1}, created.Data)
+
+		deleted, ok := events[1].GetDeletedEvent()
+		a.True(ok)
+		a.Equal("2", deleted.ID)
+		a.Equal("bye", deleted.Data)
+
+		deleted, ok = events[2].GetDeletedEvent()
+		a.True(ok)
+		a.Equal(`{"json":"string"}`, deleted.Data)
+	})
+	t.Run("FullError", func(t *testing.T) {
+		a := require.New(t)
+		s := newServer(t, &sseHandler{fullErr: true})
+		client := newClient(t, s)
+
+		res, err := client.FullEvents(ctx)
+		a.NoError(err)
+		a.Equal(&api.ErrorStatusCode{
+			StatusCode: http.StatusConflict,
+			Response:   api.Error{Message: "conflict"},
+		}, res)
+	})
+	t.Run("KeepAliveAndDisconnect", func(t *testing.T) {
+		a := require.New(t)
+		h := &sseHandler{
+			keepAlive: make(chan struct{}),
+			stopped:   make(chan struct{}),
+		}
+		s := newServer(t, h, api.WithSSEKeepAlive(10*time.Millisecond))
+
+		reqCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, s.URL+"/events/headers", nil)
+		a.NoError(err)
+		resp, err := s.Client().Do(req)
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal("stream-1", resp.Header.Get("X-Stream-Id"))
+
+		r := bufio.NewReader(resp.Body)
+		readFrame := func() string {
+			var frame strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				a.NoError(err)
+				if line == "\n" {
+					return frame.String()
+				}
+				frame.WriteString(line)
+			}
+		}
+		a.Equal("event: tick\ndata: {\"seq\":0}\n", readFrame())
+		a.Equal(": keep-alive\n", readFrame())
+		close(h.keepAlive)
+		frame := readFrame()
+		for frame == ": keep-alive\n" {
+			frame = readFrame()
+		}
+		a.Equal("event: tick\ndata: {\"seq\":1}\n", frame)
+
+		// Client disconnect must stop the event producer.
+		cancel()
+		select {
+		case <-h.stopped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("event producer is not stopped")
+		}
+	})
+}
+
+type sseServerOnlyHandler struct {
+	srvapi.UnimplementedHandler
+}
+
+func (sseServerOnlyHandler) DataEvents(ctx context.Context, params srvapi.DataEventsParams) (*srvapi.DataEventsOK, error) {
+	ch := make(chan srvapi.DataEventsOKEvent, params.Count)
+	for i := range params.Count {
+		ch <- srvapi.DataEventsOKEvent{Data: srvapi.Tick{Seq: i}}
+	}
+	close(ch)
+	return srvapi.NewDataEventsOKFromChan(ch), nil
+}
+
+func TestServerSentEventsServerOnly(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	srv, err := srvapi.NewServer(sseServerOnlyHandler{})
+	a.NoError(err)
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	a.NoError(err)
+	stream, err := client.DataEvents(ctx, api.DataEventsParams{Count: 2})
+	a.NoError(err)
+	defer stream.Close()
+
+	events := collectSSE(t, stream.All(ctx), 2)
+	a.Equal([]api.Tick{{Seq: 0}, {Seq: 1}}, []api.Tick{events[0].Data, events[1].Data})
+}
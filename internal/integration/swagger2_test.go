@@ -0,0 +1,230 @@
+package integration_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_swagger2"
+)
+
+type testSwagger2 struct {
+	photo   string
+	caption string
+}
+
+var _ api.Handler = (*testSwagger2)(nil)
+
+func (t *testSwagger2) CreatePet(ctx context.Context, req *api.Pet) (api.CreatePetRes, error) {
+	if req.Name == "" {
+		return &api.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response:   api.Error{Message: "empty name"},
+		}, nil
+	}
+	return req, nil
+}
+
+func (t *testSwagger2) ListPets(ctx context.Context, params api.ListPetsParams) (api.ListPetsRes, error) {
+	var pets []api.Pet
+	for _, id := range params.Ids {
+		pets = append(pets, api.Pet{
+			ID:   int64(id),
+			Name: strings.Join(params.Tags, ","),
+		})
+	}
+	if limit, ok := params.Limit.Get(); ok && len(pets) > limit {
+		pets = pets[:limit]
+	}
+	return &api.ListPetsOKHeaders{
+		XTotal:   api.NewOptInt(len(params.Ids)),
+		Response: pets,
+	}, nil
+}
+
+func (t *testSwagger2) UpdatePetName(ctx context.Context, req *api.UpdatePetNameReq, params api.UpdatePetNameParams) (*api.Pet, error) {
+	return &api.Pet{
+		ID:   params.ID,
+		Name: req.Name + " (" + strings.Join(req.Nicknames, ",") + ")",
+	}, nil
+}
+
+func (t *testSwagger2) UploadPetPhoto(ctx context.Context, req *api.UploadPetPhotoReq, params api.UploadPetPhotoParams) error {
+	data, err := io.ReadAll(req.Photo.File)
+	if err != nil {
+		return err
+	}
+	t.photo = string(data)
+	t.caption = req.Caption.Or("")
+	return nil
+}
+
+func (t *testSwagger2) HandleApiKey(ctx context.Context, operationName api.OperationName, v api.ApiKey) (context.Context, error) {
+	if v.APIKey != "key" {
+		return nil, errors.Errorf("invalid api key: %q", v.APIKey)
+	}
+	return ctx, nil
+}
+
+func (t *testSwagger2) HandleBasicAuth(ctx context.Context, operationName api.OperationName, v api.BasicAuth) (context.Context, error) {
+	if v.Username != "admin" || v.Password != "secret" {
+		return nil, errors.Errorf("invalid basic auth: %q", v.Username)
+	}
+	return ctx, nil
+}
+
+func (t *testSwagger2) HandleOAuth(ctx context.Context, operationName api.OperationName, v api.OAuth) (context.Context, error) {
+	if v.Token != "token" {
+		return nil, errors.Errorf("invalid token: %q", v.Token)
+	}
+	return ctx, nil
+}
+
+type testSwagger2Source struct{}
+
+func (testSwagger2Source) ApiKey(ctx context.Context, operationName api.OperationName) (api.ApiKey, error) {
+	return api.ApiKey{APIKey: "key"}, nil
+}
+
+func (testSwagger2Source) BasicAuth(ctx context.Context, operationName api.OperationName) (api.BasicAuth, error) {
+	return api.BasicAuth{Username: "admin", Password: "secret"}, nil
+}
+
+func (testSwagger2Source) OAuth(ctx context.Context, operationName api.OperationName) (api.OAuth, error) {
+	return api.OAuth{Token: "token"}, nil
+}
+
+func TestSwagger2(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	handler := &testSwagger2{}
+	// basePath of the Swagger 2.0 document becomes the server URL path.
+	h, err := api.NewServer(handler, handler, api.WithPathPrefix("/v1"))
+	a.NoError(err)
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL+"/v1", testSwagger2Source{}, api.WithClient(s.Client()))
+	a.NoError(err)
+
+	t.Run("Body", func(t *testing.T) {
+		a := require.New(t)
+
+		pet := api.Pet{
+			ID:   1,
+			Name: "Tom",
+			Tag:  api.NewOptNilString("cat"),
+			Kind: api.NewOptKind(api.KindCat),
+		}
+		res, err := client.CreatePet(ctx, &pet)
+		a.NoError(err)
+		a.Equal(&pet, res)
+
+		res, err = client.CreatePet(ctx, &api.Pet{ID: 2})
+		a.NoError(err)
+		a.Equal(&api.ErrorStatusCode{
+			StatusCode: http.StatusBadRequest,
+			Response:   api.Error{Message: "empty name"},
+		}, res)
+	})
+	t.Run("Nullable", func(t *testing.T) {
+		a := require.New(t)
+
+		var pet api.Pet
+		a.NoError(pet.UnmarshalJSON([]byte(`{"id":1,"name":"Tom","tag":null}`)))
+		a.True(pet.Tag.IsSet())
+		a.True(pet.Tag.IsNull())
+	})
+	t.Run("Parameters", func(t *testing.T) {
+		a := require.New(t)
+
+		res, err := client.ListPets(ctx, api.ListPetsParams{
+			Limit: api.NewOptInt(2),
+			Tags:  []string{"a", "b"},
+			Ids:   []int{1, 2, 3},
+		})
+		a.NoError(err)
+		a.Equal(&api.ListPetsOKHeaders{
+			XTotal: api.NewOptInt(3),
+			Response: []api.Pet{
+				{ID: 1, Name: "a,b"},
+				{ID: 2, Name: "a,b"},
+			},
+		}, res)
+	})
+	t.Run("CollectionFormat", func(t *testing.T) {
+		a := require.New(t)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			s.URL+"/v1/pets?tags=a,b&ids=1&ids=2",
+			http.NoBody,
+		)
+		a.NoError(err)
+		req.Header.Set("X-API-Key", "key")
+
+		resp, err := s.Client().Do(req)
+		a.NoError(err)
+		defer resp.Body.Close()
+
+		a.Equal(http.StatusOK, resp.StatusCode)
+		a.Equal("2", resp.Header.Get("X-Total"))
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.JSONEq(`[{"id":1,"name":"a,b"},{"id":2,"name":"a,b"}]`, string(data))
+	})
+	t.Run("FormData", func(t *testing.T) {
+		a := require.New(t)
+
+		res, err := client.UpdatePetName(ctx,
+			&api.UpdatePetNameReq{
+				Name:      "Tom",
+				Nicknames: []string{"tommy", "tomcat"},
+			},
+			api.UpdatePetNameParams{ID: 10},
+		)
+		a.NoError(err)
+		a.Equal(&api.Pet{ID: 10, Name: "Tom (tommy,tomcat)"}, res)
+	})
+	t.Run("File", func(t *testing.T) {
+		a := require.New(t)
+
+		err := client.UploadPetPhoto(ctx,
+			&api.UploadPetPhotoReq{
+				Photo: ht.MultipartFile{
+					Name: "tom.png",
+					File: strings.NewReader("photo"),
+				},
+				Caption: api.NewOptString("Tom"),
+			},
+			api.UploadPetPhotoParams{ID: 10},
+		)
+		a.NoError(err)
+		a.Equal("photo", handler.photo)
+		a.Equal("Tom", handler.caption)
+	})
+	t.Run("Security", func(t *testing.T) {
+		a := require.New(t)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut,
+			s.URL+"/v1/pets/10",
+			strings.NewReader("name=Tom"),
+		)
+		a.NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("admin", "wrong")
+
+		resp, err := s.Client().Do(req)
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal(http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,97 @@
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_tag_groups"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// testTagBilling implements only the billing group.
+type testTagBilling struct{}
+
+var _ api.BillingHandler = testTagBilling{}
+
+func (testTagBilling) CreatePayment(ctx context.Context, req *api.Payment) (*api.Payment, error) {
+	return req, nil
+}
+
+func (testTagBilling) GetInvoice(ctx context.Context, params api.GetInvoiceParams) (*api.Invoice, error) {
+	return &api.Invoice{ID: params.ID, Amount: 100}, nil
+}
+
+// testTagUsers implements only the users group.
+type testTagUsers struct{}
+
+var _ api.UsersHandler = testTagUsers{}
+
+func (testTagUsers) GetUser(ctx context.Context, params api.GetUserParams) (*api.User, error) {
+	return &api.User{ID: params.ID}, nil
+}
+
+// testTagGroups composes group implementations, leaving the rest unimplemented.
+type testTagGroups struct {
+	api.UnimplementedHandler
+	testTagBilling
+	testTagUsers
+}
+
+var _ api.Handler = testTagGroups{}
+
+func (testTagGroups) Health(ctx context.Context) error {
+	return nil
+}
+
+func TestTagGroups(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	h, err := api.NewServer(testTagGroups{})
+	a.NoError(err)
+	s := httptest.NewServer(h)
+	defer s.Close()
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	a.NoError(err)
+
+	// Group clients.
+	invoice, err := client.Billing.GetInvoice(ctx, api.GetInvoiceParams{ID: 10})
+	a.NoError(err)
+	a.Equal(&api.Invoice{ID: 10, Amount: 100}, invoice)
+
+	payment, err := client.Billing.CreatePayment(ctx, &api.Payment{Invoice: 10, Amount: 5})
+	a.NoError(err)
+	a.Equal(&api.Payment{Invoice: 10, Amount: 5}, payment)
+
+	// Server validates request of the group operation.
+	_, err = client.Billing.CreatePayment(ctx, &api.Payment{Invoice: 10})
+	var serr *validate.UnexpectedStatusCodeError
+	a.ErrorAs(err, &serr)
+	a.Equal(http.StatusBadRequest, serr.StatusCode)
+
+	user, err := client.Users.GetUser(ctx, api.GetUserParams{ID: "u1"})
+	a.NoError(err)
+	a.Equal(&api.User{ID: "u1"}, user)
+
+	// Group client may be used through the group invoker interface.
+	var invoker api.UsersInvoker = client.Users
+	_, err = invoker.GetUser(ctx, api.GetUserParams{ID: "u2"})
+	a.NoError(err)
+
+	// Ungrouped operations are still on the root client.
+	a.NoError(client.Health(ctx))
+
+	// Operation group extension takes precedence over tags.
+	resp, err := s.Client().Get(s.URL + "/admin/stats")
+	a.NoError(err)
+	defer resp.Body.Close()
+	a.Equal(http.StatusNotImplemented, resp.StatusCode)
+
+	_, err = client.Admin.GetStats(ctx)
+	a.Error(err)
+}
@@ -182,13 +182,14 @@ func encodeReferencedAllOfNullableRequest(
 				return errors.Wrap(err, "encode query")
 			}
 		}
-		body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		// Body without files can be replayed.
+		body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 			if err := q.WriteMultipart(w); err != nil {
 				return errors.Wrap(err, "write multipart")
 			}
 			return nil
 		})
-		ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+		ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 		return nil
 	default:
 		return errors.Errorf("unexpected request type: %T", req)
@@ -259,13 +260,14 @@ func encodeReferencedAllofRequest(
 				return errors.Wrap(err, "encode query")
 			}
 		}
-		body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		// Body without files can be replayed.
+		body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 			if err := q.WriteMultipart(w); err != nil {
 				return errors.Wrap(err, "write multipart")
 			}
 			return nil
 		})
-		ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+		ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 		return nil
 	default:
 		return errors.Errorf("unexpected request type: %T", req)
@@ -339,13 +341,14 @@ func encodeReferencedAllofOptionalRequest(
 				return errors.Wrap(err, "encode query")
 			}
 		}
-		body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		// Body without files can be replayed.
+		body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 			if err := q.WriteMultipart(w); err != nil {
 				return errors.Wrap(err, "write multipart")
 			}
 			return nil
 		})
-		ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+		ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 		return nil
 	default:
 		return errors.Errorf("unexpected request type: %T", req)
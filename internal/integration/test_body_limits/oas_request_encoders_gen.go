@@ -0,0 +1,67 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/go-faster/jx"
+	ht "github.com/ogen-go/ogen/http"
+)
+
+func encodeCreateItemsRequest(
+	req []Item,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		e.ArrStart()
+		for _, elem := range req {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeStreamItemsRequest(
+	req []Item,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	body := func() io.ReadCloser {
+		return ht.CreateBodyWriter(func(w io.Writer) (rerr error) {
+			e := jx.NewStreamingEncoder(w, -1)
+			defer func() {
+				if rerr == nil {
+					rerr = e.Close()
+				}
+			}()
+			{
+				e.ArrStart()
+				for _, elem := range req {
+					elem.Encode(e)
+				}
+				e.ArrEnd()
+			}
+			return nil
+		})
+	}
+	ht.SetReplayableBody(r, body, contentType)
+	return nil
+}
+
+func encodeUploadRequest(
+	req UploadReq,
+	r *http.Request,
+) error {
+	const contentType = "application/octet-stream"
+	body := req
+	ht.SetBody(r, body, contentType)
+	return nil
+}
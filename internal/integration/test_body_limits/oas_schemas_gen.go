@@ -0,0 +1,53 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"io"
+)
+
+// CreateItemsNoContent is response for CreateItems operation.
+type CreateItemsNoContent struct{}
+
+// Ref: #/components/schemas/Item
+type Item struct {
+	Name     string `json:"name"`
+	Children []Item `json:"children"`
+}
+
+// GetName returns the value of Name.
+func (s *Item) GetName() string {
+	return s.Name
+}
+
+// GetChildren returns the value of Children.
+func (s *Item) GetChildren() []Item {
+	return s.Children
+}
+
+// SetName sets the value of Name.
+func (s *Item) SetName(val string) {
+	s.Name = val
+}
+
+// SetChildren sets the value of Children.
+func (s *Item) SetChildren(val []Item) {
+	s.Children = val
+}
+
+// StreamItemsNoContent is response for StreamItems operation.
+type StreamItemsNoContent struct{}
+
+type UploadReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s UploadReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
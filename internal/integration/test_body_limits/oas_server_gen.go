@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreateItems implements createItems operation.
+	//
+	// POST /items
+	CreateItems(ctx context.Context, req []Item) error
+	// StreamItems implements streamItems operation.
+	//
+	// POST /items/stream
+	StreamItems(ctx context.Context, req []Item) error
+	// Upload implements upload operation.
+	//
+	// POST /upload
+	Upload(ctx context.Context, req UploadReq) (int, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
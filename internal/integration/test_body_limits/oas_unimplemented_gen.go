@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreateItems implements createItems operation.
+//
+// POST /items
+func (UnimplementedHandler) CreateItems(ctx context.Context, req []Item) error {
+	return ht.ErrNotImplemented
+}
+
+// StreamItems implements streamItems operation.
+//
+// POST /items/stream
+func (UnimplementedHandler) StreamItems(ctx context.Context, req []Item) error {
+	return ht.ErrNotImplemented
+}
+
+// Upload implements upload operation.
+//
+// POST /upload
+func (UnimplementedHandler) Upload(ctx context.Context, req UploadReq) (r int, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,69 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+)
+
+// ResubscribeOnEventCallbackURL returns URL of "onEvent" callback of resubscribe operation.
+//
+// Callback URL expression: {$request.body#/callbackUrl}
+func ResubscribeOnEventCallbackURL(request *Subscription) (string, error) {
+	var sb strings.Builder
+	{
+		if request == nil {
+			return "", errors.Errorf("%s: value is nil", "$request.body#/callbackUrl")
+		}
+		v0 := *request
+		sb.WriteString(conv.URLToString(v0.CallbackUrl))
+	}
+	return sb.String(), nil
+}
+
+// SubscribeOnEventCallbackURL returns URL of "onEvent" callback of subscribe operation.
+//
+// Callback URL expression: {$request.body#/callbackUrl}
+func SubscribeOnEventCallbackURL(request *Subscription) (string, error) {
+	var sb strings.Builder
+	{
+		if request == nil {
+			return "", errors.Errorf("%s: value is nil", "$request.body#/callbackUrl")
+		}
+		v0 := *request
+		sb.WriteString(conv.URLToString(v0.CallbackUrl))
+	}
+	return sb.String(), nil
+}
+
+// SubscribeOnStatusCallbackURL returns URL of "onStatus" callback of subscribe operation.
+//
+// Callback URL expression: {$request.body#/callbackUrl}/{$request.header.X-Subscription-Kind}/status?tenant={$request.query.tenant}&method={$method}
+func SubscribeOnStatusCallbackURL(request *Subscription, params SubscribeParams) (string, error) {
+	var sb strings.Builder
+	{
+		if request == nil {
+			return "", errors.Errorf("%s: value is nil", "$request.body#/callbackUrl")
+		}
+		v0 := *request
+		sb.WriteString(conv.URLToString(v0.CallbackUrl))
+	}
+	sb.WriteString("/")
+	{
+		sb.WriteString(conv.StringToString(string(params.XSubscriptionKind)))
+	}
+	sb.WriteString("/status?tenant=")
+	{
+		v0, ok := params.Tenant.Get()
+		if !ok {
+			return "", errors.Errorf("%s: value is not set", "$request.query.tenant")
+		}
+		sb.WriteString(conv.StringToString(v0))
+	}
+	sb.WriteString("&method=")
+	sb.WriteString("POST")
+	return sb.String(), nil
+}
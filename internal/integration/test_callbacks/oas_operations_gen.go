@@ -0,0 +1,13 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	ResubscribeOperation        OperationName = "Resubscribe"
+	SubscribeOperation          OperationName = "Subscribe"
+	EventNotificationOperation  OperationName = "EventNotification"
+	SubscriptionStatusOperation OperationName = "SubscriptionStatus"
+)
@@ -0,0 +1,239 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/uri"
+)
+
+// SubscribeParams is parameters of subscribe operation.
+type SubscribeParams struct {
+	Tenant            OptString `json:",omitempty,omitzero"`
+	XSubscriptionKind SubscriptionKind
+}
+
+func unpackSubscribeParams(packed middleware.Parameters) (params SubscribeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "tenant",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Tenant = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Subscription-Kind",
+			In:   "header",
+		}
+		params.XSubscriptionKind = packed[key].(SubscriptionKind)
+	}
+	return params
+}
+
+func decodeSubscribeParams(args [0]string, argsEscaped bool, r *http.Request) (params SubscribeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode query: tenant.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "tenant",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTenantVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTenantVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Tenant.SetTo(paramsDotTenantVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tenant",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: X-Subscription-Kind.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Subscription-Kind",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XSubscriptionKind = SubscriptionKind(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.XSubscriptionKind.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Subscription-Kind",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// EventNotificationParams is parameters of eventNotification operation.
+type EventNotificationParams struct {
+	XEventID int64
+}
+
+func unpackEventNotificationParams(packed middleware.Parameters) (params EventNotificationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Event-Id",
+			In:   "header",
+		}
+		params.XEventID = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeEventNotificationParams(args [0]string, argsEscaped bool, r *http.Request) (params EventNotificationParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Event-Id.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Event-Id",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.XEventID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Event-Id",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SubscriptionStatusParams is parameters of subscriptionStatus operation.
+type SubscriptionStatusParams struct {
+	State string
+}
+
+func unpackSubscriptionStatusParams(packed middleware.Parameters) (params SubscriptionStatusParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "state",
+			In:   "query",
+		}
+		params.State = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSubscriptionStatusParams(args [0]string, argsEscaped bool, r *http.Request) (params SubscriptionStatusParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: state.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.State = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "state",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,185 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/url"
+
+	"github.com/go-faster/errors"
+)
+
+// Ref: #/components/schemas/Event
+type Event struct {
+	Topic   string `json:"topic"`
+	Payload string `json:"payload"`
+}
+
+// GetTopic returns the value of Topic.
+func (s *Event) GetTopic() string {
+	return s.Topic
+}
+
+// GetPayload returns the value of Payload.
+func (s *Event) GetPayload() string {
+	return s.Payload
+}
+
+// SetTopic sets the value of Topic.
+func (s *Event) SetTopic(val string) {
+	s.Topic = val
+}
+
+// SetPayload sets the value of Payload.
+func (s *Event) SetPayload(val string) {
+	s.Payload = val
+}
+
+// Ref: #/components/schemas/EventAck
+type EventAck struct {
+	Received bool `json:"received"`
+}
+
+// GetReceived returns the value of Received.
+func (s *EventAck) GetReceived() bool {
+	return s.Received
+}
+
+// SetReceived sets the value of Received.
+func (s *EventAck) SetReceived(val bool) {
+	s.Received = val
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// ResubscribeOK is response for Resubscribe operation.
+type ResubscribeOK struct{}
+
+// Ref: #/components/schemas/Subscription
+type Subscription struct {
+	CallbackUrl url.URL   `json:"callbackUrl"`
+	Topic       OptString `json:"topic"`
+}
+
+// GetCallbackUrl returns the value of CallbackUrl.
+func (s *Subscription) GetCallbackUrl() url.URL {
+	return s.CallbackUrl
+}
+
+// GetTopic returns the value of Topic.
+func (s *Subscription) GetTopic() OptString {
+	return s.Topic
+}
+
+// SetCallbackUrl sets the value of CallbackUrl.
+func (s *Subscription) SetCallbackUrl(val url.URL) {
+	s.CallbackUrl = val
+}
+
+// SetTopic sets the value of Topic.
+func (s *Subscription) SetTopic(val OptString) {
+	s.Topic = val
+}
+
+// Ref: #/components/schemas/SubscriptionID
+type SubscriptionID struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *SubscriptionID) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *SubscriptionID) SetID(val string) {
+	s.ID = val
+}
+
+// Ref: #/components/schemas/SubscriptionKind
+type SubscriptionKind string
+
+const (
+	SubscriptionKindEvents SubscriptionKind = "events"
+	SubscriptionKindAudit  SubscriptionKind = "audit"
+)
+
+// AllValues returns all SubscriptionKind values.
+func (SubscriptionKind) AllValues() []SubscriptionKind {
+	return []SubscriptionKind{
+		SubscriptionKindEvents,
+		SubscriptionKindAudit,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SubscriptionKind) MarshalText() ([]byte, error) {
+	switch s {
+	case SubscriptionKindEvents:
+		return []byte(s), nil
+	case SubscriptionKindAudit:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SubscriptionKind) UnmarshalText(data []byte) error {
+	switch SubscriptionKind(data) {
+	case SubscriptionKindEvents:
+		*s = SubscriptionKindEvents
+		return nil
+	case SubscriptionKindAudit:
+		*s = SubscriptionKindAudit
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// SubscriptionStatusNoContent is response for SubscriptionStatus operation.
+type SubscriptionStatusNoContent struct{}
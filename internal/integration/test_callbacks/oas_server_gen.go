@@ -0,0 +1,67 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// Resubscribe implements resubscribe operation.
+	//
+	// POST /resubscribe
+	Resubscribe(ctx context.Context, req *Subscription) error
+	// Subscribe implements subscribe operation.
+	//
+	// POST /subscribe
+	Subscribe(ctx context.Context, req *Subscription, params SubscribeParams) (*SubscriptionID, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
+
+// CallbackHandler handles callbacks described by OpenAPI v3 specification.
+type CallbackHandler interface {
+	// EventNotification implements eventNotification operation.
+	//
+	EventNotification(ctx context.Context, req *Event, params EventNotificationParams) (*EventAck, error)
+	// SubscriptionStatus implements subscriptionStatus operation.
+	//
+	SubscriptionStatus(ctx context.Context, params SubscriptionStatusParams) error
+}
+
+// CallbackServer implements http server based on OpenAPI v3 specification and
+// calls CallbackHandler to handle requests.
+type CallbackServer struct {
+	h CallbackHandler
+	baseServer
+}
+
+// NewCallbackServer creates new CallbackServer.
+func NewCallbackServer(h CallbackHandler, opts ...ServerOption) (*CallbackServer, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackServer{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
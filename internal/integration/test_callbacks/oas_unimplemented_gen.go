@@ -0,0 +1,40 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// Resubscribe implements resubscribe operation.
+//
+// POST /resubscribe
+func (UnimplementedHandler) Resubscribe(ctx context.Context, req *Subscription) error {
+	return ht.ErrNotImplemented
+}
+
+// Subscribe implements subscribe operation.
+//
+// POST /subscribe
+func (UnimplementedHandler) Subscribe(ctx context.Context, req *Subscription, params SubscribeParams) (r *SubscriptionID, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+var _ CallbackHandler = UnimplementedHandler{}
+
+// EventNotification implements eventNotification operation.
+func (UnimplementedHandler) EventNotification(ctx context.Context, req *Event, params EventNotificationParams) (r *EventAck, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SubscriptionStatus implements subscriptionStatus operation.
+func (UnimplementedHandler) SubscriptionStatus(ctx context.Context, params SubscriptionStatusParams) error {
+	return ht.ErrNotImplemented
+}
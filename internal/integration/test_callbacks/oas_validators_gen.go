@@ -0,0 +1,18 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+)
+
+func (s SubscriptionKind) Validate() error {
+	switch s {
+	case "events":
+		return nil
+	case "audit":
+		return nil
+	default:
+		return errors.Errorf("invalid value: %v", s)
+	}
+}
@@ -0,0 +1,12 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	UpdateBillingOperation  OperationName = "UpdateBilling"
+	UpdatePaymentOperation  OperationName = "UpdatePayment"
+	UpdateShippingOperation OperationName = "UpdateShipping"
+)
@@ -0,0 +1,311 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+)
+
+// Ref: #/components/schemas/Billing
+type Billing struct {
+	Name       string    `json:"name"`
+	Street     OptString `json:"street"`
+	City       OptString `json:"city"`
+	PostalCode OptString `json:"postal_code"`
+	TaxID      OptString `json:"tax_id"`
+	Company    OptString `json:"company"`
+}
+
+// GetName returns the value of Name.
+func (s *Billing) GetName() string {
+	return s.Name
+}
+
+// GetStreet returns the value of Street.
+func (s *Billing) GetStreet() OptString {
+	return s.Street
+}
+
+// GetCity returns the value of City.
+func (s *Billing) GetCity() OptString {
+	return s.City
+}
+
+// GetPostalCode returns the value of PostalCode.
+func (s *Billing) GetPostalCode() OptString {
+	return s.PostalCode
+}
+
+// GetTaxID returns the value of TaxID.
+func (s *Billing) GetTaxID() OptString {
+	return s.TaxID
+}
+
+// GetCompany returns the value of Company.
+func (s *Billing) GetCompany() OptString {
+	return s.Company
+}
+
+// SetName sets the value of Name.
+func (s *Billing) SetName(val string) {
+	s.Name = val
+}
+
+// SetStreet sets the value of Street.
+func (s *Billing) SetStreet(val OptString) {
+	s.Street = val
+}
+
+// SetCity sets the value of City.
+func (s *Billing) SetCity(val OptString) {
+	s.City = val
+}
+
+// SetPostalCode sets the value of PostalCode.
+func (s *Billing) SetPostalCode(val OptString) {
+	s.PostalCode = val
+}
+
+// SetTaxID sets the value of TaxID.
+func (s *Billing) SetTaxID(val OptString) {
+	s.TaxID = val
+}
+
+// SetCompany sets the value of Company.
+func (s *Billing) SetCompany(val OptString) {
+	s.Company = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Payment
+type Payment struct {
+	Method     OptString `json:"method"`
+	CardNumber OptString `json:"card_number"`
+	Iban       OptString `json:"iban"`
+	Amount     OptInt    `json:"amount"`
+}
+
+// GetMethod returns the value of Method.
+func (s *Payment) GetMethod() OptString {
+	return s.Method
+}
+
+// GetCardNumber returns the value of CardNumber.
+func (s *Payment) GetCardNumber() OptString {
+	return s.CardNumber
+}
+
+// GetIban returns the value of Iban.
+func (s *Payment) GetIban() OptString {
+	return s.Iban
+}
+
+// GetAmount returns the value of Amount.
+func (s *Payment) GetAmount() OptInt {
+	return s.Amount
+}
+
+// SetMethod sets the value of Method.
+func (s *Payment) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetCardNumber sets the value of CardNumber.
+func (s *Payment) SetCardNumber(val OptString) {
+	s.CardNumber = val
+}
+
+// SetIban sets the value of Iban.
+func (s *Payment) SetIban(val OptString) {
+	s.Iban = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *Payment) SetAmount(val OptInt) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/Shipping
+type Shipping struct {
+	Country    ShippingCountry `json:"country"`
+	PostalCode OptString       `json:"postal_code"`
+	State      OptString       `json:"state"`
+}
+
+// GetCountry returns the value of Country.
+func (s *Shipping) GetCountry() ShippingCountry {
+	return s.Country
+}
+
+// GetPostalCode returns the value of PostalCode.
+func (s *Shipping) GetPostalCode() OptString {
+	return s.PostalCode
+}
+
+// GetState returns the value of State.
+func (s *Shipping) GetState() OptString {
+	return s.State
+}
+
+// SetCountry sets the value of Country.
+func (s *Shipping) SetCountry(val ShippingCountry) {
+	s.Country = val
+}
+
+// SetPostalCode sets the value of PostalCode.
+func (s *Shipping) SetPostalCode(val OptString) {
+	s.PostalCode = val
+}
+
+// SetState sets the value of State.
+func (s *Shipping) SetState(val OptString) {
+	s.State = val
+}
+
+type ShippingCountry string
+
+const (
+	ShippingCountryUS ShippingCountry = "US"
+	ShippingCountryCA ShippingCountry = "CA"
+	ShippingCountryNL ShippingCountry = "NL"
+)
+
+// AllValues returns all ShippingCountry values.
+func (ShippingCountry) AllValues() []ShippingCountry {
+	return []ShippingCountry{
+		ShippingCountryUS,
+		ShippingCountryCA,
+		ShippingCountryNL,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ShippingCountry) MarshalText() ([]byte, error) {
+	switch s {
+	case ShippingCountryUS:
+		return []byte(s), nil
+	case ShippingCountryCA:
+		return []byte(s), nil
+	case ShippingCountryNL:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ShippingCountry) UnmarshalText(data []byte) error {
+	switch ShippingCountry(data) {
+	case ShippingCountryUS:
+		*s = ShippingCountryUS
+		return nil
+	case ShippingCountryCA:
+		*s = ShippingCountryCA
+		return nil
+	case ShippingCountryNL:
+		*s = ShippingCountryNL
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// UpdateBillingNoContent is response for UpdateBilling operation.
+type UpdateBillingNoContent struct{}
+
+// UpdatePaymentNoContent is response for UpdatePayment operation.
+type UpdatePaymentNoContent struct{}
+
+// UpdateShippingNoContent is response for UpdateShipping operation.
+type UpdateShippingNoContent struct{}
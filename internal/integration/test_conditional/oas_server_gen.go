@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// UpdateBilling implements updateBilling operation.
+	//
+	// POST /billing
+	UpdateBilling(ctx context.Context, req *Billing) error
+	// UpdatePayment implements updatePayment operation.
+	//
+	// POST /payment
+	UpdatePayment(ctx context.Context, req *Payment) error
+	// UpdateShipping implements updateShipping operation.
+	//
+	// POST /shipping
+	UpdateShipping(ctx context.Context, req *Shipping) error
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// UpdateBilling implements updateBilling operation.
+//
+// POST /billing
+func (UnimplementedHandler) UpdateBilling(ctx context.Context, req *Billing) error {
+	return ht.ErrNotImplemented
+}
+
+// UpdatePayment implements updatePayment operation.
+//
+// POST /payment
+func (UnimplementedHandler) UpdatePayment(ctx context.Context, req *Payment) error {
+	return ht.ErrNotImplemented
+}
+
+// UpdateShipping implements updateShipping operation.
+//
+// POST /shipping
+func (UnimplementedHandler) UpdateShipping(ctx context.Context, req *Shipping) error {
+	return ht.ErrNotImplemented
+}
@@ -0,0 +1,238 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func (s *Billing) Validate() error {
+	if s == nil {
+		return validate.ErrNilPointer
+	}
+
+	var failures []validate.FieldError
+	if s.Company.Set {
+		if !s.TaxID.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "tax_id",
+				Error: &validate.DependentRequiredError{Dependency: "company"},
+			})
+		}
+	}
+	if s.Street.Set {
+		if !s.City.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "city",
+				Error: &validate.DependentRequiredError{Dependency: "street"},
+			})
+		}
+		if !s.PostalCode.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "postal_code",
+				Error: &validate.DependentRequiredError{Dependency: "street"},
+			})
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+func (s *Payment) Validate() error {
+	if s == nil {
+		return validate.ErrNilPointer
+	}
+
+	var failures []validate.FieldError
+	if s.CardNumber.Set {
+		if !s.Method.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "method",
+				Error: validate.ErrFieldRequired,
+			})
+		}
+		if err := func() error {
+			value, ok := s.Method.Get()
+			if !ok {
+				return nil
+			}
+			switch value {
+			case "card":
+			default:
+				return errors.Errorf("invalid value: %v", value)
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "method",
+				Error: err,
+			})
+		}
+		if err := func() error {
+			value, ok := s.Amount.Get()
+			if !ok {
+				return nil
+			}
+			if err := (validate.Int{
+				MinSet:        false,
+				Min:           0,
+				MaxSet:        true,
+				Max:           10000,
+				MinExclusive:  false,
+				MaxExclusive:  false,
+				MultipleOfSet: false,
+				MultipleOf:    0,
+				Pattern:       nil,
+			}).Validate(int64(value)); err != nil {
+				return errors.Wrap(err, "int")
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "amount",
+				Error: err,
+			})
+		}
+	}
+	if s.Iban.Set {
+		if err := func() error {
+			value, ok := s.Method.Get()
+			if !ok {
+				return nil
+			}
+			switch value {
+			case "sepa", "wire":
+			default:
+				return errors.Errorf("invalid value: %v", value)
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "method",
+				Error: err,
+			})
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+func (s *Shipping) Validate() error {
+	if s == nil {
+		return validate.ErrNilPointer
+	}
+
+	var failures []validate.FieldError
+	if err := func() error {
+		if err := s.Country.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "country",
+			Error: err,
+		})
+	}
+	if ifFailures := func() (failures []validate.FieldError) {
+		if err := func() error {
+			value := s.Country
+			switch value {
+			case "US":
+			default:
+				return errors.Errorf("invalid value: %v", value)
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "country",
+				Error: err,
+			})
+		}
+		return failures
+	}(); len(ifFailures) == 0 {
+		if !s.State.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "state",
+				Error: validate.ErrFieldRequired,
+			})
+		}
+		if err := func() error {
+			value, ok := s.PostalCode.Get()
+			if !ok {
+				return nil
+			}
+			if err := (validate.String{
+				MinLength:     0,
+				MinLengthSet:  false,
+				MaxLength:     0,
+				MaxLengthSet:  false,
+				Email:         false,
+				Hostname:      false,
+				Regex:         regexMap["^[0-9]{5}(-[0-9]{4})?$"],
+				MinNumeric:    0,
+				MinNumericSet: false,
+				MaxNumeric:    0,
+				MaxNumericSet: false,
+			}).Validate(string(value)); err != nil {
+				return errors.Wrap(err, "string")
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "postal_code",
+				Error: err,
+			})
+		}
+	} else {
+		if err := func() error {
+			value, ok := s.PostalCode.Get()
+			if !ok {
+				return nil
+			}
+			if err := (validate.String{
+				MinLength:     4,
+				MinLengthSet:  true,
+				MaxLength:     10,
+				MaxLengthSet:  true,
+				Email:         false,
+				Hostname:      false,
+				Regex:         nil,
+				MinNumeric:    0,
+				MinNumericSet: false,
+				MaxNumeric:    0,
+				MaxNumericSet: false,
+			}).Validate(string(value)); err != nil {
+				return errors.Wrap(err, "string")
+			}
+			return nil
+		}(); err != nil {
+			failures = append(failures, validate.FieldError{
+				Name:  "postal_code",
+				Error: err,
+			})
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+func (s ShippingCountry) Validate() error {
+	switch s {
+	case "US":
+		return nil
+	case "CA":
+		return nil
+	case "NL":
+		return nil
+	default:
+		return errors.Errorf("invalid value: %v", s)
+	}
+}
@@ -0,0 +1,321 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// Ref: #/components/schemas/Meta
+type Meta struct {
+	Name string `json:"name"`
+	Size OptInt `json:"size"`
+}
+
+// GetName returns the value of Name.
+func (s *Meta) GetName() string {
+	return s.Name
+}
+
+// GetSize returns the value of Size.
+func (s *Meta) GetSize() OptInt {
+	return s.Size
+}
+
+// SetName sets the value of Name.
+func (s *Meta) SetName(val string) {
+	s.Name = val
+}
+
+// SetSize sets the value of Size.
+func (s *Meta) SetSize(val OptInt) {
+	s.Size = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBase64URL returns new OptNilBase64URL with value set to v.
+func NewOptNilBase64URL(v []byte) OptNilBase64URL {
+	return OptNilBase64URL{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBase64URL is optional nullable []byte.
+type OptNilBase64URL struct {
+	Value []byte
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBase64URL was set.
+func (o OptNilBase64URL) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBase64URL) Reset() {
+	var v []byte
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBase64URL) SetTo(v []byte) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilBase64URL) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilBase64URL) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []byte
+	o.Value = v
+}
+
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilBase64URL) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBase64URL) Get() (v []byte, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBase64URL) Or(d []byte) []byte {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptToken returns new OptToken with value set to v.
+func NewOptToken(v Token) OptToken {
+	return OptToken{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptToken is optional Token.
+type OptToken struct {
+	Value Token
+	Set   bool
+}
+
+// IsSet returns true if OptToken was set.
+func (o OptToken) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptToken) Reset() {
+	var v Token
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptToken) SetTo(v Token) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptToken) Get() (v Token, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptToken) Or(d Token) Token {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Token
+type Token TokenContent
+
+type TokenContent struct {
+	Sub string `json:"sub"`
+	Exp OptInt `json:"exp"`
+}
+
+// GetSub returns the value of Sub.
+func (s *TokenContent) GetSub() string {
+	return s.Sub
+}
+
+// GetExp returns the value of Exp.
+func (s *TokenContent) GetExp() OptInt {
+	return s.Exp
+}
+
+// SetSub sets the value of Sub.
+func (s *TokenContent) SetSub(val string) {
+	s.Sub = val
+}
+
+// SetExp sets the value of Exp.
+func (s *TokenContent) SetExp(val OptInt) {
+	s.Exp = val
+}
+
+// Ref: #/components/schemas/Upload
+type Upload struct {
+	Data      []byte          `json:"data"`
+	Thumbnail []byte          `json:"thumbnail"`
+	Checksum  OptNilBase64URL `json:"checksum"`
+	Meta      UploadMeta      `json:"meta"`
+	Labels    UploadLabels    `json:"labels"`
+	Token     OptToken        `json:"token"`
+}
+
+// GetData returns the value of Data.
+func (s *Upload) GetData() []byte {
+	return s.Data
+}
+
+// GetThumbnail returns the value of Thumbnail.
+func (s *Upload) GetThumbnail() []byte {
+	return s.Thumbnail
+}
+
+// GetChecksum returns the value of Checksum.
+func (s *Upload) GetChecksum() OptNilBase64URL {
+	return s.Checksum
+}
+
+// GetMeta returns the value of Meta.
+func (s *Upload) GetMeta() UploadMeta {
+	return s.Meta
+}
+
+// GetLabels returns the value of Labels.
+func (s *Upload) GetLabels() UploadLabels {
+	return s.Labels
+}
+
+// GetToken returns the value of Token.
+func (s *Upload) GetToken() OptToken {
+	return s.Token
+}
+
+// SetData sets the value of Data.
+func (s *Upload) SetData(val []byte) {
+	s.Data = val
+}
+
+// SetThumbnail sets the value of Thumbnail.
+func (s *Upload) SetThumbnail(val []byte) {
+	s.Thumbnail = val
+}
+
+// SetChecksum sets the value of Checksum.
+func (s *Upload) SetChecksum(val OptNilBase64URL) {
+	s.Checksum = val
+}
+
+// SetMeta sets the value of Meta.
+func (s *Upload) SetMeta(val UploadMeta) {
+	s.Meta = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *Upload) SetLabels(val UploadLabels) {
+	s.Labels = val
+}
+
+// SetToken sets the value of Token.
+func (s *Upload) SetToken(val OptToken) {
+	s.Token = val
+}
+
+func (*Upload) uploadReq() {}
+
+// Ref: #/components/schemas/UploadForm
+type UploadForm struct {
+	Data []byte `json:"data"`
+	Tag  []byte `json:"tag"`
+}
+
+// GetData returns the value of Data.
+func (s *UploadForm) GetData() []byte {
+	return s.Data
+}
+
+// GetTag returns the value of Tag.
+func (s *UploadForm) GetTag() []byte {
+	return s.Tag
+}
+
+// SetData sets the value of Data.
+func (s *UploadForm) SetData(val []byte) {
+	s.Data = val
+}
+
+// SetTag sets the value of Tag.
+func (s *UploadForm) SetTag(val []byte) {
+	s.Tag = val
+}
+
+func (*UploadForm) uploadReq() {}
+
+type UploadLabels []string
+
+type UploadMeta Meta
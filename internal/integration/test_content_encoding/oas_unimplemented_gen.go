@@ -0,0 +1,21 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// Upload implements upload operation.
+//
+// POST /upload/{id}
+func (UnimplementedHandler) Upload(ctx context.Context, req UploadReq, params UploadParams) (r *Upload, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,281 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// Ref: #/components/schemas/Circle
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+// GetRadius returns the value of Radius.
+func (s *Circle) GetRadius() float64 {
+	return s.Radius
+}
+
+// SetRadius sets the value of Radius.
+func (s *Circle) SetRadius(val float64) {
+	s.Radius = val
+}
+
+// Ref: #/components/schemas/Labels
+type Labels map[string]string
+
+func (s *Labels) init() Labels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLabels returns new OptLabels with value set to v.
+func NewOptLabels(v Labels) OptLabels {
+	return OptLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLabels is optional Labels.
+type OptLabels struct {
+	Value Labels
+	Set   bool
+}
+
+// IsSet returns true if OptLabels was set.
+func (o OptLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLabels) Reset() {
+	var v Labels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLabels) SetTo(v Labels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLabels) Get() (v Labels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLabels) Or(d Labels) Labels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Merged schema.
+// Ref: #/components/schemas/Pet
+type Pet struct {
+	Name string `json:"name"`
+	Age  OptInt `json:"age"`
+}
+
+// GetName returns the value of Name.
+func (s *Pet) GetName() string {
+	return s.Name
+}
+
+// GetAge returns the value of Age.
+func (s *Pet) GetAge() OptInt {
+	return s.Age
+}
+
+// SetName sets the value of Name.
+func (s *Pet) SetName(val string) {
+	s.Name = val
+}
+
+// SetAge sets the value of Age.
+func (s *Pet) SetAge(val OptInt) {
+	s.Age = val
+}
+
+// Ref: #/components/schemas/Shape
+type Shape struct {
+	ID    string `json:"id"`
+	OneOf ShapeSum
+}
+
+// GetID returns the value of ID.
+func (s *Shape) GetID() string {
+	return s.ID
+}
+
+// GetOneOf returns the value of OneOf.
+func (s *Shape) GetOneOf() ShapeSum {
+	return s.OneOf
+}
+
+// SetID sets the value of ID.
+func (s *Shape) SetID(val string) {
+	s.ID = val
+}
+
+// SetOneOf sets the value of OneOf.
+func (s *Shape) SetOneOf(val ShapeSum) {
+	s.OneOf = val
+}
+
+// ShapeSum represents sum type.
+type ShapeSum struct {
+	// Type selects the active sum variant, switch on this field.
+	Type   ShapeSumType
+	Circle Circle
+	Square Square
+}
+
+// ShapeSumType is oneOf type of ShapeSum.
+type ShapeSumType string
+
+// Possible values for ShapeSumType.
+const (
+	CircleShapeSum ShapeSumType = "Circle"
+	SquareShapeSum ShapeSumType = "Square"
+)
+
+// IsCircle reports whether ShapeSum is Circle.
+func (s ShapeSum) IsCircle() bool { return s.Type == CircleShapeSum }
+
+// IsSquare reports whether ShapeSum is Square.
+func (s ShapeSum) IsSquare() bool { return s.Type == SquareShapeSum }
+
+// SetCircle sets ShapeSum to Circle.
+func (s *ShapeSum) SetCircle(v Circle) {
+	s.Type = CircleShapeSum
+	s.Circle = v
+}
+
+// GetCircle returns Circle and true boolean if ShapeSum is Circle.
+func (s ShapeSum) GetCircle() (v Circle, ok bool) {
+	if !s.IsCircle() {
+		return v, false
+	}
+	return s.Circle, true
+}
+
+// NewCircleShapeSum returns new ShapeSum from Circle.
+func NewCircleShapeSum(v Circle) ShapeSum {
+	var s ShapeSum
+	s.SetCircle(v)
+	return s
+}
+
+// SetSquare sets ShapeSum to Square.
+func (s *ShapeSum) SetSquare(v Square) {
+	s.Type = SquareShapeSum
+	s.Square = v
+}
+
+// GetSquare returns Square and true boolean if ShapeSum is Square.
+func (s ShapeSum) GetSquare() (v Square, ok bool) {
+	if !s.IsSquare() {
+		return v, false
+	}
+	return s.Square, true
+}
+
+// NewSquareShapeSum returns new ShapeSum from Square.
+func NewSquareShapeSum(v Square) ShapeSum {
+	var s ShapeSum
+	s.SetSquare(v)
+	return s
+}
+
+// Ref: #/components/schemas/Square
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+// GetSide returns the value of Side.
+func (s *Square) GetSide() float64 {
+	return s.Side
+}
+
+// SetSide sets the value of Side.
+func (s *Square) SetSide(val float64) {
+	s.Side = val
+}
+
+// Ref: #/components/schemas/User
+type User struct {
+	Login  string    `json:"login"`
+	Labels OptLabels `json:"labels"`
+}
+
+// GetLogin returns the value of Login.
+func (s *User) GetLogin() string {
+	return s.Login
+}
+
+// GetLabels returns the value of Labels.
+func (s *User) GetLabels() OptLabels {
+	return s.Labels
+}
+
+// SetLogin sets the value of Login.
+func (s *User) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *User) SetLabels(val OptLabels) {
+	s.Labels = val
+}
@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreatePet implements createPet operation.
+	//
+	// POST /pets
+	CreatePet(ctx context.Context, req *Pet) (*Pet, error)
+	// CreateShape implements createShape operation.
+	//
+	// POST /shapes
+	CreateShape(ctx context.Context, req *Shape) (*Shape, error)
+	// CreateUser implements createUser operation.
+	//
+	// POST /users
+	CreateUser(ctx context.Context, req *User) (*User, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
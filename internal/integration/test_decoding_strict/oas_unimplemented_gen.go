@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreatePet implements createPet operation.
+//
+// POST /pets
+func (UnimplementedHandler) CreatePet(ctx context.Context, req *Pet) (r *Pet, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateShape implements createShape operation.
+//
+// POST /shapes
+func (UnimplementedHandler) CreateShape(ctx context.Context, req *Shape) (r *Shape, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateUser implements createUser operation.
+//
+// POST /users
+func (UnimplementedHandler) CreateUser(ctx context.Context, req *User) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -8,6 +8,7 @@ import (
 	"mime"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
@@ -40,6 +41,9 @@ func (s *Server) decodeOnlyFormRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -55,7 +59,9 @@ func (s *Server) decodeOnlyFormRequest(r *http.Request) (
 		}
 
 		var request OnlyFormReq
-		defined := func(name string) bool {
+		defined := func(key string) bool {
+			// Nested parameters use keys like "name[field]".
+			name, _, _ := strings.Cut(key, "[")
 			switch name {
 			case "field":
 				// Form parameter.
@@ -125,6 +131,9 @@ func (s *Server) decodeOnlyMultipartFileRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -134,7 +143,7 @@ func (s *Server) decodeOnlyMultipartFileRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -147,7 +156,9 @@ func (s *Server) decodeOnlyMultipartFileRequest(r *http.Request) (
 		_ = form
 
 		var request OnlyMultipartFileReq
-		defined := func(name string) bool {
+		defined := func(key string) bool {
+			// Nested parameters use keys like "name[field]".
+			name, _, _ := strings.Cut(key, "[")
 			switch name {
 			case "file":
 				// File parameter.
@@ -218,6 +229,9 @@ func (s *Server) decodeOnlyMultipartFormRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -227,7 +241,7 @@ func (s *Server) decodeOnlyMultipartFormRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -240,7 +254,9 @@ func (s *Server) decodeOnlyMultipartFormRequest(r *http.Request) (
 		_ = form
 
 		var request OnlyMultipartFormReq
-		defined := func(name string) bool {
+		defined := func(key string) bool {
+			// Nested parameters use keys like "name[field]".
+			name, _, _ := strings.Cut(key, "[")
 			switch name {
 			case "field":
 				// Form parameter.
@@ -310,6 +326,9 @@ func (s *Server) decodeTestFormURLEncodedRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -523,6 +542,9 @@ func (s *Server) decodeTestMultipartRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -532,7 +554,7 @@ func (s *Server) decodeTestMultipartRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -748,6 +770,9 @@ func (s *Server) decodeTestMultipartUploadRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -757,7 +782,7 @@ func (s *Server) decodeTestMultipartUploadRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -770,7 +795,9 @@ func (s *Server) decodeTestMultipartUploadRequest(r *http.Request) (
 		_ = form
 
 		var request TestMultipartUploadReq
-		defined := func(name string) bool {
+		defined := func(key string) bool {
+			// Nested parameters use keys like "name[field]".
+			name, _, _ := strings.Cut(key, "[")
 			switch name {
 			case "orderId":
 				// Form parameter.
@@ -982,6 +1009,9 @@ func (s *Server) decodeTestReuseFormOptionalSchemaRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	if _, ok := r.Header["Content-Type"]; !ok && r.ContentLength == 0 {
 		return req, rawBody, close, nil
 	}
@@ -994,7 +1024,7 @@ func (s *Server) decodeTestReuseFormOptionalSchemaRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, nil
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -1098,6 +1128,9 @@ func (s *Server) decodeTestReuseFormSchemaRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1107,7 +1140,7 @@ func (s *Server) decodeTestReuseFormSchemaRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
@@ -1204,6 +1237,9 @@ func (s *Server) decodeTestShareFormSchemaRequest(r *http.Request) (
 			rerr = errors.Join(rerr, close())
 		}
 	}()
+	if err := ogenerrors.LimitBody(r, s.cfg.MaxRequestBodySize); err != nil {
+		return req, rawBody, close, err
+	}
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, rawBody, close, errors.Wrap(err, "parse media type")
@@ -1229,6 +1265,9 @@ func (s *Server) decodeTestShareFormSchemaRequest(r *http.Request) (
 		}
 
 		rawBody = append(rawBody, buf...)
+		if err := ogenerrors.CheckJSONLimits(buf, s.cfg.JSONLimits); err != nil {
+			return req, rawBody, close, err
+		}
 		d := jx.DecodeBytes(buf)
 
 		var request SharedRequest
@@ -1253,7 +1292,7 @@ func (s *Server) decodeTestShareFormSchemaRequest(r *http.Request) (
 		if r.ContentLength == 0 {
 			return req, rawBody, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+		if err := ht.ParseMultipartForm(r, s.cfg.MaxMultipartMemory); err != nil {
 			return req, rawBody, close, errors.Wrap(err, "parse multipart form")
 		}
 		// Remove all temporary files created by ParseMultipartForm when the request is done.
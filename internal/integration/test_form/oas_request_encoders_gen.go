@@ -84,13 +84,14 @@ func encodeOnlyMultipartFormRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+	// Body without files can be replayed.
+	body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
 		return nil
 	})
-	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 	return nil
 }
 
@@ -324,13 +325,14 @@ func encodeTestMultipartRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+	// Body without files can be replayed.
+	body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
 		return nil
 	})
-	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 	return nil
 }
 
@@ -0,0 +1,336 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/uri"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func encodeUpdateProfileRequest(
+	req *Profile,
+	r *http.Request,
+) error {
+	const contentType = "application/x-www-form-urlencoded"
+	request := req
+
+	q := uri.NewFormEncoder(map[string]string{})
+	{
+		// Encode "name" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(request.Name))
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "address" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "address",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.Address.Get(); ok {
+				return val.EncodeURI(e)
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "items" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "items",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if request.Items != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range request.Items {
+						if err := func() error {
+							// Items of arrays of objects and arrays are encoded with indices.
+							return e.EncodeField(strconv.Itoa(i), func(e uri.Encoder) error {
+								return item.EncodeURI(e)
+							})
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	for k, elem := range request.AdditionalProps {
+		// Encode additional form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    k,
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(elem))
+		}); err != nil {
+			return errors.Wrapf(err, "encode field %q", k)
+		}
+	}
+	encoded := q.Values().Encode()
+	ht.SetBody(r, strings.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUploadRequest(
+	req *UploadMultipart,
+	r *http.Request,
+) error {
+	const contentType = "multipart/form-data"
+	request := req
+
+	q := uri.NewFormEncoder(map[string]string{
+		"metadata": "application/vnd.meta+json",
+		"note":     "text/plain",
+	})
+	{
+		// Encode "metadata" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "metadata",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			var enc jx.Encoder
+			func(e *jx.Encoder) {
+				request.Metadata.Encode(e)
+			}(&enc)
+			return e.EncodeValue(string(enc.Bytes()))
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "note" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "note",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.Note.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "options" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "options",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := request.Options.Get(); ok {
+				return val.EncodeURI(e)
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		{
+			val := request.File
+			if err := func(h textproto.MIMEHeader) error {
+				hd := uri.NewHeaderDecoder(http.Header(h))
+				// Validate "X-Checksum" header.
+				{
+					cfg := uri.HeaderParameterDecodingConfig{
+						Name:    "X-Checksum",
+						Explode: false,
+					}
+					if err := hd.HasParam(cfg); err == nil {
+						var value string
+						if err := hd.DecodeParam(cfg, func(d uri.Decoder) error {
+							val, err := d.DecodeValue()
+							if err != nil {
+								return err
+							}
+
+							c, err := conv.ToString(val)
+							if err != nil {
+								return err
+							}
+
+							value = c
+							return nil
+						}); err != nil {
+							return errors.Wrap(err, "parse X-Checksum header")
+						}
+						if err := func() error {
+							if err := (validate.String{
+								MinLength:     0,
+								MinLengthSet:  false,
+								MaxLength:     0,
+								MaxLengthSet:  false,
+								Email:         false,
+								Hostname:      false,
+								Regex:         regexMap["^[a-f0-9]{8}$"],
+								MinNumeric:    0,
+								MinNumericSet: false,
+								MaxNumeric:    0,
+								MaxNumericSet: false,
+							}).Validate(string(value)); err != nil {
+								return errors.Wrap(err, "string")
+							}
+							return nil
+						}(); err != nil {
+							return errors.Wrap(err, "validate X-Checksum header")
+						}
+					} else {
+						return errors.Wrap(err, "X-Checksum header")
+					}
+				}
+				// Validate "X-Page" header.
+				{
+					cfg := uri.HeaderParameterDecodingConfig{
+						Name:    "X-Page",
+						Explode: false,
+					}
+					if err := hd.HasParam(cfg); err == nil {
+						var value OptInt
+						if err := hd.DecodeParam(cfg, func(d uri.Decoder) error {
+							var valueVal int
+							if err := func() error {
+								val, err := d.DecodeValue()
+								if err != nil {
+									return err
+								}
+
+								c, err := conv.ToInt(val)
+								if err != nil {
+									return err
+								}
+
+								valueVal = c
+								return nil
+							}(); err != nil {
+								return err
+							}
+							value.SetTo(valueVal)
+							return nil
+						}); err != nil {
+							return errors.Wrap(err, "parse X-Page header")
+						}
+						if err := func() error {
+							if value, ok := value.Get(); ok {
+								if err := func() error {
+									if err := (validate.Int{
+										MinSet:        true,
+										Min:           1,
+										MaxSet:        false,
+										Max:           0,
+										MinExclusive:  false,
+										MaxExclusive:  false,
+										MultipleOfSet: false,
+										MultipleOf:    0,
+										Pattern:       nil,
+									}).Validate(int64(value)); err != nil {
+										return errors.Wrap(err, "int")
+									}
+									return nil
+								}(); err != nil {
+									return err
+								}
+							}
+							return nil
+						}(); err != nil {
+							return errors.Wrap(err, "validate X-Page header")
+						}
+					}
+				}
+				return nil
+			}(val.Header); err != nil {
+				return errors.Wrap(err, "validate file headers")
+			}
+		}
+		if err := request.File.WriteMultipartAs("file", "image/png", w); err != nil {
+			return errors.Wrap(err, "write \"file\"")
+		}
+		if err := q.WriteMultipart(w); err != nil {
+			return errors.Wrap(err, "write multipart")
+		}
+		return nil
+	})
+	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	return nil
+}
+
+func encodeUploadBatchRequest(
+	req *UploadBatchReq,
+	r *http.Request,
+) error {
+	const contentType = "multipart/mixed"
+	request := req
+
+	q := uri.NewFormEncoder(map[string]string{
+		"items": "application/json; charset=utf-8",
+	})
+	{
+		// Encode "items" form field.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "items",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			var enc jx.Encoder
+			func(e *jx.Encoder) {
+				e.ArrStart()
+				for _, elem := range request.Items {
+					elem.Encode(e)
+				}
+				e.ArrEnd()
+			}(&enc)
+			return e.EncodeValue(string(enc.Bytes()))
+		}); err != nil {
+			return errors.Wrap(err, "encode query")
+		}
+	}
+	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.Attachment.Get(); ok {
+			if err := val.WriteMultipart("attachment", w); err != nil {
+				return errors.Wrap(err, "write \"attachment\"")
+			}
+		}
+		if err := q.WriteMultipart(w); err != nil {
+			return errors.Wrap(err, "write multipart")
+		}
+		return nil
+	})
+	ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+	return nil
+}
@@ -0,0 +1,591 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// Ref: #/components/schemas/Address
+type Address struct {
+	City string    `json:"city"`
+	Zip  OptString `json:"zip"`
+}
+
+// GetCity returns the value of City.
+func (s *Address) GetCity() string {
+	return s.City
+}
+
+// GetZip returns the value of Zip.
+func (s *Address) GetZip() OptString {
+	return s.Zip
+}
+
+// SetCity sets the value of City.
+func (s *Address) SetCity(val string) {
+	s.City = val
+}
+
+// SetZip sets the value of Zip.
+func (s *Address) SetZip(val OptString) {
+	s.Zip = val
+}
+
+// Ref: #/components/schemas/Item
+type Item struct {
+	Name string `json:"name"`
+	Qty  OptInt `json:"qty"`
+}
+
+// GetName returns the value of Name.
+func (s *Item) GetName() string {
+	return s.Name
+}
+
+// GetQty returns the value of Qty.
+func (s *Item) GetQty() OptInt {
+	return s.Qty
+}
+
+// SetName sets the value of Name.
+func (s *Item) SetName(val string) {
+	s.Name = val
+}
+
+// SetQty sets the value of Qty.
+func (s *Item) SetQty(val OptInt) {
+	s.Qty = val
+}
+
+// Ref: #/components/schemas/Meta
+type Meta struct {
+	Title string `json:"title"`
+	Pages OptInt `json:"pages"`
+}
+
+// GetTitle returns the value of Title.
+func (s *Meta) GetTitle() string {
+	return s.Title
+}
+
+// GetPages returns the value of Pages.
+func (s *Meta) GetPages() OptInt {
+	return s.Pages
+}
+
+// SetTitle sets the value of Title.
+func (s *Meta) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetPages sets the value of Pages.
+func (s *Meta) SetPages(val OptInt) {
+	s.Pages = val
+}
+
+// NewOptAddress returns new OptAddress with value set to v.
+func NewOptAddress(v Address) OptAddress {
+	return OptAddress{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAddress is optional Address.
+type OptAddress struct {
+	Value Address
+	Set   bool
+}
+
+// IsSet returns true if OptAddress was set.
+func (o OptAddress) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAddress) Reset() {
+	var v Address
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAddress) SetTo(v Address) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAddress) Get() (v Address, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAddress) Or(d Address) Address {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
+	Set   bool
+}
+
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUploadMultipartOptions returns new OptUploadMultipartOptions with value set to v.
+func NewOptUploadMultipartOptions(v UploadMultipartOptions) OptUploadMultipartOptions {
+	return OptUploadMultipartOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUploadMultipartOptions is optional UploadMultipartOptions.
+type OptUploadMultipartOptions struct {
+	Value UploadMultipartOptions
+	Set   bool
+}
+
+// IsSet returns true if OptUploadMultipartOptions was set.
+func (o OptUploadMultipartOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUploadMultipartOptions) Reset() {
+	var v UploadMultipartOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUploadMultipartOptions) SetTo(v UploadMultipartOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUploadMultipartOptions) Get() (v UploadMultipartOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUploadMultipartOptions) Or(d UploadMultipartOptions) UploadMultipartOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUploadMultipartOptionsQuality returns new OptUploadMultipartOptionsQuality with value set to v.
+func NewOptUploadMultipartOptionsQuality(v UploadMultipartOptionsQuality) OptUploadMultipartOptionsQuality {
+	return OptUploadMultipartOptionsQuality{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUploadMultipartOptionsQuality is optional UploadMultipartOptionsQuality.
+type OptUploadMultipartOptionsQuality struct {
+	Value UploadMultipartOptionsQuality
+	Set   bool
+}
+
+// IsSet returns true if OptUploadMultipartOptionsQuality was set.
+func (o OptUploadMultipartOptionsQuality) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUploadMultipartOptionsQuality) Reset() {
+	var v UploadMultipartOptionsQuality
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUploadMultipartOptionsQuality) SetTo(v UploadMultipartOptionsQuality) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUploadMultipartOptionsQuality) Get() (v UploadMultipartOptionsQuality, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUploadMultipartOptionsQuality) Or(d UploadMultipartOptionsQuality) UploadMultipartOptionsQuality {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Profile
+type Profile struct {
+	Name            string     `json:"name"`
+	Address         OptAddress `json:"address"`
+	Items           []Item     `json:"items"`
+	AdditionalProps ProfileAdditional
+}
+
+// GetName returns the value of Name.
+func (s *Profile) GetName() string {
+	return s.Name
+}
+
+// GetAddress returns the value of Address.
+func (s *Profile) GetAddress() OptAddress {
+	return s.Address
+}
+
+// GetItems returns the value of Items.
+func (s *Profile) GetItems() []Item {
+	return s.Items
+}
+
+// GetAdditionalProps returns the value of AdditionalProps.
+func (s *Profile) GetAdditionalProps() ProfileAdditional {
+	return s.AdditionalProps
+}
+
+// SetName sets the value of Name.
+func (s *Profile) SetName(val string) {
+	s.Name = val
+}
+
+// SetAddress sets the value of Address.
+func (s *Profile) SetAddress(val OptAddress) {
+	s.Address = val
+}
+
+// SetItems sets the value of Items.
+func (s *Profile) SetItems(val []Item) {
+	s.Items = val
+}
+
+// SetAdditionalProps sets the value of AdditionalProps.
+func (s *Profile) SetAdditionalProps(val ProfileAdditional) {
+	s.AdditionalProps = val
+}
+
+type ProfileAdditional map[string]string
+
+func (s *ProfileAdditional) init() ProfileAdditional {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type UploadBatchReq struct {
+	Items      []Item           `json:"items"`
+	Attachment OptMultipartFile `json:"attachment"`
+}
+
+// GetItems returns the value of Items.
+func (s *UploadBatchReq) GetItems() []Item {
+	return s.Items
+}
+
+// GetAttachment returns the value of Attachment.
+func (s *UploadBatchReq) GetAttachment() OptMultipartFile {
+	return s.Attachment
+}
+
+// SetItems sets the value of Items.
+func (s *UploadBatchReq) SetItems(val []Item) {
+	s.Items = val
+}
+
+// SetAttachment sets the value of Attachment.
+func (s *UploadBatchReq) SetAttachment(val OptMultipartFile) {
+	s.Attachment = val
+}
+
+// Ref: #/components/schemas/Upload
+type UploadMultipart struct {
+	Metadata Meta                      `json:"metadata"`
+	Note     OptString                 `json:"note"`
+	Options  OptUploadMultipartOptions `json:"options"`
+	File     ht.MultipartFile          `json:"file"`
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *UploadMultipart) GetMetadata() Meta {
+	return s.Metadata
+}
+
+// GetNote returns the value of Note.
+func (s *UploadMultipart) GetNote() OptString {
+	return s.Note
+}
+
+// GetOptions returns the value of Options.
+func (s *UploadMultipart) GetOptions() OptUploadMultipartOptions {
+	return s.Options
+}
+
+// GetFile returns the value of File.
+func (s *UploadMultipart) GetFile() ht.MultipartFile {
+	return s.File
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *UploadMultipart) SetMetadata(val Meta) {
+	s.Metadata = val
+}
+
+// SetNote sets the value of Note.
+func (s *UploadMultipart) SetNote(val OptString) {
+	s.Note = val
+}
+
+// SetOptions sets the value of Options.
+func (s *UploadMultipart) SetOptions(val OptUploadMultipartOptions) {
+	s.Options = val
+}
+
+// SetFile sets the value of File.
+func (s *UploadMultipart) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
+
+type UploadMultipartOptions struct {
+	Quality OptUploadMultipartOptionsQuality `json:"quality"`
+}
+
+// GetQuality returns the value of Quality.
+func (s *UploadMultipartOptions) GetQuality() OptUploadMultipartOptionsQuality {
+	return s.Quality
+}
+
+// SetQuality sets the value of Quality.
+func (s *UploadMultipartOptions) SetQuality(val OptUploadMultipartOptionsQuality) {
+	s.Quality = val
+}
+
+type UploadMultipartOptionsQuality struct {
+	Level OptInt `json:"level"`
+}
+
+// GetLevel returns the value of Level.
+func (s *UploadMultipartOptionsQuality) GetLevel() OptInt {
+	return s.Level
+}
+
+// SetLevel sets the value of Level.
+func (s *UploadMultipartOptionsQuality) SetLevel(val OptInt) {
+	s.Level = val
+}
+
+// Ref: #/components/schemas/UploadResult
+type UploadResult struct {
+	Title       string    `json:"title"`
+	Note        OptString `json:"note"`
+	Level       OptInt    `json:"level"`
+	Size        int       `json:"size"`
+	ContentType string    `json:"contentType"`
+	Checksum    string    `json:"checksum"`
+}
+
+// GetTitle returns the value of Title.
+func (s *UploadResult) GetTitle() string {
+	return s.Title
+}
+
+// GetNote returns the value of Note.
+func (s *UploadResult) GetNote() OptString {
+	return s.Note
+}
+
+// GetLevel returns the value of Level.
+func (s *UploadResult) GetLevel() OptInt {
+	return s.Level
+}
+
+// GetSize returns the value of Size.
+func (s *UploadResult) GetSize() int {
+	return s.Size
+}
+
+// GetContentType returns the value of ContentType.
+func (s *UploadResult) GetContentType() string {
+	return s.ContentType
+}
+
+// GetChecksum returns the value of Checksum.
+func (s *UploadResult) GetChecksum() string {
+	return s.Checksum
+}
+
+// SetTitle sets the value of Title.
+func (s *UploadResult) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetNote sets the value of Note.
+func (s *UploadResult) SetNote(val OptString) {
+	s.Note = val
+}
+
+// SetLevel sets the value of Level.
+func (s *UploadResult) SetLevel(val OptInt) {
+	s.Level = val
+}
+
+// SetSize sets the value of Size.
+func (s *UploadResult) SetSize(val int) {
+	s.Size = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *UploadResult) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetChecksum sets the value of Checksum.
+func (s *UploadResult) SetChecksum(val string) {
+	s.Checksum = val
+}
@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// UpdateProfile implements updateProfile operation.
+//
+// POST /profile
+func (UnimplementedHandler) UpdateProfile(ctx context.Context, req *Profile) (r *Profile, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Upload implements upload operation.
+//
+// POST /upload
+func (UnimplementedHandler) Upload(ctx context.Context, req *UploadMultipart) (r *UploadResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadBatch implements uploadBatch operation.
+//
+// POST /batch
+func (UnimplementedHandler) UploadBatch(ctx context.Context, req *UploadBatchReq) (r []Item, _ error) {
+	return r, ht.ErrNotImplemented
+}
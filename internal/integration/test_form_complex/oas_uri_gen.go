@@ -0,0 +1,356 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"math/bits"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/uri"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// EncodeURI encodes Address as URI form.
+func (s *Address) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("city", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.StringToString(s.City))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"city\"")
+	}
+	if err := e.EncodeField("zip", func(e uri.Encoder) error {
+		if val, ok := s.Zip.Get(); ok {
+			return e.EncodeValue(conv.StringToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"zip\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfAddress = [2]string{
+	0: "city",
+	1: "zip",
+}
+
+// DecodeURI decodes Address from URI form.
+func (s *Address) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Address to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "city":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				s.City = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"city\"")
+			}
+		case "zip":
+			if err := func() error {
+				var sDotZipVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					sDotZipVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Zip.SetTo(sDotZipVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"zip\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Address")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(uriFieldsNameOfAddress) {
+					name = uriFieldsNameOfAddress[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Item as URI form.
+func (s *Item) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("name", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.StringToString(s.Name))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"name\"")
+	}
+	if err := e.EncodeField("qty", func(e uri.Encoder) error {
+		if val, ok := s.Qty.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"qty\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfItem = [2]string{
+	0: "name",
+	1: "qty",
+}
+
+// DecodeURI decodes Item from URI form.
+func (s *Item) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Item to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				s.Name = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "qty":
+			if err := func() error {
+				var sDotQtyVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotQtyVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Qty.SetTo(sDotQtyVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"qty\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Item")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(uriFieldsNameOfItem) {
+					name = uriFieldsNameOfItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// EncodeURI encodes UploadMultipartOptions as URI form.
+func (s *UploadMultipartOptions) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("quality", func(e uri.Encoder) error {
+		if val, ok := s.Quality.Get(); ok {
+			return val.EncodeURI(e)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"quality\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfUploadMultipartOptions = [1]string{
+	0: "quality",
+}
+
+// DecodeURI decodes UploadMultipartOptions from URI form.
+func (s *UploadMultipartOptions) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadMultipartOptions to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "quality":
+			if err := func() error {
+				var sDotQualityVal UploadMultipartOptionsQuality
+				if err := func() error {
+					return sDotQualityVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				s.Quality.SetTo(sDotQualityVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quality\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadMultipartOptions")
+	}
+
+	return nil
+}
+
+// EncodeURI encodes UploadMultipartOptionsQuality as URI form.
+func (s *UploadMultipartOptionsQuality) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("level", func(e uri.Encoder) error {
+		if val, ok := s.Level.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"level\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfUploadMultipartOptionsQuality = [1]string{
+	0: "level",
+}
+
+// DecodeURI decodes UploadMultipartOptionsQuality from URI form.
+func (s *UploadMultipartOptionsQuality) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UploadMultipartOptionsQuality to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "level":
+			if err := func() error {
+				var sDotLevelVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotLevelVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Level.SetTo(sDotLevelVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UploadMultipartOptionsQuality")
+	}
+
+	return nil
+}
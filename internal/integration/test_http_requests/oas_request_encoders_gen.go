@@ -108,13 +108,14 @@ func encodeAllRequestBodiesRequest(
 				return errors.Wrap(err, "encode query")
 			}
 		}
-		body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		// Body without files can be replayed.
+		body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 			if err := q.WriteMultipart(w); err != nil {
 				return errors.Wrap(err, "write multipart")
 			}
 			return nil
 		})
-		ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+		ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 		return nil
 	case *AllRequestBodiesReqTextPlain:
 		const contentType = "text/plain"
@@ -219,13 +220,14 @@ func encodeAllRequestBodiesOptionalRequest(
 				return errors.Wrap(err, "encode query")
 			}
 		}
-		body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		// Body without files can be replayed.
+		body, boundary := ht.CreateMultipartBodyProducer(func(w *multipart.Writer) error {
 			if err := q.WriteMultipart(w); err != nil {
 				return errors.Wrap(err, "write multipart")
 			}
 			return nil
 		})
-		ht.SetCloserBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
+		ht.SetReplayableBody(r, body, mime.FormatMediaType(contentType, map[string]string{"boundary": boundary}))
 		return nil
 	case *AllRequestBodiesOptionalReqTextPlain:
 		const contentType = "text/plain"
@@ -294,22 +296,24 @@ func encodeStreamJSONRequest(
 	r *http.Request,
 ) error {
 	const contentType = "application/json"
-	body := ht.CreateBodyWriter(func(w io.Writer) (rerr error) {
-		e := jx.NewStreamingEncoder(w, -1)
-		defer func() {
-			if rerr == nil {
-				rerr = e.Close()
-			}
-		}()
-		{
-			e.ArrStart()
-			for _, elem := range req {
-				e.Float64(elem)
+	body := func() io.ReadCloser {
+		return ht.CreateBodyWriter(func(w io.Writer) (rerr error) {
+			e := jx.NewStreamingEncoder(w, -1)
+			defer func() {
+				if rerr == nil {
+					rerr = e.Close()
+				}
+			}()
+			{
+				e.ArrStart()
+				for _, elem := range req {
+					e.Float64(elem)
+				}
+				e.ArrEnd()
 			}
-			e.ArrEnd()
-		}
-		return nil
-	})
-	ht.SetCloserBody(r, body, contentType)
+			return nil
+		})
+	}
+	ht.SetReplayableBody(r, body, contentType)
 	return nil
 }
@@ -0,0 +1,13 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	StreamEventsOperation  OperationName = "StreamEvents"
+	StreamLogsOperation    OperationName = "StreamLogs"
+	StreamRecordsOperation OperationName = "StreamRecords"
+	UploadLogsOperation    OperationName = "UploadLogs"
+)
@@ -0,0 +1,24 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"io"
+	"net/http"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+func encodeUploadLogsRequest(
+	req UploadLogsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/x-ndjson"
+	if body, ok := req.Data.(io.ReadCloser); ok {
+		// Close stops the encoded sequence if request is not sent completely.
+		ht.SetCloserBody(r, body, contentType)
+	} else {
+		ht.SetBody(r, req, contentType)
+	}
+	return nil
+}
@@ -0,0 +1,930 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/json"
+	"github.com/ogen-go/ogen/sse"
+)
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+func (*ErrorStatusCode) streamLogsRes() {}
+
+// Ref: #/components/schemas/LogEntry
+type LogEntry struct {
+	Seq     int    `json:"seq"`
+	Message string `json:"message"`
+}
+
+// GetSeq returns the value of Seq.
+func (s *LogEntry) GetSeq() int {
+	return s.Seq
+}
+
+// GetMessage returns the value of Message.
+func (s *LogEntry) GetMessage() string {
+	return s.Message
+}
+
+// SetSeq sets the value of Seq.
+func (s *LogEntry) SetSeq(val int) {
+	s.Seq = val
+}
+
+// SetMessage sets the value of Message.
+func (s *LogEntry) SetMessage(val string) {
+	s.Message = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// StreamEventsOKClient reads events from the StreamEventsOK SSE stream.
+type StreamEventsOKClient interface {
+	sse.Client[StreamEventsOKEvent]
+}
+
+// StreamEventsOK is a Server-Sent Events response stream.
+//
+// Servers create it using NewStreamEventsOK or NewStreamEventsOKFromChan.
+type StreamEventsOK struct {
+	// events is the server-side event source.
+	events iter.Seq2[StreamEventsOKEvent, error]
+
+	resp      *http.Response
+	decoder   *sse.Decoder
+	connect   sseConnectFunc
+	options   sseClientConfig
+	stateMu   sync.RWMutex
+	state     sse.State
+	latestErr error
+	closed    atomic.Bool
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewStreamEventsOK creates a new StreamEventsOK stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewStreamEventsOK(events iter.Seq2[StreamEventsOKEvent, error]) *StreamEventsOK {
+	return &StreamEventsOK{events: events}
+}
+
+// NewStreamEventsOKFromChan creates a new StreamEventsOK stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewStreamEventsOKFromChan(events <-chan StreamEventsOKEvent) *StreamEventsOK {
+	return NewStreamEventsOK(func(yield func(StreamEventsOKEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
+func (s *StreamEventsOK) initSSEStream(
+	connect sseConnectFunc,
+	options sseClientConfig,
+) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.resp != nil {
+		s.decoder = newSSEResponseDecoder(s.resp, options)
+	}
+	s.connect = connect
+	s.options = options
+	s.state = sse.StateOpen
+	s.latestErr = nil
+	s.closeCh = make(chan struct{})
+}
+
+// State returns the current stream state and the latest terminal or current reconnect error.
+func (s *StreamEventsOK) State() (state sse.State, latestErr error) {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+	return s.state, s.latestErr
+}
+
+func (s *StreamEventsOK) setState(state sse.State, latestErr error) {
+	s.stateMu.Lock()
+	s.state = state
+	s.latestErr = latestErr
+	s.stateMu.Unlock()
+}
+
+func (s *StreamEventsOK) withCloseContext(ctx context.Context,
+) (context.Context, context.CancelFunc) {
+	reconnectCtx, cancel := context.WithCancel(ctx)
+	if s.closed.Load() {
+		cancel()
+		return reconnectCtx, func() {}
+	}
+	if s.closeCh == nil {
+		return reconnectCtx, cancel
+	}
+
+	go func() {
+		select {
+		case <-s.closeCh:
+			cancel()
+		case <-reconnectCtx.Done():
+		}
+	}()
+	return reconnectCtx, cancel
+}
+
+// Close closes the current stream and stops further reconnect attempts.
+func (s *StreamEventsOK) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	s.stateMu.Lock()
+	resp := s.resp
+	s.resp = nil
+	s.decoder = nil
+	closeCh := s.closeCh
+	s.state = sse.StateClosed
+	s.latestErr = sse.ErrStreamClosed
+	s.stateMu.Unlock()
+
+	if closeCh != nil {
+		s.closeOnce.Do(func() {
+			close(closeCh)
+		})
+	}
+
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	return resp.Body.Close()
+}
+
+// Next returns the next event from the stream, reconnecting when needed.
+func (s *StreamEventsOK) Next(ctx context.Context,
+) (StreamEventsOKEvent, error) {
+	for {
+		s.stateMu.RLock()
+		resp, decoder, connect, options, latestErr := s.resp, s.decoder, s.connect, s.options, s.latestErr
+		s.stateMu.RUnlock()
+		if s.closed.Load() {
+			var event StreamEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if decoder == nil {
+			// No decoder means the stream is already terminal.
+			if latestErr == nil {
+				latestErr = sse.ErrStreamClosed
+			}
+			s.setState(sse.StateClosed, latestErr)
+			var event StreamEventsOKEvent
+			return event, latestErr
+		}
+		raw, err := decoder.Decode()
+		if err == nil {
+			s.setState(sse.StateOpen, nil)
+			return s.decodeEvent(raw)
+		}
+		// Special case, on ErrEventTooLarge the current event is drained
+		// without closing the stream.
+		if errors.Is(err, sse.ErrEventTooLarge) {
+			s.setState(sse.StateOpen, err)
+			var event StreamEventsOKEvent
+			return event, err
+		}
+		if !sse.IsReconnectableError(err) {
+			s.setState(sse.StateClosed, err)
+			var event StreamEventsOKEvent
+			return event, err
+		}
+		if connect == nil {
+			// Without a reconnect function, a reconnectable read error
+			// becomes terminal.
+			s.setState(sse.StateClosed, sse.ErrStreamClosed)
+			var event StreamEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+
+		s.setState(sse.StateConnecting, err)
+		reconnectCtx, cancel := s.withCloseContext(ctx)
+		nextResp, nextDecoder, err := reconnectSSE(reconnectCtx, resp, decoder, connect, options, s)
+		cancel()
+		if s.closed.Load() {
+			if nextResp != nil && nextResp.Body != nil {
+				_ = nextResp.Body.Close()
+			}
+			var event StreamEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if err != nil {
+			s.stateMu.Lock()
+			s.resp = nil
+			s.decoder = nil
+			s.stateMu.Unlock()
+			s.setState(sse.StateClosed, err)
+			var event StreamEventsOKEvent
+			return event, err
+		}
+		s.stateMu.Lock()
+		s.resp = nextResp
+		s.decoder = nextDecoder
+		s.stateMu.Unlock()
+		s.setState(sse.StateOpen, nil)
+	}
+}
+
+// All iterates over stream events until the stream is closed, reconnecting when needed.
+func (s *StreamEventsOK) All(ctx context.Context,
+) iter.Seq2[StreamEventsOKEvent, error] {
+	return func(yield func(StreamEventsOKEvent, error) bool) {
+		for {
+			event, err := s.Next(ctx)
+			if err != nil {
+				if !sse.IsReconnectableError(err) {
+					return
+				}
+				var zero StreamEventsOKEvent
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *StreamEventsOK) decodeEvent(raw sse.Event,
+) (StreamEventsOKEvent, error) {
+	e := jx.GetEncoder()
+	e.ObjStart()
+	e.FieldStart("id")
+	e.Str(raw.ID)
+	e.FieldStart("event")
+	e.Str(raw.Type)
+	e.FieldStart("data")
+	e.Raw([]byte(raw.Data))
+	if raw.Retry != nil {
+		e.FieldStart("retry")
+		e.Int(int((*raw.Retry) / time.Millisecond))
+	}
+	e.ObjEnd()
+	buf := e.Bytes()
+	jx.PutEncoder(e)
+
+	d := jx.DecodeBytes(buf)
+	var event StreamEventsOKEvent
+	if err := func() error {
+		if err := event.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		return event, err
+	}
+	if err := func() error {
+		if err := event.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return event, errors.Wrap(err, "validate")
+	}
+	return event, nil
+}
+
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *StreamEventsOK) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *StreamEventsOK) encodeEvent(event StreamEventsOKEvent,
+) (sse.Event, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Encode(e)
+
+	var raw sse.Event
+	d := jx.DecodeBytes(e.Bytes())
+	if err := d.ObjBytes(func(d *jx.Decoder, key []byte) error {
+		switch string(key) {
+		case "id":
+			v, err := d.Str()
+			raw.ID = v
+			return err
+		case "event":
+			v, err := d.Str()
+			raw.Type = v
+			return err
+		case "data":
+			buf, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			raw.Data, err = sseEventData(buf)
+			return err
+		case "retry":
+			if d.Next() == jx.Null {
+				return d.Null()
+			}
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			retry := time.Duration(v) * time.Millisecond
+			raw.Retry = &retry
+			return nil
+		default:
+			return d.Skip()
+		}
+	}); err != nil {
+		return raw, err
+	}
+	return raw, nil
+}
+
+type StreamEventsOKEvent struct {
+	ID    string   `json:"id"`
+	Event string   `json:"event"`
+	Data  LogEntry `json:"data"`
+	Retry OptInt   `json:"retry"`
+}
+
+// GetID returns the value of ID.
+func (s *StreamEventsOKEvent) GetID() string {
+	return s.ID
+}
+
+// GetEvent returns the value of Event.
+func (s *StreamEventsOKEvent) GetEvent() string {
+	return s.Event
+}
+
+// GetData returns the value of Data.
+func (s *StreamEventsOKEvent) GetData() LogEntry {
+	return s.Data
+}
+
+// GetRetry returns the value of Retry.
+func (s *StreamEventsOKEvent) GetRetry() OptInt {
+	return s.Retry
+}
+
+// SetID sets the value of ID.
+func (s *StreamEventsOKEvent) SetID(val string) {
+	s.ID = val
+}
+
+// SetEvent sets the value of Event.
+func (s *StreamEventsOKEvent) SetEvent(val string) {
+	s.Event = val
+}
+
+// SetData sets the value of Data.
+func (s *StreamEventsOKEvent) SetData(val LogEntry) {
+	s.Data = val
+}
+
+// SetRetry sets the value of Retry.
+func (s *StreamEventsOKEvent) SetRetry(val OptInt) {
+	s.Retry = val
+}
+
+// StreamLogsOK is a stream of LogEntry items.
+//
+// Servers and clients sending the stream create it using NewStreamLogsOK or NewStreamLogsOKFromChan,
+// receivers read items using Next or All.
+type StreamLogsOK struct {
+	// Data is the encoded stream.
+	Data io.Reader
+
+	dec *json.SeqDecoder
+}
+
+// NewStreamLogsOK creates a new StreamLogsOK stream that encodes items produced by the sequence.
+//
+// Items are encoded lazily, while the stream is being sent.
+// The stream ends when the sequence ends or yields an error.
+func NewStreamLogsOK(items iter.Seq2[LogEntry, error]) StreamLogsOK {
+	return StreamLogsOK{
+		Data: json.NewSeqReader(json.SeqLines, func(yield func([]byte, error) bool) {
+			e := jx.GetEncoder()
+			defer jx.PutEncoder(e)
+
+			for item, err := range items {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				e.Reset()
+				item.Encode(e)
+				if !yield(e.Bytes(), nil) {
+					return
+				}
+			}
+		}),
+	}
+}
+
+// NewStreamLogsOKFromChan creates a new StreamLogsOK stream that encodes items received from the channel.
+//
+// The stream ends when the channel is closed.
+func NewStreamLogsOKFromChan(items <-chan LogEntry) StreamLogsOK {
+	return NewStreamLogsOK(func(yield func(LogEntry, error) bool) {
+		for item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	})
+}
+
+// Read reads encoded items from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s StreamLogsOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Close closes the Data reader, if it implements io.Closer.
+func (s StreamLogsOK) Close() error {
+	if closer, ok := s.Data.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Next decodes the next item of the stream.
+//
+// Returns io.EOF at the end of the stream.
+func (s *StreamLogsOK) Next() (LogEntry, error) {
+	var item LogEntry
+	if s.Data == nil {
+		return item, io.EOF
+	}
+	if s.dec == nil {
+		s.dec = json.NewSeqDecoder(s.Data, json.SeqLines)
+	}
+
+	buf, err := s.dec.Next()
+	if err != nil {
+		return item, err
+	}
+	d := jx.DecodeBytes(buf)
+	if err := func() error {
+		if err := item.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		return item, errors.Wrap(err, "decode item")
+	}
+	if err := func() error {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return item, errors.Wrap(err, "validate item")
+	}
+	return item, nil
+}
+
+// All iterates over stream items until the end of the stream or the first error.
+func (s *StreamLogsOK) All() iter.Seq2[LogEntry, error] {
+	return func(yield func(LogEntry, error) bool) {
+		for {
+			item, err := s.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (*StreamLogsOK) streamLogsRes() {}
+
+// StreamRecordsOK is a stream of StreamRecordsOKItem items.
+//
+// Servers and clients sending the stream create it using NewStreamRecordsOK or NewStreamRecordsOKFromChan,
+// receivers read items using Next or All.
+type StreamRecordsOK struct {
+	// Data is the encoded stream.
+	Data io.Reader
+
+	dec *json.SeqDecoder
+}
+
+// NewStreamRecordsOK creates a new StreamRecordsOK stream that encodes items produced by the sequence.
+//
+// Items are encoded lazily, while the stream is being sent.
+// The stream ends when the sequence ends or yields an error.
+func NewStreamRecordsOK(items iter.Seq2[StreamRecordsOKItem, error]) StreamRecordsOK {
+	return StreamRecordsOK{
+		Data: json.NewSeqReader(json.SeqRecords, func(yield func([]byte, error) bool) {
+			e := jx.GetEncoder()
+			defer jx.PutEncoder(e)
+
+			for item, err := range items {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				e.Reset()
+				item.Encode(e)
+				if !yield(e.Bytes(), nil) {
+					return
+				}
+			}
+		}),
+	}
+}
+
+// NewStreamRecordsOKFromChan creates a new StreamRecordsOK stream that encodes items received from the channel.
+//
+// The stream ends when the channel is closed.
+func NewStreamRecordsOKFromChan(items <-chan StreamRecordsOKItem) StreamRecordsOK {
+	return NewStreamRecordsOK(func(yield func(StreamRecordsOKItem, error) bool) {
+		for item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	})
+}
+
+// Read reads encoded items from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s StreamRecordsOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Close closes the Data reader, if it implements io.Closer.
+func (s StreamRecordsOK) Close() error {
+	if closer, ok := s.Data.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Next decodes the next item of the stream.
+//
+// Returns io.EOF at the end of the stream.
+func (s *StreamRecordsOK) Next() (StreamRecordsOKItem, error) {
+	var item StreamRecordsOKItem
+	if s.Data == nil {
+		return item, io.EOF
+	}
+	if s.dec == nil {
+		s.dec = json.NewSeqDecoder(s.Data, json.SeqRecords)
+	}
+
+	buf, err := s.dec.Next()
+	if err != nil {
+		return item, err
+	}
+	d := jx.DecodeBytes(buf)
+	if err := func() error {
+		if err := item.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		return item, errors.Wrap(err, "decode item")
+	}
+	return item, nil
+}
+
+// All iterates over stream items until the end of the stream or the first error.
+func (s *StreamRecordsOK) All() iter.Seq2[StreamRecordsOKItem, error] {
+	return func(yield func(StreamRecordsOKItem, error) bool) {
+		for {
+			item, err := s.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// StreamRecordsOKHeaders wraps StreamRecordsOK with response headers.
+type StreamRecordsOKHeaders struct {
+	XTotal   OptInt
+	Response StreamRecordsOK
+}
+
+// GetXTotal returns the value of XTotal.
+func (s *StreamRecordsOKHeaders) GetXTotal() OptInt {
+	return s.XTotal
+}
+
+// GetResponse returns the value of Response.
+func (s *StreamRecordsOKHeaders) GetResponse() StreamRecordsOK {
+	return s.Response
+}
+
+// SetXTotal sets the value of XTotal.
+func (s *StreamRecordsOKHeaders) SetXTotal(val OptInt) {
+	s.XTotal = val
+}
+
+// SetResponse sets the value of Response.
+func (s *StreamRecordsOKHeaders) SetResponse(val StreamRecordsOK) {
+	s.Response = val
+}
+
+type StreamRecordsOKItem struct {
+	Key   string `json:"key"`
+	Value OptInt `json:"value"`
+}
+
+// GetKey returns the value of Key.
+func (s *StreamRecordsOKItem) GetKey() string {
+	return s.Key
+}
+
+// GetValue returns the value of Value.
+func (s *StreamRecordsOKItem) GetValue() OptInt {
+	return s.Value
+}
+
+// SetKey sets the value of Key.
+func (s *StreamRecordsOKItem) SetKey(val string) {
+	s.Key = val
+}
+
+// SetValue sets the value of Value.
+func (s *StreamRecordsOKItem) SetValue(val OptInt) {
+	s.Value = val
+}
+
+// UploadLogsReq is a stream of LogEntry items.
+//
+// Servers and clients sending the stream create it using NewUploadLogsReq or NewUploadLogsReqFromChan,
+// receivers read items using Next or All.
+type UploadLogsReq struct {
+	// Data is the encoded stream.
+	Data io.Reader
+
+	dec *json.SeqDecoder
+}
+
+// NewUploadLogsReq creates a new UploadLogsReq stream that encodes items produced by the sequence.
+//
+// Items are encoded lazily, while the stream is being sent.
+// The stream ends when the sequence ends or yields an error.
+func NewUploadLogsReq(items iter.Seq2[LogEntry, error]) UploadLogsReq {
+	return UploadLogsReq{
+		Data: json.NewSeqReader(json.SeqLines, func(yield func([]byte, error) bool) {
+			e := jx.GetEncoder()
+			defer jx.PutEncoder(e)
+
+			for item, err := range items {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				e.Reset()
+				item.Encode(e)
+				if !yield(e.Bytes(), nil) {
+					return
+				}
+			}
+		}),
+	}
+}
+
+// NewUploadLogsReqFromChan creates a new UploadLogsReq stream that encodes items received from the channel.
+//
+// The stream ends when the channel is closed.
+func NewUploadLogsReqFromChan(items <-chan LogEntry) UploadLogsReq {
+	return NewUploadLogsReq(func(yield func(LogEntry, error) bool) {
+		for item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	})
+}
+
+// Read reads encoded items from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s UploadLogsReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// Close closes the Data reader, if it implements io.Closer.
+func (s UploadLogsReq) Close() error {
+	if closer, ok := s.Data.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Next decodes the next item of the stream.
+//
+// Returns io.EOF at the end of the stream.
+func (s *UploadLogsReq) Next() (LogEntry, error) {
+	var item LogEntry
+	if s.Data == nil {
+		return item, io.EOF
+	}
+	if s.dec == nil {
+		s.dec = json.NewSeqDecoder(s.Data, json.SeqLines)
+	}
+
+	buf, err := s.dec.Next()
+	if err != nil {
+		return item, err
+	}
+	d := jx.DecodeBytes(buf)
+	if err := func() error {
+		if err := item.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		return item, errors.Wrap(err, "decode item")
+	}
+	if err := func() error {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return item, errors.Wrap(err, "validate item")
+	}
+	return item, nil
+}
+
+// All iterates over stream items until the end of the stream or the first error.
+func (s *UploadLogsReq) All() iter.Seq2[LogEntry, error] {
+	return func(yield func(LogEntry, error) bool) {
+		for {
+			item, err := s.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Ref: #/components/schemas/UploadResult
+type UploadResult struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns the value of Count.
+func (s *UploadResult) GetCount() int {
+	return s.Count
+}
+
+// SetCount sets the value of Count.
+func (s *UploadResult) SetCount(val int) {
+	s.Count = val
+}
@@ -0,0 +1,46 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// StreamEvents implements streamEvents operation.
+	//
+	// GET /events
+	StreamEvents(ctx context.Context) (*StreamEventsOK, error)
+	// StreamLogs implements streamLogs operation.
+	//
+	// GET /logs
+	StreamLogs(ctx context.Context, params StreamLogsParams) (StreamLogsRes, error)
+	// StreamRecords implements streamRecords operation.
+	//
+	// GET /records
+	StreamRecords(ctx context.Context) (*StreamRecordsOKHeaders, error)
+	// UploadLogs implements uploadLogs operation.
+	//
+	// POST /logs
+	UploadLogs(ctx context.Context, req UploadLogsReq) (*UploadResult, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
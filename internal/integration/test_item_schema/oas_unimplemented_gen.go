@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// StreamEvents implements streamEvents operation.
+//
+// GET /events
+func (UnimplementedHandler) StreamEvents(ctx context.Context) (r *StreamEventsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StreamLogs implements streamLogs operation.
+//
+// GET /logs
+func (UnimplementedHandler) StreamLogs(ctx context.Context, params StreamLogsParams) (r StreamLogsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StreamRecords implements streamRecords operation.
+//
+// GET /records
+func (UnimplementedHandler) StreamRecords(ctx context.Context) (r *StreamRecordsOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadLogs implements uploadLogs operation.
+//
+// POST /logs
+func (UnimplementedHandler) UploadLogs(ctx context.Context, req UploadLogsReq) (r *UploadResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
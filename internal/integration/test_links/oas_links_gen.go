@@ -0,0 +1,96 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+)
+
+// CreateUserGetUserLink returns parameters of getUser operation
+// linked by "GetUser" link of createUser operation "201" response.
+func CreateUserGetUserLink(response *UserHeaders) (result GetUserParams, _ error) {
+	{
+		if response == nil {
+			return result, errors.Errorf("%s: value is nil", "$response.body#/id")
+		}
+		v0 := *response
+		result.ID = v0.Response.ID
+	}
+	return result, nil
+}
+
+// CreateUserGetUserByLocationLink returns parameters of getUserByLocation operation
+// linked by "GetUserByLocation" link of createUser operation "201" response.
+func CreateUserGetUserByLocationLink(response *UserHeaders) (result GetUserByLocationParams, _ error) {
+	{
+		if response == nil {
+			return result, errors.Errorf("%s: value is nil", "$response.header.Location")
+		}
+		v0 := *response
+		result.Location = v0.Location
+	}
+	return result, nil
+}
+
+// CreateUserListTeamLink returns parameters of listUsers operation
+// linked by "ListTeam" link of createUser operation "201" response.
+//
+// Lists users of the same team.
+func CreateUserListTeamLink(response *UserHeaders, request *NewUser, params CreateUserParams) (result ListUsersParams, _ error) {
+	{
+		val := int(10)
+		result.Limit.SetTo(val)
+	}
+	{
+		if request == nil {
+			return result, errors.Errorf("%s: value is nil", "$request.body#/team")
+		}
+		v0 := *request
+		result.Team = v0.Team
+	}
+	{
+		result.Tenant.SetTo(params.XTenant)
+	}
+	return result, nil
+}
+
+// GetUserGetUserByIDLink returns parameters of getUser operation
+// linked by "GetUserByID" link of getUser operation "204" response.
+func GetUserGetUserByIDLink(response *GetUserNoContent, params GetUserParams) (result GetUserParams, _ error) {
+	{
+		result.ID = params.ID
+	}
+	return result, nil
+}
+
+// ListUsersNextPageLink returns parameters of listUsers operation
+// linked by "NextPage" link of listUsers operation "200" response.
+func ListUsersNextPageLink(response *UserPageHeaders, params ListUsersParams) (result ListUsersParams, _ error) {
+	{
+		if response == nil {
+			return result, errors.Errorf("%s: value is nil", "$response.header.X-Next-Cursor")
+		}
+		v0 := *response
+		result.Cursor = v0.XNextCursor
+	}
+	{
+		result.Team = params.Team
+	}
+	{
+		result.Tenant = params.Tenant
+	}
+	return result, nil
+}
+
+// ListUsersNextPageByBodyLink returns parameters of listUsers operation
+// linked by "NextPageByBody" link of listUsers operation "200" response.
+func ListUsersNextPageByBodyLink(response *UserPageHeaders) (result ListUsersParams, _ error) {
+	{
+		if response == nil {
+			return result, errors.Errorf("%s: value is nil", "$response.body#/next")
+		}
+		v0 := *response
+		result.Cursor = v0.Response.Next
+	}
+	return result, nil
+}
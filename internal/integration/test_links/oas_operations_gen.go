@@ -0,0 +1,13 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreateUserOperation        OperationName = "CreateUser"
+	GetUserOperation           OperationName = "GetUser"
+	GetUserByLocationOperation OperationName = "GetUserByLocation"
+	ListUsersOperation         OperationName = "ListUsers"
+)
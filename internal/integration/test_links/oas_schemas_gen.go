@@ -0,0 +1,243 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// GetUserNoContent is response for GetUser operation.
+type GetUserNoContent struct{}
+
+func (*GetUserNoContent) getUserRes() {}
+
+// Ref: #/components/schemas/NewUser
+type NewUser struct {
+	Name string    `json:"name"`
+	Team OptString `json:"team"`
+}
+
+// GetName returns the value of Name.
+func (s *NewUser) GetName() string {
+	return s.Name
+}
+
+// GetTeam returns the value of Team.
+func (s *NewUser) GetTeam() OptString {
+	return s.Team
+}
+
+// SetName sets the value of Name.
+func (s *NewUser) SetName(val string) {
+	s.Name = val
+}
+
+// SetTeam sets the value of Team.
+func (s *NewUser) SetTeam(val OptString) {
+	s.Team = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/User
+type User struct {
+	ID   int64     `json:"id"`
+	Name string    `json:"name"`
+	Team OptString `json:"team"`
+}
+
+// GetID returns the value of ID.
+func (s *User) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *User) GetName() string {
+	return s.Name
+}
+
+// GetTeam returns the value of Team.
+func (s *User) GetTeam() OptString {
+	return s.Team
+}
+
+// SetID sets the value of ID.
+func (s *User) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *User) SetName(val string) {
+	s.Name = val
+}
+
+// SetTeam sets the value of Team.
+func (s *User) SetTeam(val OptString) {
+	s.Team = val
+}
+
+func (*User) getUserRes() {}
+
+// UserHeaders wraps User with response headers.
+type UserHeaders struct {
+	Location string
+	Response User
+}
+
+// GetLocation returns the value of Location.
+func (s *UserHeaders) GetLocation() string {
+	return s.Location
+}
+
+// GetResponse returns the value of Response.
+func (s *UserHeaders) GetResponse() User {
+	return s.Response
+}
+
+// SetLocation sets the value of Location.
+func (s *UserHeaders) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UserHeaders) SetResponse(val User) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/UserPage
+type UserPage struct {
+	Users []User    `json:"users"`
+	Next  OptString `json:"next"`
+}
+
+// GetUsers returns the value of Users.
+func (s *UserPage) GetUsers() []User {
+	return s.Users
+}
+
+// GetNext returns the value of Next.
+func (s *UserPage) GetNext() OptString {
+	return s.Next
+}
+
+// SetUsers sets the value of Users.
+func (s *UserPage) SetUsers(val []User) {
+	s.Users = val
+}
+
+// SetNext sets the value of Next.
+func (s *UserPage) SetNext(val OptString) {
+	s.Next = val
+}
+
+// UserPageHeaders wraps UserPage with response headers.
+type UserPageHeaders struct {
+	XNextCursor OptString
+	Response    UserPage
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *UserPageHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *UserPageHeaders) GetResponse() UserPage {
+	return s.Response
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *UserPageHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UserPageHeaders) SetResponse(val UserPage) {
+	s.Response = val
+}
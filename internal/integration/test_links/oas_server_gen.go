@@ -0,0 +1,46 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreateUser implements createUser operation.
+	//
+	// POST /users
+	CreateUser(ctx context.Context, req *NewUser, params CreateUserParams) (*UserHeaders, error)
+	// GetUser implements getUser operation.
+	//
+	// GET /users/{id}
+	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// GetUserByLocation implements getUserByLocation operation.
+	//
+	// GET /user
+	GetUserByLocation(ctx context.Context, params GetUserByLocationParams) (*User, error)
+	// ListUsers implements listUsers operation.
+	//
+	// GET /users
+	ListUsers(ctx context.Context, params ListUsersParams) (*UserPageHeaders, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreateUser implements createUser operation.
+//
+// POST /users
+func (UnimplementedHandler) CreateUser(ctx context.Context, req *NewUser, params CreateUserParams) (r *UserHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUser implements getUser operation.
+//
+// GET /users/{id}
+func (UnimplementedHandler) GetUser(ctx context.Context, params GetUserParams) (r GetUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUserByLocation implements getUserByLocation operation.
+//
+// GET /user
+func (UnimplementedHandler) GetUserByLocation(ctx context.Context, params GetUserByLocationParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListUsers implements listUsers operation.
+//
+// GET /users
+func (UnimplementedHandler) ListUsers(ctx context.Context, params ListUsersParams) (r *UserPageHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,203 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/uri"
+)
+
+// ListProductsParams is parameters of listProducts operation.
+type ListProductsParams struct {
+	Filter OptFilter `json:",omitempty,omitzero"`
+	Sort   OptSort   `json:",omitempty,omitzero"`
+	Point  OptPoint  `json:",omitempty,omitzero"`
+	Area   OptPoint  `json:",omitempty,omitzero"`
+}
+
+func unpackListProductsParams(packed middleware.Parameters) (params ListProductsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Filter = v.(OptFilter)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "point",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Point = v.(OptPoint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "area",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Area = v.(OptPoint)
+		}
+	}
+	return params
+}
+
+func decodeListProductsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListProductsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: filter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter",
+			Style:   uri.QueryStyleDeepObject,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "price", Required: false}, {Name: "tags", Required: false}, {Name: "items", Required: false}, {Name: "attrs", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterVal Filter
+				if err := func() error {
+					return paramsDotFilterVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Filter.SetTo(paramsDotFilterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Filter.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+			Fields:  []uri.QueryParameterObjectField{{Name: "by", Required: false}, {Name: "order", Required: false}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal Sort
+				if err := func() error {
+					return paramsDotSortVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: point.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "point",
+			Style:   uri.QueryStyleSpaceDelimited,
+			Explode: false,
+			Fields:  []uri.QueryParameterObjectField{{Name: "x", Required: true}, {Name: "y", Required: true}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPointVal Point
+				if err := func() error {
+					return paramsDotPointVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Point.SetTo(paramsDotPointVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "point",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: area.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "area",
+			Style:   uri.QueryStylePipeDelimited,
+			Explode: false,
+			Fields:  []uri.QueryParameterObjectField{{Name: "x", Required: true}, {Name: "y", Required: true}},
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAreaVal Point
+				if err := func() error {
+					return paramsDotAreaVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				params.Area.SetTo(paramsDotAreaVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "area",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
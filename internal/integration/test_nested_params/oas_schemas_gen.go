@@ -0,0 +1,597 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// Ref: #/components/schemas/Filter
+type Filter struct {
+	Price OptRange       `json:"price"`
+	Tags  []string       `json:"tags"`
+	Items []Item         `json:"items"`
+	Attrs OptFilterAttrs `json:"attrs"`
+}
+
+// GetPrice returns the value of Price.
+func (s *Filter) GetPrice() OptRange {
+	return s.Price
+}
+
+// GetTags returns the value of Tags.
+func (s *Filter) GetTags() []string {
+	return s.Tags
+}
+
+// GetItems returns the value of Items.
+func (s *Filter) GetItems() []Item {
+	return s.Items
+}
+
+// GetAttrs returns the value of Attrs.
+func (s *Filter) GetAttrs() OptFilterAttrs {
+	return s.Attrs
+}
+
+// SetPrice sets the value of Price.
+func (s *Filter) SetPrice(val OptRange) {
+	s.Price = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Filter) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetItems sets the value of Items.
+func (s *Filter) SetItems(val []Item) {
+	s.Items = val
+}
+
+// SetAttrs sets the value of Attrs.
+func (s *Filter) SetAttrs(val OptFilterAttrs) {
+	s.Attrs = val
+}
+
+type FilterAttrs map[string][]string
+
+func (s *FilterAttrs) init() FilterAttrs {
+	m := *s
+	if m == nil {
+		m = map[string][]string{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/Item
+type Item struct {
+	Name  string `json:"name"`
+	Sizes []int  `json:"sizes"`
+}
+
+// GetName returns the value of Name.
+func (s *Item) GetName() string {
+	return s.Name
+}
+
+// GetSizes returns the value of Sizes.
+func (s *Item) GetSizes() []int {
+	return s.Sizes
+}
+
+// SetName sets the value of Name.
+func (s *Item) SetName(val string) {
+	s.Name = val
+}
+
+// SetSizes sets the value of Sizes.
+func (s *Item) SetSizes(val []int) {
+	s.Sizes = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFilter returns new OptFilter with value set to v.
+func NewOptFilter(v Filter) OptFilter {
+	return OptFilter{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFilter is optional Filter.
+type OptFilter struct {
+	Value Filter
+	Set   bool
+}
+
+// IsSet returns true if OptFilter was set.
+func (o OptFilter) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFilter) Reset() {
+	var v Filter
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFilter) SetTo(v Filter) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFilter) Get() (v Filter, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFilter) Or(d Filter) Filter {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFilterAttrs returns new OptFilterAttrs with value set to v.
+func NewOptFilterAttrs(v FilterAttrs) OptFilterAttrs {
+	return OptFilterAttrs{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFilterAttrs is optional FilterAttrs.
+type OptFilterAttrs struct {
+	Value FilterAttrs
+	Set   bool
+}
+
+// IsSet returns true if OptFilterAttrs was set.
+func (o OptFilterAttrs) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFilterAttrs) Reset() {
+	var v FilterAttrs
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFilterAttrs) SetTo(v FilterAttrs) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFilterAttrs) Get() (v FilterAttrs, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFilterAttrs) Or(d FilterAttrs) FilterAttrs {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrder returns new OptOrder with value set to v.
+func NewOptOrder(v Order) OptOrder {
+	return OptOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrder is optional Order.
+type OptOrder struct {
+	Value Order
+	Set   bool
+}
+
+// IsSet returns true if OptOrder was set.
+func (o OptOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrder) Reset() {
+	var v Order
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrder) SetTo(v Order) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrder) Get() (v Order, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrder) Or(d Order) Order {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPoint returns new OptPoint with value set to v.
+func NewOptPoint(v Point) OptPoint {
+	return OptPoint{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPoint is optional Point.
+type OptPoint struct {
+	Value Point
+	Set   bool
+}
+
+// IsSet returns true if OptPoint was set.
+func (o OptPoint) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPoint) Reset() {
+	var v Point
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPoint) SetTo(v Point) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPoint) Get() (v Point, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPoint) Or(d Point) Point {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRange returns new OptRange with value set to v.
+func NewOptRange(v Range) OptRange {
+	return OptRange{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRange is optional Range.
+type OptRange struct {
+	Value Range
+	Set   bool
+}
+
+// IsSet returns true if OptRange was set.
+func (o OptRange) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRange) Reset() {
+	var v Range
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRange) SetTo(v Range) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRange) Get() (v Range, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRange) Or(d Range) Range {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSort returns new OptSort with value set to v.
+func NewOptSort(v Sort) OptSort {
+	return OptSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSort is optional Sort.
+type OptSort struct {
+	Value Sort
+	Set   bool
+}
+
+// IsSet returns true if OptSort was set.
+func (o OptSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSort) Reset() {
+	var v Sort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSort) SetTo(v Sort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSort) Get() (v Sort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSort) Or(d Sort) Sort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Order
+type Order struct {
+	Desc OptBool `json:"desc"`
+}
+
+// GetDesc returns the value of Desc.
+func (s *Order) GetDesc() OptBool {
+	return s.Desc
+}
+
+// SetDesc sets the value of Desc.
+func (s *Order) SetDesc(val OptBool) {
+	s.Desc = val
+}
+
+// Ref: #/components/schemas/Point
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// GetX returns the value of X.
+func (s *Point) GetX() int {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *Point) GetY() int {
+	return s.Y
+}
+
+// SetX sets the value of X.
+func (s *Point) SetX(val int) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *Point) SetY(val int) {
+	s.Y = val
+}
+
+// Ref: #/components/schemas/Query
+type Query struct {
+	Filter OptFilter `json:"filter"`
+	Sort   OptSort   `json:"sort"`
+	Point  OptPoint  `json:"point"`
+	Area   OptPoint  `json:"area"`
+}
+
+// GetFilter returns the value of Filter.
+func (s *Query) GetFilter() OptFilter {
+	return s.Filter
+}
+
+// GetSort returns the value of Sort.
+func (s *Query) GetSort() OptSort {
+	return s.Sort
+}
+
+// GetPoint returns the value of Point.
+func (s *Query) GetPoint() OptPoint {
+	return s.Point
+}
+
+// GetArea returns the value of Area.
+func (s *Query) GetArea() OptPoint {
+	return s.Area
+}
+
+// SetFilter sets the value of Filter.
+func (s *Query) SetFilter(val OptFilter) {
+	s.Filter = val
+}
+
+// SetSort sets the value of Sort.
+func (s *Query) SetSort(val OptSort) {
+	s.Sort = val
+}
+
+// SetPoint sets the value of Point.
+func (s *Query) SetPoint(val OptPoint) {
+	s.Point = val
+}
+
+// SetArea sets the value of Area.
+func (s *Query) SetArea(val OptPoint) {
+	s.Area = val
+}
+
+// Ref: #/components/schemas/Range
+type Range struct {
+	Gte OptInt `json:"gte"`
+	Lte OptInt `json:"lte"`
+}
+
+// GetGte returns the value of Gte.
+func (s *Range) GetGte() OptInt {
+	return s.Gte
+}
+
+// GetLte returns the value of Lte.
+func (s *Range) GetLte() OptInt {
+	return s.Lte
+}
+
+// SetGte sets the value of Gte.
+func (s *Range) SetGte(val OptInt) {
+	s.Gte = val
+}
+
+// SetLte sets the value of Lte.
+func (s *Range) SetLte(val OptInt) {
+	s.Lte = val
+}
+
+// Ref: #/components/schemas/Sort
+type Sort struct {
+	By    []string `json:"by"`
+	Order OptOrder `json:"order"`
+}
+
+// GetBy returns the value of By.
+func (s *Sort) GetBy() []string {
+	return s.By
+}
+
+// GetOrder returns the value of Order.
+func (s *Sort) GetOrder() OptOrder {
+	return s.Order
+}
+
+// SetBy sets the value of By.
+func (s *Sort) SetBy(val []string) {
+	s.By = val
+}
+
+// SetOrder sets the value of Order.
+func (s *Sort) SetOrder(val OptOrder) {
+	s.Order = val
+}
@@ -0,0 +1,21 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// ListProducts implements listProducts operation.
+//
+// GET /products
+func (UnimplementedHandler) ListProducts(ctx context.Context, params ListProductsParams) (r *Query, _ error) {
+	return r, ht.ErrNotImplemented
+}
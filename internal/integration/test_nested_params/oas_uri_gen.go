@@ -0,0 +1,715 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"math/bits"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/uri"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// EncodeURI encodes Filter as URI form.
+func (s *Filter) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("price", func(e uri.Encoder) error {
+		if val, ok := s.Price.Get(); ok {
+			return val.EncodeURI(e)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"price\"")
+	}
+	if err := e.EncodeField("tags", func(e uri.Encoder) error {
+		if s.Tags != nil {
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range s.Tags {
+					if err := func() error {
+						return e.EncodeValue(conv.StringToString(item))
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"tags\"")
+	}
+	if err := e.EncodeField("items", func(e uri.Encoder) error {
+		if s.Items != nil {
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range s.Items {
+					if err := func() error {
+						// Items of arrays of objects and arrays are encoded with indices.
+						return e.EncodeField(strconv.Itoa(i), func(e uri.Encoder) error {
+							return item.EncodeURI(e)
+						})
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"items\"")
+	}
+	if err := e.EncodeField("attrs", func(e uri.Encoder) error {
+		if val, ok := s.Attrs.Get(); ok {
+			return val.EncodeURI(e)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"attrs\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfFilter = [4]string{
+	0: "price",
+	1: "tags",
+	2: "items",
+	3: "attrs",
+}
+
+// DecodeURI decodes Filter from URI form.
+func (s *Filter) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Filter to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "price":
+			if err := func() error {
+				var sDotPriceVal Range
+				if err := func() error {
+					return sDotPriceVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				s.Price.SetTo(sDotPriceVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"price\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var sDotTagsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						sDotTagsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, sDotTagsVal)
+					return nil
+				})
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "items":
+			if err := func() error {
+				s.Items = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var sDotItemsVal Item
+					if err := func() error {
+						return sDotItemsVal.DecodeURI(d)
+					}(); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, sDotItemsVal)
+					return nil
+				})
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"items\"")
+			}
+		case "attrs":
+			if err := func() error {
+				var sDotAttrsVal FilterAttrs
+				if err := func() error {
+					return sDotAttrsVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				s.Attrs.SetTo(sDotAttrsVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attrs\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Filter")
+	}
+
+	return nil
+}
+
+// EncodeURI encodes FilterAttrs as URI form.
+func (s FilterAttrs) EncodeURI(e uri.Encoder) error {
+	for k, elem := range s {
+		if err := e.EncodeField(k, func(e uri.Encoder) error {
+
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range elem {
+					if err := func() error {
+						return e.EncodeValue(conv.StringToString(item))
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}); err != nil {
+			return errors.Wrapf(err, "encode field %q", k)
+		}
+	}
+	return nil
+}
+
+// DecodeURI decodes FilterAttrs from URI form.
+func (s *FilterAttrs) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode FilterAttrs to nil")
+	}
+	m := s.init()
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		var elem []string
+		if err := func() error {
+			elem = nil
+			return d.DecodeArray(func(d uri.Decoder) error {
+				var elemVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					elemVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				elem = append(elem, elemVal)
+				return nil
+			})
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode FilterAttrs")
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Item as URI form.
+func (s *Item) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("name", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.StringToString(s.Name))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"name\"")
+	}
+	if err := e.EncodeField("sizes", func(e uri.Encoder) error {
+		if s.Sizes != nil {
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range s.Sizes {
+					if err := func() error {
+						return e.EncodeValue(conv.IntToString(item))
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"sizes\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfItem = [2]string{
+	0: "name",
+	1: "sizes",
+}
+
+// DecodeURI decodes Item from URI form.
+func (s *Item) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Item to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				s.Name = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "sizes":
+			if err := func() error {
+				s.Sizes = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var sDotSizesVal int
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt(val)
+						if err != nil {
+							return err
+						}
+
+						sDotSizesVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					s.Sizes = append(s.Sizes, sDotSizesVal)
+					return nil
+				})
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sizes\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Item")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(uriFieldsNameOfItem) {
+					name = uriFieldsNameOfItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Order as URI form.
+func (s *Order) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("desc", func(e uri.Encoder) error {
+		if val, ok := s.Desc.Get(); ok {
+			return e.EncodeValue(conv.BoolToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"desc\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfOrder = [1]string{
+	0: "desc",
+}
+
+// DecodeURI decodes Order from URI form.
+func (s *Order) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Order to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "desc":
+			if err := func() error {
+				var sDotDescVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					sDotDescVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Desc.SetTo(sDotDescVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"desc\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Order")
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Point as URI form.
+func (s *Point) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("x", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.IntToString(s.X))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"x\"")
+	}
+	if err := e.EncodeField("y", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.IntToString(s.Y))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"y\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfPoint = [2]string{
+	0: "x",
+	1: "y",
+}
+
+// DecodeURI decodes Point from URI form.
+func (s *Point) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Point to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "x":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				s.X = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"x\"")
+			}
+		case "y":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				s.Y = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"y\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Point")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(uriFieldsNameOfPoint) {
+					name = uriFieldsNameOfPoint[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Range as URI form.
+func (s *Range) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("gte", func(e uri.Encoder) error {
+		if val, ok := s.Gte.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"gte\"")
+	}
+	if err := e.EncodeField("lte", func(e uri.Encoder) error {
+		if val, ok := s.Lte.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"lte\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfRange = [2]string{
+	0: "gte",
+	1: "lte",
+}
+
+// DecodeURI decodes Range from URI form.
+func (s *Range) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Range to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "gte":
+			if err := func() error {
+				var sDotGteVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotGteVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Gte.SetTo(sDotGteVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gte\"")
+			}
+		case "lte":
+			if err := func() error {
+				var sDotLteVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotLteVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Lte.SetTo(sDotLteVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lte\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Range")
+	}
+
+	return nil
+}
+
+// EncodeURI encodes Sort as URI form.
+func (s *Sort) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("by", func(e uri.Encoder) error {
+		if s.By != nil {
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range s.By {
+					if err := func() error {
+						return e.EncodeValue(conv.StringToString(item))
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"by\"")
+	}
+	if err := e.EncodeField("order", func(e uri.Encoder) error {
+		if val, ok := s.Order.Get(); ok {
+			return val.EncodeURI(e)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"order\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfSort = [2]string{
+	0: "by",
+	1: "order",
+}
+
+// DecodeURI decodes Sort from URI form.
+func (s *Sort) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Sort to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "by":
+			if err := func() error {
+				s.By = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var sDotByVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						sDotByVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					s.By = append(s.By, sDotByVal)
+					return nil
+				})
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"by\"")
+			}
+		case "order":
+			if err := func() error {
+				var sDotOrderVal Order
+				if err := func() error {
+					return sDotOrderVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				s.Order.SetTo(sDotOrderVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"order\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Sort")
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+)
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/User
+type User struct {
+	Login    string    `json:"login"`
+	Nickname OptString `json:"nickname"`
+	Role     UserRole  `json:"role"`
+	Age      OptInt    `json:"age"`
+	Email    OptString `json:"email"`
+	Phone    OptString `json:"phone"`
+	Meta     *UserMeta `json:"meta"`
+}
+
+// GetLogin returns the value of Login.
+func (s *User) GetLogin() string {
+	return s.Login
+}
+
+// GetNickname returns the value of Nickname.
+func (s *User) GetNickname() OptString {
+	return s.Nickname
+}
+
+// GetRole returns the value of Role.
+func (s *User) GetRole() UserRole {
+	return s.Role
+}
+
+// GetAge returns the value of Age.
+func (s *User) GetAge() OptInt {
+	return s.Age
+}
+
+// GetEmail returns the value of Email.
+func (s *User) GetEmail() OptString {
+	return s.Email
+}
+
+// GetPhone returns the value of Phone.
+func (s *User) GetPhone() OptString {
+	return s.Phone
+}
+
+// GetMeta returns the value of Meta.
+func (s *User) GetMeta() *UserMeta {
+	return s.Meta
+}
+
+// SetLogin sets the value of Login.
+func (s *User) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetNickname sets the value of Nickname.
+func (s *User) SetNickname(val OptString) {
+	s.Nickname = val
+}
+
+// SetRole sets the value of Role.
+func (s *User) SetRole(val UserRole) {
+	s.Role = val
+}
+
+// SetAge sets the value of Age.
+func (s *User) SetAge(val OptInt) {
+	s.Age = val
+}
+
+// SetEmail sets the value of Email.
+func (s *User) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetPhone sets the value of Phone.
+func (s *User) SetPhone(val OptString) {
+	s.Phone = val
+}
+
+// SetMeta sets the value of Meta.
+func (s *User) SetMeta(val *UserMeta) {
+	s.Meta = val
+}
+
+type UserMeta struct{}
+
+type UserRole string
+
+const (
+	UserRoleGuest UserRole = "guest"
+	UserRoleUser  UserRole = "user"
+)
+
+// AllValues returns all UserRole values.
+func (UserRole) AllValues() []UserRole {
+	return []UserRole{
+		UserRoleGuest,
+		UserRoleUser,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UserRoleGuest:
+		return []byte(s), nil
+	case UserRoleUser:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserRole) UnmarshalText(data []byte) error {
+	switch UserRole(data) {
+	case UserRoleGuest:
+		*s = UserRoleGuest
+		return nil
+	case UserRoleUser:
+		*s = UserRoleUser
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Value
+// Value represents sum type.
+type Value struct {
+	// Type selects the active sum variant, switch on this field.
+	Type   ValueType
+	String string
+	Int    int
+	Bool   bool
+}
+
+// ValueType is oneOf type of Value.
+type ValueType string
+
+// Possible values for ValueType.
+const (
+	StringValue ValueType = "string"
+	IntValue    ValueType = "int"
+	BoolValue   ValueType = "bool"
+)
+
+// IsString reports whether Value is string.
+func (s Value) IsString() bool { return s.Type == StringValue }
+
+// IsInt reports whether Value is int.
+func (s Value) IsInt() bool { return s.Type == IntValue }
+
+// IsBool reports whether Value is bool.
+func (s Value) IsBool() bool { return s.Type == BoolValue }
+
+// SetString sets Value to string.
+func (s *Value) SetString(v string) {
+	s.Type = StringValue
+	s.String = v
+}
+
+// GetString returns string and true boolean if Value is string.
+func (s Value) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringValue returns new Value from string.
+func NewStringValue(v string) Value {
+	var s Value
+	s.SetString(v)
+	return s
+}
+
+// SetInt sets Value to int.
+func (s *Value) SetInt(v int) {
+	s.Type = IntValue
+	s.Int = v
+}
+
+// GetInt returns int and true boolean if Value is int.
+func (s Value) GetInt() (v int, ok bool) {
+	if !s.IsInt() {
+		return v, false
+	}
+	return s.Int, true
+}
+
+// NewIntValue returns new Value from int.
+func NewIntValue(v int) Value {
+	var s Value
+	s.SetInt(v)
+	return s
+}
+
+// SetBool sets Value to bool.
+func (s *Value) SetBool(v bool) {
+	s.Type = BoolValue
+	s.Bool = v
+}
+
+// GetBool returns bool and true boolean if Value is bool.
+func (s Value) GetBool() (v bool, ok bool) {
+	if !s.IsBool() {
+		return v, false
+	}
+	return s.Bool, true
+}
+
+// NewBoolValue returns new Value from bool.
+func NewBoolValue(v bool) Value {
+	var s Value
+	s.SetBool(v)
+	return s
+}
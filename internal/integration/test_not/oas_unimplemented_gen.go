@@ -0,0 +1,21 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreateUser implements createUser operation.
+//
+// POST /users
+func (UnimplementedHandler) CreateUser(ctx context.Context, req *User) (r Value, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,156 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func (s *User) Validate() error {
+	if s == nil {
+		return validate.ErrNilPointer
+	}
+
+	var failures []validate.FieldError
+	if err := func() error {
+		if err := (validate.String{
+			MinLength:     0,
+			MinLengthSet:  false,
+			MaxLength:     32,
+			MaxLengthSet:  true,
+			Email:         false,
+			Hostname:      false,
+			Regex:         nil,
+			MinNumeric:    0,
+			MinNumericSet: false,
+			MaxNumeric:    0,
+			MaxNumericSet: false,
+		}).Validate(string(s.Login)); err != nil {
+			return errors.Wrap(err, "string")
+		}
+		switch s.Login {
+		case "root", "admin":
+			return errors.Wrapf(validate.ErrNotAllowed, "value %v", s.Login)
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "login",
+			Error: err,
+		})
+	}
+	if err := func() error {
+		if value, ok := s.Nickname.Get(); ok {
+			if err := func() error {
+				if err := func() error {
+					if err := (validate.String{
+						MinLength:     0,
+						MinLengthSet:  false,
+						MaxLength:     0,
+						MaxLengthSet:  false,
+						Email:         false,
+						Hostname:      false,
+						Regex:         regexMap["^_"],
+						MinNumeric:    0,
+						MinNumericSet: false,
+						MaxNumeric:    0,
+						MaxNumericSet: false,
+					}).Validate(string(value)); err != nil {
+						return errors.Wrap(err, "string")
+					}
+					return nil
+				}(); err == nil {
+					return validate.ErrNotAllowed
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "nickname",
+			Error: err,
+		})
+	}
+	if err := func() error {
+		if err := s.Role.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "role",
+			Error: err,
+		})
+	}
+	if err := func() error {
+		if value, ok := s.Age.Get(); ok {
+			if err := func() error {
+				if err := func() error {
+					if err := (validate.Int{
+						MinSet:        true,
+						Min:           0,
+						MaxSet:        true,
+						Max:           12,
+						MinExclusive:  false,
+						MaxExclusive:  false,
+						MultipleOfSet: false,
+						MultipleOf:    0,
+						Pattern:       nil,
+					}).Validate(int64(value)); err != nil {
+						return errors.Wrap(err, "int")
+					}
+					return nil
+				}(); err == nil {
+					return validate.ErrNotAllowed
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "age",
+			Error: err,
+		})
+	}
+	if notFailures := func() (failures []validate.FieldError) {
+		if !s.Email.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "email",
+				Error: validate.ErrFieldRequired,
+			})
+		}
+		if !s.Phone.Set {
+			failures = append(failures, validate.FieldError{
+				Name:  "phone",
+				Error: validate.ErrFieldRequired,
+			})
+		}
+		return failures
+	}(); len(notFailures) == 0 {
+		failures = append(failures, validate.FieldError{
+			Error: validate.ErrNotAllowed,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+func (s UserRole) Validate() error {
+	switch s {
+	case "guest":
+		return nil
+	case "user":
+		return nil
+	default:
+		return errors.Errorf("invalid value: %v", s)
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"time"
+
+	"github.com/go-faster/jx"
+)
+
+// Ref: #/components/schemas/Label
+type Label struct {
+	V0   string
+	V1   int
+	Rest []jx.Raw
+}
+
+// GetV0 returns the value of V0.
+func (s *Label) GetV0() string {
+	return s.V0
+}
+
+// GetV1 returns the value of V1.
+func (s *Label) GetV1() int {
+	return s.V1
+}
+
+// GetRest returns the value of Rest.
+func (s *Label) GetRest() []jx.Raw {
+	return s.Rest
+}
+
+// SetV0 sets the value of V0.
+func (s *Label) SetV0(val string) {
+	s.V0 = val
+}
+
+// SetV1 sets the value of V1.
+func (s *Label) SetV1(val int) {
+	s.V1 = val
+}
+
+// SetRest sets the value of Rest.
+func (s *Label) SetRest(val []jx.Raw) {
+	s.Rest = val
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Point
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// GetLat returns the value of Lat.
+func (s *Point) GetLat() float64 {
+	return s.Lat
+}
+
+// GetLon returns the value of Lon.
+func (s *Point) GetLon() float64 {
+	return s.Lon
+}
+
+// SetLat sets the value of Lat.
+func (s *Point) SetLat(val float64) {
+	s.Lat = val
+}
+
+// SetLon sets the value of Lon.
+func (s *Point) SetLon(val float64) {
+	s.Lon = val
+}
+
+// Ref: #/components/schemas/Sample
+type Sample struct {
+	V0   time.Time
+	V1   float64
+	Rest []string
+}
+
+// GetV0 returns the value of V0.
+func (s *Sample) GetV0() time.Time {
+	return s.V0
+}
+
+// GetV1 returns the value of V1.
+func (s *Sample) GetV1() float64 {
+	return s.V1
+}
+
+// GetRest returns the value of Rest.
+func (s *Sample) GetRest() []string {
+	return s.Rest
+}
+
+// SetV0 sets the value of V0.
+func (s *Sample) SetV0(val time.Time) {
+	s.V0 = val
+}
+
+// SetV1 sets the value of V1.
+func (s *Sample) SetV1(val float64) {
+	s.V1 = val
+}
+
+// SetRest sets the value of Rest.
+func (s *Sample) SetRest(val []string) {
+	s.Rest = val
+}
+
+// Ref: #/components/schemas/Track
+type Track struct {
+	Name    OptString `json:"name"`
+	Points  []Point   `json:"points"`
+	Samples []Sample  `json:"samples"`
+	Labels  []Label   `json:"labels"`
+}
+
+// GetName returns the value of Name.
+func (s *Track) GetName() OptString {
+	return s.Name
+}
+
+// GetPoints returns the value of Points.
+func (s *Track) GetPoints() []Point {
+	return s.Points
+}
+
+// GetSamples returns the value of Samples.
+func (s *Track) GetSamples() []Sample {
+	return s.Samples
+}
+
+// GetLabels returns the value of Labels.
+func (s *Track) GetLabels() []Label {
+	return s.Labels
+}
+
+// SetName sets the value of Name.
+func (s *Track) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPoints sets the value of Points.
+func (s *Track) SetPoints(val []Point) {
+	s.Points = val
+}
+
+// SetSamples sets the value of Samples.
+func (s *Track) SetSamples(val []Sample) {
+	s.Samples = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *Track) SetLabels(val []Label) {
+	s.Labels = val
+}
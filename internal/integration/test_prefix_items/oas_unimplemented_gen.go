@@ -0,0 +1,21 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// Track implements track operation.
+//
+// POST /track
+func (UnimplementedHandler) Track(ctx context.Context, req *Track) (r *Track, _ error) {
+	return r, ht.ErrNotImplemented
+}
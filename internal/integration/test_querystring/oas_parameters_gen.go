@@ -0,0 +1,242 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/uri"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// LookupParams is parameters of lookup operation.
+type LookupParams struct {
+	Kind  string
+	Query OptLookupQuery `json:",omitempty,omitzero"`
+}
+
+func unpackLookupParams(packed middleware.Parameters) (params LookupParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "path",
+		}
+		params.Kind = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "query",
+			In:   "querystring",
+		}
+		if v, ok := packed[key]; ok {
+			params.Query = v.(OptLookupQuery)
+		}
+	}
+	return params
+}
+
+func decodeLookupParams(args [1]string, argsEscaped bool, r *http.Request) (params LookupParams, _ error) {
+	qs := uri.NewQueryStringDecoder(r.URL)
+	// Decode path: kind.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "kind",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Kind = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode querystring: query.
+	if err := func() error {
+		cfg := uri.QueryStringDecodingConfig{
+			Form: false,
+		}
+		if err := qs.HasParam(cfg); err == nil {
+			if err := qs.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+				if err := func(d *jx.Decoder) error {
+					params.Query.Reset()
+					if err := params.Query.Decode(d); err != nil {
+						return err
+					}
+					return nil
+				}(jx.DecodeStr(val)); err != nil {
+					return err
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Query.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "query",
+			In:   "querystring",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchParams is parameters of search operation.
+type SearchParams struct {
+	Filter     SearchFilter
+	XRequestID OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchParams(packed middleware.Parameters) (params SearchParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "querystring",
+		}
+		params.Filter = packed[key].(SearchFilter)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Request-ID",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.XRequestID = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	qs := uri.NewQueryStringDecoder(r.URL)
+	// Decode querystring: filter.
+	if err := func() error {
+		cfg := uri.QueryStringDecodingConfig{
+			Form:   true,
+			Fields: []uri.QueryParameterObjectField{{Name: "q", Required: true}, {Name: "limit", Required: false}, {Name: "tags", Required: false}, {Name: "range", Required: false}},
+		}
+		if err := qs.HasParam(cfg); err == nil {
+			if err := qs.DecodeParam(cfg, func(d uri.Decoder) error {
+				return params.Filter.DecodeURI(d)
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Filter.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "querystring",
+			Err:  err,
+		}
+	}
+	// Decode header: X-Request-ID.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-ID",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotXRequestIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotXRequestIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.XRequestID.SetTo(paramsDotXRequestIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Request-ID",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
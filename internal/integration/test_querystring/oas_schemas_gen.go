@@ -0,0 +1,338 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// Ref: #/components/schemas/LookupQuery
+type LookupQuery struct {
+	Ids  []int     `json:"ids"`
+	Name OptString `json:"name"`
+}
+
+// GetIds returns the value of Ids.
+func (s *LookupQuery) GetIds() []int {
+	return s.Ids
+}
+
+// GetName returns the value of Name.
+func (s *LookupQuery) GetName() OptString {
+	return s.Name
+}
+
+// SetIds sets the value of Ids.
+func (s *LookupQuery) SetIds(val []int) {
+	s.Ids = val
+}
+
+// SetName sets the value of Name.
+func (s *LookupQuery) SetName(val OptString) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/LookupResult
+type LookupResult struct {
+	Kind  string         `json:"kind"`
+	Query OptLookupQuery `json:"query"`
+}
+
+// GetKind returns the value of Kind.
+func (s *LookupResult) GetKind() string {
+	return s.Kind
+}
+
+// GetQuery returns the value of Query.
+func (s *LookupResult) GetQuery() OptLookupQuery {
+	return s.Query
+}
+
+// SetKind sets the value of Kind.
+func (s *LookupResult) SetKind(val string) {
+	s.Kind = val
+}
+
+// SetQuery sets the value of Query.
+func (s *LookupResult) SetQuery(val OptLookupQuery) {
+	s.Query = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLookupQuery returns new OptLookupQuery with value set to v.
+func NewOptLookupQuery(v LookupQuery) OptLookupQuery {
+	return OptLookupQuery{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLookupQuery is optional LookupQuery.
+type OptLookupQuery struct {
+	Value LookupQuery
+	Set   bool
+}
+
+// IsSet returns true if OptLookupQuery was set.
+func (o OptLookupQuery) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLookupQuery) Reset() {
+	var v LookupQuery
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLookupQuery) SetTo(v LookupQuery) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLookupQuery) Get() (v LookupQuery, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLookupQuery) Or(d LookupQuery) LookupQuery {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchFilterRange returns new OptSearchFilterRange with value set to v.
+func NewOptSearchFilterRange(v SearchFilterRange) OptSearchFilterRange {
+	return OptSearchFilterRange{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchFilterRange is optional SearchFilterRange.
+type OptSearchFilterRange struct {
+	Value SearchFilterRange
+	Set   bool
+}
+
+// IsSet returns true if OptSearchFilterRange was set.
+func (o OptSearchFilterRange) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchFilterRange) Reset() {
+	var v SearchFilterRange
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchFilterRange) SetTo(v SearchFilterRange) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchFilterRange) Get() (v SearchFilterRange, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchFilterRange) Or(d SearchFilterRange) SearchFilterRange {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/SearchFilter
+type SearchFilter struct {
+	Q     string               `json:"q"`
+	Limit OptInt               `json:"limit"`
+	Tags  []string             `json:"tags"`
+	Range OptSearchFilterRange `json:"range"`
+}
+
+// GetQ returns the value of Q.
+func (s *SearchFilter) GetQ() string {
+	return s.Q
+}
+
+// GetLimit returns the value of Limit.
+func (s *SearchFilter) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetTags returns the value of Tags.
+func (s *SearchFilter) GetTags() []string {
+	return s.Tags
+}
+
+// GetRange returns the value of Range.
+func (s *SearchFilter) GetRange() OptSearchFilterRange {
+	return s.Range
+}
+
+// SetQ sets the value of Q.
+func (s *SearchFilter) SetQ(val string) {
+	s.Q = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *SearchFilter) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetTags sets the value of Tags.
+func (s *SearchFilter) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetRange sets the value of Range.
+func (s *SearchFilter) SetRange(val OptSearchFilterRange) {
+	s.Range = val
+}
+
+type SearchFilterRange struct {
+	Min OptInt `json:"min"`
+	Max OptInt `json:"max"`
+}
+
+// GetMin returns the value of Min.
+func (s *SearchFilterRange) GetMin() OptInt {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *SearchFilterRange) GetMax() OptInt {
+	return s.Max
+}
+
+// SetMin sets the value of Min.
+func (s *SearchFilterRange) SetMin(val OptInt) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *SearchFilterRange) SetMax(val OptInt) {
+	s.Max = val
+}
+
+// Ref: #/components/schemas/SearchResult
+type SearchResult struct {
+	Filter    SearchFilter `json:"filter"`
+	RequestID OptString    `json:"requestID"`
+}
+
+// GetFilter returns the value of Filter.
+func (s *SearchResult) GetFilter() SearchFilter {
+	return s.Filter
+}
+
+// GetRequestID returns the value of RequestID.
+func (s *SearchResult) GetRequestID() OptString {
+	return s.RequestID
+}
+
+// SetFilter sets the value of Filter.
+func (s *SearchResult) SetFilter(val SearchFilter) {
+	s.Filter = val
+}
+
+// SetRequestID sets the value of RequestID.
+func (s *SearchResult) SetRequestID(val OptString) {
+	s.RequestID = val
+}
@@ -0,0 +1,38 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// Lookup implements lookup operation.
+	//
+	// GET /lookup/{kind}
+	Lookup(ctx context.Context, params LookupParams) (*LookupResult, error)
+	// Search implements search operation.
+	//
+	// GET /search
+	Search(ctx context.Context, params SearchParams) (*SearchResult, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// Lookup implements lookup operation.
+//
+// GET /lookup/{kind}
+func (UnimplementedHandler) Lookup(ctx context.Context, params LookupParams) (r *LookupResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Search implements search operation.
+//
+// GET /search
+func (UnimplementedHandler) Search(ctx context.Context, params SearchParams) (r *SearchResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,290 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"math/bits"
+	"strconv"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/uri"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// EncodeURI encodes SearchFilter as URI form.
+func (s *SearchFilter) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("q", func(e uri.Encoder) error {
+		return e.EncodeValue(conv.StringToString(s.Q))
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"q\"")
+	}
+	if err := e.EncodeField("limit", func(e uri.Encoder) error {
+		if val, ok := s.Limit.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"limit\"")
+	}
+	if err := e.EncodeField("tags", func(e uri.Encoder) error {
+		if s.Tags != nil {
+			return e.EncodeArray(func(e uri.Encoder) error {
+				for i, item := range s.Tags {
+					if err := func() error {
+						return e.EncodeValue(conv.StringToString(item))
+					}(); err != nil {
+						return errors.Wrapf(err, "[%d]", i)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"tags\"")
+	}
+	if err := e.EncodeField("range", func(e uri.Encoder) error {
+		if val, ok := s.Range.Get(); ok {
+			return val.EncodeURI(e)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"range\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfSearchFilter = [4]string{
+	0: "q",
+	1: "limit",
+	2: "tags",
+	3: "range",
+}
+
+// DecodeURI decodes SearchFilter from URI form.
+func (s *SearchFilter) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchFilter to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "q":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				s.Q = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"q\"")
+			}
+		case "limit":
+			if err := func() error {
+				var sDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Limit.SetTo(sDotLimitVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags = nil
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var sDotTagsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						sDotTagsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, sDotTagsVal)
+					return nil
+				})
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "range":
+			if err := func() error {
+				var sDotRangeVal SearchFilterRange
+				if err := func() error {
+					return sDotRangeVal.DecodeURI(d)
+				}(); err != nil {
+					return err
+				}
+				s.Range.SetTo(sDotRangeVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"range\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchFilter")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(uriFieldsNameOfSearchFilter) {
+					name = uriFieldsNameOfSearchFilter[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// EncodeURI encodes SearchFilterRange as URI form.
+func (s *SearchFilterRange) EncodeURI(e uri.Encoder) error {
+	if err := e.EncodeField("min", func(e uri.Encoder) error {
+		if val, ok := s.Min.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"min\"")
+	}
+	if err := e.EncodeField("max", func(e uri.Encoder) error {
+		if val, ok := s.Max.Get(); ok {
+			return e.EncodeValue(conv.IntToString(val))
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "encode field \"max\"")
+	}
+	return nil
+}
+
+var uriFieldsNameOfSearchFilterRange = [2]string{
+	0: "min",
+	1: "max",
+}
+
+// DecodeURI decodes SearchFilterRange from URI form.
+func (s *SearchFilterRange) DecodeURI(d uri.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchFilterRange to nil")
+	}
+
+	if err := d.DecodeFields(func(k string, d uri.Decoder) error {
+		switch k {
+		case "min":
+			if err := func() error {
+				var sDotMinVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotMinVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Min.SetTo(sDotMinVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"min\"")
+			}
+		case "max":
+			if err := func() error {
+				var sDotMaxVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					sDotMaxVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Max.SetTo(sDotMaxVal)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max\"")
+			}
+		default:
+			return nil
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchFilterRange")
+	}
+
+	return nil
+}
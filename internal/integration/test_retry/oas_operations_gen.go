@@ -0,0 +1,14 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CountItemsOperation   OperationName = "CountItems"
+	CreateItemOperation   OperationName = "CreateItem"
+	ImportItemsOperation  OperationName = "ImportItems"
+	ListItemsOperation    OperationName = "ListItems"
+	ReplaceItemsOperation OperationName = "ReplaceItems"
+)
@@ -0,0 +1,98 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// CreateItemCreated is response for CreateItem operation.
+type CreateItemCreated struct{}
+
+// ImportItemsNoContent is response for ImportItems operation.
+type ImportItemsNoContent struct{}
+
+type ImportItemsReq struct {
+	Source string  `json:"source"`
+	DryRun OptBool `json:"dryRun"`
+}
+
+// GetSource returns the value of Source.
+func (s *ImportItemsReq) GetSource() string {
+	return s.Source
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *ImportItemsReq) GetDryRun() OptBool {
+	return s.DryRun
+}
+
+// SetSource sets the value of Source.
+func (s *ImportItemsReq) SetSource(val string) {
+	s.Source = val
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *ImportItemsReq) SetDryRun(val OptBool) {
+	s.DryRun = val
+}
+
+// Ref: #/components/schemas/Item
+type Item struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *Item) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *Item) SetName(val string) {
+	s.Name = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// ReplaceItemsNoContent is response for ReplaceItems operation.
+type ReplaceItemsNoContent struct{}
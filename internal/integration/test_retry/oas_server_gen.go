@@ -0,0 +1,50 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CountItems implements countItems operation.
+	//
+	// GET /items/count
+	CountItems(ctx context.Context) (int, error)
+	// CreateItem implements createItem operation.
+	//
+	// POST /items
+	CreateItem(ctx context.Context, req *Item) error
+	// ImportItems implements importItems operation.
+	//
+	// POST /items/import
+	ImportItems(ctx context.Context, req *ImportItemsReq) error
+	// ListItems implements listItems operation.
+	//
+	// GET /items
+	ListItems(ctx context.Context) ([]Item, error)
+	// ReplaceItems implements replaceItems operation.
+	//
+	// PUT /items
+	ReplaceItems(ctx context.Context, req []Item) error
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
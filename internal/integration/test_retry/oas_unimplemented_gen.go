@@ -0,0 +1,49 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CountItems implements countItems operation.
+//
+// GET /items/count
+func (UnimplementedHandler) CountItems(ctx context.Context) (r int, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateItem implements createItem operation.
+//
+// POST /items
+func (UnimplementedHandler) CreateItem(ctx context.Context, req *Item) error {
+	return ht.ErrNotImplemented
+}
+
+// ImportItems implements importItems operation.
+//
+// POST /items/import
+func (UnimplementedHandler) ImportItems(ctx context.Context, req *ImportItemsReq) error {
+	return ht.ErrNotImplemented
+}
+
+// ListItems implements listItems operation.
+//
+// GET /items
+func (UnimplementedHandler) ListItems(ctx context.Context) (r []Item, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReplaceItems implements replaceItems operation.
+//
+// PUT /items
+func (UnimplementedHandler) ReplaceItems(ctx context.Context, req []Item) error {
+	return ht.ErrNotImplemented
+}
@@ -0,0 +1,106 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+type Digest struct {
+	Username    string
+	Password    string
+	Credentials ht.DigestCredentials
+	Roles       []string
+}
+
+// GetUsername returns the value of Username.
+func (s *Digest) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *Digest) GetPassword() string {
+	return s.Password
+}
+
+// GetCredentials returns the value of Credentials.
+func (s *Digest) GetCredentials() ht.DigestCredentials {
+	return s.Credentials
+}
+
+// GetRoles returns the value of Roles.
+func (s *Digest) GetRoles() []string {
+	return s.Roles
+}
+
+// SetUsername sets the value of Username.
+func (s *Digest) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *Digest) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetCredentials sets the value of Credentials.
+func (s *Digest) SetCredentials(val ht.DigestCredentials) {
+	s.Credentials = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *Digest) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type Hoba struct {
+	Credentials string
+	Roles       []string
+}
+
+// GetCredentials returns the value of Credentials.
+func (s *Hoba) GetCredentials() string {
+	return s.Credentials
+}
+
+// GetRoles returns the value of Roles.
+func (s *Hoba) GetRoles() []string {
+	return s.Roles
+}
+
+// SetCredentials sets the value of Credentials.
+func (s *Hoba) SetCredentials(val string) {
+	s.Credentials = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *Hoba) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type Signature struct {
+	Request *http.Request
+	Roles   []string
+}
+
+// GetRequest returns the value of Request.
+func (s *Signature) GetRequest() *http.Request {
+	return s.Request
+}
+
+// GetRoles returns the value of Roles.
+func (s *Signature) GetRoles() []string {
+	return s.Roles
+}
+
+// SetRequest sets the value of Request.
+func (s *Signature) SetRequest(val *http.Request) {
+	s.Request = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *Signature) SetRoles(val []string) {
+	s.Roles = val
+}
@@ -0,0 +1,207 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleDigest handles digest security.
+	HandleDigest(ctx context.Context, operationName OperationName, t Digest) (context.Context, error)
+	// HandleHoba handles hoba security.
+	// HTTP Origin-Bound Authentication.
+	HandleHoba(ctx context.Context, operationName OperationName, t Hoba) (context.Context, error)
+	// HandleSignature handles signature security.
+	// HTTP Message Signature (RFC 9421).
+	HandleSignature(ctx context.Context, operationName OperationName, t Signature) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// operationRolesDigest is a private map storing roles per operation.
+var operationRolesDigest = map[string][]string{
+	DigestEchoOperation: []string{},
+}
+
+// GetRolesForDigest returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForDigest(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForDigest(operation string) []string {
+	roles, ok := operationRolesDigest[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesHoba is a private map storing roles per operation.
+var operationRolesHoba = map[string][]string{
+	HobaWhoamiOperation: []string{
+		"admin",
+	},
+}
+
+// GetRolesForHoba returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForHoba(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForHoba(operation string) []string {
+	roles, ok := operationRolesHoba[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesSignature is a private map storing roles per operation.
+var operationRolesSignature = map[string][]string{
+	SignedEchoOperation: []string{},
+}
+
+// GetRolesForSignature returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForSignature(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForSignature(operation string) []string {
+	roles, ok := operationRolesSignature[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+func (s *Server) securityDigest(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Digest
+	// Only the header is parsed, WWW-Authenticate challenge is not issued.
+	creds, ok, err := ht.DigestAuth(req)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "invalid digest auth")
+	}
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Username = creds.Username
+	t.Credentials = creds
+	t.Roles = operationRolesDigest[operationName]
+	rctx, err := s.sec.HandleDigest(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityHoba(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Hoba
+	credentials, ok := findAuthorization(req.Header, "HOBA")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Credentials = credentials
+	t.Roles = operationRolesHoba[operationName]
+	rctx, err := s.sec.HandleHoba(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securitySignature(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Signature
+	t.Request = req
+	t.Roles = operationRolesSignature[operationName]
+	rctx, err := s.sec.HandleSignature(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// Digest provides digest security value.
+	Digest(ctx context.Context, operationName OperationName) (Digest, error)
+	// Hoba provides hoba security value.
+	// HTTP Origin-Bound Authentication.
+	Hoba(ctx context.Context, operationName OperationName) (Hoba, error)
+	// Signature provides signature security value.
+	// HTTP Message Signature (RFC 9421).
+	Signature(ctx context.Context, operationName OperationName, req *http.Request) error
+}
+
+func (s *Client) securityDigest(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.Digest(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"Digest\"")
+	}
+	ht.SetDigestAuth(req, t.Username, t.Password)
+	return nil
+}
+func (s *Client) securityHoba(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.Hoba(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"Hoba\"")
+	}
+	req.Header.Set("Authorization", "HOBA "+t.Credentials)
+	return nil
+}
+func (s *Client) securitySignature(ctx context.Context, operationName OperationName, req *http.Request) error {
+	if err := s.sec.Signature(ctx, operationName, req); err != nil {
+		return errors.Wrap(err, "security source \"Signature\"")
+	}
+	return nil
+}
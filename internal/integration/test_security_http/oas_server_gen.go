@@ -0,0 +1,44 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// DigestEcho implements digestEcho operation.
+	//
+	// POST /digest
+	DigestEcho(ctx context.Context, req string) (string, error)
+	// HobaWhoami implements hobaWhoami operation.
+	//
+	// GET /hoba
+	HobaWhoami(ctx context.Context) (string, error)
+	// SignedEcho implements signedEcho operation.
+	//
+	// POST /signed
+	SignedEcho(ctx context.Context, req string) (string, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h   Handler
+	sec SecurityHandler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		sec:        sec,
+		baseServer: s,
+	}, nil
+}
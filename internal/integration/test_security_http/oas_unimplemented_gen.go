@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// DigestEcho implements digestEcho operation.
+//
+// POST /digest
+func (UnimplementedHandler) DigestEcho(ctx context.Context, req string) (r string, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HobaWhoami implements hobaWhoami operation.
+//
+// GET /hoba
+func (UnimplementedHandler) HobaWhoami(ctx context.Context) (r string, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SignedEcho implements signedEcho operation.
+//
+// POST /signed
+func (UnimplementedHandler) SignedEcho(ctx context.Context, req string) (r string, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,11 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	AdminActionOperation OperationName = "AdminAction"
+	WhoamiOperation      OperationName = "Whoami"
+)
@@ -0,0 +1,108 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+)
+
+// AdminActionNoContent is response for AdminAction operation.
+type AdminActionNoContent struct{}
+
+type Mtls struct {
+	Certificates     []tls.Certificate
+	PeerCertificates []*x509.Certificate
+	VerifiedChains   [][]*x509.Certificate
+	Roles            []string
+}
+
+// GetCertificates returns the value of Certificates.
+func (s *Mtls) GetCertificates() []tls.Certificate {
+	return s.Certificates
+}
+
+// GetPeerCertificates returns the value of PeerCertificates.
+func (s *Mtls) GetPeerCertificates() []*x509.Certificate {
+	return s.PeerCertificates
+}
+
+// GetVerifiedChains returns the value of VerifiedChains.
+func (s *Mtls) GetVerifiedChains() [][]*x509.Certificate {
+	return s.VerifiedChains
+}
+
+// GetRoles returns the value of Roles.
+func (s *Mtls) GetRoles() []string {
+	return s.Roles
+}
+
+// SetCertificates sets the value of Certificates.
+func (s *Mtls) SetCertificates(val []tls.Certificate) {
+	s.Certificates = val
+}
+
+// SetPeerCertificates sets the value of PeerCertificates.
+func (s *Mtls) SetPeerCertificates(val []*x509.Certificate) {
+	s.PeerCertificates = val
+}
+
+// SetVerifiedChains sets the value of VerifiedChains.
+func (s *Mtls) SetVerifiedChains(val [][]*x509.Certificate) {
+	s.VerifiedChains = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *Mtls) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type RootKey struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *RootKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *RootKey) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *RootKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *RootKey) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type Token struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *Token) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *Token) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *Token) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *Token) SetRoles(val []string) {
+	s.Roles = val
+}
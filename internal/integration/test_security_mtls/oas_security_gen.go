@@ -0,0 +1,209 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleMtls handles mtls security.
+	// Client certificate issued by the mesh CA.
+	HandleMtls(ctx context.Context, operationName OperationName, t Mtls) (context.Context, error)
+	// HandleRootKey handles rootKey security.
+	HandleRootKey(ctx context.Context, operationName OperationName, t RootKey) (context.Context, error)
+	// HandleToken handles token security.
+	HandleToken(ctx context.Context, operationName OperationName, t Token) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// operationRolesMtls is a private map storing roles per operation.
+var operationRolesMtls = map[string][]string{
+	AdminActionOperation: []string{
+		"admin",
+	},
+	WhoamiOperation: []string{},
+}
+
+// GetRolesForMtls returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForMtls(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForMtls(operation string) []string {
+	roles, ok := operationRolesMtls[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesRootKey is a private map storing roles per operation.
+var operationRolesRootKey = map[string][]string{
+	AdminActionOperation: []string{},
+}
+
+// GetRolesForRootKey returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForRootKey(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForRootKey(operation string) []string {
+	roles, ok := operationRolesRootKey[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesToken is a private map storing roles per operation.
+var operationRolesToken = map[string][]string{
+	AdminActionOperation: []string{},
+}
+
+// GetRolesForToken returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForToken(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForToken(operation string) []string {
+	roles, ok := operationRolesToken[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+func (s *Server) securityMtls(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Mtls
+	// Certificate must be verified, unverified one is sent with tls.RequestClientCert.
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 || len(req.TLS.VerifiedChains) == 0 {
+		return ctx, false, nil
+	}
+	t.PeerCertificates = req.TLS.PeerCertificates
+	t.VerifiedChains = req.TLS.VerifiedChains
+	t.Roles = operationRolesMtls[operationName]
+	rctx, err := s.sec.HandleMtls(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityRootKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t RootKey
+	const parameterName = "X-Root-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesRootKey[operationName]
+	rctx, err := s.sec.HandleRootKey(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityToken(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Token
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesToken[operationName]
+	rctx, err := s.sec.HandleToken(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// Mtls provides mtls security value.
+	// Client certificate issued by the mesh CA.
+	Mtls(ctx context.Context, operationName OperationName) (Mtls, error)
+	// RootKey provides rootKey security value.
+	RootKey(ctx context.Context, operationName OperationName) (RootKey, error)
+	// Token provides token security value.
+	Token(ctx context.Context, operationName OperationName) (Token, error)
+}
+
+func (s *Client) securityMtls(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.Mtls(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"Mtls\"")
+	}
+	ht.SetClientCertificates(req, t.Certificates)
+	return nil
+}
+func (s *Client) securityRootKey(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.RootKey(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"RootKey\"")
+	}
+	req.Header.Set("X-Root-Key", t.APIKey)
+	return nil
+}
+func (s *Client) securityToken(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.Token(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"Token\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
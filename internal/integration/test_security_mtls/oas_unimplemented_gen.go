@@ -0,0 +1,28 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// AdminAction implements adminAction operation.
+//
+// POST /admin
+func (UnimplementedHandler) AdminAction(ctx context.Context) error {
+	return ht.ErrNotImplemented
+}
+
+// Whoami implements whoami operation.
+//
+// GET /whoami
+func (UnimplementedHandler) Whoami(ctx context.Context) (r string, _ error) {
+	return r, ht.ErrNotImplemented
+}
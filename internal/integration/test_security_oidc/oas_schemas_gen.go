@@ -0,0 +1,71 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// AdminActionNoContent is response for AdminAction operation.
+type AdminActionNoContent struct{}
+
+type ApiKey struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *ApiKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *ApiKey) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *ApiKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *ApiKey) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type Oidc struct {
+	Token  string
+	Scopes []string
+}
+
+// GetToken returns the value of Token.
+func (s *Oidc) GetToken() string {
+	return s.Token
+}
+
+// GetScopes returns the value of Scopes.
+func (s *Oidc) GetScopes() []string {
+	return s.Scopes
+}
+
+// SetToken sets the value of Token.
+func (s *Oidc) SetToken(val string) {
+	s.Token = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *Oidc) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// Ref: #/components/schemas/Profile
+type Profile struct {
+	Subject string `json:"subject"`
+}
+
+// GetSubject returns the value of Subject.
+func (s *Profile) GetSubject() string {
+	return s.Subject
+}
+
+// SetSubject sets the value of Subject.
+func (s *Profile) SetSubject(val string) {
+	s.Subject = val
+}
@@ -0,0 +1,159 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleApiKey handles apiKey security.
+	HandleApiKey(ctx context.Context, operationName OperationName, t ApiKey) (context.Context, error)
+	// HandleOidc handles oidc security.
+	// OpenID Connect authentication.
+	HandleOidc(ctx context.Context, operationName OperationName, t Oidc) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// operationRolesApiKey is a private map storing roles per operation.
+var operationRolesApiKey = map[string][]string{
+	AdminActionOperation: []string{},
+}
+
+// GetRolesForApiKey returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForApiKey(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForApiKey(operation string) []string {
+	roles, ok := operationRolesApiKey[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// oauth2ScopesOidc is a private map storing OAuth2 scopes per operation.
+var oauth2ScopesOidc = map[string][]string{
+	AdminActionOperation: []string{
+		"profile",
+		"admin",
+	},
+	GetProfileOperation: []string{
+		"profile",
+	},
+}
+
+// GetOAuth2ScopesForOidc returns the required OAuth2 scopes for the given operation.
+//
+// This is useful for token exchange scenarios where you need to know which scopes
+// to request when obtaining a token for a downstream API call.
+//
+// Example:
+//
+//	requiredScopes := GetOAuth2ScopesForOidc(AddPetOperation)
+//	token := exchangeTokenWithScopes(requiredScopes, "https://api.example.com")
+//
+// Returns nil if the operation has no scope requirements or if the operation is unknown.
+func GetOAuth2ScopesForOidc(operation string) []string {
+	scopes, ok := oauth2ScopesOidc[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(scopes))
+	copy(result, scopes)
+	return result
+}
+
+// OpenIDConnectURLForOidc is the OpenID Connect discovery document URL of Oidc security.
+const OpenIDConnectURLForOidc = "https://auth.example.com/.well-known/openid-configuration"
+
+func (s *Server) securityApiKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t ApiKey
+	const parameterName = "X-Api-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesApiKey[operationName]
+	rctx, err := s.sec.HandleApiKey(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityOidc(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t Oidc
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Scopes = oauth2ScopesOidc[operationName]
+	rctx, err := s.sec.HandleOidc(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// ApiKey provides apiKey security value.
+	ApiKey(ctx context.Context, operationName OperationName) (ApiKey, error)
+	// Oidc provides oidc security value.
+	// OpenID Connect authentication.
+	Oidc(ctx context.Context, operationName OperationName) (Oidc, error)
+}
+
+func (s *Client) securityApiKey(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.ApiKey(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"ApiKey\"")
+	}
+	req.Header.Set("X-Api-Key", t.APIKey)
+	return nil
+}
+func (s *Client) securityOidc(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.Oidc(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"Oidc\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
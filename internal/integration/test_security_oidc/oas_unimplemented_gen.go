@@ -0,0 +1,28 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// AdminAction implements adminAction operation.
+//
+// POST /admin
+func (UnimplementedHandler) AdminAction(ctx context.Context) error {
+	return ht.ErrNotImplemented
+}
+
+// GetProfile implements getProfile operation.
+//
+// GET /profile
+func (UnimplementedHandler) GetProfile(ctx context.Context) (r *Profile, _ error) {
+	return r, ht.ErrNotImplemented
+}
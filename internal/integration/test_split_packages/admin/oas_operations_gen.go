@@ -0,0 +1,10 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package admin
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	GetStatsOperation OperationName = "GetStats"
+)
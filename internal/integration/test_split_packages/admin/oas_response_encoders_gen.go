@@ -0,0 +1,25 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func encodeGetStatsResponse(response *api.GetStatsOK, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(200)
+
+	e := new(jx.Encoder)
+	response.Encode(e)
+	if _, err := e.WriteTo(w); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
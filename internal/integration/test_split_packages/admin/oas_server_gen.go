@@ -0,0 +1,36 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package admin
+
+import (
+	"context"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// GetStats implements getStats operation.
+	//
+	// GET /admin/stats
+	GetStats(ctx context.Context) (*api.GetStatsOK, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
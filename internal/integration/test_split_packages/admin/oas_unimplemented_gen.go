@@ -0,0 +1,22 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package admin
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// GetStats implements getStats operation.
+//
+// GET /admin/stats
+func (UnimplementedHandler) GetStats(ctx context.Context) (r *api.GetStatsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
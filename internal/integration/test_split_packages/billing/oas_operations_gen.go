@@ -0,0 +1,11 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package billing
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreatePaymentOperation OperationName = "CreatePayment"
+	GetInvoiceOperation    OperationName = "GetInvoice"
+)
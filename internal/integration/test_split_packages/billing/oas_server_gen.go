@@ -0,0 +1,40 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package billing
+
+import (
+	"context"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreatePayment implements createPayment operation.
+	//
+	// POST /payments
+	CreatePayment(ctx context.Context, req *api.Payment) (*api.Payment, error)
+	// GetInvoice implements getInvoice operation.
+	//
+	// GET /invoices/{id}
+	GetInvoice(ctx context.Context, params GetInvoiceParams) (*api.Invoice, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,29 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package billing
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreatePayment implements createPayment operation.
+//
+// POST /payments
+func (UnimplementedHandler) CreatePayment(ctx context.Context, req *api.Payment) (r *api.Payment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetInvoice implements getInvoice operation.
+//
+// GET /invoices/{id}
+func (UnimplementedHandler) GetInvoice(ctx context.Context, params GetInvoiceParams) (r *api.Invoice, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,10 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package users
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	GetUserOperation OperationName = "GetUser"
+)
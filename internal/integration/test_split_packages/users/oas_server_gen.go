@@ -0,0 +1,36 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// GetUser implements getUser operation.
+	//
+	// GET /users/{id}
+	GetUser(ctx context.Context, params GetUserParams) (*api.User, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
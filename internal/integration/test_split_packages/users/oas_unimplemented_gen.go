@@ -0,0 +1,22 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package users
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+	api "github.com/ogen-go/ogen/internal/integration/test_split_packages"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// GetUser implements getUser operation.
+//
+// GET /users/{id}
+func (UnimplementedHandler) GetUser(ctx context.Context, params GetUserParams) (r *api.User, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,99 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/uri"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func encodeDataEventsResponse(ctx context.Context, response *DataEventsOK, w http.ResponseWriter, keepAlive time.Duration, span trace.Span) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+
+	if err := response.writeSSE(ctx, w, keepAlive); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeFullEventsResponse(ctx context.Context, response FullEventsRes, w http.ResponseWriter, keepAlive time.Duration, span trace.Span) error {
+	switch response := response.(type) {
+	case *FullEventsOK:
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(200)
+
+		if err := response.writeSSE(ctx, w, keepAlive); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+		if code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(code))
+		}
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeHeaderEventsResponse(ctx context.Context, response *HeaderEventsOKHeaders, w http.ResponseWriter, keepAlive time.Duration, span trace.Span) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Access-Control-Expose-Headers", "X-Stream-Id")
+	// Encoding response headers.
+	{
+		h := uri.NewHeaderEncoder(w.Header())
+		// Encode "X-Stream-Id" header.
+		{
+			cfg := uri.HeaderParameterEncodingConfig{
+				Name:    "X-Stream-Id",
+				Explode: false,
+			}
+			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+				return e.EncodeValue(conv.StringToString(response.XStreamID))
+			}); err != nil {
+				return errors.Wrap(err, "encode X-Stream-Id header")
+			}
+		}
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+
+	if err := response.Response.writeSSE(ctx, w, keepAlive); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
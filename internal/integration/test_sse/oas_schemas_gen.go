@@ -0,0 +1,1383 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/sse"
+)
+
+// Ref: #/components/schemas/CreatedEvent
+type CreatedEvent struct {
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	Data  Tick   `json:"data"`
+	Retry OptInt `json:"retry"`
+}
+
+// GetID returns the value of ID.
+func (s *CreatedEvent) GetID() string {
+	return s.ID
+}
+
+// GetEvent returns the value of Event.
+func (s *CreatedEvent) GetEvent() string {
+	return s.Event
+}
+
+// GetData returns the value of Data.
+func (s *CreatedEvent) GetData() Tick {
+	return s.Data
+}
+
+// GetRetry returns the value of Retry.
+func (s *CreatedEvent) GetRetry() OptInt {
+	return s.Retry
+}
+
+// SetID sets the value of ID.
+func (s *CreatedEvent) SetID(val string) {
+	s.ID = val
+}
+
+// SetEvent sets the value of Event.
+func (s *CreatedEvent) SetEvent(val string) {
+	s.Event = val
+}
+
+// SetData sets the value of Data.
+func (s *CreatedEvent) SetData(val Tick) {
+	s.Data = val
+}
+
+// SetRetry sets the value of Retry.
+func (s *CreatedEvent) SetRetry(val OptInt) {
+	s.Retry = val
+}
+
+// DataEventsOKClient reads events from the DataEventsOK SSE stream.
+type DataEventsOKClient interface {
+	sse.Client[DataEventsOKEvent]
+}
+
+// DataEventsOK is a Server-Sent Events response stream.
+//
+// Servers create it using NewDataEventsOK or NewDataEventsOKFromChan.
+type DataEventsOK struct {
+	// events is the server-side event source.
+	events iter.Seq2[DataEventsOKEvent, error]
+
+	resp      *http.Response
+	decoder   *sse.Decoder
+	connect   sseConnectFunc
+	options   sseClientConfig
+	stateMu   sync.RWMutex
+	state     sse.State
+	latestErr error
+	closed    atomic.Bool
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewDataEventsOK creates a new DataEventsOK stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewDataEventsOK(events iter.Seq2[DataEventsOKEvent, error]) *DataEventsOK {
+	return &DataEventsOK{events: events}
+}
+
+// NewDataEventsOKFromChan creates a new DataEventsOK stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewDataEventsOKFromChan(events <-chan DataEventsOKEvent) *DataEventsOK {
+	return NewDataEventsOK(func(yield func(DataEventsOKEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
+func (s *DataEventsOK) initSSEStream(
+	connect sseConnectFunc,
+	options sseClientConfig,
+) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.resp != nil {
+		s.decoder = newSSEResponseDecoder(s.resp, options)
+	}
+	s.connect = connect
+	s.options = options
+	s.state = sse.StateOpen
+	s.latestErr = nil
+	s.closeCh = make(chan struct{})
+}
+
+// State returns the current stream state and the latest terminal or current reconnect error.
+func (s *DataEventsOK) State() (state sse.State, latestErr error) {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+	return s.state, s.latestErr
+}
+
+func (s *DataEventsOK) setState(state sse.State, latestErr error) {
+	s.stateMu.Lock()
+	s.state = state
+	s.latestErr = latestErr
+	s.stateMu.Unlock()
+}
+
+func (s *DataEventsOK) withCloseContext(ctx context.Context,
+) (context.Context, context.CancelFunc) {
+	reconnectCtx, cancel := context.WithCancel(ctx)
+	if s.closed.Load() {
+		cancel()
+		return reconnectCtx, func() {}
+	}
+	if s.closeCh == nil {
+		return reconnectCtx, cancel
+	}
+
+	go func() {
+		select {
+		case <-s.closeCh:
+			cancel()
+		case <-reconnectCtx.Done():
+		}
+	}()
+	return reconnectCtx, cancel
+}
+
+// Close closes the current stream and stops further reconnect attempts.
+func (s *DataEventsOK) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	s.stateMu.Lock()
+	resp := s.resp
+	s.resp = nil
+	s.decoder = nil
+	closeCh := s.closeCh
+	s.state = sse.StateClosed
+	s.latestErr = sse.ErrStreamClosed
+	s.stateMu.Unlock()
+
+	if closeCh != nil {
+		s.closeOnce.Do(func() {
+			close(closeCh)
+		})
+	}
+
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	return resp.Body.Close()
+}
+
+// Next returns the next event from the stream, reconnecting when needed.
+func (s *DataEventsOK) Next(ctx context.Context,
+) (DataEventsOKEvent, error) {
+	for {
+		s.stateMu.RLock()
+		resp, decoder, connect, options, latestErr := s.resp, s.decoder, s.connect, s.options, s.latestErr
+		s.stateMu.RUnlock()
+		if s.closed.Load() {
+			var event DataEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if decoder == nil {
+			// No decoder means the stream is already terminal.
+			if latestErr == nil {
+				latestErr = sse.ErrStreamClosed
+			}
+			s.setState(sse.StateClosed, latestErr)
+			var event DataEventsOKEvent
+			return event, latestErr
+		}
+		raw, err := decoder.Decode()
+		if err == nil {
+			s.setState(sse.StateOpen, nil)
+			return s.decodeEvent(raw)
+		}
+		// Special case, on ErrEventTooLarge the current event is drained
+		// without closing the stream.
+		if errors.Is(err, sse.ErrEventTooLarge) {
+			s.setState(sse.StateOpen, err)
+			var event DataEventsOKEvent
+			return event, err
+		}
+		if !sse.IsReconnectableError(err) {
+			s.setState(sse.StateClosed, err)
+			var event DataEventsOKEvent
+			return event, err
+		}
+		if connect == nil {
+			// Without a reconnect function, a reconnectable read error
+			// becomes terminal.
+			s.setState(sse.StateClosed, sse.ErrStreamClosed)
+			var event DataEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+
+		s.setState(sse.StateConnecting, err)
+		reconnectCtx, cancel := s.withCloseContext(ctx)
+		nextResp, nextDecoder, err := reconnectSSE(reconnectCtx, resp, decoder, connect, options, s)
+		cancel()
+		if s.closed.Load() {
+			if nextResp != nil && nextResp.Body != nil {
+				_ = nextResp.Body.Close()
+			}
+			var event DataEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if err != nil {
+			s.stateMu.Lock()
+			s.resp = nil
+			s.decoder = nil
+			s.stateMu.Unlock()
+			s.setState(sse.StateClosed, err)
+			var event DataEventsOKEvent
+			return event, err
+		}
+		s.stateMu.Lock()
+		s.resp = nextResp
+		s.decoder = nextDecoder
+		s.stateMu.Unlock()
+		s.setState(sse.StateOpen, nil)
+	}
+}
+
+// All iterates over stream events until the stream is closed, reconnecting when needed.
+func (s *DataEventsOK) All(ctx context.Context,
+) iter.Seq2[DataEventsOKEvent, error] {
+	return func(yield func(DataEventsOKEvent, error) bool) {
+		for {
+			event, err := s.Next(ctx)
+			if err != nil {
+				if !sse.IsReconnectableError(err) {
+					return
+				}
+				var zero DataEventsOKEvent
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *DataEventsOK) decodeEvent(raw sse.Event,
+) (DataEventsOKEvent, error) {
+	var data Tick
+	buf := []byte(raw.Data)
+	d := jx.DecodeBytes(buf)
+	if err := func() error {
+		if err := data.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		var event DataEventsOKEvent
+		return event, err
+	}
+	event := DataEventsOKEvent{
+		ID:   raw.ID,
+		Type: raw.Type,
+		Data: data,
+	}
+	if raw.Retry != nil {
+		event.Retry.SetTo(int((*raw.Retry) / time.Millisecond))
+	}
+	return event, nil
+}
+
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *DataEventsOK) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *DataEventsOK) encodeEvent(event DataEventsOKEvent,
+) (sse.Event, error) {
+	raw := sse.Event{
+		ID:   event.ID,
+		Type: event.Type,
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Data.Encode(e)
+	data, err := sseEventData(e.Bytes())
+	if err != nil {
+		return raw, errors.Wrap(err, "data")
+	}
+	raw.Data = data
+	if v, ok := event.Retry.Get(); ok {
+		retry := time.Duration(v) * time.Millisecond
+		raw.Retry = &retry
+	}
+	return raw, nil
+}
+
+// DataEventsOKEvent is a parsed Server-Sent Event.
+type DataEventsOKEvent struct {
+	ID    string `json:"id"`
+	Type  string `json:"event"`
+	Data  Tick   `json:"data"`
+	Retry OptInt `json:"retry"`
+}
+
+// GetID returns the value of ID.
+func (s *DataEventsOKEvent) GetID() string {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *DataEventsOKEvent) GetType() string {
+	return s.Type
+}
+
+// GetData returns the value of Data.
+func (s *DataEventsOKEvent) GetData() Tick {
+	return s.Data
+}
+
+// GetRetry returns the value of Retry.
+func (s *DataEventsOKEvent) GetRetry() OptInt {
+	return s.Retry
+}
+
+// SetID sets the value of ID.
+func (s *DataEventsOKEvent) SetID(val string) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *DataEventsOKEvent) SetType(val string) {
+	s.Type = val
+}
+
+// SetData sets the value of Data.
+func (s *DataEventsOKEvent) SetData(val Tick) {
+	s.Data = val
+}
+
+// SetRetry sets the value of Retry.
+func (s *DataEventsOKEvent) SetRetry(val OptInt) {
+	s.Retry = val
+}
+
+// Ref: #/components/schemas/DeletedEvent
+type DeletedEvent struct {
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	Data  string `json:"data"`
+	Retry OptInt `json:"retry"`
+}
+
+// GetID returns the value of ID.
+func (s *DeletedEvent) GetID() string {
+	return s.ID
+}
+
+// GetEvent returns the value of Event.
+func (s *DeletedEvent) GetEvent() string {
+	return s.Event
+}
+
+// GetData returns the value of Data.
+func (s *DeletedEvent) GetData() string {
+	return s.Data
+}
+
+// GetRetry returns the value of Retry.
+func (s *DeletedEvent) GetRetry() OptInt {
+	return s.Retry
+}
+
+// SetID sets the value of ID.
+func (s *DeletedEvent) SetID(val string) {
+	s.ID = val
+}
+
+// SetEvent sets the value of Event.
+func (s *DeletedEvent) SetEvent(val string) {
+	s.Event = val
+}
+
+// SetData sets the value of Data.
+func (s *DeletedEvent) SetData(val string) {
+	s.Data = val
+}
+
+// SetRetry sets the value of Retry.
+func (s *DeletedEvent) SetRetry(val OptInt) {
+	s.Retry = val
+}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+func (*ErrorStatusCode) fullEventsRes()                                {}
+func (*ErrorStatusCode) initSSEStream(sseConnectFunc, sseClientConfig) {}
+
+// FullEventsOKClient reads events from the FullEventsOK SSE stream.
+type FullEventsOKClient interface {
+	sse.Client[FullEventsOKEvent]
+}
+
+// FullEventsOK is a Server-Sent Events response stream.
+//
+// Servers create it using NewFullEventsOK or NewFullEventsOKFromChan.
+type FullEventsOK struct {
+	// events is the server-side event source.
+	events iter.Seq2[FullEventsOKEvent, error]
+
+	resp      *http.Response
+	decoder   *sse.Decoder
+	connect   sseConnectFunc
+	options   sseClientConfig
+	stateMu   sync.RWMutex
+	state     sse.State
+	latestErr error
+	closed    atomic.Bool
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewFullEventsOK creates a new FullEventsOK stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewFullEventsOK(events iter.Seq2[FullEventsOKEvent, error]) *FullEventsOK {
+	return &FullEventsOK{events: events}
+}
+
+// NewFullEventsOKFromChan creates a new FullEventsOK stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewFullEventsOKFromChan(events <-chan FullEventsOKEvent) *FullEventsOK {
+	return NewFullEventsOK(func(yield func(FullEventsOKEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
+func (s *FullEventsOK) initSSEStream(
+	connect sseConnectFunc,
+	options sseClientConfig,
+) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.resp != nil {
+		s.decoder = newSSEResponseDecoder(s.resp, options)
+	}
+	s.connect = connect
+	s.options = options
+	s.state = sse.StateOpen
+	s.latestErr = nil
+	s.closeCh = make(chan struct{})
+}
+
+// State returns the current stream state and the latest terminal or current reconnect error.
+func (s *FullEventsOK) State() (state sse.State, latestErr error) {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+	return s.state, s.latestErr
+}
+
+func (s *FullEventsOK) setState(state sse.State, latestErr error) {
+	s.stateMu.Lock()
+	s.state = state
+	s.latestErr = latestErr
+	s.stateMu.Unlock()
+}
+
+func (s *FullEventsOK) withCloseContext(ctx context.Context,
+) (context.Context, context.CancelFunc) {
+	reconnectCtx, cancel := context.WithCancel(ctx)
+	if s.closed.Load() {
+		cancel()
+		return reconnectCtx, func() {}
+	}
+	if s.closeCh == nil {
+		return reconnectCtx, cancel
+	}
+
+	go func() {
+		select {
+		case <-s.closeCh:
+			cancel()
+		case <-reconnectCtx.Done():
+		}
+	}()
+	return reconnectCtx, cancel
+}
+
+// Close closes the current stream and stops further reconnect attempts.
+func (s *FullEventsOK) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	s.stateMu.Lock()
+	resp := s.resp
+	s.resp = nil
+	s.decoder = nil
+	closeCh := s.closeCh
+	s.state = sse.StateClosed
+	s.latestErr = sse.ErrStreamClosed
+	s.stateMu.Unlock()
+
+	if closeCh != nil {
+		s.closeOnce.Do(func() {
+			close(closeCh)
+		})
+	}
+
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	return resp.Body.Close()
+}
+
+// Next returns the next event from the stream, reconnecting when needed.
+func (s *FullEventsOK) Next(ctx context.Context,
+) (FullEventsOKEvent, error) {
+	for {
+		s.stateMu.RLock()
+		resp, decoder, connect, options, latestErr := s.resp, s.decoder, s.connect, s.options, s.latestErr
+		s.stateMu.RUnlock()
+		if s.closed.Load() {
+			var event FullEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if decoder == nil {
+			// No decoder means the stream is already terminal.
+			if latestErr == nil {
+				latestErr = sse.ErrStreamClosed
+			}
+			s.setState(sse.StateClosed, latestErr)
+			var event FullEventsOKEvent
+			return event, latestErr
+		}
+		raw, err := decoder.Decode()
+		if err == nil {
+			s.setState(sse.StateOpen, nil)
+			return s.decodeEvent(raw)
+		}
+		// Special case, on ErrEventTooLarge the current event is drained
+		// without closing the stream.
+		if errors.Is(err, sse.ErrEventTooLarge) {
+			s.setState(sse.StateOpen, err)
+			var event FullEventsOKEvent
+			return event, err
+		}
+		if !sse.IsReconnectableError(err) {
+			s.setState(sse.StateClosed, err)
+			var event FullEventsOKEvent
+			return event, err
+		}
+		if connect == nil {
+			// Without a reconnect function, a reconnectable read error
+			// becomes terminal.
+			s.setState(sse.StateClosed, sse.ErrStreamClosed)
+			var event FullEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+
+		s.setState(sse.StateConnecting, err)
+		reconnectCtx, cancel := s.withCloseContext(ctx)
+		nextResp, nextDecoder, err := reconnectSSE(reconnectCtx, resp, decoder, connect, options, s)
+		cancel()
+		if s.closed.Load() {
+			if nextResp != nil && nextResp.Body != nil {
+				_ = nextResp.Body.Close()
+			}
+			var event FullEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if err != nil {
+			s.stateMu.Lock()
+			s.resp = nil
+			s.decoder = nil
+			s.stateMu.Unlock()
+			s.setState(sse.StateClosed, err)
+			var event FullEventsOKEvent
+			return event, err
+		}
+		s.stateMu.Lock()
+		s.resp = nextResp
+		s.decoder = nextDecoder
+		s.stateMu.Unlock()
+		s.setState(sse.StateOpen, nil)
+	}
+}
+
+// All iterates over stream events until the stream is closed, reconnecting when needed.
+func (s *FullEventsOK) All(ctx context.Context,
+) iter.Seq2[FullEventsOKEvent, error] {
+	return func(yield func(FullEventsOKEvent, error) bool) {
+		for {
+			event, err := s.Next(ctx)
+			if err != nil {
+				if !sse.IsReconnectableError(err) {
+					return
+				}
+				var zero FullEventsOKEvent
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *FullEventsOK) decodeEvent(raw sse.Event,
+) (FullEventsOKEvent, error) {
+	e := jx.GetEncoder()
+	e.ObjStart()
+	e.FieldStart("id")
+	e.Str(raw.ID)
+	e.FieldStart("event")
+	e.Str(raw.Type)
+	e.FieldStart("data")
+	switch raw.Type {
+	case "created":
+		e.Raw([]byte(raw.Data))
+	case "deleted":
+		if jx.Valid([]byte(raw.Data)) {
+			e.Raw([]byte(raw.Data))
+		} else {
+			e.Str(raw.Data)
+		}
+	default:
+		if jx.Valid([]byte(raw.Data)) {
+			e.Raw([]byte(raw.Data))
+		} else {
+			e.Str(raw.Data)
+		}
+	}
+	if raw.Retry != nil {
+		e.FieldStart("retry")
+		e.Int(int((*raw.Retry) / time.Millisecond))
+	}
+	e.ObjEnd()
+	buf := e.Bytes()
+	jx.PutEncoder(e)
+
+	d := jx.DecodeBytes(buf)
+	var event FullEventsOKEvent
+	if err := func() error {
+		if err := event.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		return event, err
+	}
+	return event, nil
+}
+
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *FullEventsOK) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *FullEventsOK) encodeEvent(event FullEventsOKEvent,
+) (sse.Event, error) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Encode(e)
+
+	var raw sse.Event
+	d := jx.DecodeBytes(e.Bytes())
+	if err := d.ObjBytes(func(d *jx.Decoder, key []byte) error {
+		switch string(key) {
+		case "id":
+			v, err := d.Str()
+			raw.ID = v
+			return err
+		case "event":
+			v, err := d.Str()
+			raw.Type = v
+			return err
+		case "data":
+			buf, err := d.Raw()
+			if err != nil {
+				return err
+			}
+			raw.Data, err = sseEventData(buf)
+			return err
+		case "retry":
+			if d.Next() == jx.Null {
+				return d.Null()
+			}
+			v, err := d.Int64()
+			if err != nil {
+				return err
+			}
+			retry := time.Duration(v) * time.Millisecond
+			raw.Retry = &retry
+			return nil
+		default:
+			return d.Skip()
+		}
+	}); err != nil {
+		return raw, err
+	}
+	return raw, nil
+}
+
+func (*FullEventsOK) fullEventsRes() {}
+
+// FullEventsOKEvent represents sum type.
+type FullEventsOKEvent struct {
+	// Type selects the active sum variant, switch on this field.
+	Type         FullEventsOKEventType
+	CreatedEvent CreatedEvent
+	DeletedEvent DeletedEvent
+}
+
+// FullEventsOKEventType is oneOf type of FullEventsOKEvent.
+type FullEventsOKEventType string
+
+// Possible values for FullEventsOKEventType.
+const (
+	CreatedEventFullEventsOKEvent FullEventsOKEventType = "created"
+	DeletedEventFullEventsOKEvent FullEventsOKEventType = "deleted"
+)
+
+// IsCreatedEvent reports whether FullEventsOKEvent is CreatedEvent.
+func (s FullEventsOKEvent) IsCreatedEvent() bool { return s.Type == CreatedEventFullEventsOKEvent }
+
+// IsDeletedEvent reports whether FullEventsOKEvent is DeletedEvent.
+func (s FullEventsOKEvent) IsDeletedEvent() bool { return s.Type == DeletedEventFullEventsOKEvent }
+
+// SetCreatedEvent sets FullEventsOKEvent to CreatedEvent.
+func (s *FullEventsOKEvent) SetCreatedEvent(v CreatedEvent) {
+	s.Type = CreatedEventFullEventsOKEvent
+	s.CreatedEvent = v
+}
+
+// GetCreatedEvent returns CreatedEvent and true boolean if FullEventsOKEvent is CreatedEvent.
+func (s FullEventsOKEvent) GetCreatedEvent() (v CreatedEvent, ok bool) {
+	if !s.IsCreatedEvent() {
+		return v, false
+	}
+	return s.CreatedEvent, true
+}
+
+// NewCreatedEventFullEventsOKEvent returns new FullEventsOKEvent from CreatedEvent.
+func NewCreatedEventFullEventsOKEvent(v CreatedEvent) FullEventsOKEvent {
+	var s FullEventsOKEvent
+	s.SetCreatedEvent(v)
+	return s
+}
+
+// SetDeletedEvent sets FullEventsOKEvent to DeletedEvent.
+func (s *FullEventsOKEvent) SetDeletedEvent(v DeletedEvent) {
+	s.Type = DeletedEventFullEventsOKEvent
+	s.DeletedEvent = v
+}
+
+// GetDeletedEvent returns DeletedEvent and true boolean if FullEventsOKEvent is DeletedEvent.
+func (s FullEventsOKEvent) GetDeletedEvent() (v DeletedEvent, ok bool) {
+	if !s.IsDeletedEvent() {
+		return v, false
+	}
+	return s.DeletedEvent, true
+}
+
+// NewDeletedEventFullEventsOKEvent returns new FullEventsOKEvent from DeletedEvent.
+func NewDeletedEventFullEventsOKEvent(v DeletedEvent) FullEventsOKEvent {
+	var s FullEventsOKEvent
+	s.SetDeletedEvent(v)
+	return s
+}
+
+// HeaderEventsOKClient reads events from the HeaderEventsOK SSE stream.
+type HeaderEventsOKClient interface {
+	sse.Client[HeaderEventsOKEvent]
+}
+
+// HeaderEventsOK is a Server-Sent Events response stream.
+//
+// Servers create it using NewHeaderEventsOK or NewHeaderEventsOKFromChan.
+type HeaderEventsOK struct {
+	// events is the server-side event source.
+	events iter.Seq2[HeaderEventsOKEvent, error]
+
+	resp      *http.Response
+	decoder   *sse.Decoder
+	connect   sseConnectFunc
+	options   sseClientConfig
+	stateMu   sync.RWMutex
+	state     sse.State
+	latestErr error
+	closed    atomic.Bool
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewHeaderEventsOK creates a new HeaderEventsOK stream that writes events produced by the sequence.
+//
+// The stream ends when the sequence ends, yields an error or the client disconnects.
+func NewHeaderEventsOK(events iter.Seq2[HeaderEventsOKEvent, error]) *HeaderEventsOK {
+	return &HeaderEventsOK{events: events}
+}
+
+// NewHeaderEventsOKFromChan creates a new HeaderEventsOK stream that writes events received from the channel.
+//
+// The stream ends when the channel is closed or the client disconnects.
+// The sender should stop and close the channel once the request context is done.
+func NewHeaderEventsOKFromChan(events <-chan HeaderEventsOKEvent) *HeaderEventsOK {
+	return NewHeaderEventsOK(func(yield func(HeaderEventsOKEvent, error) bool) {
+		for event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	})
+}
+
+func (s *HeaderEventsOK) initSSEStream(
+	connect sseConnectFunc,
+	options sseClientConfig,
+) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.resp != nil {
+		s.decoder = newSSEResponseDecoder(s.resp, options)
+	}
+	s.connect = connect
+	s.options = options
+	s.state = sse.StateOpen
+	s.latestErr = nil
+	s.closeCh = make(chan struct{})
+}
+
+// State returns the current stream state and the latest terminal or current reconnect error.
+func (s *HeaderEventsOK) State() (state sse.State, latestErr error) {
+	s.stateMu.RLock()
+	defer s.stateMu.RUnlock()
+	return s.state, s.latestErr
+}
+
+func (s *HeaderEventsOK) setState(state sse.State, latestErr error) {
+	s.stateMu.Lock()
+	s.state = state
+	s.latestErr = latestErr
+	s.stateMu.Unlock()
+}
+
+func (s *HeaderEventsOK) withCloseContext(ctx context.Context,
+) (context.Context, context.CancelFunc) {
+	reconnectCtx, cancel := context.WithCancel(ctx)
+	if s.closed.Load() {
+		cancel()
+		return reconnectCtx, func() {}
+	}
+	if s.closeCh == nil {
+		return reconnectCtx, cancel
+	}
+
+	go func() {
+		select {
+		case <-s.closeCh:
+			cancel()
+		case <-reconnectCtx.Done():
+		}
+	}()
+	return reconnectCtx, cancel
+}
+
+// Close closes the current stream and stops further reconnect attempts.
+func (s *HeaderEventsOK) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	s.stateMu.Lock()
+	resp := s.resp
+	s.resp = nil
+	s.decoder = nil
+	closeCh := s.closeCh
+	s.state = sse.StateClosed
+	s.latestErr = sse.ErrStreamClosed
+	s.stateMu.Unlock()
+
+	if closeCh != nil {
+		s.closeOnce.Do(func() {
+			close(closeCh)
+		})
+	}
+
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	return resp.Body.Close()
+}
+
+// Next returns the next event from the stream, reconnecting when needed.
+func (s *HeaderEventsOK) Next(ctx context.Context,
+) (HeaderEventsOKEvent, error) {
+	for {
+		s.stateMu.RLock()
+		resp, decoder, connect, options, latestErr := s.resp, s.decoder, s.connect, s.options, s.latestErr
+		s.stateMu.RUnlock()
+		if s.closed.Load() {
+			var event HeaderEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if decoder == nil {
+			// No decoder means the stream is already terminal.
+			if latestErr == nil {
+				latestErr = sse.ErrStreamClosed
+			}
+			s.setState(sse.StateClosed, latestErr)
+			var event HeaderEventsOKEvent
+			return event, latestErr
+		}
+		raw, err := decoder.Decode()
+		if err == nil {
+			s.setState(sse.StateOpen, nil)
+			return s.decodeEvent(raw)
+		}
+		// Special case, on ErrEventTooLarge the current event is drained
+		// without closing the stream.
+		if errors.Is(err, sse.ErrEventTooLarge) {
+			s.setState(sse.StateOpen, err)
+			var event HeaderEventsOKEvent
+			return event, err
+		}
+		if !sse.IsReconnectableError(err) {
+			s.setState(sse.StateClosed, err)
+			var event HeaderEventsOKEvent
+			return event, err
+		}
+		if connect == nil {
+			// Without a reconnect function, a reconnectable read error
+			// becomes terminal.
+			s.setState(sse.StateClosed, sse.ErrStreamClosed)
+			var event HeaderEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+
+		s.setState(sse.StateConnecting, err)
+		reconnectCtx, cancel := s.withCloseContext(ctx)
+		nextResp, nextDecoder, err := reconnectSSE(reconnectCtx, resp, decoder, connect, options, s)
+		cancel()
+		if s.closed.Load() {
+			if nextResp != nil && nextResp.Body != nil {
+				_ = nextResp.Body.Close()
+			}
+			var event HeaderEventsOKEvent
+			return event, sse.ErrStreamClosed
+		}
+		if err != nil {
+			s.stateMu.Lock()
+			s.resp = nil
+			s.decoder = nil
+			s.stateMu.Unlock()
+			s.setState(sse.StateClosed, err)
+			var event HeaderEventsOKEvent
+			return event, err
+		}
+		s.stateMu.Lock()
+		s.resp = nextResp
+		s.decoder = nextDecoder
+		s.stateMu.Unlock()
+		s.setState(sse.StateOpen, nil)
+	}
+}
+
+// All iterates over stream events until the stream is closed, reconnecting when needed.
+func (s *HeaderEventsOK) All(ctx context.Context,
+) iter.Seq2[HeaderEventsOKEvent, error] {
+	return func(yield func(HeaderEventsOKEvent, error) bool) {
+		for {
+			event, err := s.Next(ctx)
+			if err != nil {
+				if !sse.IsReconnectableError(err) {
+					return
+				}
+				var zero HeaderEventsOKEvent
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (s *HeaderEventsOK) decodeEvent(raw sse.Event,
+) (HeaderEventsOKEvent, error) {
+	var data Tick
+	buf := []byte(raw.Data)
+	d := jx.DecodeBytes(buf)
+	if err := func() error {
+		if err := data.Decode(d); err != nil {
+			return err
+		}
+		if err := d.Skip(); err != io.EOF {
+			return errors.New("unexpected trailing data")
+		}
+		return nil
+	}(); err != nil {
+		var event HeaderEventsOKEvent
+		return event, err
+	}
+	event := HeaderEventsOKEvent{
+		ID:   raw.ID,
+		Type: raw.Type,
+		Data: data,
+	}
+	if raw.Retry != nil {
+		event.Retry.SetTo(int((*raw.Retry) / time.Millisecond))
+	}
+	return event, nil
+}
+
+// writeSSE writes stream events to w until the stream ends or ctx is done.
+func (s *HeaderEventsOK) writeSSE(ctx context.Context, w http.ResponseWriter, keepAlive time.Duration) error {
+	if s == nil || s.events == nil {
+		return sse.WriteStream(ctx, w, nil, keepAlive)
+	}
+	return sse.WriteStream(ctx, w, func(yield func(sse.Event, error) bool) {
+		for event, err := range s.events {
+			if err != nil {
+				yield(sse.Event{}, err)
+				return
+			}
+			raw, err := s.encodeEvent(event)
+			if err != nil {
+				err = errors.Wrap(err, "encode event")
+			}
+			if !yield(raw, err) || err != nil {
+				return
+			}
+		}
+	}, keepAlive)
+}
+
+func (s *HeaderEventsOK) encodeEvent(event HeaderEventsOKEvent,
+) (sse.Event, error) {
+	raw := sse.Event{
+		ID:   event.ID,
+		Type: event.Type,
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	event.Data.Encode(e)
+	data, err := sseEventData(e.Bytes())
+	if err != nil {
+		return raw, errors.Wrap(err, "data")
+	}
+	raw.Data = data
+	if v, ok := event.Retry.Get(); ok {
+		retry := time.Duration(v) * time.Millisecond
+		raw.Retry = &retry
+	}
+	return raw, nil
+}
+
+// HeaderEventsOKEvent is a parsed Server-Sent Event.
+type HeaderEventsOKEvent struct {
+	ID    string `json:"id"`
+	Type  string `json:"event"`
+	Data  Tick   `json:"data"`
+	Retry OptInt `json:"retry"`
+}
+
+// GetID returns the value of ID.
+func (s *HeaderEventsOKEvent) GetID() string {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *HeaderEventsOKEvent) GetType() string {
+	return s.Type
+}
+
+// GetData returns the value of Data.
+func (s *HeaderEventsOKEvent) GetData() Tick {
+	return s.Data
+}
+
+// GetRetry returns the value of Retry.
+func (s *HeaderEventsOKEvent) GetRetry() OptInt {
+	return s.Retry
+}
+
+// SetID sets the value of ID.
+func (s *HeaderEventsOKEvent) SetID(val string) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *HeaderEventsOKEvent) SetType(val string) {
+	s.Type = val
+}
+
+// SetData sets the value of Data.
+func (s *HeaderEventsOKEvent) SetData(val Tick) {
+	s.Data = val
+}
+
+// SetRetry sets the value of Retry.
+func (s *HeaderEventsOKEvent) SetRetry(val OptInt) {
+	s.Retry = val
+}
+
+// HeaderEventsOKHeaders wraps HeaderEventsOK with response headers.
+type HeaderEventsOKHeaders struct {
+	XStreamID string
+	Response  *HeaderEventsOK
+}
+
+// GetXStreamID returns the value of XStreamID.
+func (s *HeaderEventsOKHeaders) GetXStreamID() string {
+	return s.XStreamID
+}
+
+// GetResponse returns the value of Response.
+func (s *HeaderEventsOKHeaders) GetResponse() *HeaderEventsOK {
+	return s.Response
+}
+
+// SetXStreamID sets the value of XStreamID.
+func (s *HeaderEventsOKHeaders) SetXStreamID(val string) {
+	s.XStreamID = val
+}
+
+// SetResponse sets the value of Response.
+func (s *HeaderEventsOKHeaders) SetResponse(val *HeaderEventsOK) {
+	s.Response = val
+}
+
+// initSSEStream initializes the wrapped SSE stream with reconnect and decoder options.
+func (s *HeaderEventsOKHeaders) initSSEStream(connect sseConnectFunc, options sseClientConfig) {
+	if s.Response != nil {
+		s.Response.initSSEStream(connect, options)
+	}
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Tick
+type Tick struct {
+	Seq  int       `json:"seq"`
+	Note OptString `json:"note"`
+}
+
+// GetSeq returns the value of Seq.
+func (s *Tick) GetSeq() int {
+	return s.Seq
+}
+
+// GetNote returns the value of Note.
+func (s *Tick) GetNote() OptString {
+	return s.Note
+}
+
+// SetSeq sets the value of Seq.
+func (s *Tick) SetSeq(val int) {
+	s.Seq = val
+}
+
+// SetNote sets the value of Note.
+func (s *Tick) SetNote(val OptString) {
+	s.Note = val
+}
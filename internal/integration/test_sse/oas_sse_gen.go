@@ -0,0 +1,190 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/sse"
+)
+
+type sseClientConfig struct {
+	LastEventID       string
+	Retry             *time.Duration
+	MaxRetries        int
+	InitialBufferCap  int
+	MaxEventSize      int
+	RetryErrorHandler sse.RetryErrorHandler
+}
+
+type SSEClientOption func(*sseClientConfig)
+
+func newSSEClientConfig(opts ...SSEClientOption) sseClientConfig {
+	var cfg sseClientConfig
+	cfg.apply(opts...)
+	return cfg
+}
+
+func (c *sseClientConfig) apply(opts ...SSEClientOption) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+}
+
+// WithSSELastEventID sets the initial lastEventID value for the stream.
+func WithSSELastEventID(lastEventID string) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.LastEventID = lastEventID
+	}
+}
+
+// WithSSERetry sets the initial SSE reconnect delay.
+func WithSSERetry(delay time.Duration) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.Retry = &delay
+	}
+}
+
+// WithSSEMaxRetries sets the maximum number of reconnect attempts.
+//
+// Zero sets unlimited reconnect attempts.
+func WithSSEMaxRetries(n int) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.MaxRetries = n
+	}
+}
+
+// WithSSEInitialBufferCap sets the initial decoder line buffer capacity.
+func WithSSEInitialBufferCap(n int) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.InitialBufferCap = n
+	}
+}
+
+// WithSSEMaxEventSize sets the maximum parsable SSE event size in bytes.
+//
+// Zero disables the limit.
+func WithSSEMaxEventSize(n int) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.MaxEventSize = n
+	}
+}
+
+// WithSSERetryErrorHandler sets the callback invoked after a reconnect attempt fails.
+func WithSSERetryErrorHandler(h sse.RetryErrorHandler) SSEClientOption {
+	return func(o *sseClientConfig) {
+		o.RetryErrorHandler = h
+	}
+}
+
+// sseConnectFunc reconnects an SSE stream using the lastEventID value.
+//
+// Attempt is the number of the reconnect attempt, starting from 1.
+type sseConnectFunc func(ctx context.Context, lastEventID string, attempt int) (*http.Response, error)
+
+func newSSEResponseDecoder(resp *http.Response, options sseClientConfig) *sse.Decoder {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+	return sse.NewDecoder(resp.Body,
+		options.InitialBufferCap,
+		options.MaxEventSize,
+		options.LastEventID,
+		options.Retry,
+	)
+}
+
+func reconnectSSE(ctx context.Context,
+	resp *http.Response,
+	decoder *sse.Decoder,
+	connect sseConnectFunc,
+	options sseClientConfig,
+	stateUpdater interface{ setState(sse.State, error) },
+) (*http.Response, *sse.Decoder, error) {
+	if resp != nil && resp.Body != nil {
+		if err := resp.Body.Close(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	retry := sse.DefaultRetry
+	if decoder != nil {
+		retry = decoder.Retry()
+	} else if options.Retry != nil {
+		retry = *options.Retry
+	}
+
+	lastEventID := options.LastEventID
+	if decoder != nil {
+		lastEventID = decoder.LastEventID()
+	}
+
+	if err := waitSSERetry(ctx, retry); err != nil {
+		if stateUpdater != nil {
+			stateUpdater.setState(sse.StateConnecting, err)
+		}
+		return nil, nil, err
+	}
+
+	var attempts int
+	for {
+		nextResp, err := connect(ctx, lastEventID, attempts+1)
+		if err == nil {
+			options.LastEventID = lastEventID
+			options.Retry = &retry
+			return nextResp, newSSEResponseDecoder(nextResp, options), nil
+		}
+
+		attempts++
+		stateUpdater.setState(sse.StateConnecting, err)
+		if options.RetryErrorHandler != nil {
+			options.RetryErrorHandler(ctx, err)
+		}
+		if options.MaxRetries > 0 && attempts >= options.MaxRetries {
+			return nil, nil, errors.Wrap(sse.ErrMaxRetriesExceeded, err.Error())
+		}
+		if err := waitSSERetry(ctx, retry); err != nil {
+			if stateUpdater != nil {
+				stateUpdater.setState(sse.StateConnecting, err)
+			}
+			return nil, nil, err
+		}
+	}
+}
+
+func waitSSERetry(ctx context.Context, retry time.Duration) error {
+	timer := time.NewTimer(retry)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// sseEventData converts JSON-encoded event data to the SSE data field value.
+//
+// JSON strings are written unquoted, unless the unquoted value is a valid JSON
+// itself, so that clients can tell strings from other values.
+func sseEventData(buf []byte) (string, error) {
+	d := jx.DecodeBytes(buf)
+	if d.Next() != jx.String {
+		return string(buf), nil
+	}
+	s, err := d.Str()
+	if err != nil {
+		return "", err
+	}
+	if jx.Valid([]byte(s)) {
+		return string(buf), nil
+	}
+	return s, nil
+}
@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// DataEvents implements dataEvents operation.
+//
+// GET /events/data
+func (UnimplementedHandler) DataEvents(ctx context.Context, params DataEventsParams) (r *DataEventsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FullEvents implements fullEvents operation.
+//
+// GET /events/full
+func (UnimplementedHandler) FullEvents(ctx context.Context) (r FullEventsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HeaderEvents implements headerEvents operation.
+//
+// GET /events/headers
+func (UnimplementedHandler) HeaderEvents(ctx context.Context) (r *HeaderEventsOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// DataEvents implements dataEvents operation.
+	//
+	// GET /events/data
+	DataEvents(ctx context.Context, params DataEventsParams) (*DataEventsOK, error)
+	// FullEvents implements fullEvents operation.
+	//
+	// GET /events/full
+	FullEvents(ctx context.Context) (FullEventsRes, error)
+	// HeaderEvents implements headerEvents operation.
+	//
+	// GET /events/headers
+	HeaderEvents(ctx context.Context) (*HeaderEventsOKHeaders, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
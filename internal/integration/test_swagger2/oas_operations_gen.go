@@ -0,0 +1,13 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreatePetOperation      OperationName = "CreatePet"
+	ListPetsOperation       OperationName = "ListPets"
+	UpdatePetNameOperation  OperationName = "UpdatePetName"
+	UploadPetPhotoOperation OperationName = "UploadPetPhoto"
+)
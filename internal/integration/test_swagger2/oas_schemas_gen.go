@@ -0,0 +1,517 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+	ht "github.com/ogen-go/ogen/http"
+)
+
+type ApiKey struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *ApiKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *ApiKey) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *ApiKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *ApiKey) SetRoles(val []string) {
+	s.Roles = val
+}
+
+type BasicAuth struct {
+	Username string
+	Password string
+	Roles    []string
+}
+
+// GetUsername returns the value of Username.
+func (s *BasicAuth) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *BasicAuth) GetPassword() string {
+	return s.Password
+}
+
+// GetRoles returns the value of Roles.
+func (s *BasicAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetUsername sets the value of Username.
+func (s *BasicAuth) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *BasicAuth) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BasicAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+func (*ErrorStatusCode) createPetRes() {}
+func (*ErrorStatusCode) listPetsRes()  {}
+
+// Ref: #/components/schemas/Kind
+type Kind string
+
+const (
+	KindCat Kind = "cat"
+	KindDog Kind = "dog"
+)
+
+// AllValues returns all Kind values.
+func (Kind) AllValues() []Kind {
+	return []Kind{
+		KindCat,
+		KindDog,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Kind) MarshalText() ([]byte, error) {
+	switch s {
+	case KindCat:
+		return []byte(s), nil
+	case KindDog:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Kind) UnmarshalText(data []byte) error {
+	switch Kind(data) {
+	case KindCat:
+		*s = KindCat
+		return nil
+	case KindDog:
+		*s = KindDog
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ListPetsOKHeaders wraps []Pet with response headers.
+type ListPetsOKHeaders struct {
+	XTotal   OptInt
+	Response []Pet
+}
+
+// GetXTotal returns the value of XTotal.
+func (s *ListPetsOKHeaders) GetXTotal() OptInt {
+	return s.XTotal
+}
+
+// GetResponse returns the value of Response.
+func (s *ListPetsOKHeaders) GetResponse() []Pet {
+	return s.Response
+}
+
+// SetXTotal sets the value of XTotal.
+func (s *ListPetsOKHeaders) SetXTotal(val OptInt) {
+	s.XTotal = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListPetsOKHeaders) SetResponse(val []Pet) {
+	s.Response = val
+}
+
+func (*ListPetsOKHeaders) listPetsRes() {}
+
+type OAuth struct {
+	Token  string
+	Scopes []string
+}
+
+// GetToken returns the value of Token.
+func (s *OAuth) GetToken() string {
+	return s.Token
+}
+
+// GetScopes returns the value of Scopes.
+func (s *OAuth) GetScopes() []string {
+	return s.Scopes
+}
+
+// SetToken sets the value of Token.
+func (s *OAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *OAuth) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptKind returns new OptKind with value set to v.
+func NewOptKind(v Kind) OptKind {
+	return OptKind{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptKind is optional Kind.
+type OptKind struct {
+	Value Kind
+	Set   bool
+}
+
+// IsSet returns true if OptKind was set.
+func (o OptKind) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptKind) Reset() {
+	var v Kind
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptKind) SetTo(v Kind) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptKind) Get() (v Kind, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptKind) Or(d Kind) Kind {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// IsEmpty returns true if the field was omitted from the payload (not Set and not Null).
+func (o OptNilString) IsEmpty() bool {
+	return !o.Set && !o.Null
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Pet
+type Pet struct {
+	ID   int64        `json:"id"`
+	Name string       `json:"name"`
+	Tag  OptNilString `json:"tag"`
+	Kind OptKind      `json:"kind"`
+}
+
+// GetID returns the value of ID.
+func (s *Pet) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Pet) GetName() string {
+	return s.Name
+}
+
+// GetTag returns the value of Tag.
+func (s *Pet) GetTag() OptNilString {
+	return s.Tag
+}
+
+// GetKind returns the value of Kind.
+func (s *Pet) GetKind() OptKind {
+	return s.Kind
+}
+
+// SetID sets the value of ID.
+func (s *Pet) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Pet) SetName(val string) {
+	s.Name = val
+}
+
+// SetTag sets the value of Tag.
+func (s *Pet) SetTag(val OptNilString) {
+	s.Tag = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Pet) SetKind(val OptKind) {
+	s.Kind = val
+}
+
+func (*Pet) createPetRes() {}
+
+type UpdatePetNameReq struct {
+	Name      string   `json:"name"`
+	Nicknames []string `json:"nicknames"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdatePetNameReq) GetName() string {
+	return s.Name
+}
+
+// GetNicknames returns the value of Nicknames.
+func (s *UpdatePetNameReq) GetNicknames() []string {
+	return s.Nicknames
+}
+
+// SetName sets the value of Name.
+func (s *UpdatePetNameReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetNicknames sets the value of Nicknames.
+func (s *UpdatePetNameReq) SetNicknames(val []string) {
+	s.Nicknames = val
+}
+
+// UploadPetPhotoNoContent is response for UploadPetPhoto operation.
+type UploadPetPhotoNoContent struct{}
+
+type UploadPetPhotoReq struct {
+	Photo   ht.MultipartFile `json:"photo"`
+	Caption OptString        `json:"caption"`
+}
+
+// GetPhoto returns the value of Photo.
+func (s *UploadPetPhotoReq) GetPhoto() ht.MultipartFile {
+	return s.Photo
+}
+
+// GetCaption returns the value of Caption.
+func (s *UploadPetPhotoReq) GetCaption() OptString {
+	return s.Caption
+}
+
+// SetPhoto sets the value of Photo.
+func (s *UploadPetPhotoReq) SetPhoto(val ht.MultipartFile) {
+	s.Photo = val
+}
+
+// SetCaption sets the value of Caption.
+func (s *UploadPetPhotoReq) SetCaption(val OptString) {
+	s.Caption = val
+}
@@ -0,0 +1,210 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// SecurityHandler is handler for security parameters.
+type SecurityHandler interface {
+	// HandleApiKey handles apiKey security.
+	HandleApiKey(ctx context.Context, operationName OperationName, t ApiKey) (context.Context, error)
+	// HandleBasicAuth handles basicAuth security.
+	HandleBasicAuth(ctx context.Context, operationName OperationName, t BasicAuth) (context.Context, error)
+	// HandleOAuth handles oauth security.
+	HandleOAuth(ctx context.Context, operationName OperationName, t OAuth) (context.Context, error)
+}
+
+func findAuthorization(h http.Header, prefix string) (string, bool) {
+	v, ok := h["Authorization"]
+	if !ok {
+		return "", false
+	}
+	for _, vv := range v {
+		scheme, value, ok := strings.Cut(vv, " ")
+		if !ok || !strings.EqualFold(scheme, prefix) {
+			continue
+		}
+		return value, true
+	}
+	return "", false
+}
+
+// operationRolesApiKey is a private map storing roles per operation.
+var operationRolesApiKey = map[string][]string{
+	CreatePetOperation: []string{},
+	ListPetsOperation:  []string{},
+}
+
+// GetRolesForApiKey returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForApiKey(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForApiKey(operation string) []string {
+	roles, ok := operationRolesApiKey[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesBasicAuth is a private map storing roles per operation.
+var operationRolesBasicAuth = map[string][]string{
+	UpdatePetNameOperation: []string{},
+}
+
+// GetRolesForBasicAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBasicAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBasicAuth(operation string) []string {
+	roles, ok := operationRolesBasicAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// oauth2ScopesOAuth is a private map storing OAuth2 scopes per operation.
+var oauth2ScopesOAuth = map[string][]string{
+	UploadPetPhotoOperation: []string{
+		"read",
+	},
+}
+
+// GetOAuth2ScopesForOAuth returns the required OAuth2 scopes for the given operation.
+//
+// This is useful for token exchange scenarios where you need to know which scopes
+// to request when obtaining a token for a downstream API call.
+//
+// Example:
+//
+//	requiredScopes := GetOAuth2ScopesForOAuth(AddPetOperation)
+//	token := exchangeTokenWithScopes(requiredScopes, "https://api.example.com")
+//
+// Returns nil if the operation has no scope requirements or if the operation is unknown.
+func GetOAuth2ScopesForOAuth(operation string) []string {
+	scopes, ok := oauth2ScopesOAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(scopes))
+	copy(result, scopes)
+	return result
+}
+
+func (s *Server) securityApiKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t ApiKey
+	const parameterName = "X-API-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesApiKey[operationName]
+	rctx, err := s.sec.HandleApiKey(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityBasicAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BasicAuth
+	if _, ok := findAuthorization(req.Header, "Basic"); !ok {
+		return ctx, false, nil
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok {
+		return nil, false, errors.New("invalid basic auth")
+	}
+	t.Username = username
+	t.Password = password
+	t.Roles = operationRolesBasicAuth[operationName]
+	rctx, err := s.sec.HandleBasicAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securityOAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t OAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Scopes = oauth2ScopesOAuth[operationName]
+	rctx, err := s.sec.HandleOAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+// SecuritySource is provider of security values (tokens, passwords, etc.).
+type SecuritySource interface {
+	// ApiKey provides apiKey security value.
+	ApiKey(ctx context.Context, operationName OperationName) (ApiKey, error)
+	// BasicAuth provides basicAuth security value.
+	BasicAuth(ctx context.Context, operationName OperationName) (BasicAuth, error)
+	// OAuth provides oauth security value.
+	OAuth(ctx context.Context, operationName OperationName) (OAuth, error)
+}
+
+func (s *Client) securityApiKey(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.ApiKey(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"ApiKey\"")
+	}
+	req.Header.Set("X-API-Key", t.APIKey)
+	return nil
+}
+func (s *Client) securityBasicAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BasicAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BasicAuth\"")
+	}
+	req.SetBasicAuth(t.Username, t.Password)
+	return nil
+}
+func (s *Client) securityOAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.OAuth(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"OAuth\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
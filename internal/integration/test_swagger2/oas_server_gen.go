@@ -0,0 +1,48 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreatePet implements createPet operation.
+	//
+	// POST /pets
+	CreatePet(ctx context.Context, req *Pet) (CreatePetRes, error)
+	// ListPets implements listPets operation.
+	//
+	// GET /pets
+	ListPets(ctx context.Context, params ListPetsParams) (ListPetsRes, error)
+	// UpdatePetName implements updatePetName operation.
+	//
+	// PUT /pets/{id}
+	UpdatePetName(ctx context.Context, req *UpdatePetNameReq, params UpdatePetNameParams) (*Pet, error)
+	// UploadPetPhoto implements uploadPetPhoto operation.
+	//
+	// POST /pets/{id}/photo
+	UploadPetPhoto(ctx context.Context, req *UploadPetPhotoReq, params UploadPetPhotoParams) error
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h   Handler
+	sec SecurityHandler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		sec:        sec,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreatePet implements createPet operation.
+//
+// POST /pets
+func (UnimplementedHandler) CreatePet(ctx context.Context, req *Pet) (r CreatePetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPets implements listPets operation.
+//
+// GET /pets
+func (UnimplementedHandler) ListPets(ctx context.Context, params ListPetsParams) (r ListPetsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdatePetName implements updatePetName operation.
+//
+// PUT /pets/{id}
+func (UnimplementedHandler) UpdatePetName(ctx context.Context, req *UpdatePetNameReq, params UpdatePetNameParams) (r *Pet, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadPetPhoto implements uploadPetPhoto operation.
+//
+// POST /pets/{id}/photo
+func (UnimplementedHandler) UploadPetPhoto(ctx context.Context, req *UploadPetPhotoReq, params UploadPetPhotoParams) error {
+	return ht.ErrNotImplemented
+}
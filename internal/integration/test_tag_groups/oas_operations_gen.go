@@ -0,0 +1,14 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreatePaymentOperation OperationName = "CreatePayment"
+	GetInvoiceOperation    OperationName = "GetInvoice"
+	GetStatsOperation      OperationName = "GetStats"
+	GetUserOperation       OperationName = "GetUser"
+	HealthOperation        OperationName = "Health"
+)
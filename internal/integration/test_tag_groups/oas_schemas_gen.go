@@ -0,0 +1,87 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+type GetStatsOK struct {
+	Users int `json:"users"`
+}
+
+// GetUsers returns the value of Users.
+func (s *GetStatsOK) GetUsers() int {
+	return s.Users
+}
+
+// SetUsers sets the value of Users.
+func (s *GetStatsOK) SetUsers(val int) {
+	s.Users = val
+}
+
+// HealthNoContent is response for Health operation.
+type HealthNoContent struct{}
+
+// Ref: #/components/schemas/Invoice
+type Invoice struct {
+	ID     int `json:"id"`
+	Amount int `json:"amount"`
+}
+
+// GetID returns the value of ID.
+func (s *Invoice) GetID() int {
+	return s.ID
+}
+
+// GetAmount returns the value of Amount.
+func (s *Invoice) GetAmount() int {
+	return s.Amount
+}
+
+// SetID sets the value of ID.
+func (s *Invoice) SetID(val int) {
+	s.ID = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *Invoice) SetAmount(val int) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/Payment
+type Payment struct {
+	Invoice int `json:"invoice"`
+	Amount  int `json:"amount"`
+}
+
+// GetInvoice returns the value of Invoice.
+func (s *Payment) GetInvoice() int {
+	return s.Invoice
+}
+
+// GetAmount returns the value of Amount.
+func (s *Payment) GetAmount() int {
+	return s.Amount
+}
+
+// SetInvoice sets the value of Invoice.
+func (s *Payment) SetInvoice(val int) {
+	s.Invoice = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *Payment) SetAmount(val int) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/User
+type User struct {
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *User) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *User) SetID(val string) {
+	s.ID = val
+}
@@ -0,0 +1,75 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	AdminHandler
+	BillingHandler
+	UsersHandler
+	// Health implements health operation.
+	//
+	// GET /health
+	Health(ctx context.Context) error
+}
+
+// AdminHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Admin
+type AdminHandler interface {
+	// GetStats implements getStats operation.
+	//
+	// GET /admin/stats
+	GetStats(ctx context.Context) (*GetStatsOK, error)
+}
+
+// BillingHandler handles operations described by OpenAPI v3 specification.
+//
+// Billing operations.
+//
+// tag: billing
+type BillingHandler interface {
+	// CreatePayment implements createPayment operation.
+	//
+	// POST /payments
+	CreatePayment(ctx context.Context, req *Payment) (*Payment, error)
+	// GetInvoice implements getInvoice operation.
+	//
+	// GET /invoices/{id}
+	GetInvoice(ctx context.Context, params GetInvoiceParams) (*Invoice, error)
+}
+
+// UsersHandler handles operations described by OpenAPI v3 specification.
+//
+// Users.
+//
+// tag: users
+type UsersHandler interface {
+	// GetUser implements getUser operation.
+	//
+	// GET /users/{id}
+	GetUser(ctx context.Context, params GetUserParams) (*User, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
@@ -0,0 +1,70 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+//
+// Operations of groups are implemented by embedded group stubs.
+type UnimplementedHandler struct {
+	UnimplementedAdminHandler
+	UnimplementedBillingHandler
+	UnimplementedUsersHandler
+}
+
+var _ Handler = UnimplementedHandler{}
+
+// Health implements health operation.
+//
+// GET /health
+func (UnimplementedHandler) Health(ctx context.Context) error {
+	return ht.ErrNotImplemented
+}
+
+// UnimplementedAdminHandler is no-op AdminHandler which returns http.ErrNotImplemented.
+type UnimplementedAdminHandler struct{}
+
+var _ AdminHandler = UnimplementedAdminHandler{}
+
+// GetStats implements getStats operation.
+//
+// GET /admin/stats
+func (UnimplementedAdminHandler) GetStats(ctx context.Context) (r *GetStatsOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnimplementedBillingHandler is no-op BillingHandler which returns http.ErrNotImplemented.
+type UnimplementedBillingHandler struct{}
+
+var _ BillingHandler = UnimplementedBillingHandler{}
+
+// CreatePayment implements createPayment operation.
+//
+// POST /payments
+func (UnimplementedBillingHandler) CreatePayment(ctx context.Context, req *Payment) (r *Payment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetInvoice implements getInvoice operation.
+//
+// GET /invoices/{id}
+func (UnimplementedBillingHandler) GetInvoice(ctx context.Context, params GetInvoiceParams) (r *Invoice, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnimplementedUsersHandler is no-op UsersHandler which returns http.ErrNotImplemented.
+type UnimplementedUsersHandler struct{}
+
+var _ UsersHandler = UnimplementedUsersHandler{}
+
+// GetUser implements getUser operation.
+//
+// GET /users/{id}
+func (UnimplementedUsersHandler) GetUser(ctx context.Context, params GetUserParams) (r *User, _ error) {
+	return r, ht.ErrNotImplemented
+}
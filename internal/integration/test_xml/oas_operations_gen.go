@@ -0,0 +1,12 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CreatePetOperation  OperationName = "CreatePet"
+	GetPetOperation     OperationName = "GetPet"
+	PutLibraryOperation OperationName = "PutLibrary"
+)
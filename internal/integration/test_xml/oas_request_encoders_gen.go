@@ -0,0 +1,61 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenxml"
+)
+
+func encodeCreatePetRequest(
+	req CreatePetReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *CreatePetApplicationJSON:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
+			req.Encode(e)
+		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *CreatePetApplicationXML:
+		const contentType = "application/xml"
+		var buf bytes.Buffer
+		e := ogenxml.NewEncoder(&buf)
+		req.EncodeXML(e, ogenxml.Name{Space: "http://example.com/pets", Prefix: "p", Local: "pet"})
+		if err := e.Close(); err != nil {
+			return errors.Wrap(err, "encode xml")
+		}
+		ht.SetBody(r, bytes.NewReader(buf.Bytes()), contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodePutLibraryRequest(
+	req OptLibrary,
+	r *http.Request,
+) error {
+	const contentType = "application/soap+xml"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	var buf bytes.Buffer
+	e := ogenxml.NewEncoder(&buf)
+	req.Value.EncodeXML(e, ogenxml.Name{Local: "library"})
+	if err := e.Close(); err != nil {
+		return errors.Wrap(err, "encode xml")
+	}
+	ht.SetBody(r, bytes.NewReader(buf.Bytes()), contentType)
+	return nil
+}
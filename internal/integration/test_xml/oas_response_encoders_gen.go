@@ -0,0 +1,87 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/ogenxml"
+	"github.com/ogen-go/ogen/uri"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func encodeCreatePetResponse(response *Pet, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(200)
+
+	e := ogenxml.NewEncoder(w)
+	response.EncodeXML(e, ogenxml.Name{Space: "http://example.com/pets", Prefix: "p", Local: "pet"})
+	if err := e.Close(); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
+
+func encodeGetPetResponse(response GetPetRes, w http.ResponseWriter, span trace.Span) error {
+	switch response := response.(type) {
+	case *Pet:
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(200)
+
+		e := ogenxml.NewEncoder(w)
+		response.EncodeXML(e, ogenxml.Name{Space: "http://example.com/pets", Prefix: "p", Local: "pet"})
+		if err := e.Close(); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *Problem:
+		w.Header().Set("Content-Type", "application/problem+xml")
+		w.WriteHeader(404)
+
+		e := ogenxml.NewEncoder(w)
+		response.EncodeXML(e, ogenxml.Name{Local: "Problem"})
+		if err := e.Close(); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodePutLibraryResponse(response *LibraryHeaders, w http.ResponseWriter, span trace.Span) error {
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Access-Control-Expose-Headers", "X-Books-Count")
+	// Encoding response headers.
+	{
+		h := uri.NewHeaderEncoder(w.Header())
+		// Encode "X-Books-Count" header.
+		{
+			cfg := uri.HeaderParameterEncodingConfig{
+				Name:    "X-Books-Count",
+				Explode: false,
+			}
+			if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+				return e.EncodeValue(conv.IntToString(response.XBooksCount))
+			}); err != nil {
+				return errors.Wrap(err, "encode X-Books-Count header")
+			}
+		}
+	}
+	w.WriteHeader(200)
+
+	e := ogenxml.NewEncoder(w)
+	response.Response.EncodeXML(e, ogenxml.Name{Local: "library"})
+	if err := e.Close(); err != nil {
+		return errors.Wrap(err, "write")
+	}
+
+	return nil
+}
@@ -0,0 +1,562 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Ref: #/components/schemas/Book
+type Book struct {
+	Title string `json:"title"`
+	Year  OptInt `json:"year"`
+}
+
+// GetTitle returns the value of Title.
+func (s *Book) GetTitle() string {
+	return s.Title
+}
+
+// GetYear returns the value of Year.
+func (s *Book) GetYear() OptInt {
+	return s.Year
+}
+
+// SetTitle sets the value of Title.
+func (s *Book) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetYear sets the value of Year.
+func (s *Book) SetYear(val OptInt) {
+	s.Year = val
+}
+
+type CreatePetApplicationJSON Pet
+
+func (*CreatePetApplicationJSON) createPetReq() {}
+
+type CreatePetApplicationXML Pet
+
+func (*CreatePetApplicationXML) createPetReq() {}
+
+// Ref: #/components/schemas/Library
+type Library struct {
+	Name  OptString `json:"name"`
+	Books []Book    `json:"books"`
+}
+
+// GetName returns the value of Name.
+func (s *Library) GetName() OptString {
+	return s.Name
+}
+
+// GetBooks returns the value of Books.
+func (s *Library) GetBooks() []Book {
+	return s.Books
+}
+
+// SetName sets the value of Name.
+func (s *Library) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetBooks sets the value of Books.
+func (s *Library) SetBooks(val []Book) {
+	s.Books = val
+}
+
+// LibraryHeaders wraps Library with response headers.
+type LibraryHeaders struct {
+	XBooksCount int
+	Response    Library
+}
+
+// GetXBooksCount returns the value of XBooksCount.
+func (s *LibraryHeaders) GetXBooksCount() int {
+	return s.XBooksCount
+}
+
+// GetResponse returns the value of Response.
+func (s *LibraryHeaders) GetResponse() Library {
+	return s.Response
+}
+
+// SetXBooksCount sets the value of XBooksCount.
+func (s *LibraryHeaders) SetXBooksCount(val int) {
+	s.XBooksCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *LibraryHeaders) SetResponse(val Library) {
+	s.Response = val
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLibrary returns new OptLibrary with value set to v.
+func NewOptLibrary(v Library) OptLibrary {
+	return OptLibrary{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLibrary is optional Library.
+type OptLibrary struct {
+	Value Library
+	Set   bool
+}
+
+// IsSet returns true if OptLibrary was set.
+func (o OptLibrary) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLibrary) Reset() {
+	var v Library
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLibrary) SetTo(v Library) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLibrary) Get() (v Library, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLibrary) Or(d Library) Library {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOwner returns new OptOwner with value set to v.
+func NewOptOwner(v Owner) OptOwner {
+	return OptOwner{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOwner is optional Owner.
+type OptOwner struct {
+	Value Owner
+	Set   bool
+}
+
+// IsSet returns true if OptOwner was set.
+func (o OptOwner) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOwner) Reset() {
+	var v Owner
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOwner) SetTo(v Owner) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOwner) Get() (v Owner, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOwner) Or(d Owner) Owner {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPetStatus returns new OptPetStatus with value set to v.
+func NewOptPetStatus(v PetStatus) OptPetStatus {
+	return OptPetStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPetStatus is optional PetStatus.
+type OptPetStatus struct {
+	Value PetStatus
+	Set   bool
+}
+
+// IsSet returns true if OptPetStatus was set.
+func (o OptPetStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPetStatus) Reset() {
+	var v PetStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPetStatus) SetTo(v PetStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPetStatus) Get() (v PetStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPetStatus) Or(d PetStatus) PetStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Owner
+type Owner struct {
+	Name  string    `json:"name"`
+	Email OptString `json:"email"`
+}
+
+// GetName returns the value of Name.
+func (s *Owner) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *Owner) GetEmail() OptString {
+	return s.Email
+}
+
+// SetName sets the value of Name.
+func (s *Owner) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Owner) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// Ref: #/components/schemas/Pet
+type Pet struct {
+	ID        int64        `json:"id"`
+	Status    OptPetStatus `json:"status"`
+	Name      string       `json:"name"`
+	Born      OptDate      `json:"born"`
+	Tags      []string     `json:"tags"`
+	PhotoUrls []url.URL    `json:"photoUrls"`
+	Owner     OptOwner     `json:"owner"`
+	Remark    OptString    `json:"remark"`
+}
+
+// GetID returns the value of ID.
+func (s *Pet) GetID() int64 {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *Pet) GetStatus() OptPetStatus {
+	return s.Status
+}
+
+// GetName returns the value of Name.
+func (s *Pet) GetName() string {
+	return s.Name
+}
+
+// GetBorn returns the value of Born.
+func (s *Pet) GetBorn() OptDate {
+	return s.Born
+}
+
+// GetTags returns the value of Tags.
+func (s *Pet) GetTags() []string {
+	return s.Tags
+}
+
+// GetPhotoUrls returns the value of PhotoUrls.
+func (s *Pet) GetPhotoUrls() []url.URL {
+	return s.PhotoUrls
+}
+
+// GetOwner returns the value of Owner.
+func (s *Pet) GetOwner() OptOwner {
+	return s.Owner
+}
+
+// GetRemark returns the value of Remark.
+func (s *Pet) GetRemark() OptString {
+	return s.Remark
+}
+
+// SetID sets the value of ID.
+func (s *Pet) SetID(val int64) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Pet) SetStatus(val OptPetStatus) {
+	s.Status = val
+}
+
+// SetName sets the value of Name.
+func (s *Pet) SetName(val string) {
+	s.Name = val
+}
+
+// SetBorn sets the value of Born.
+func (s *Pet) SetBorn(val OptDate) {
+	s.Born = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Pet) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetPhotoUrls sets the value of PhotoUrls.
+func (s *Pet) SetPhotoUrls(val []url.URL) {
+	s.PhotoUrls = val
+}
+
+// SetOwner sets the value of Owner.
+func (s *Pet) SetOwner(val OptOwner) {
+	s.Owner = val
+}
+
+// SetRemark sets the value of Remark.
+func (s *Pet) SetRemark(val OptString) {
+	s.Remark = val
+}
+
+func (*Pet) getPetRes() {}
+
+// Ref: #/components/schemas/PetStatus
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+// AllValues returns all PetStatus values.
+func (PetStatus) AllValues() []PetStatus {
+	return []PetStatus{
+		PetStatusAvailable,
+		PetStatusSold,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PetStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PetStatusAvailable:
+		return []byte(s), nil
+	case PetStatusSold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PetStatus) UnmarshalText(data []byte) error {
+	switch PetStatus(data) {
+	case PetStatusAvailable:
+		*s = PetStatusAvailable
+		return nil
+	case PetStatusSold:
+		*s = PetStatusSold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Problem
+type Problem struct {
+	Title  string `json:"title"`
+	Status OptInt `json:"status"`
+}
+
+// GetTitle returns the value of Title.
+func (s *Problem) GetTitle() string {
+	return s.Title
+}
+
+// GetStatus returns the value of Status.
+func (s *Problem) GetStatus() OptInt {
+	return s.Status
+}
+
+// SetTitle sets the value of Title.
+func (s *Problem) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Problem) SetStatus(val OptInt) {
+	s.Status = val
+}
+
+func (*Problem) getPetRes() {}
@@ -0,0 +1,42 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CreatePet implements createPet operation.
+	//
+	// POST /pets
+	CreatePet(ctx context.Context, req CreatePetReq) (*Pet, error)
+	// GetPet implements getPet operation.
+	//
+	// GET /pets/{id}
+	GetPet(ctx context.Context, params GetPetParams) (GetPetRes, error)
+	// PutLibrary implements putLibrary operation.
+	//
+	// PUT /library
+	PutLibrary(ctx context.Context, req OptLibrary) (*LibraryHeaders, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
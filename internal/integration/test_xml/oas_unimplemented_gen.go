@@ -0,0 +1,35 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CreatePet implements createPet operation.
+//
+// POST /pets
+func (UnimplementedHandler) CreatePet(ctx context.Context, req CreatePetReq) (r *Pet, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPet implements getPet operation.
+//
+// GET /pets/{id}
+func (UnimplementedHandler) GetPet(ctx context.Context, params GetPetParams) (r GetPetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PutLibrary implements putLibrary operation.
+//
+// PUT /library
+func (UnimplementedHandler) PutLibrary(ctx context.Context, req OptLibrary) (r *LibraryHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
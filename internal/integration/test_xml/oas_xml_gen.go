@@ -0,0 +1,620 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/ogen-go/ogen/conv"
+	"github.com/ogen-go/ogen/ogenxml"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// EncodeXML encodes Book as XML element with given name.
+func (s *Book) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	e.ElemStart(name)
+	{
+		e.TextElem(ogenxml.Name{Local: "title"}, conv.StringToString(s.Title))
+	}
+	{
+		if val, ok := s.Year.Get(); ok {
+			e.TextElem(ogenxml.Name{Local: "year"}, conv.IntToString(val))
+		}
+	}
+	e.ElemEnd()
+}
+
+// DecodeXML decodes Book from XML element.
+func (s *Book) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Book to nil")
+	}
+	var seen [2]bool
+	_ = start
+
+	if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+		switch {
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "title"}):
+			seen[0] = true
+			if err := func() error {
+				text, err := d.Text()
+				if err != nil {
+					return err
+				}
+				c, err := conv.ToString(text)
+				if err != nil {
+					return err
+				}
+				s.Title = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"title\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "year"}):
+			if err := func() error {
+				var val int
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToInt(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Year.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"year\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Book")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	if !seen[0] {
+		failures = append(failures, validate.FieldError{
+			Name:  "title",
+			Error: validate.ErrFieldRequired,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+// EncodeXML encodes CreatePetApplicationJSON as XML element with given name.
+func (s *CreatePetApplicationJSON) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	unwrapped := (*Pet)(s)
+	unwrapped.EncodeXML(e, name)
+}
+
+// DecodeXML decodes CreatePetApplicationJSON from XML element.
+func (s *CreatePetApplicationJSON) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreatePetApplicationJSON to nil")
+	}
+	var unwrapped Pet
+	if err := unwrapped.DecodeXML(d, start); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreatePetApplicationJSON(unwrapped)
+	return nil
+}
+
+// EncodeXML encodes CreatePetApplicationXML as XML element with given name.
+func (s *CreatePetApplicationXML) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	unwrapped := (*Pet)(s)
+	unwrapped.EncodeXML(e, name)
+}
+
+// DecodeXML decodes CreatePetApplicationXML from XML element.
+func (s *CreatePetApplicationXML) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreatePetApplicationXML to nil")
+	}
+	var unwrapped Pet
+	if err := unwrapped.DecodeXML(d, start); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = CreatePetApplicationXML(unwrapped)
+	return nil
+}
+
+// EncodeXML encodes Library as XML element with given name.
+func (s *Library) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	e.ElemStart(name)
+	{
+		if val, ok := s.Name.Get(); ok {
+			e.TextElem(ogenxml.Name{Local: "name"}, conv.StringToString(val))
+		}
+	}
+	{
+		if s.Books != nil {
+			e.ElemStart(ogenxml.Name{Local: "shelf"})
+			for _, elem := range s.Books {
+				elem.EncodeXML(e, ogenxml.Name{Space: "http://example.com/books", Local: "book"})
+			}
+			e.ElemEnd()
+		}
+	}
+	e.ElemEnd()
+}
+
+// DecodeXML decodes Library from XML element.
+func (s *Library) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Library to nil")
+	}
+	_ = start
+
+	if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+		switch {
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "name"}):
+			if err := func() error {
+				var val string
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToString(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Name.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"name\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "shelf"}):
+			s.Books = make([]Book, 0)
+			if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+				if !ogenxml.Match(start.Name, ogenxml.Name{Space: "http://example.com/books", Local: "book"}) {
+					return d.Skip()
+				}
+				var elem Book
+				if err := func() error {
+					if err := elem.DecodeXML(d, start); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Books = append(s.Books, elem)
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, "decode element \"shelf\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Library")
+	}
+	return nil
+}
+
+// EncodeXML encodes Owner as XML element with given name.
+func (s *Owner) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	e.ElemStart(name)
+	{
+		if val, ok := s.Email.Get(); ok {
+			e.Attr(ogenxml.Name{Local: "email"}, conv.StringToString(val))
+		}
+	}
+	{
+		e.TextElem(ogenxml.Name{Local: "name"}, conv.StringToString(s.Name))
+	}
+	e.ElemEnd()
+}
+
+// DecodeXML decodes Owner from XML element.
+func (s *Owner) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Owner to nil")
+	}
+	var seen [2]bool
+	for _, a := range start.Attr {
+		switch {
+		case ogenxml.Match(a.Name, ogenxml.Name{Local: "email"}):
+			if err := func() error {
+				text := a.Value
+				var val string
+				if err := func() error {
+					c, err := conv.ToString(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Email.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode attribute \"email\"")
+			}
+		}
+	}
+
+	if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+		switch {
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "name"}):
+			seen[0] = true
+			if err := func() error {
+				text, err := d.Text()
+				if err != nil {
+					return err
+				}
+				c, err := conv.ToString(text)
+				if err != nil {
+					return err
+				}
+				s.Name = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Owner")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	if !seen[0] {
+		failures = append(failures, validate.FieldError{
+			Name:  "name",
+			Error: validate.ErrFieldRequired,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+// EncodeXML encodes Pet as XML element with given name.
+func (s *Pet) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	e.ElemStart(name)
+	{
+		e.Attr(ogenxml.Name{Local: "id"}, conv.Int64ToString(s.ID))
+	}
+	{
+		if val, ok := s.Status.Get(); ok {
+			e.Attr(ogenxml.Name{Local: "status"}, conv.StringToString(string(val)))
+		}
+	}
+	{
+		e.TextElem(ogenxml.Name{Local: "name"}, conv.StringToString(s.Name))
+	}
+	{
+		if val, ok := s.Born.Get(); ok {
+			e.TextElem(ogenxml.Name{Local: "born"}, conv.DateToString(val))
+		}
+	}
+	{
+		if s.Tags != nil {
+			e.ElemStart(ogenxml.Name{Local: "tags"})
+			for _, elem := range s.Tags {
+				e.TextElem(ogenxml.Name{Local: "tag"}, conv.StringToString(elem))
+			}
+			e.ElemEnd()
+		}
+	}
+	{
+		if s.PhotoUrls != nil {
+			for _, elem := range s.PhotoUrls {
+				e.TextElem(ogenxml.Name{Local: "photoUrl"}, conv.URLToString(elem))
+			}
+		}
+	}
+	{
+		if val, ok := s.Owner.Get(); ok {
+			val.EncodeXML(e, ogenxml.Name{Local: "owner"})
+		}
+	}
+	{
+		if val, ok := s.Remark.Get(); ok {
+			e.TextElem(ogenxml.Name{Space: "http://example.com/notes", Prefix: "n", Local: "note"}, conv.StringToString(val))
+		}
+	}
+	e.ElemEnd()
+}
+
+// DecodeXML decodes Pet from XML element.
+func (s *Pet) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Pet to nil")
+	}
+	var seen [8]bool
+	for _, a := range start.Attr {
+		switch {
+		case ogenxml.Match(a.Name, ogenxml.Name{Local: "id"}):
+			seen[0] = true
+			if err := func() error {
+				text := a.Value
+				c, err := conv.ToInt64(text)
+				if err != nil {
+					return err
+				}
+				s.ID = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode attribute \"id\"")
+			}
+		case ogenxml.Match(a.Name, ogenxml.Name{Local: "status"}):
+			if err := func() error {
+				text := a.Value
+				var val PetStatus
+				if err := func() error {
+					c, err := conv.ToString(text)
+					if err != nil {
+						return err
+					}
+					val = PetStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Status.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode attribute \"status\"")
+			}
+		}
+	}
+
+	if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+		switch {
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "name"}):
+			seen[2] = true
+			if err := func() error {
+				text, err := d.Text()
+				if err != nil {
+					return err
+				}
+				c, err := conv.ToString(text)
+				if err != nil {
+					return err
+				}
+				s.Name = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"name\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "born"}):
+			if err := func() error {
+				var val time.Time
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToDate(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Born.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"born\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "tags"}):
+			s.Tags = make([]string, 0)
+			if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+				if !ogenxml.Match(start.Name, ogenxml.Name{Local: "tag"}) {
+					return d.Skip()
+				}
+				var elem string
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToString(text)
+					if err != nil {
+						return err
+					}
+					elem = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Tags = append(s.Tags, elem)
+				return nil
+			}); err != nil {
+				return errors.Wrap(err, "decode element \"tags\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "photoUrl"}):
+			var elem url.URL
+			if err := func() error {
+				text, err := d.Text()
+				if err != nil {
+					return err
+				}
+				c, err := conv.ToURL(text)
+				if err != nil {
+					return err
+				}
+				elem = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"photoUrl\"")
+			}
+			s.PhotoUrls = append(s.PhotoUrls, elem)
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "owner"}):
+			if err := func() error {
+				var val Owner
+				if err := func() error {
+					if err := val.DecodeXML(d, start); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Owner.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"owner\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Space: "http://example.com/notes", Prefix: "n", Local: "note"}):
+			if err := func() error {
+				var val string
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToString(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Remark.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"note\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Pet")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	if !seen[0] {
+		failures = append(failures, validate.FieldError{
+			Name:  "id",
+			Error: validate.ErrFieldRequired,
+		})
+	}
+	if !seen[2] {
+		failures = append(failures, validate.FieldError{
+			Name:  "name",
+			Error: validate.ErrFieldRequired,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
+// EncodeXML encodes Problem as XML element with given name.
+func (s *Problem) EncodeXML(e *ogenxml.Encoder, name ogenxml.Name) {
+	e.ElemStart(name)
+	{
+		e.TextElem(ogenxml.Name{Local: "title"}, conv.StringToString(s.Title))
+	}
+	{
+		if val, ok := s.Status.Get(); ok {
+			e.TextElem(ogenxml.Name{Local: "status"}, conv.IntToString(val))
+		}
+	}
+	e.ElemEnd()
+}
+
+// DecodeXML decodes Problem from XML element.
+func (s *Problem) DecodeXML(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Problem to nil")
+	}
+	var seen [2]bool
+	_ = start
+
+	if err := d.Elements(func(d *ogenxml.Decoder, start ogenxml.StartElement) error {
+		switch {
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "title"}):
+			seen[0] = true
+			if err := func() error {
+				text, err := d.Text()
+				if err != nil {
+					return err
+				}
+				c, err := conv.ToString(text)
+				if err != nil {
+					return err
+				}
+				s.Title = c
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"title\"")
+			}
+		case ogenxml.Match(start.Name, ogenxml.Name{Local: "status"}):
+			if err := func() error {
+				var val int
+				if err := func() error {
+					text, err := d.Text()
+					if err != nil {
+						return err
+					}
+					c, err := conv.ToInt(text)
+					if err != nil {
+						return err
+					}
+					val = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				s.Status.SetTo(val)
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode element \"status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Problem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	if !seen[0] {
+		failures = append(failures, validate.FieldError{
+			Name:  "title",
+			Error: validate.ErrFieldRequired,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
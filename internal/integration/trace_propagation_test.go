@@ -0,0 +1,219 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	sseapi "github.com/ogen-go/ogen/internal/integration/test_sse"
+	whapi "github.com/ogen-go/ogen/internal/integration/test_webhooks"
+	"github.com/ogen-go/ogen/otelogen"
+)
+
+var testPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// recordedHeaders stores headers of received requests.
+type recordedHeaders struct {
+	mux     sync.Mutex
+	headers []http.Header
+}
+
+func (r *recordedHeaders) add(h http.Header) int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.headers = append(r.headers, h.Clone())
+	return len(r.headers)
+}
+
+func (r *recordedHeaders) get(i int) http.Header {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.headers[i]
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestWebhookTracePropagation(t *testing.T) {
+	a := require.New(t)
+
+	var received recordedHeaders
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.add(r.Header)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(s.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, err := whapi.NewWebhookClient(
+		whapi.WithClient(s.Client()),
+		whapi.WithTracerProvider(tp),
+		whapi.WithMeterProvider(metricnoop.NewMeterProvider()),
+		whapi.WithPropagator(testPropagator),
+	)
+	a.NoError(err)
+
+	member, err := baggage.NewMember("tenant", "acme")
+	a.NoError(err)
+	bag, err := baggage.New(member)
+	a.NoError(err)
+
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+	ctx, trigger := tp.Tracer("test").Start(ctx, "trigger")
+	_, err = client.StatusWebhook(ctx, s.URL)
+	a.NoError(err)
+	trigger.End()
+
+	var delivery sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == string(whapi.StatusWebhookOperation) {
+			delivery = span
+		}
+	}
+	a.NotNil(delivery)
+
+	// Delivery starts a new trace, linked to the triggering operation.
+	a.False(delivery.Parent().IsValid())
+	a.NotEqual(trigger.SpanContext().TraceID(), delivery.SpanContext().TraceID())
+	a.Len(delivery.Links(), 1)
+	a.Equal(trigger.SpanContext(), delivery.Links()[0].SpanContext)
+
+	h := received.get(0)
+	a.Equal(
+		fmt.Sprintf("00-%s-%s-01", delivery.SpanContext().TraceID(), delivery.SpanContext().SpanID()),
+		h.Get("Traceparent"),
+	)
+	a.Equal(bag.String(), h.Get("Baggage"))
+}
+
+func TestWebhookTracePropagationDisabled(t *testing.T) {
+	a := require.New(t)
+
+	var received recordedHeaders
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.add(r.Header)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	t.Cleanup(s.Close)
+
+	tp := sdktrace.NewTracerProvider()
+	client, err := whapi.NewWebhookClient(
+		whapi.WithClient(s.Client()),
+		whapi.WithTracerProvider(tp),
+		whapi.WithMeterProvider(metricnoop.NewMeterProvider()),
+		whapi.WithPropagator(testPropagator),
+		whapi.WithPropagator(nil),
+	)
+	a.NoError(err)
+
+	member, err := baggage.NewMember("tenant", "acme")
+	a.NoError(err)
+	bag, err := baggage.New(member)
+	a.NoError(err)
+
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+	_, err = client.StatusWebhook(ctx, s.URL)
+	a.NoError(err)
+
+	h := received.get(0)
+	a.Empty(h.Get("Traceparent"))
+	a.Empty(h.Get("Baggage"))
+}
+
+func TestSSEReconnectTracePropagation(t *testing.T) {
+	a := require.New(t)
+
+	var received recordedHeaders
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := received.add(r.Header)
+		w.Header().Set("Content-Type", "text/event-stream")
+		// Every connection sends a single event and closes the stream.
+		_, _ = fmt.Fprintf(w, "id: %d\nretry: 1\ndata: {\"seq\":%d}\n\n", n, n)
+	}))
+	t.Cleanup(s.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, err := sseapi.NewClient(s.URL,
+		sseapi.WithClient(s.Client()),
+		sseapi.WithTracerProvider(tp),
+		sseapi.WithMeterProvider(metricnoop.NewMeterProvider()),
+		sseapi.WithPropagator(testPropagator),
+		sseapi.WithSSEClientOptions(sseapi.WithSSEMaxRetries(1)),
+	)
+	a.NoError(err)
+
+	ctx := context.Background()
+	stream, err := client.DataEvents(ctx, sseapi.DataEventsParams{Count: 2})
+	a.NoError(err)
+	defer stream.Close()
+
+	for i := 1; i <= 2; i++ {
+		event, err := stream.Next(ctx)
+		a.NoError(err)
+		a.Equal(i, event.Data.Seq)
+	}
+
+	var operation, reconnect sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() != string(sseapi.DataEventsOperation) {
+			continue
+		}
+		if _, ok := spanAttr(span, otelogen.SSEReconnectAttemptKey); ok {
+			reconnect = span
+		} else {
+			operation = span
+		}
+	}
+	a.NotNil(operation)
+	a.NotNil(reconnect)
+
+	// Reconnect is a child of the operation span.
+	a.Equal(operation.SpanContext().SpanID(), reconnect.Parent().SpanID())
+	a.Equal(operation.SpanContext().TraceID(), reconnect.SpanContext().TraceID())
+	a.Equal(trace.SpanKindClient, reconnect.SpanKind())
+
+	attempt, _ := spanAttr(reconnect, otelogen.SSEReconnectAttemptKey)
+	a.Equal(int64(1), attempt.AsInt64())
+	lastEventID, ok := spanAttr(reconnect, otelogen.SSELastEventIDKey)
+	a.True(ok)
+	a.Equal("1", lastEventID.AsString())
+	status, ok := spanAttr(reconnect, "http.response.status_code")
+	a.True(ok)
+	a.Equal(int64(http.StatusOK), status.AsInt64())
+
+	first, second := received.get(0), received.get(1)
+	// Initial request is sent as is.
+	a.Empty(first.Get("Traceparent"))
+	a.Equal(
+		fmt.Sprintf("00-%s-%s-01", reconnect.SpanContext().TraceID(), reconnect.SpanContext().SpanID()),
+		second.Get("Traceparent"),
+	)
+	a.Equal("1", second.Get("Last-Event-ID"))
+}
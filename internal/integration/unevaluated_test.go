@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+
+	strict "github.com/ogen-go/ogen/internal/integration/test_decoding_strict"
+	api "github.com/ogen-go/ogen/internal/integration/test_unevaluated"
+)
+
+func TestUnevaluated(t *testing.T) {
+	for i, tt := range []struct {
+		Input  string
+		Target interface {
+			Decode(d *jx.Decoder) error
+		}
+		Error bool
+	}{
+		{`{"name":"Rex","age":3}`, new(api.Pet), false},
+		{`{"name":"Rex","color":"red"}`, new(api.Pet), true},
+
+		{`{"id":"a","radius":1}`, new(api.Shape), false},
+		{`{"id":"a","side":1}`, new(api.Shape), false},
+		{`{"id":"a","radius":1,"side":1}`, new(api.Shape), true},
+		{`{"id":"a","radius":1,"color":"red"}`, new(api.Shape), true},
+
+		{`{"login":"alice","labels":{"a":"b"},"extra":true}`, new(api.User), false},
+		{`{"login":"alice","labels":{"a":1}}`, new(api.User), true},
+	} {
+		err := tt.Target.Decode(jx.DecodeStr(tt.Input))
+		if tt.Error {
+			require.Error(t, err, "test %d", i+1)
+		} else {
+			require.NoError(t, err, "test %d", i+1)
+		}
+	}
+
+	t.Run("Variant", func(t *testing.T) {
+		a := require.New(t)
+
+		var s api.Shape
+		a.NoError(s.Decode(jx.DecodeStr(`{"id":"a","side":2}`)))
+		a.Equal("a", s.ID)
+		side, ok := s.OneOf.GetSquare()
+		a.True(ok)
+		a.Equal(2.0, side.Side)
+	})
+}
+
+func TestDecodingStrict(t *testing.T) {
+	for i, tt := range []struct {
+		Input  string
+		Target interface {
+			Decode(d *jx.Decoder) error
+		}
+		Error bool
+	}{
+		{`{"login":"alice","labels":{"a":"b"}}`, new(strict.User), false},
+		{`{"login":"alice","extra":true}`, new(strict.User), true},
+
+		{`{"radius":1}`, new(strict.Circle), false},
+		{`{"radius":1,"color":"red"}`, new(strict.Circle), true},
+		{`{"id":"a","radius":1}`, new(strict.Shape), false},
+	} {
+		err := tt.Target.Decode(jx.DecodeStr(tt.Input))
+		if tt.Error {
+			require.Error(t, err, "test %d", i+1)
+		} else {
+			require.NoError(t, err, "test %d", i+1)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/ogen-go/ogen/internal/integration/test_xml"
+)
+
+type xmlHandler struct{}
+
+var _ api.Handler = xmlHandler{}
+
+func (xmlHandler) CreatePet(ctx context.Context, req api.CreatePetReq) (*api.Pet, error) {
+	switch req := req.(type) {
+	case *api.CreatePetApplicationXML:
+		return (*api.Pet)(req), nil
+	case *api.CreatePetApplicationJSON:
+		return (*api.Pet)(req), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (xmlHandler) GetPet(ctx context.Context, params api.GetPetParams) (api.GetPetRes, error) {
+	if params.ID != 1 {
+		return &api.Problem{
+			Title:  "pet not found",
+			Status: api.NewOptInt(http.StatusNotFound),
+		}, nil
+	}
+	return &api.Pet{
+		ID:   1,
+		Name: "Tom & Jerry",
+	}, nil
+}
+
+func (xmlHandler) PutLibrary(ctx context.Context, req api.OptLibrary) (*api.LibraryHeaders, error) {
+	lib, _ := req.Get()
+	return &api.LibraryHeaders{
+		XBooksCount: len(lib.Books),
+		Response:    lib,
+	}, nil
+}
+
+func TestXML(t *testing.T) {
+	ctx := context.Background()
+
+	srv, err := api.NewServer(xmlHandler{})
+	require.NoError(t, err)
+	s := httptest.NewServer(srv)
+	t.Cleanup(s.Close)
+
+	client, err := api.NewClient(s.URL, api.WithClient(s.Client()))
+	require.NoError(t, err)
+
+	photo, err := url.Parse("https://example.com/tom.png")
+	require.NoError(t, err)
+	pet := api.Pet{
+		ID:        10,
+		Status:    api.NewOptPetStatus(api.PetStatusAvailable),
+		Name:      "Tom",
+		Born:      api.NewOptDate(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		Tags:      []string{"cat", "grey"},
+		PhotoUrls: []url.URL{*photo},
+		Owner: api.NewOptOwner(api.Owner{
+			Name:  "Alice",
+			Email: api.NewOptString("alice@example.com"),
+		}),
+		Remark: api.NewOptString("likes <milk>"),
+	}
+
+	t.Run("Encode", func(t *testing.T) {
+		a := require.New(t)
+
+		input := `<?xml version="1.0"?>
+<p:pet xmlns:p="http://example.com/pets" id="10" status="available">
+	<name>Tom</name>
+	<born>2020-01-02</born>
+	<tags><tag>cat</tag><tag>grey</tag></tags>
+	<photoUrl>https://example.com/tom.png</photoUrl>
+	<owner email="alice@example.com"><name>Alice</name></owner>
+	<n:note xmlns:n="http://example.com/notes">likes &lt;milk&gt;</n:note>
+	<unknown><nested/></unknown>
+</p:pet>`
+		resp, err := s.Client().Post(s.URL+"/pets", "application/xml", strings.NewReader(input))
+		a.NoError(err)
+		defer resp.Body.Close()
+		a.Equal(http.StatusOK, resp.StatusCode)
+		a.Equal("application/xml", resp.Header.Get("Content-Type"))
+
+		data, err := io.ReadAll(resp.Body)
+		a.NoError(err)
+		a.Equal(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<p:pet xmlns:p="http://example.com/pets" id="10" status="available">`+
+			`<name>Tom</name>`+
+			`<born>2020-01-02</born>`+
+			`<tags><tag>cat</tag><tag>grey</tag></tags>`+
+			`<photoUrl>https://example.com/tom.png</photoUrl>`+
+			`<owner email="alice@example.com"><name>Alice</name></owner>`+
+			`<n:note xmlns:n="http://example.com/notes">likes &lt;milk&gt;</n:note>`+
+			`</p:pet>`, string(data))
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		a := require.New(t)
+
+		req := api.CreatePetApplicationXML(pet)
+		got, err := client.CreatePet(ctx, &req)
+		a.NoError(err)
+		a.Equal(pet, *got)
+
+		jsonReq := api.CreatePetApplicationJSON(pet)
+		got, err = client.CreatePet(ctx, &jsonReq)
+		a.NoError(err)
+		a.Equal(pet, *got)
+	})
+	t.Run("Responses", func(t *testing.T) {
+		a := require.New(t)
+
+		res, err := client.GetPet(ctx, api.GetPetParams{ID: 1})
+		a.NoError(err)
+		a.Equal(&api.Pet{ID: 1, Name: "Tom & Jerry"}, res)
+
+		res, err = client.GetPet(ctx, api.GetPetParams{ID: 2})
+		a.NoError(err)
+		a.Equal(&api.Problem{
+			Title:  "pet not found",
+			Status: api.NewOptInt(http.StatusNotFound),
+		}, res)
+	})
+	t.Run("WrappedArray", func(t *testing.T) {
+		a := require.New(t)
+
+		lib := api.Library{
+			Name: api.NewOptString("city"),
+			Books: []api.Book{
+				{Title: "Dune", Year: api.NewOptInt(1965)},
+				{Title: "Solaris"},
+			},
+		}
+		res, err := client.PutLibrary(ctx, api.NewOptLibrary(lib))
+		a.NoError(err)
+		a.Equal(2, res.XBooksCount)
+		a.Equal(lib, res.Response)
+
+		res, err = client.PutLibrary(ctx, api.OptLibrary{})
+		a.NoError(err)
+		a.Equal(0, res.XBooksCount)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for _, input := range []string{
+			// Missing required element.
+			`<pet xmlns="http://example.com/pets" id="1"></pet>`,
+			// Invalid attribute value.
+			`<pet xmlns="http://example.com/pets" id="foo"><name>Tom</name></pet>`,
+			// Invalid enum value.
+			`<pet xmlns="http://example.com/pets" id="1" status="unknown"><name>Tom</name></pet>`,
+			// Malformed document.
+			`<pet xmlns="http://example.com/pets" id="1"><name>Tom</pet>`,
+			// Trailing data.
+			`<pet xmlns="http://example.com/pets" id="1"><name>Tom</name></pet><pet/>`,
+			// Unexpected root element.
+			`<Anything xmlns="http://example.com/pets" id="1"><name>Tom</name></Anything>`,
+			// Unexpected root element namespace.
+			`<pet id="1"><name>Tom</name></pet>`,
+			`<pet xmlns="http://example.com/other" id="1"><name>Tom</name></pet>`,
+		} {
+			resp, err := s.Client().Post(s.URL+"/pets", "application/xml", strings.NewReader(input))
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			require.Equal(t, http.StatusBadRequest, resp.StatusCode, input)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"github.com/go-faster/jx"
+)
+
+// DecodeBase64URL decodes base64url string, padding is optional.
+func DecodeBase64URL(d *jx.Decoder) ([]byte, error) {
+	raw, err := d.StrBytes()
+	if err != nil {
+		return nil, err
+	}
+	raw = bytes.TrimRight(raw, "=")
+
+	v := make([]byte, base64.RawURLEncoding.DecodedLen(len(raw)))
+	n, err := base64.RawURLEncoding.Decode(v, raw)
+	if err != nil {
+		return nil, err
+	}
+	return v[:n], nil
+}
+
+// EncodeBase64URL encodes v as base64url string without padding.
+func EncodeBase64URL(e *jx.Encoder, v []byte) {
+	e.Str(base64.RawURLEncoding.EncodeToString(v))
+}
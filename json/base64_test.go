@@ -0,0 +1,43 @@
+package json
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase64URL(t *testing.T) {
+	for i, tt := range []struct {
+		Input   string
+		Value   []byte
+		Encoded string
+		Error   bool
+	}{
+		{`""`, []byte{}, `""`, false},
+		{`"_-8"`, []byte{0xff, 0xef}, `"_-8"`, false},
+		// Padding is optional.
+		{`"_-8="`, []byte{0xff, 0xef}, `"_-8"`, false},
+		{`"aGVsbG8"`, []byte("hello"), `"aGVsbG8"`, false},
+		// Standard alphabet.
+		{`"/+8="`, nil, ``, true},
+		{`10`, nil, ``, true},
+	} {
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			v, err := DecodeBase64URL(jx.DecodeStr(tt.Input))
+			if tt.Error {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.Value, v)
+
+			e := jx.GetEncoder()
+			EncodeBase64URL(e, v)
+			a.Equal(tt.Encoded, e.String())
+		})
+	}
+}
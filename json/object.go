@@ -0,0 +1,29 @@
+package json
+
+import (
+	"slices"
+
+	"github.com/go-faster/jx"
+)
+
+// FilterObject returns decoder of the next object without given fields.
+func FilterObject(d *jx.Decoder, skip ...string) (*jx.Decoder, error) {
+	e := &jx.Encoder{}
+	e.ObjStart()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		raw, err := d.Raw()
+		if err != nil {
+			return err
+		}
+		if slices.Contains(skip, string(k)) {
+			return nil
+		}
+		e.FieldStart(string(k))
+		e.Raw(raw)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	e.ObjEnd()
+	return jx.DecodeBytes(e.Bytes()), nil
+}
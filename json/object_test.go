@@ -0,0 +1,34 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/go-faster/jx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterObject(t *testing.T) {
+	for i, tt := range []struct {
+		Input  string
+		Skip   []string
+		Output string
+	}{
+		{`{}`, nil, `{}`},
+		{`{"a":1,"b":[1,2]}`, nil, `{"a":1,"b":[1,2]}`},
+		{`{"a":1,"b":{"a":2},"c":"a"}`, []string{"a"}, `{"b":{"a":2},"c":"a"}`},
+		{`{"a":1,"b":2}`, []string{"a", "b"}, `{}`},
+		{`{"a\"b":null}`, []string{"b"}, `{"a\"b":null}`},
+	} {
+		a := require.New(t)
+
+		d, err := FilterObject(jx.DecodeStr(tt.Input), tt.Skip...)
+		a.NoError(err, "test %d", i+1)
+
+		raw, err := d.Raw()
+		a.NoError(err)
+		a.JSONEq(tt.Output, raw.String(), "test %d", i+1)
+	}
+
+	_, err := FilterObject(jx.DecodeStr(`[1]`))
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"iter"
+
+	"github.com/go-faster/errors"
+)
+
+// SeqFormat is a framing of a sequence of JSON texts.
+type SeqFormat int
+
+const (
+	// SeqLines is a sequence of newline-delimited JSON texts.
+	//
+	// Used by JSON Lines (application/jsonl) and NDJSON (application/x-ndjson).
+	SeqLines SeqFormat = iota
+	// SeqRecords is a sequence of JSON texts, each prefixed by the record separator.
+	//
+	// Used by JSON text sequences (application/json-seq), see RFC 7464.
+	SeqRecords
+)
+
+const recordSeparator = 0x1e
+
+func (f SeqFormat) delim() byte {
+	if f == SeqRecords {
+		return recordSeparator
+	}
+	return '\n'
+}
+
+// ErrSeqClosed is returned by reading from closed sequence reader.
+var ErrSeqClosed = errors.New("sequence is closed")
+
+// SeqDecoder reads JSON texts from a sequence.
+type SeqDecoder struct {
+	r      *bufio.Reader
+	format SeqFormat
+}
+
+// NewSeqDecoder creates new SeqDecoder.
+func NewSeqDecoder(r io.Reader, format SeqFormat) *SeqDecoder {
+	return &SeqDecoder{
+		r:      bufio.NewReader(r),
+		format: format,
+	}
+}
+
+// Next returns the next JSON text of the sequence.
+//
+// Empty texts are skipped. Returns io.EOF at the end of the sequence.
+func (d *SeqDecoder) Next() ([]byte, error) {
+	delim := d.format.delim()
+	for {
+		text, err := d.r.ReadBytes(delim)
+		text = bytes.TrimSuffix(text, []byte{delim})
+		text = bytes.TrimSpace(text)
+		if len(text) > 0 && (err == nil || err == io.EOF) {
+			return text, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// NewSeqReader returns a reader of the sequence of JSON texts produced by texts.
+//
+// Texts are pulled lazily, so the sequence is not started until the first read.
+// Close stops the sequence.
+func NewSeqReader(format SeqFormat, texts iter.Seq2[[]byte, error]) io.ReadCloser {
+	return &seqReader{
+		format: format,
+		texts:  texts,
+	}
+}
+
+type seqReader struct {
+	format SeqFormat
+	texts  iter.Seq2[[]byte, error]
+
+	next func() ([]byte, error, bool)
+	stop func()
+	buf  []byte
+	off  int
+	err  error
+}
+
+func (r *seqReader) Read(p []byte) (int, error) {
+	for r.off >= len(r.buf) {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.next == nil {
+			r.next, r.stop = iter.Pull2(r.texts)
+		}
+
+		text, err, ok := r.next()
+		switch {
+		case !ok:
+			r.finish(io.EOF)
+		case err != nil:
+			r.finish(err)
+		default:
+			r.buf = r.buf[:0]
+			if r.format == SeqRecords {
+				r.buf = append(r.buf, recordSeparator)
+			}
+			r.buf = append(r.buf, text...)
+			r.buf = append(r.buf, '\n')
+			r.off = 0
+		}
+	}
+
+	// Every read returns at most one text, so writer may flush texts one by one.
+	n := copy(p, r.buf[r.off:])
+	r.off += n
+	return n, nil
+}
+
+func (r *seqReader) finish(err error) {
+	r.err = err
+	r.buf = r.buf[:0]
+	r.off = 0
+	if r.stop != nil {
+		r.stop()
+	}
+}
+
+// Close stops the sequence.
+func (r *seqReader) Close() error {
+	if r.err == nil {
+		r.finish(ErrSeqClosed)
+	}
+	return nil
+}
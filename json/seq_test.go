@@ -0,0 +1,125 @@
+package json
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeSeq(t *testing.T, input string, format SeqFormat) (texts []string) {
+	t.Helper()
+
+	d := NewSeqDecoder(strings.NewReader(input), format)
+	for {
+		text, err := d.Next()
+		if err == io.EOF {
+			return texts
+		}
+		require.NoError(t, err)
+		texts = append(texts, string(text))
+	}
+}
+
+func TestSeqDecoder(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		format SeqFormat
+		want   []string
+	}{
+		{"Empty", "", SeqLines, nil},
+		{"Lines", "{\"a\":1}\n[1,2]\n\"s\"\n", SeqLines, []string{`{"a":1}`, `[1,2]`, `"s"`}},
+		{"NoTrailingNewline", "1\n2", SeqLines, []string{`1`, `2`}},
+		{"CRLF", "1\r\n\r\n2\r\n", SeqLines, []string{`1`, `2`}},
+		{"Records", "\x1e{\"a\":1}\n\x1e[1,\n2]\n", SeqRecords, []string{`{"a":1}`, "[1,\n2]"}},
+		{"EmptyRecords", "\x1e\n\x1e\x1e1\n", SeqRecords, []string{`1`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, decodeSeq(t, tt.input, tt.format))
+		})
+	}
+}
+
+func TestSeqReader(t *testing.T) {
+	texts := func(values ...string) func(yield func([]byte, error) bool) {
+		return func(yield func([]byte, error) bool) {
+			for _, v := range values {
+				if !yield([]byte(v), nil) {
+					return
+				}
+			}
+		}
+	}
+
+	t.Run("Lines", func(t *testing.T) {
+		a := require.New(t)
+
+		data, err := io.ReadAll(NewSeqReader(SeqLines, texts(`{"a":1}`, `2`)))
+		a.NoError(err)
+		a.Equal("{\"a\":1}\n2\n", string(data))
+	})
+	t.Run("Records", func(t *testing.T) {
+		a := require.New(t)
+
+		data, err := io.ReadAll(NewSeqReader(SeqRecords, texts(`{"a":1}`, `2`)))
+		a.NoError(err)
+		a.Equal("\x1e{\"a\":1}\n\x1e2\n", string(data))
+		a.Equal([]string{`{"a":1}`, `2`}, decodeSeq(t, string(data), SeqRecords))
+	})
+	t.Run("OneTextPerRead", func(t *testing.T) {
+		a := require.New(t)
+
+		r := NewSeqReader(SeqLines, texts(`1`, `22`))
+		buf := make([]byte, 16)
+		n, err := r.Read(buf)
+		a.NoError(err)
+		a.Equal("1\n", string(buf[:n]))
+		n, err = r.Read(buf[:1])
+		a.NoError(err)
+		a.Equal("2", string(buf[:n]))
+		n, err = r.Read(buf)
+		a.NoError(err)
+		a.Equal("2\n", string(buf[:n]))
+		_, err = r.Read(buf)
+		a.ErrorIs(err, io.EOF)
+	})
+	t.Run("Error", func(t *testing.T) {
+		a := require.New(t)
+
+		testErr := errors.New("test")
+		r := NewSeqReader(SeqLines, func(yield func([]byte, error) bool) {
+			if !yield([]byte(`1`), nil) {
+				return
+			}
+			yield(nil, testErr)
+		})
+		data, err := io.ReadAll(r)
+		a.ErrorIs(err, testErr)
+		a.Equal("1\n", string(data))
+	})
+	t.Run("Close", func(t *testing.T) {
+		a := require.New(t)
+
+		stopped := false
+		r := NewSeqReader(SeqLines, func(yield func([]byte, error) bool) {
+			defer func() { stopped = true }()
+			for {
+				if !yield([]byte(`1`), nil) {
+					return
+				}
+			}
+		})
+		buf := make([]byte, 16)
+		_, err := r.Read(buf)
+		a.NoError(err)
+		a.NoError(r.Close())
+		a.True(stopped)
+
+		_, err = r.Read(buf)
+		a.ErrorIs(err, ErrSeqClosed)
+	})
+}
@@ -292,15 +292,22 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 			}
 		default:
 			// Try to infer schema type from properties.
+			//
+			// Keywords of "not" subschema describe excluded values,
+			// so they are not used for inference.
 			switch {
 			case len(schema.Properties) > 0 ||
 				schema.AdditionalProperties != nil ||
+				schema.UnevaluatedProperties != nil ||
 				schema.PatternProperties != nil ||
 				schema.MaxProperties != nil ||
-				schema.MinProperties != nil:
+				schema.MinProperties != nil ||
+				len(schema.DependentRequired) > 0 ||
+				len(schema.DependentSchemas) > 0:
 				schema.Type = "object"
 
 			case schema.Items != nil ||
+				len(schema.PrefixItems) > 0 ||
 				schema.UniqueItems ||
 				schema.MaxItems != nil ||
 				schema.MinItems != nil:
@@ -349,14 +356,18 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 			},
 			"array": {
 				"items":       {},
+				"prefixItems": {},
 				"maxItems":    {},
 				"minItems":    {},
 				"uniqueItems": {},
 			},
 			"string": {
-				"maxLength": {},
-				"minLength": {},
-				"pattern":   {},
+				"maxLength":        {},
+				"minLength":        {},
+				"pattern":          {},
+				"contentEncoding":  {},
+				"contentMediaType": {},
+				"contentSchema":    {},
 			},
 			"integer": {
 				"multipleOf":       {},
@@ -380,9 +391,10 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 			// Generic fields.
 			for _, f := range []string{
 				"type", "enum", "const", "nullable", "format", "default",
-				"oneOf", "anyOf", "allOf", "discriminator",
+				"oneOf", "anyOf", "allOf", "not", "discriminator",
+				"if", "then", "else", "dependentRequired", "dependentSchemas",
 				"description", "example", "examples", "deprecated",
-				"additionalProperties", "xml",
+				"additionalProperties", "unevaluatedProperties", "xml",
 			} {
 				fset[f] = struct{}{}
 			}
@@ -454,6 +466,24 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 		}
 	}
 
+	if not := schema.Not; not != nil {
+		s.Not, err = p.parse(not, ctx)
+		if err != nil {
+			return nil, wrapField("not", err)
+		}
+	}
+
+	if err := p.parseConditionals(schema, s, ctx); err != nil {
+		return nil, err
+	}
+
+	if cs := schema.ContentSchema; cs != nil {
+		s.ContentSchema, err = p.parse(cs, ctx)
+		if err != nil {
+			return nil, wrapField("contentSchema", err)
+		}
+	}
+
 	// Object properties
 	{
 		if err := validateMinMax(
@@ -486,6 +516,19 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 			s.AdditionalProperties = &additional
 		}
 
+		if up := schema.UnevaluatedProperties; up != nil {
+			unevaluated := true
+			if val := up.Bool; val != nil {
+				unevaluated = *val
+			} else {
+				s.UnevaluatedItem, err = p.parse(&up.Schema, ctx)
+				if err != nil {
+					return nil, wrapField("unevaluatedProperties", err)
+				}
+			}
+			s.UnevaluatedProperties = &unevaluated
+		}
+
 		if pp := schema.PatternProperties; len(pp) > 0 {
 			ppLoc := schema.Common.Field("patternProperties")
 
@@ -544,8 +587,33 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 			return nil, err
 		}
 
+		if prefix := schema.PrefixItems; len(prefix) > 0 {
+			if items := schema.Items; items != nil && items.Bool == nil && items.Item == nil {
+				err := errors.New("prefixItems and array of items cannot be used together")
+				return nil, wrapField("prefixItems", err)
+			}
+			s.Items, err = p.parseMany(prefix, s.Locator.Field("prefixItems"), ctx)
+			if err != nil {
+				return nil, wrapField("prefixItems", err)
+			}
+			s.PrefixItems = true
+			if schema.Items == nil {
+				// Absent items allows any additional items.
+				s.Item = &Schema{}
+			}
+		}
+
 		if items := schema.Items; items != nil {
-			if item := items.Item; item != nil {
+			if b := items.Bool; b != nil {
+				switch {
+				case !*b && len(schema.PrefixItems) == 0:
+					err := errors.New("false items schema is supported only with prefixItems")
+					return nil, wrapField("items", err)
+				case *b && len(schema.PrefixItems) > 0:
+					// Any additional items are allowed.
+					s.Item = &Schema{}
+				}
+			} else if item := items.Item; item != nil {
 				s.Item, err = p.parse(items.Item, ctx)
 				if err != nil {
 					return nil, wrapField("items", err)
@@ -597,6 +665,63 @@ func (p *Parser) parseSchema(schema *RawSchema, ctx *jsonpointer.ResolveCtx, hoo
 	return s, nil
 }
 
+func (p *Parser) parseConditionals(schema *RawSchema, s *Schema, ctx *jsonpointer.ResolveCtx) (err error) {
+	file := p.file(ctx)
+	wrapField := func(field string, err error) error {
+		return p.wrapField(field, file, schema.Common.Locator, errors.Wrap(err, field))
+	}
+
+	for _, c := range []struct {
+		field string
+		raw   *RawSchema
+		to    **Schema
+	}{
+		{"if", schema.If, &s.If},
+		{"then", schema.Then, &s.Then},
+		{"else", schema.Else, &s.Else},
+	} {
+		if c.raw == nil {
+			continue
+		}
+		*c.to, err = p.parse(c.raw, ctx)
+		if err != nil {
+			return wrapField(c.field, err)
+		}
+	}
+
+	if dr := schema.DependentRequired; len(dr) > 0 {
+		drLoc := schema.Common.Field("dependentRequired")
+
+		s.DependentRequired = make(map[string][]string, len(dr))
+		for prop, required := range dr {
+			seen := make(map[string]struct{}, len(required))
+			for _, name := range required {
+				if _, ok := seen[name]; ok {
+					err := errors.Errorf("dependentRequired: property %q: duplicate property %q", prop, name)
+					return p.wrapField(prop, file, drLoc, err)
+				}
+				seen[name] = struct{}{}
+			}
+			s.DependentRequired[prop] = slices.Clone(required)
+		}
+	}
+
+	if ds := schema.DependentSchemas; len(ds) > 0 {
+		dsLoc := schema.Common.Field("dependentSchemas")
+
+		s.DependentSchemas = make(map[string]*Schema, len(ds))
+		for prop, raw := range ds {
+			dep, err := p.parse(raw, ctx)
+			if err != nil {
+				err := errors.Wrapf(err, "dependentSchemas: property %q", prop)
+				return p.wrapField(prop, file, dsLoc, err)
+			}
+			s.DependentSchemas[prop] = dep
+		}
+	}
+	return nil
+}
+
 func (p *Parser) parseMany(schemas []*RawSchema, loc location.Locator, ctx *jsonpointer.ResolveCtx) ([]*Schema, error) {
 	result := make([]*Schema, 0, len(schemas))
 	for i, schema := range schemas {
@@ -38,6 +38,11 @@ func parseEnumValues(s *Schema, rawValues []json.RawMessage) ([]any, error) {
 	return values, nil
 }
 
+// ParseJSONValue parses JSON value the same way as default, const and enum values.
+func ParseJSONValue(v json.RawMessage) (any, error) {
+	return parseJSONValue(nil, v)
+}
+
 func parseJSONValue(root *Schema, v json.RawMessage) (any, error) {
 	var parse func(s *Schema, d *jx.Decoder) (any, error)
 	parse = func(s *Schema, d *jx.Decoder) (any, error) {
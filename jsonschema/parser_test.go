@@ -625,6 +625,16 @@ func TestSchemaInferTypes(t *testing.T) {
 				DefaultSet: true,
 			},
 		},
+		{
+			name: "not",
+			raw:  &RawSchema{Not: &RawSchema{Pattern: "^a"}},
+			expect: &Schema{
+				Not: &Schema{
+					Type:    String,
+					Pattern: "^a",
+				},
+			},
+		},
 	}
 
 	parser := NewParser(Settings{InferTypes: true})
@@ -636,3 +646,167 @@ func TestSchemaInferTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaConditionals(t *testing.T) {
+	a := require.New(t)
+	parser := NewParser(Settings{})
+
+	var raw RawSchema
+	a.NoError(yaml.Unmarshal([]byte(`
+type: object
+properties:
+  country:
+    type: string
+  state:
+    type: string
+if:
+  properties:
+    country:
+      const: US
+then:
+  required: [state]
+else:
+  properties:
+    state:
+      maxLength: 0
+dependentRequired:
+  state: [country]
+dependentSchemas:
+  country:
+    required: [state]
+`), &raw))
+
+	out, err := parser.Parse(&raw, testCtx())
+	a.NoError(err)
+
+	a.NotNil(out.If)
+	a.Len(out.If.Properties, 1)
+	a.Equal("US", out.If.Properties[0].Schema.Const)
+	a.Equal([]string{"state"}, out.Then.Required)
+	a.Equal(uint64(0), *out.Else.Properties[0].Schema.MaxLength)
+	a.Equal(map[string][]string{"state": {"country"}}, out.DependentRequired)
+	a.Equal([]string{"state"}, out.DependentSchemas["country"].Required)
+
+	t.Run("InferObject", func(t *testing.T) {
+		parser := NewParser(Settings{InferTypes: true})
+		out, err := parser.Parse(&RawSchema{
+			DependentRequired: map[string][]string{"a": {"b"}},
+		}, testCtx())
+		require.NoError(t, err)
+		require.Equal(t, Object, out.Type)
+	})
+	t.Run("DuplicateDependentRequired", func(t *testing.T) {
+		_, err := parser.Parse(&RawSchema{
+			Type:              "object",
+			DependentRequired: map[string][]string{"a": {"b", "b"}},
+		}, testCtx())
+		require.Error(t, err)
+	})
+}
+
+func TestSchemaPrefixItems(t *testing.T) {
+	a := require.New(t)
+	parser := NewParser(Settings{})
+
+	parse := func(input string) (*Schema, error) {
+		var raw RawSchema
+		a.NoError(yaml.Unmarshal([]byte(input), &raw))
+		return parser.Parse(&raw, testCtx())
+	}
+
+	out, err := parse(`
+type: array
+prefixItems:
+  - type: string
+  - type: integer
+items:
+  type: boolean
+`)
+	a.NoError(err)
+	a.Len(out.Items, 2)
+	a.True(out.PrefixItems)
+	a.Equal(String, out.Items[0].Type)
+	a.Equal(Integer, out.Items[1].Type)
+	a.Equal(Boolean, out.Item.Type)
+
+	out, err = parse(`
+type: array
+prefixItems:
+  - type: string
+`)
+	a.NoError(err)
+	a.Len(out.Items, 1)
+	a.NotNil(out.Item)
+	a.Equal(Empty, out.Item.Type)
+
+	out, err = parse(`
+type: array
+prefixItems:
+  - type: string
+items: false
+`)
+	a.NoError(err)
+	a.Len(out.Items, 1)
+	a.Nil(out.Item)
+
+	out, err = parse(`
+type: array
+prefixItems:
+  - type: string
+items: true
+`)
+	a.NoError(err)
+	a.Len(out.Items, 1)
+	a.NotNil(out.Item)
+	a.Equal(Empty, out.Item.Type)
+
+	_, err = parse(`
+type: array
+prefixItems:
+  - type: string
+items:
+  - type: string
+`)
+	a.Error(err)
+
+	_, err = parse(`
+type: array
+items: false
+`)
+	a.Error(err)
+
+	t.Run("InferArray", func(t *testing.T) {
+		parser := NewParser(Settings{InferTypes: true})
+		out, err := parser.Parse(&RawSchema{
+			PrefixItems: []*RawSchema{{Type: "string"}},
+		}, testCtx())
+		require.NoError(t, err)
+		require.Equal(t, Array, out.Type)
+	})
+}
+
+func TestSchemaContentSchema(t *testing.T) {
+	a := require.New(t)
+	parser := NewParser(Settings{})
+
+	var raw RawSchema
+	a.NoError(yaml.Unmarshal([]byte(`
+type: string
+contentMediaType: application/json
+contentEncoding: base64
+contentSchema:
+  type: object
+  required: [name]
+  properties:
+    name:
+      type: string
+`), &raw))
+
+	out, err := parser.Parse(&raw, testCtx())
+	a.NoError(err)
+	a.Equal("base64", out.ContentEncoding)
+	a.Equal("application/json", out.ContentMediaType)
+	a.NotNil(out.ContentSchema)
+	a.Equal(Object, out.ContentSchema.Type)
+	a.Equal([]string{"name"}, out.ContentSchema.Required)
+}
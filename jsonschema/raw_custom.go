@@ -261,12 +261,17 @@ func (p *RawPatternProperties) UnmarshalJSON(data []byte) error {
 
 // RawItems is unparsed JSON Schema items validator description.
 type RawItems struct {
+	// Bool is set, if items is a boolean schema.
+	Bool  *bool
 	Item  *RawSchema
 	Items []*RawSchema
 }
 
 // MarshalYAML implements yaml.Marshaler.
 func (p RawItems) MarshalYAML() (any, error) {
+	if p.Bool != nil {
+		return *p.Bool, nil
+	}
 	if p.Item != nil {
 		return p.Item, nil
 	}
@@ -276,6 +281,8 @@ func (p RawItems) MarshalYAML() (any, error) {
 // UnmarshalYAML implements yaml.Unmarshaler.
 func (p *RawItems) UnmarshalYAML(node *yaml.Node) error {
 	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Decode(&p.Bool)
 	case yaml.MappingNode:
 		return node.Decode(&p.Item)
 	case yaml.SequenceNode:
@@ -287,6 +294,9 @@ func (p *RawItems) UnmarshalYAML(node *yaml.Node) error {
 
 // MarshalJSON implements json.Marshaler.
 func (p RawItems) MarshalJSON() ([]byte, error) {
+	if p.Bool != nil {
+		return json.Marshal(p.Bool)
+	}
 	if p.Item != nil {
 		return json.Marshal(p.Item)
 	}
@@ -295,7 +305,15 @@ func (p RawItems) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *RawItems) UnmarshalJSON(data []byte) error {
-	switch tt := jx.DecodeBytes(data).Next(); tt {
+	d := jx.DecodeBytes(data)
+	switch tt := d.Next(); tt {
+	case jx.Bool:
+		val, err := d.Bool()
+		if err != nil {
+			return err
+		}
+		p.Bool = &val
+		return nil
 	case jx.Object:
 		s := RawSchema{}
 		if err := json.Unmarshal(data, &s); err != nil {
@@ -161,6 +161,8 @@ func TestItems(t *testing.T) {
 	}{
 		{`{"type":"string"}`, RawItems{Item: &RawSchema{Type: "string"}}, false},
 		{`[]`, RawItems{}, false},
+		{`true`, RawItems{Bool: func() *bool { v := true; return &v }()}, false},
+		{`false`, RawItems{Bool: new(bool)}, false},
 		{`[{"type":"string"}, {"type":"integer"}]`, RawItems{
 			Items: []*RawSchema{
 				{Type: "string"},
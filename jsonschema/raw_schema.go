@@ -21,10 +21,17 @@ type RawSchema struct {
 	PatternProperties    RawPatternProperties  `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
 	Required             []string              `json:"required,omitempty" yaml:"required,omitempty"`
 	Items                *RawItems             `json:"items,omitempty" yaml:"items,omitempty"`
+	PrefixItems          []*RawSchema          `json:"prefixItems,omitempty" yaml:"prefixItems,omitempty"`
 	Nullable             bool                  `json:"nullable,omitempty" yaml:"nullable,omitempty"`
 	AllOf                []*RawSchema          `json:"allOf,omitempty" yaml:"allOf,omitempty"`
 	OneOf                []*RawSchema          `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
 	AnyOf                []*RawSchema          `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
+	Not                  *RawSchema            `json:"not,omitempty" yaml:"not,omitempty"`
+	If                   *RawSchema            `json:"if,omitempty" yaml:"if,omitempty"`
+	Then                 *RawSchema            `json:"then,omitempty" yaml:"then,omitempty"`
+	Else                 *RawSchema            `json:"else,omitempty" yaml:"else,omitempty"`
+	DependentRequired    map[string][]string   `json:"dependentRequired,omitempty" yaml:"dependentRequired,omitempty"`
+	DependentSchemas     map[string]*RawSchema `json:"dependentSchemas,omitempty" yaml:"dependentSchemas,omitempty"`
 	Enum                 Enum                  `json:"enum,omitempty" yaml:"enum,omitempty"`
 	MultipleOf           Num                   `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
 	Maximum              Num                   `json:"maximum,omitempty" yaml:"maximum,omitempty"`
@@ -44,6 +51,11 @@ type RawSchema struct {
 	Deprecated           bool                  `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 	ContentEncoding      string                `json:"contentEncoding,omitempty" yaml:"contentEncoding,omitempty"`
 	ContentMediaType     string                `json:"contentMediaType,omitempty" yaml:"contentMediaType,omitempty"`
+	ContentSchema        *RawSchema            `json:"contentSchema,omitempty" yaml:"contentSchema,omitempty"`
+
+	// UnevaluatedProperties applies to properties not evaluated by the schema and its
+	// allOf, oneOf, anyOf and conditional subschemas.
+	UnevaluatedProperties *AdditionalProperties `json:"unevaluatedProperties,omitempty" yaml:"unevaluatedProperties,omitempty"`
 
 	Discriminator *RawDiscriminator `json:"discriminator,omitempty" yaml:"discriminator,omitempty"`
 	XML           *XML              `json:"xml,omitempty" yaml:"xml,omitempty"`
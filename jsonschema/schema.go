@@ -53,6 +53,7 @@ type Schema struct {
 	Format           string // Schema format, optional.
 	ContentEncoding  string
 	ContentMediaType string
+	ContentSchema    *Schema // Schema of the decoded string content, optional.
 
 	Summary     string // Schema summary from Reference Object, optional.
 	Description string // Schema description, optional.
@@ -60,6 +61,7 @@ type Schema struct {
 
 	Item                 *Schema           // Only for Array and Object with additional properties.
 	Items                []*Schema         // Only for Array
+	PrefixItems          bool              // Whether Items are defined by prefixItems.
 	AdditionalProperties *bool             // Whether Object has additional properties.
 	PatternProperties    []PatternProperty // Only for Object.
 	Enum                 []any             // Only for Enum.
@@ -68,11 +70,30 @@ type Schema struct {
 	Properties           []Property        // Only for Object.
 	Required             []string
 
+	// UnevaluatedProperties is whether Object has properties not evaluated
+	// by the schema and its subschemas.
+	UnevaluatedProperties *bool
+	// UnevaluatedItem is the schema of unevaluated properties, optional.
+	UnevaluatedItem *Schema
+
 	Nullable bool // Whether schema is nullable or not. Any types.
 
 	OneOf []*Schema
 	AnyOf []*Schema
 	AllOf []*Schema
+	Not   *Schema // Value MUST NOT be valid against Not.
+
+	// Conditional subschemas.
+	If   *Schema
+	Then *Schema
+	Else *Schema
+
+	// DependentRequired maps property name to properties required
+	// when the property is present.
+	DependentRequired map[string][]string
+	// DependentSchemas maps property name to schema applied
+	// when the property is present.
+	DependentSchemas map[string]*Schema
 
 	Discriminator *Discriminator
 	XML           *XML
@@ -21,8 +21,9 @@ func ErrorCode(err error) (code int) {
 	code = http.StatusInternalServerError
 
 	var (
-		ctError *validate.InvalidContentTypeError
-		ogenErr Error
+		ctError  *validate.InvalidContentTypeError
+		limitErr *LimitError
+		ogenErr  Error
 	)
 	switch {
 	case errors.Is(err, ht.ErrNotImplemented):
@@ -30,6 +31,9 @@ func ErrorCode(err error) (code int) {
 	case errors.As(err, &ctError):
 		// Takes precedence over Error.
 		code = http.StatusUnsupportedMediaType
+	case errors.As(err, &limitErr):
+		// Takes precedence over Error.
+		code = limitErr.Code()
 	case errors.As(err, &ogenErr):
 		code = ogenErr.Code()
 	}
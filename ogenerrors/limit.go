@@ -0,0 +1,253 @@
+package ogenerrors
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+)
+
+// LimitKind defines the kind of request limit.
+type LimitKind string
+
+const (
+	// LimitBodySize limits the size of the request body in bytes.
+	LimitBodySize LimitKind = "body size"
+	// LimitJSONDepth limits the nesting depth of JSON values.
+	LimitJSONDepth LimitKind = "JSON depth"
+	// LimitJSONArrayLength limits the number of JSON array elements.
+	LimitJSONArrayLength LimitKind = "JSON array length"
+)
+
+// LimitError reports that request exceeds configured limit.
+type LimitError struct {
+	Kind  LimitKind
+	Limit int64
+}
+
+// Code returns http code to respond.
+func (e *LimitError) Code() int {
+	return http.StatusRequestEntityTooLarge
+}
+
+// Error implements error.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("request %s exceeds limit of %d", e.Kind, e.Limit)
+}
+
+// LimitBody limits the size of the request body.
+//
+// If request declares bigger Content-Length, LimitBody returns *LimitError.
+// Otherwise, request body is replaced with reader that returns *LimitError
+// after limit bytes are read.
+//
+// Zero or negative limit means no limit.
+func LimitBody(r *http.Request, limit int64) error {
+	if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+	if r.ContentLength > limit {
+		return &LimitError{Kind: LimitBodySize, Limit: limit}
+	}
+	r.Body = &limitedBody{
+		ReadCloser: r.Body,
+		limit:      limit,
+		left:       limit,
+	}
+	return nil
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	limit int64
+	left  int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if b.left <= 0 {
+		// Limit is reached, check whether body has more data.
+		var buf [1]byte
+		n, err := b.ReadCloser.Read(buf[:])
+		if n > 0 {
+			return 0, &LimitError{Kind: LimitBodySize, Limit: b.limit}
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > b.left {
+		p = p[:b.left]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.left -= int64(n)
+	return n, err
+}
+
+// JSONLimits defines limits of decoded JSON values.
+//
+// Zero value means no limit.
+type JSONLimits struct {
+	// MaxDepth is the maximum nesting depth of objects and arrays.
+	MaxDepth int
+	// MaxArrayLength is the maximum number of elements of any array.
+	MaxArrayLength int
+}
+
+// IsZero whether no limits are set.
+func (l JSONLimits) IsZero() bool {
+	return l.MaxDepth <= 0 && l.MaxArrayLength <= 0
+}
+
+// CheckJSONLimits checks that given JSON value does not exceed limits.
+//
+// Returns *LimitError if any limit is exceeded. Syntax errors are ignored,
+// they are reported by the decoder.
+//
+// Data is parsed separately from decoding, so callers pay for an extra
+// parse only if any limit is set.
+func CheckJSONLimits(data []byte, l JSONLimits) error {
+	if l.IsZero() {
+		return nil
+	}
+
+	var limitErr *LimitError
+	if err := checkJSONLimits(jx.DecodeBytes(data), l, 0); errors.As(err, &limitErr) {
+		return limitErr
+	}
+	return nil
+}
+
+func checkJSONLimits(d *jx.Decoder, l JSONLimits, depth int) error {
+	switch tt := d.Next(); tt {
+	case jx.Object, jx.Array:
+		depth++
+		if l.MaxDepth > 0 && depth > l.MaxDepth {
+			return &LimitError{Kind: LimitJSONDepth, Limit: int64(l.MaxDepth)}
+		}
+
+		if tt == jx.Object {
+			return d.ObjBytes(func(d *jx.Decoder, _ []byte) error {
+				return checkJSONLimits(d, l, depth)
+			})
+		}
+		var n int
+		return d.Arr(func(d *jx.Decoder) error {
+			n++
+			if l.MaxArrayLength > 0 && n > l.MaxArrayLength {
+				return &LimitError{Kind: LimitJSONArrayLength, Limit: int64(l.MaxArrayLength)}
+			}
+			return checkJSONLimits(d, l, depth)
+		})
+	default:
+		return d.Skip()
+	}
+}
+
+// LimitJSON returns reader that checks JSON read from r against limits.
+//
+// It is used for JSON values decoded from stream, where CheckJSONLimits
+// cannot be applied. Reader returns *LimitError once any limit is exceeded,
+// syntax errors are left to the decoder.
+func LimitJSON(r io.Reader, l JSONLimits) io.Reader {
+	if l.IsZero() {
+		return r
+	}
+	return &jsonLimitReader{r: r, limits: l}
+}
+
+type jsonScope struct {
+	array bool
+	// pending is set when next array element is expected.
+	pending bool
+	n       int
+}
+
+type jsonLimitReader struct {
+	r      io.Reader
+	limits JSONLimits
+	err    error
+
+	stack    []jsonScope
+	inString bool
+	escape   bool
+}
+
+func (j *jsonLimitReader) Read(p []byte) (int, error) {
+	if j.err != nil {
+		return 0, j.err
+	}
+
+	n, err := j.r.Read(p)
+	for i, c := range p[:n] {
+		if limitErr := j.scan(c); limitErr != nil {
+			j.err = limitErr
+			if i == 0 {
+				return 0, limitErr
+			}
+			// Return bytes before the offending one, error is reported
+			// on the next call.
+			return i, nil
+		}
+	}
+	return n, err
+}
+
+func (j *jsonLimitReader) scan(c byte) error {
+	if j.inString {
+		switch {
+		case j.escape:
+			j.escape = false
+		case c == '\\':
+			j.escape = true
+		case c == '"':
+			j.inString = false
+		}
+		return nil
+	}
+
+	switch c {
+	case ' ', '\t', '\r', '\n':
+		return nil
+	case ']', '}':
+		if len(j.stack) > 0 {
+			j.stack = j.stack[:len(j.stack)-1]
+		}
+		return nil
+	case ',':
+		if len(j.stack) > 0 {
+			if top := &j.stack[len(j.stack)-1]; top.array {
+				top.pending = true
+			}
+		}
+		return nil
+	}
+
+	// Any other byte starts or continues a value.
+	if len(j.stack) > 0 {
+		if top := &j.stack[len(j.stack)-1]; top.array && top.pending {
+			top.pending = false
+			top.n++
+			if l := j.limits.MaxArrayLength; l > 0 && top.n > l {
+				return &LimitError{Kind: LimitJSONArrayLength, Limit: int64(l)}
+			}
+		}
+	}
+
+	switch c {
+	case '"':
+		j.inString = true
+	case '{', '[':
+		if l := j.limits.MaxDepth; l > 0 && len(j.stack) >= l {
+			return &LimitError{Kind: LimitJSONDepth, Limit: int64(l)}
+		}
+		j.stack = append(j.stack, jsonScope{
+			array:   c == '[',
+			pending: c == '[',
+		})
+	}
+	return nil
+}
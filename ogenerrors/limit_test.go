@@ -0,0 +1,132 @@
+package ogenerrors
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitBody(t *testing.T) {
+	newRequest := func(body string, contentLength int64) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.ContentLength = contentLength
+		return r
+	}
+
+	for i, tt := range []struct {
+		Body          string
+		ContentLength int64
+		Limit         int64
+		Err           bool
+	}{
+		{`abc`, 3, 0, false},
+		{`abc`, 3, 3, false},
+		{`abc`, -1, 3, false},
+		{`abcd`, 4, 3, true},
+		// Content-Length is unknown.
+		{`abcd`, -1, 3, true},
+		// Content-Length is wrong.
+		{`abcd`, 2, 3, true},
+	} {
+		a := require.New(t)
+		r := newRequest(tt.Body, tt.ContentLength)
+
+		err := LimitBody(r, tt.Limit)
+		if err == nil {
+			var data []byte
+			data, err = io.ReadAll(r.Body)
+			if err == nil {
+				a.Equal(tt.Body, string(data), "test %d", i+1)
+			}
+		}
+		if !tt.Err {
+			a.NoError(err, "test %d", i+1)
+			continue
+		}
+
+		var limitErr *LimitError
+		a.ErrorAs(err, &limitErr, "test %d", i+1)
+		a.Equal(&LimitError{Kind: LimitBodySize, Limit: tt.Limit}, limitErr)
+		a.Equal(http.StatusRequestEntityTooLarge, ErrorCode(&DecodeRequestError{Err: err}))
+	}
+}
+
+func TestCheckJSONLimits(t *testing.T) {
+	for i, tt := range []struct {
+		Input  string
+		Limits JSONLimits
+		Err    *LimitError
+	}{
+		{`[[[1]]]`, JSONLimits{}, nil},
+		{`[[[1]]]`, JSONLimits{MaxDepth: 3}, nil},
+		{`[[[1]]]`, JSONLimits{MaxDepth: 2}, &LimitError{Kind: LimitJSONDepth, Limit: 2}},
+		{`{"a":{"b":{}}}`, JSONLimits{MaxDepth: 2}, &LimitError{Kind: LimitJSONDepth, Limit: 2}},
+		{`{"a":[1,2,3]}`, JSONLimits{MaxArrayLength: 3}, nil},
+		{`{"a":[1,2,3]}`, JSONLimits{MaxArrayLength: 2}, &LimitError{Kind: LimitJSONArrayLength, Limit: 2}},
+		{`[[1],[1,2,3]]`, JSONLimits{MaxArrayLength: 2}, &LimitError{Kind: LimitJSONArrayLength, Limit: 2}},
+		// Syntax errors are reported by the decoder.
+		{`[1,2`, JSONLimits{MaxArrayLength: 2}, nil},
+		{`{"a":`, JSONLimits{MaxDepth: 1}, nil},
+	} {
+		err := CheckJSONLimits([]byte(tt.Input), tt.Limits)
+		if tt.Err == nil {
+			require.NoError(t, err, "test %d", i+1)
+			continue
+		}
+		require.Equal(t, tt.Err, err, "test %d", i+1)
+	}
+}
+
+func TestLimitJSON(t *testing.T) {
+	for i, tt := range []struct {
+		Input  string
+		Limits JSONLimits
+		Err    *LimitError
+	}{
+		{`[[[1]]]`, JSONLimits{}, nil},
+		{`[[[1]]]`, JSONLimits{MaxDepth: 3}, nil},
+		{`[[[1]]]`, JSONLimits{MaxDepth: 2}, &LimitError{Kind: LimitJSONDepth, Limit: 2}},
+		{`{"a":{"b":{}}}`, JSONLimits{MaxDepth: 2}, &LimitError{Kind: LimitJSONDepth, Limit: 2}},
+		{`{"a":[1,2,3]}`, JSONLimits{MaxArrayLength: 3}, nil},
+		{`{"a":[1,2,3]}`, JSONLimits{MaxArrayLength: 2}, &LimitError{Kind: LimitJSONArrayLength, Limit: 2}},
+		{`[[1],[1,2,3]]`, JSONLimits{MaxArrayLength: 2}, &LimitError{Kind: LimitJSONArrayLength, Limit: 2}},
+		{`[ [] , {} ]`, JSONLimits{MaxDepth: 2, MaxArrayLength: 2}, nil},
+		// Strings are not scanned.
+		{`["[[[,,,", "\\"]`, JSONLimits{MaxDepth: 1, MaxArrayLength: 2}, nil},
+		{`["\"[[["]`, JSONLimits{MaxDepth: 1, MaxArrayLength: 1}, nil},
+		// Syntax errors are reported by the decoder.
+		{`[1,2`, JSONLimits{MaxArrayLength: 2}, nil},
+		{`{"a":`, JSONLimits{MaxDepth: 1}, nil},
+	} {
+		// Read byte by byte to check state between reads.
+		data, err := io.ReadAll(LimitJSON(iotest.OneByteReader(strings.NewReader(tt.Input)), tt.Limits))
+		if tt.Err == nil {
+			require.NoError(t, err, "test %d", i+1)
+			require.Equal(t, tt.Input, string(data), "test %d", i+1)
+			continue
+		}
+		require.Equal(t, tt.Err, err, "test %d", i+1)
+
+		// Error is returned after data preceding the offending byte.
+		data, err = io.ReadAll(LimitJSON(strings.NewReader(tt.Input), tt.Limits))
+		require.Equal(t, tt.Err, err, "test %d", i+1)
+		require.True(t, strings.HasPrefix(tt.Input, string(data)), "test %d", i+1)
+	}
+}
+
+func TestDefaultErrorHandlerLimit(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+	DefaultErrorHandler(context.Background(), w, r, &DecodeRequestError{
+		OperationContext: OperationContext{Name: "Op", ID: "op"},
+		Err:              &LimitError{Kind: LimitBodySize, Limit: 10},
+	})
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	require.JSONEq(t, `{"error_message":"operation Op: decode request: request body size exceeds limit of 10"}`, w.Body.String())
+}
@@ -0,0 +1,219 @@
+package ogenerrors
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+
+	"github.com/ogen-go/ogen/validate"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Problem is RFC 9457 problem details object.
+//
+// See https://www.rfc-editor.org/rfc/rfc9457.
+type Problem struct {
+	// Type is a URI reference that identifies the problem type.
+	//
+	// If empty, "about:blank" is assumed.
+	Type string
+	// Title is a short, human-readable summary of the problem type.
+	Title string
+	// Status is the HTTP status code.
+	Status int
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string
+	// Instance is a URI reference that identifies the specific occurrence of the problem.
+	Instance string
+	// InvalidParams lists invalid parameters and fields of the request.
+	InvalidParams []InvalidParam
+	// Extensions are additional members of the problem details object.
+	//
+	// Values are encoded using encoding/json.
+	Extensions map[string]any
+}
+
+// InvalidParam describes invalid parameter or body field.
+type InvalidParam struct {
+	// Name is the name of parameter or field.
+	Name string
+	// In is the location of the parameter: "path", "query", "header", "cookie" or "body".
+	In string
+	// Pointer is the JSON pointer to the invalid body field, if any.
+	Pointer string
+	// Reason is the description of the failure.
+	Reason string
+}
+
+// NewProblem creates problem details from given error.
+//
+// Status is selected using ErrorCode. Invalid parameters are collected
+// from DecodeParamError and validate.Error.
+func NewProblem(err error) *Problem {
+	code := ErrorCode(err)
+	p := &Problem{
+		Title:  http.StatusText(code),
+		Status: code,
+		Detail: err.Error(),
+	}
+
+	var (
+		paramErr    *DecodeParamError
+		requestErr  *DecodeRequestError
+		securityErr *SecurityError
+		ogenErr     Error
+	)
+	switch {
+	case errors.As(err, &paramErr):
+		p.InvalidParams = append(p.InvalidParams, InvalidParam{
+			Name:   paramErr.Name,
+			In:     string(paramErr.In),
+			Reason: paramErr.Err.Error(),
+		})
+	case errors.As(err, &requestErr):
+		if validateErr := new(validate.Error); errors.As(requestErr.Err, &validateErr) {
+			p.InvalidParams = appendInvalidFields(p.InvalidParams, validateErr)
+		}
+	case errors.As(err, &securityErr):
+		p.setExtension("security", securityErr.Security)
+	}
+	if errors.As(err, &ogenErr) {
+		p.setExtension("operation", ogenErr.OperationName())
+	}
+	return p
+}
+
+func (p *Problem) setExtension(key string, value any) {
+	if p.Extensions == nil {
+		p.Extensions = map[string]any{}
+	}
+	p.Extensions[key] = value
+}
+
+// appendInvalidFields appends failures of validation error.
+func appendInvalidFields(to []InvalidParam, err *validate.Error) []InvalidParam {
+	err.Walk(func(f validate.Failure) bool {
+		to = append(to, InvalidParam{
+			Name:    f.Name,
+			In:      "body",
+			Pointer: f.Pointer,
+			Reason:  f.Err.Error(),
+		})
+		return true
+	})
+	return to
+}
+
+// Encode encodes problem details as JSON.
+func (p *Problem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	if p.Type != "" {
+		e.FieldStart("type")
+		e.StrEscape(p.Type)
+	}
+	if p.Title != "" {
+		e.FieldStart("title")
+		e.StrEscape(p.Title)
+	}
+	if p.Status != 0 {
+		e.FieldStart("status")
+		e.Int(p.Status)
+	}
+	if p.Detail != "" {
+		e.FieldStart("detail")
+		e.StrEscape(p.Detail)
+	}
+	if p.Instance != "" {
+		e.FieldStart("instance")
+		e.StrEscape(p.Instance)
+	}
+	if len(p.InvalidParams) > 0 {
+		e.FieldStart("invalid_params")
+		e.ArrStart()
+		for _, param := range p.InvalidParams {
+			e.ObjStart()
+			e.FieldStart("name")
+			e.StrEscape(param.Name)
+			if param.In != "" {
+				e.FieldStart("in")
+				e.StrEscape(param.In)
+			}
+			if param.Pointer != "" {
+				e.FieldStart("pointer")
+				e.StrEscape(param.Pointer)
+			}
+			e.FieldStart("reason")
+			e.StrEscape(param.Reason)
+			e.ObjEnd()
+		}
+		e.ArrEnd()
+	}
+	// Sort keys to get stable output.
+	for _, key := range slices.Sorted(maps.Keys(p.Extensions)) {
+		switch key {
+		case "type", "title", "status", "detail", "instance", "invalid_params":
+			// Do not override standard members.
+			continue
+		}
+		data, err := json.Marshal(p.Extensions[key])
+		if err != nil {
+			continue
+		}
+		e.FieldStart(key)
+		e.Raw(data)
+	}
+	e.ObjEnd()
+}
+
+// ProblemMapper maps error to the problem details.
+//
+// Problem is pre-filled by NewProblem. Mapper returns false, if error is not handled.
+type ProblemMapper func(ctx context.Context, err error, p *Problem) bool
+
+// MapProblem creates ProblemMapper for errors of type E.
+func MapProblem[E error](fn func(ctx context.Context, err E, p *Problem)) ProblemMapper {
+	return func(ctx context.Context, err error, p *Problem) bool {
+		var target E
+		if !errors.As(err, &target) {
+			return false
+		}
+		fn(ctx, target, p)
+		return true
+	}
+}
+
+// ProblemErrorHandler returns error handler that writes RFC 9457 problem details
+// as application/problem+json.
+//
+// Mappers are called in order, until one of them handles the error.
+func ProblemErrorHandler(mappers ...ProblemMapper) ErrorHandler {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+		p := NewProblem(err)
+		if r != nil && r.URL != nil {
+			p.Instance = r.URL.Path
+		}
+		for _, m := range mappers {
+			if m(ctx, err, p) {
+				break
+			}
+		}
+		if p.Status == 0 {
+			p.Status = http.StatusInternalServerError
+		}
+
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(p.Status)
+
+		e := jx.GetEncoder()
+		p.Encode(e)
+		_, _ = w.Write(e.Bytes())
+		jx.PutEncoder(e)
+	}
+}
@@ -0,0 +1,138 @@
+package ogenerrors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen/openapi"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func TestProblemErrorHandler(t *testing.T) {
+	op := OperationContext{Name: "CreatePet", ID: "createPet"}
+	for i, tt := range []struct {
+		Err     error
+		Mappers []ProblemMapper
+		Code    int
+		Body    string
+	}{
+		{
+			errors.New("internal"),
+			nil,
+			http.StatusInternalServerError,
+			`{
+				"title": "Internal Server Error",
+				"status": 500,
+				"detail": "internal",
+				"instance": "/pets"
+			}`,
+		},
+		{
+			&DecodeParamsError{
+				OperationContext: op,
+				Err: &DecodeParamError{
+					Name: "limit",
+					In:   openapi.LocationQuery,
+					Err:  errors.New("invalid"),
+				},
+			},
+			nil,
+			http.StatusBadRequest,
+			`{
+				"title": "Bad Request",
+				"status": 400,
+				"detail": "operation CreatePet: decode params: query: \"limit\": invalid",
+				"instance": "/pets",
+				"invalid_params": [
+					{"name": "limit", "in": "query", "reason": "invalid"}
+				],
+				"operation": "CreatePet"
+			}`,
+		},
+		{
+			&DecodeRequestError{
+				OperationContext: op,
+				Err: errors.Wrap(&validate.Error{Fields: []validate.FieldError{
+					{Name: "name", Error: validate.ErrFieldRequired},
+					{Name: "tags", Error: &validate.Error{Fields: []validate.FieldError{
+						{Name: "[1]", Error: &validate.Error{Fields: []validate.FieldError{
+							{Name: "a/b", Error: errors.New("too long")},
+						}}},
+					}}},
+				}}, "validate"),
+			},
+			nil,
+			http.StatusBadRequest,
+			`{
+				"title": "Bad Request",
+				"status": 400,
+				"detail": "operation CreatePet: decode request: validate: invalid: name (field required), tags (invalid: [1] (invalid: a/b (too long)))",
+				"instance": "/pets",
+				"invalid_params": [
+					{"name": "name", "in": "body", "pointer": "/name", "reason": "field required"},
+					{"name": "a/b", "in": "body", "pointer": "/tags/1/a~1b", "reason": "too long"}
+				],
+				"operation": "CreatePet"
+			}`,
+		},
+		{
+			&SecurityError{
+				OperationContext: op,
+				Security:         "ApiKey",
+				Err:              errors.New("bad key"),
+			},
+			nil,
+			http.StatusUnauthorized,
+			`{
+				"title": "Unauthorized",
+				"status": 401,
+				"detail": "operation CreatePet: security \"ApiKey\": bad key",
+				"instance": "/pets",
+				"operation": "CreatePet",
+				"security": "ApiKey"
+			}`,
+		},
+		{
+			&LimitError{Kind: LimitBodySize, Limit: 10},
+			[]ProblemMapper{
+				MapProblem(func(ctx context.Context, err *SecurityError, p *Problem) {
+					t.Fatal("unexpected call")
+				}),
+				MapProblem(func(ctx context.Context, err *LimitError, p *Problem) {
+					p.Type = "https://example.com/problems/too-large"
+					p.Extensions = map[string]any{
+						"limit":  err.Limit,
+						"status": "ignored",
+					}
+				}),
+				func(ctx context.Context, err error, p *Problem) bool {
+					t.Fatal("unexpected call")
+					return false
+				},
+			},
+			http.StatusRequestEntityTooLarge,
+			`{
+				"type": "https://example.com/problems/too-large",
+				"title": "Request Entity Too Large",
+				"status": 413,
+				"detail": "request body size exceeds limit of 10",
+				"instance": "/pets",
+				"limit": 10
+			}`,
+		},
+	} {
+		a := require.New(t)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/pets?token=secret", http.NoBody)
+
+		ProblemErrorHandler(tt.Mappers...)(context.Background(), w, r, tt.Err)
+		a.Equal(tt.Code, w.Code, "test %d", i+1)
+		a.Equal(ProblemContentType, w.Header().Get("Content-Type"), "test %d", i+1)
+		a.JSONEq(tt.Body, w.Body.String(), "test %d", i+1)
+	}
+}
@@ -0,0 +1,78 @@
+package ogenerrors
+
+import (
+	"context"
+
+	"github.com/go-faster/errors"
+
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/validate"
+)
+
+// Error types returned by ErrorType.
+const (
+	ErrorTypeTimeout            = "timeout"
+	ErrorTypeCanceled           = "canceled"
+	ErrorTypeNotImplemented     = "not_implemented"
+	ErrorTypeSecurity           = "security"
+	ErrorTypeLimit              = "limit"
+	ErrorTypeInvalidContentType = "invalid_content_type"
+	ErrorTypeValidation         = "validation"
+	ErrorTypeUnexpectedStatus   = "unexpected_status_code"
+	ErrorTypeDecodeParams       = "decode_params"
+	ErrorTypeDecodeRequest      = "decode_request"
+	ErrorTypeDecodeBody         = "decode_body"
+	// ErrorTypeOther is the type of any other error, as defined by
+	// OpenTelemetry "error.type" attribute.
+	ErrorTypeOther = "_OTHER"
+)
+
+// ErrorType returns low-cardinality type of given error, suitable for
+// metric attributes.
+//
+// Returns empty string if err is nil.
+func ErrorType(err error) string {
+	var (
+		securityErr *SecurityError
+		limitErr    *LimitError
+		ctError     *validate.InvalidContentTypeError
+		validateErr *validate.Error
+		statusErr   *validate.UnexpectedStatusCodeError
+		paramsErr   *DecodeParamsError
+		requestErr  *DecodeRequestError
+		bodyErr     *DecodeBodyError
+	)
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorTypeTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorTypeCanceled
+	case errors.Is(err, ht.ErrNotImplemented):
+		return ErrorTypeNotImplemented
+	case errors.As(err, &securityErr),
+		errors.Is(err, ErrSecurityRequirementIsNotSatisfied):
+		return ErrorTypeSecurity
+	case errors.As(err, &limitErr):
+		return ErrorTypeLimit
+	case errors.As(err, &ctError):
+		return ErrorTypeInvalidContentType
+	case errors.As(err, &validateErr),
+		errors.Is(err, validate.ErrFieldRequired),
+		errors.Is(err, validate.ErrBodyRequired):
+		// Takes precedence over decoding errors, validation failures are
+		// reported as DecodeRequestError or DecodeParamsError by server.
+		return ErrorTypeValidation
+	case errors.As(err, &statusErr):
+		return ErrorTypeUnexpectedStatus
+	case errors.As(err, &paramsErr):
+		return ErrorTypeDecodeParams
+	case errors.As(err, &requestErr):
+		return ErrorTypeDecodeRequest
+	case errors.As(err, &bodyErr):
+		return ErrorTypeDecodeBody
+	default:
+		return ErrorTypeOther
+	}
+}
@@ -0,0 +1,41 @@
+package ogenerrors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/validate"
+)
+
+func TestErrorType(t *testing.T) {
+	validationErr := &validate.Error{Fields: []validate.FieldError{
+		{Name: "name", Error: validate.ErrFieldRequired},
+	}}
+	for i, tt := range []struct {
+		err  error
+		want string
+	}{
+		{nil, ""},
+		{errors.Wrap(context.DeadlineExceeded, "handler"), ErrorTypeTimeout},
+		{&SecurityError{Err: context.Canceled}, ErrorTypeCanceled},
+		{ht.ErrNotImplemented, ErrorTypeNotImplemented},
+		{&SecurityError{Security: "APIKey", Err: errors.New("invalid key")}, ErrorTypeSecurity},
+		{errors.Wrap(ErrSecurityRequirementIsNotSatisfied, "client"), ErrorTypeSecurity},
+		{&DecodeRequestError{Err: &LimitError{Kind: LimitBodySize, Limit: 1}}, ErrorTypeLimit},
+		{&DecodeRequestError{Err: validate.InvalidContentType("text/plain")}, ErrorTypeInvalidContentType},
+		{&DecodeRequestError{Err: errors.Wrap(validationErr, "validate")}, ErrorTypeValidation},
+		{&DecodeRequestError{Err: validate.ErrBodyRequired}, ErrorTypeValidation},
+		{&DecodeParamsError{Err: validate.ErrFieldRequired}, ErrorTypeValidation},
+		{errors.Wrap(validate.UnexpectedStatusCode(500), "decode response"), ErrorTypeUnexpectedStatus},
+		{&DecodeParamsError{Err: errors.New("invalid integer")}, ErrorTypeDecodeParams},
+		{&DecodeRequestError{Err: errors.New("unexpected EOF")}, ErrorTypeDecodeRequest},
+		{&DecodeBodyError{Err: errors.New("unexpected EOF")}, ErrorTypeDecodeBody},
+		{errors.New("oops"), ErrorTypeOther},
+	} {
+		require.Equal(t, tt.want, ErrorType(tt.err), "test %d: %v", i+1, tt.err)
+	}
+}
@@ -0,0 +1,129 @@
+package ogenoidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/go-faster/errors"
+)
+
+// jwk is JSON Web Key (RFC 7517).
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA.
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC and OKP.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwks is JSON Web Key Set.
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type publicKey struct {
+	// Alg is an optional algorithm the key is intended for.
+	Alg string
+	Key crypto.PublicKey
+}
+
+// parse parses verification keys of set.
+//
+// Keys of unknown types and encryption keys are ignored.
+func (s jwks) parse() (map[string]publicKey, error) {
+	keys := make(map[string]publicKey, len(s.Keys))
+	for i, k := range s.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.parse()
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %d (%q)", i, k.Kid)
+		}
+		if key == nil {
+			continue
+		}
+		keys[k.Kid] = publicKey{Alg: k.Alg, Key: key}
+	}
+	return keys, nil
+}
+
+func (k jwk) parse() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "n")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "e")
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too big")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "x")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "y")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "x")
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.Errorf("invalid key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("value is empty")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
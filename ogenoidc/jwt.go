@@ -0,0 +1,265 @@
+package ogenoidc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	_ "crypto/sha256" // for crypto.SHA256
+	_ "crypto/sha512" // for crypto.SHA384 and crypto.SHA512
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Claims is a set of verified JWT claims.
+type Claims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+	// Scopes is a list of scopes from "scope" or "scp" claim.
+	Scopes []string
+	// Raw is a raw JSON payload, may be used to decode custom claims.
+	Raw json.RawMessage
+}
+
+// HasScopes whether claims contain all given scopes.
+//
+// Useful to check scopes required by operation:
+//
+//	if !claims.HasScopes(t.Scopes...) {
+//		return nil, errors.New("insufficient scope")
+//	}
+func (c *Claims) HasScopes(scopes ...string) bool {
+	for _, s := range scopes {
+		if !slices.Contains(c.Scopes, s) {
+			return false
+		}
+	}
+	return true
+}
+
+type rawClaims struct {
+	Iss   string          `json:"iss"`
+	Sub   string          `json:"sub"`
+	Aud   json.RawMessage `json:"aud"`
+	Exp   *json.Number    `json:"exp"`
+	Nbf   *json.Number    `json:"nbf"`
+	Iat   *json.Number    `json:"iat"`
+	Scope string          `json:"scope"`
+	Scp   json.RawMessage `json:"scp"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// Verify verifies JWT signature and registered claims and returns token claims.
+//
+// Token must be signed with one of provider's keys and must not be expired.
+// The "iss" claim must be identical to the issuer of discovery document and
+// the "aud" claim must contain the audience set using WithAudience.
+func (p *Provider) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token: expected 3 parts")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, errors.Wrap(err, "decode header")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "decode signature")
+	}
+
+	key, err := p.key(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if key.Alg != "" && key.Alg != h.Alg {
+		return nil, errors.Errorf("algorithm %q does not match key algorithm %q", h.Alg, key.Alg)
+	}
+	signed := token[:len(parts[0])+1+len(parts[1])]
+	if err := verifySignature(h.Alg, key.Key, []byte(signed), sig); err != nil {
+		return nil, errors.Wrap(err, "verify signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "decode payload")
+	}
+	claims, err := parseClaims(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode claims")
+	}
+	if err := p.validate(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *Provider) validate(c *Claims) error {
+	if c.Issuer != p.metadata.Issuer {
+		return errors.Errorf("invalid issuer %q", c.Issuer)
+	}
+	if p.audience != "" && !slices.Contains(c.Audience, p.audience) {
+		return errors.Errorf("invalid audience %q", c.Audience)
+	}
+
+	now := p.now()
+	if c.ExpiresAt.IsZero() {
+		return errors.New(`"exp" claim is required`)
+	}
+	if !now.Before(c.ExpiresAt.Add(p.leeway)) {
+		return errors.Errorf("token is expired at %s", c.ExpiresAt.Format(time.RFC3339))
+	}
+	if !c.NotBefore.IsZero() && now.Add(p.leeway).Before(c.NotBefore) {
+		return errors.Errorf("token is not valid before %s", c.NotBefore.Format(time.RFC3339))
+	}
+	if !c.IssuedAt.IsZero() && now.Add(p.leeway).Before(c.IssuedAt) {
+		return errors.Errorf("token is issued in the future at %s", c.IssuedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func decodeSegment(s string, target any) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+func parseClaims(payload []byte) (*Claims, error) {
+	var raw rawClaims
+	d := json.NewDecoder(bytes.NewReader(payload))
+	d.UseNumber()
+	if err := d.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	c := &Claims{
+		Issuer:  raw.Iss,
+		Subject: raw.Sub,
+		Raw:     payload,
+	}
+
+	var err error
+	if c.Audience, err = parseStrings(raw.Aud); err != nil {
+		return nil, errors.Wrap(err, "aud")
+	}
+	for _, date := range []struct {
+		name   string
+		value  *json.Number
+		target *time.Time
+	}{
+		{"exp", raw.Exp, &c.ExpiresAt},
+		{"nbf", raw.Nbf, &c.NotBefore},
+		{"iat", raw.Iat, &c.IssuedAt},
+	} {
+		if date.value == nil {
+			continue
+		}
+		f, err := date.value.Float64()
+		if err != nil || math.IsInf(f, 0) || f < 0 {
+			return nil, errors.Errorf("%s: invalid date %q", date.name, date.value)
+		}
+		sec, frac := math.Modf(f)
+		*date.target = time.Unix(int64(sec), int64(frac*1e9))
+	}
+
+	if raw.Scope != "" {
+		c.Scopes = strings.Fields(raw.Scope)
+	} else if c.Scopes, err = parseStrings(raw.Scp); err != nil {
+		return nil, errors.Wrap(err, "scp")
+	}
+	return c, nil
+}
+
+// parseStrings parses claim that may be a string or an array of strings.
+func parseStrings(data json.RawMessage) ([]string, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return []string{s}, nil
+	}
+	var r []string
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var h crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		h = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		h = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		h = crypto.SHA512
+	case "EdDSA":
+		k, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return errors.Errorf("algorithm %q requires Ed25519 key, got %T", alg, key)
+		}
+		if !ed25519.Verify(k, signed, sig) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported algorithm %q", alg)
+	}
+	hasher := h.New()
+	_, _ = hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch alg[0] {
+	case 'R', 'P':
+		k, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("algorithm %q requires RSA key, got %T", alg, key)
+		}
+		if alg[0] == 'P' {
+			return rsa.VerifyPSS(k, h, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+		return rsa.VerifyPKCS1v15(k, h, digest, sig)
+	default:
+		k, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.Errorf("algorithm %q requires ECDSA key, got %T", alg, key)
+		}
+		bits := k.Curve.Params().BitSize
+		if want := map[string]int{"ES256": 256, "ES384": 384, "ES512": 521}[alg]; bits != want {
+			return errors.Errorf("algorithm %q does not match curve %s", alg, k.Curve.Params().Name)
+		}
+		size := (bits + 7) / 8
+		if len(sig) != 2*size {
+			return errors.Errorf("invalid signature size %d", len(sig))
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+}
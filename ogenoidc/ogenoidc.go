@@ -0,0 +1,234 @@
+// Package ogenoidc contains OpenID Connect helpers for generated security handlers.
+//
+// Provider fetches the discovery document referenced by "openIdConnectUrl"
+// and verifies JWT access and ID tokens using the provider's JWKS.
+package ogenoidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Metadata is a subset of OpenID Provider Metadata.
+//
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata.
+type Metadata struct {
+	Issuer                           string   `json:"issuer"`
+	AuthorizationEndpoint            string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint                    string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint                 string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ScopesSupported                  []string `json:"scopes_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
+
+// Option is Provider option.
+type Option func(p *Provider)
+
+// WithClient sets HTTP client to use for discovery and JWKS requests.
+//
+// Default is http.DefaultClient.
+func WithClient(client *http.Client) Option {
+	return func(p *Provider) {
+		if client != nil {
+			p.client = client
+		}
+	}
+}
+
+// WithAudience sets expected "aud" claim value, usually the client_id of the API.
+//
+// Audience is required, unless WithoutAudienceCheck is used.
+func WithAudience(aud string) Option {
+	return func(p *Provider) {
+		p.audience = aud
+	}
+}
+
+// WithoutAudienceCheck disables "aud" claim check.
+//
+// Any token issued by the provider is accepted, including tokens
+// issued to other clients. Use with care.
+func WithoutAudienceCheck() Option {
+	return func(p *Provider) {
+		p.skipAudience = true
+	}
+}
+
+// WithLeeway sets allowed clock skew for "exp", "nbf" and "iat" checks.
+//
+// Default is one minute.
+func WithLeeway(d time.Duration) Option {
+	return func(p *Provider) {
+		p.leeway = d
+	}
+}
+
+// WithClock sets function to get current time.
+//
+// Default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(p *Provider) {
+		if now != nil {
+			p.now = now
+		}
+	}
+}
+
+// WithRefreshInterval sets minimal interval between JWKS refreshes caused by unknown key IDs.
+//
+// Default is one minute.
+func WithRefreshInterval(d time.Duration) Option {
+	return func(p *Provider) {
+		p.refreshInterval = d
+	}
+}
+
+// Provider verifies tokens issued by OpenID Connect provider.
+//
+// Provider is safe for concurrent use.
+type Provider struct {
+	metadata Metadata
+
+	client          *http.Client
+	audience        string
+	skipAudience    bool
+	leeway          time.Duration
+	now             func() time.Time
+	refreshInterval time.Duration
+
+	mux         sync.Mutex
+	keys        map[string]publicKey // guarded by mux
+	lastRefresh time.Time            // guarded by mux
+}
+
+// NewProvider creates new Provider from discovery document URL.
+//
+// The URL is usually the "openIdConnectUrl" of the security scheme, i.e.
+// "<issuer>/.well-known/openid-configuration".
+//
+// Expected audience must be set using WithAudience, or the check must be
+// disabled explicitly using WithoutAudienceCheck.
+func NewProvider(ctx context.Context, discoveryURL string, opts ...Option) (*Provider, error) {
+	p := &Provider{
+		client:          http.DefaultClient,
+		leeway:          time.Minute,
+		now:             time.Now,
+		refreshInterval: time.Minute,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	if p.audience == "" && !p.skipAudience {
+		return nil, errors.New("audience is required: use WithAudience or WithoutAudienceCheck")
+	}
+
+	if err := p.getJSON(ctx, discoveryURL, &p.metadata); err != nil {
+		return nil, errors.Wrap(err, "get discovery document")
+	}
+	if p.metadata.Issuer == "" {
+		return nil, errors.New("discovery document: issuer is empty")
+	}
+	if p.metadata.JWKSURI == "" {
+		return nil, errors.New("discovery document: jwks_uri is empty")
+	}
+	// Issuer MUST be identical to the URL used to retrieve the document,
+	// excluding the well-known suffix.
+	if issuer, ok := strings.CutSuffix(discoveryURL, wellKnownPath); ok &&
+		strings.TrimSuffix(issuer, "/") != strings.TrimSuffix(p.metadata.Issuer, "/") {
+		return nil, errors.Errorf("discovery document: issuer %q does not match URL %q", p.metadata.Issuer, discoveryURL)
+	}
+
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+const wellKnownPath = "/.well-known/openid-configuration"
+
+// Metadata returns provider metadata.
+func (p *Provider) Metadata() Metadata {
+	return p.metadata
+}
+
+func (p *Provider) refresh(ctx context.Context) error {
+	var set jwks
+	if err := p.getJSON(ctx, p.metadata.JWKSURI, &set); err != nil {
+		return errors.Wrap(err, "get jwks")
+	}
+	keys, err := set.parse()
+	if err != nil {
+		return errors.Wrap(err, "parse jwks")
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.keys = keys
+	p.lastRefresh = p.now()
+	return nil
+}
+
+func (p *Provider) key(ctx context.Context, kid string) (publicKey, error) {
+	p.mux.Lock()
+	k, ok := p.keys[kid]
+	canRefresh := p.now().Sub(p.lastRefresh) >= p.refreshInterval
+	p.mux.Unlock()
+	if ok {
+		return k, nil
+	}
+	if !canRefresh {
+		return publicKey{}, errors.Errorf("unknown key %q", kid)
+	}
+
+	// Key may be rotated, refresh the set.
+	if err := p.refresh(ctx); err != nil {
+		return publicKey{}, err
+	}
+
+	p.mux.Lock()
+	k, ok = p.keys[kid]
+	p.mux.Unlock()
+	if !ok {
+		return publicKey{}, errors.Errorf("unknown key %q", kid)
+	}
+	return k, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "do request")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	// Limit body size to prevent memory exhaustion.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return errors.Wrap(err, "read body")
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return errors.Wrap(err, "decode")
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package ogenoidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type testKey struct {
+	kid    string
+	alg    string
+	signer crypto.Signer
+}
+
+func (k testKey) jwk() map[string]string {
+	m := map[string]string{"kid": k.kid, "use": "sig"}
+	switch pub := k.signer.Public().(type) {
+	case *rsa.PublicKey:
+		m["kty"] = "RSA"
+		m["n"] = b64(pub.N.Bytes())
+		m["e"] = b64(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		m["kty"] = "EC"
+		m["crv"] = pub.Curve.Params().Name
+		m["x"] = b64(pub.X.FillBytes(make([]byte, 32)))
+		m["y"] = b64(pub.Y.FillBytes(make([]byte, 32)))
+	case ed25519.PublicKey:
+		m["kty"] = "OKP"
+		m["crv"] = "Ed25519"
+		m["x"] = b64(pub)
+	}
+	return m
+}
+
+func (k testKey) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+	a := require.New(t)
+
+	header, err := json.Marshal(map[string]string{"alg": k.alg, "kid": k.kid, "typ": "JWT"})
+	a.NoError(err)
+	payload, err := json.Marshal(claims)
+	a.NoError(err)
+	signed := b64(header) + "." + b64(payload)
+
+	var sig []byte
+	switch s := k.signer.(type) {
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(s, []byte(signed))
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		r, ss, err := ecdsa.Sign(rand.Reader, s, digest[:])
+		a.NoError(err)
+		sig = append(r.FillBytes(make([]byte, 32)), ss.FillBytes(make([]byte, 32))...)
+	default:
+		digest := sha256.Sum256([]byte(signed))
+		sig, err = s.Sign(rand.Reader, digest[:], crypto.SHA256)
+		a.NoError(err)
+	}
+	return signed + "." + b64(sig)
+}
+
+func newTestIssuer(t *testing.T, keys *atomic.Pointer[[]testKey]) *httptest.Server {
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Metadata{
+			Issuer:  s.URL,
+			JWKSURI: s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		var set []map[string]string
+		for _, k := range *keys.Load() {
+			set = append(set, k.jwk())
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": set})
+	})
+	return s
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+	a := require.New(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	a.NoError(err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	a.NoError(err)
+
+	var (
+		rs = testKey{kid: "rsa", alg: "RS256", signer: rsaKey}
+		es = testKey{kid: "ec", alg: "ES256", signer: ecKey}
+		ed = testKey{kid: "ed", alg: "EdDSA", signer: edKey}
+	)
+	keys := new(atomic.Pointer[[]testKey])
+	keys.Store(&[]testKey{rs, es})
+	s := newTestIssuer(t, keys)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := NewProvider(ctx, s.URL+"/.well-known/openid-configuration",
+		WithClient(s.Client()),
+		WithAudience("api"),
+		WithClock(func() time.Time { return now }),
+		WithRefreshInterval(0),
+	)
+	a.NoError(err)
+	a.Equal(s.URL, p.Metadata().Issuer)
+
+	claims := func(mod func(m map[string]any)) map[string]any {
+		m := map[string]any{
+			"iss":   s.URL,
+			"sub":   "alice",
+			"aud":   []string{"api", "other"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"iat":   now.Unix(),
+			"scope": "read write",
+		}
+		if mod != nil {
+			mod(m)
+		}
+		return m
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		for _, k := range []testKey{rs, es} {
+			a := require.New(t)
+
+			c, err := p.Verify(ctx, k.sign(t, claims(nil)))
+			a.NoError(err, k.alg)
+			a.Equal("alice", c.Subject)
+			a.Equal([]string{"api", "other"}, c.Audience)
+			a.Equal(now.Add(time.Hour), c.ExpiresAt.UTC())
+			a.Equal([]string{"read", "write"}, c.Scopes)
+			a.True(c.HasScopes("read"))
+			a.False(c.HasScopes("read", "admin"))
+		}
+	})
+	t.Run("Rotation", func(t *testing.T) {
+		a := require.New(t)
+
+		token := ed.sign(t, claims(func(m map[string]any) {
+			m["aud"] = "api"
+			delete(m, "scope")
+			m["scp"] = []string{"admin"}
+		}))
+		_, err := p.Verify(ctx, token)
+		a.Error(err)
+
+		keys.Store(&[]testKey{rs, es, ed})
+		c, err := p.Verify(ctx, token)
+		a.NoError(err)
+		a.Equal([]string{"api"}, c.Audience)
+		a.Equal([]string{"admin"}, c.Scopes)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for name, token := range map[string]string{
+			"Malformed": "foo.bar",
+			"Expired": rs.sign(t, claims(func(m map[string]any) {
+				m["exp"] = now.Add(-time.Hour).Unix()
+			})),
+			"NoExpiration": rs.sign(t, claims(func(m map[string]any) {
+				delete(m, "exp")
+			})),
+			"NotBefore": rs.sign(t, claims(func(m map[string]any) {
+				m["nbf"] = now.Add(time.Hour).Unix()
+			})),
+			"Issuer": rs.sign(t, claims(func(m map[string]any) {
+				m["iss"] = "https://evil.example.com"
+			})),
+			"IssuerTrailingSlash": rs.sign(t, claims(func(m map[string]any) {
+				m["iss"] = s.URL + "/"
+			})),
+			"Audience": rs.sign(t, claims(func(m map[string]any) {
+				m["aud"] = "other"
+			})),
+			"UnknownKey":        testKey{kid: "unknown", alg: "RS256", signer: rsaKey}.sign(t, claims(nil)),
+			"AlgorithmMismatch": testKey{kid: "ec", alg: "RS256", signer: rsaKey}.sign(t, claims(nil)),
+			"None":              b64([]byte(`{"alg":"none","kid":"rsa"}`)) + "." + b64([]byte(`{}`)) + ".",
+			"Signature": func() string {
+				other, err := rsa.GenerateKey(rand.Reader, 2048)
+				require.NoError(t, err)
+				return testKey{kid: "rsa", alg: "RS256", signer: other}.sign(t, claims(nil))
+			}(),
+		} {
+			_, err := p.Verify(ctx, token)
+			require.Error(t, err, name)
+		}
+	})
+}
+
+func TestNewProvider(t *testing.T) {
+	ctx := context.Background()
+
+	mux := http.NewServeMux()
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Metadata{
+			Issuer:  "https://other.example.com",
+			JWKSURI: s.URL + "/jwks",
+		})
+	})
+	for _, u := range []string{
+		s.URL + "/.well-known/openid-configuration",
+		s.URL + "/not-found",
+	} {
+		_, err := NewProvider(ctx, u, WithClient(s.Client()), WithoutAudienceCheck())
+		require.Error(t, err, u)
+	}
+}
+
+func TestProviderAudience(t *testing.T) {
+	ctx := context.Background()
+
+	keys := new(atomic.Pointer[[]testKey])
+	keys.Store(&[]testKey{})
+	s := newTestIssuer(t, keys)
+	u := s.URL + "/.well-known/openid-configuration"
+
+	t.Run("Required", func(t *testing.T) {
+		_, err := NewProvider(ctx, u, WithClient(s.Client()))
+		require.ErrorContains(t, err, "audience is required")
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		_, err := NewProvider(ctx, u, WithClient(s.Client()), WithoutAudienceCheck())
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,128 @@
+package ogenxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// Decoder is a streaming XML decoder.
+type Decoder struct {
+	d *xml.Decoder
+}
+
+// NewDecoder creates new Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		d: xml.NewDecoder(r),
+	}
+}
+
+// DecodeBytes creates new Decoder reading from buf.
+func DecodeBytes(buf []byte) *Decoder {
+	return NewDecoder(bytes.NewReader(buf))
+}
+
+func (d *Decoder) token() (xml.Token, error) {
+	tok, err := d.d.Token()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return tok, nil
+}
+
+func isSpace(data []byte) bool {
+	return len(bytes.TrimSpace(data)) == 0
+}
+
+// Root reads the document prolog and returns start of the root element.
+func (d *Decoder) Root() (StartElement, error) {
+	for {
+		tok, err := d.token()
+		if err != nil {
+			return StartElement{}, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			return tok, nil
+		case xml.CharData:
+			if !isSpace(tok) {
+				return StartElement{}, errors.New("unexpected character data before root element")
+			}
+		case xml.EndElement:
+			return StartElement{}, errors.Errorf("unexpected end of element %q", tok.Name.Local)
+		}
+	}
+}
+
+// End checks that there is no data after the root element.
+func (d *Decoder) End() error {
+	for {
+		tok, err := d.d.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			return errors.Errorf("unexpected element %q after root element", tok.Name.Local)
+		case xml.CharData:
+			if !isSpace(tok) {
+				return errors.New("unexpected trailing data")
+			}
+		}
+	}
+}
+
+// Elements calls f for every child element of the current element.
+//
+// f must consume the child element entirely, e.g. using Text, Elements or Skip.
+// Character data between child elements is ignored.
+func (d *Decoder) Elements(f func(d *Decoder, start StartElement) error) error {
+	for {
+		tok, err := d.token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if err := f(d, tok); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// Text reads character data of the current element.
+func (d *Decoder) Text() (string, error) {
+	var sb strings.Builder
+	for {
+		tok, err := d.token()
+		if err != nil {
+			return "", err
+		}
+		switch tok := tok.(type) {
+		case xml.CharData:
+			sb.Write(tok)
+		case xml.StartElement:
+			return "", errors.Errorf("unexpected element %q", tok.Name.Local)
+		case xml.EndElement:
+			return sb.String(), nil
+		}
+	}
+}
+
+// Skip skips the current element.
+func (d *Decoder) Skip() error {
+	return d.d.Skip()
+}
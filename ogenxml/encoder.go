@@ -0,0 +1,192 @@
+package ogenxml
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+
+	"github.com/go-faster/errors"
+)
+
+// Encoder is a streaming XML encoder.
+//
+// Encoder remembers the first write error and skips all writes after it.
+// The error is returned by Close.
+type Encoder struct {
+	w     *bufio.Writer
+	stack []element
+	decls []decl
+	// open is true if start tag of the last element is not closed yet.
+	open   bool
+	header bool
+	err    error
+}
+
+type element struct {
+	tag string
+	// decls is a length of decls before element.
+	decls int
+}
+
+type decl struct {
+	prefix string
+	space  string
+}
+
+// NewEncoder creates new Encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w: bufio.NewWriter(w),
+	}
+}
+
+func (e *Encoder) fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *Encoder) writeString(s string) {
+	if e.err != nil {
+		return
+	}
+	if _, err := e.w.WriteString(s); err != nil {
+		e.fail(err)
+	}
+}
+
+func (e *Encoder) writeEscaped(s string) {
+	if e.err != nil {
+		return
+	}
+	if err := xml.EscapeText(e.w, []byte(s)); err != nil {
+		e.fail(err)
+	}
+}
+
+func (e *Encoder) closeStart() {
+	if e.open {
+		e.writeString(">")
+		e.open = false
+	}
+}
+
+// lookup returns namespace bound to prefix in current scope.
+func (e *Encoder) lookup(prefix string) (string, bool) {
+	for i := len(e.decls) - 1; i >= 0; i-- {
+		if d := e.decls[i]; d.prefix == prefix {
+			return d.space, true
+		}
+	}
+	return "", false
+}
+
+// declare writes namespace declaration, if prefix is not bound to namespace yet.
+func (e *Encoder) declare(n Name) {
+	if n.Space == "" {
+		return
+	}
+	if space, ok := e.lookup(n.Prefix); ok && space == n.Space {
+		return
+	}
+	e.decls = append(e.decls, decl{prefix: n.Prefix, space: n.Space})
+
+	if n.Prefix == "" {
+		e.writeString(` xmlns="`)
+	} else {
+		e.writeString(` xmlns:`)
+		e.writeString(n.Prefix)
+		e.writeString(`="`)
+	}
+	e.writeEscaped(n.Space)
+	e.writeString(`"`)
+}
+
+// ElemStart writes start tag of element.
+//
+// Namespace of the name is declared, if it is not in scope.
+func (e *Encoder) ElemStart(n Name) {
+	e.closeStart()
+	if len(e.stack) == 0 && !e.header {
+		e.header = true
+		e.writeString(xml.Header)
+	}
+
+	tag := n.String()
+	e.stack = append(e.stack, element{
+		tag:   tag,
+		decls: len(e.decls),
+	})
+	e.writeString("<")
+	e.writeString(tag)
+	e.declare(n)
+	e.open = true
+}
+
+// Attr writes attribute of the current element.
+//
+// Attr must be called right after ElemStart or other Attr call.
+func (e *Encoder) Attr(n Name, value string) {
+	if !e.open {
+		e.fail(errors.Errorf("attribute %q outside of start tag", n))
+		return
+	}
+	if n.Prefix != "" {
+		e.declare(n)
+	}
+	e.writeString(" ")
+	e.writeString(n.String())
+	e.writeString(`="`)
+	e.writeEscaped(value)
+	e.writeString(`"`)
+}
+
+// Text writes character data.
+func (e *Encoder) Text(s string) {
+	if len(e.stack) == 0 {
+		e.fail(errors.New("text outside of root element"))
+		return
+	}
+	e.closeStart()
+	e.writeEscaped(s)
+}
+
+// ElemEnd writes end tag of the current element.
+func (e *Encoder) ElemEnd() {
+	if len(e.stack) == 0 {
+		e.fail(errors.New("unexpected end of element"))
+		return
+	}
+	last := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+	e.decls = e.decls[:last.decls]
+
+	if e.open {
+		e.open = false
+		e.writeString("/>")
+		return
+	}
+	e.writeString("</")
+	e.writeString(last.tag)
+	e.writeString(">")
+}
+
+// TextElem writes element with given character data.
+func (e *Encoder) TextElem(n Name, s string) {
+	e.ElemStart(n)
+	if s != "" {
+		e.Text(s)
+	}
+	e.ElemEnd()
+}
+
+// Close flushes buffered data and returns the first encoding error.
+func (e *Encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if len(e.stack) > 0 {
+		return errors.Errorf("element %q is not closed", e.stack[len(e.stack)-1].tag)
+	}
+	return e.w.Flush()
+}
@@ -0,0 +1,36 @@
+// Package ogenxml contains XML encoding and decoding helpers for generated code.
+package ogenxml
+
+import "encoding/xml"
+
+// Name is a qualified XML name of element or attribute.
+type Name struct {
+	// Space is a namespace URI. May be empty.
+	Space string
+	// Prefix is a namespace prefix. May be empty.
+	Prefix string
+	// Local is a local name.
+	Local string
+}
+
+// String returns name as it appears in the document.
+func (n Name) String() string {
+	if n.Prefix == "" {
+		return n.Local
+	}
+	return n.Prefix + ":" + n.Local
+}
+
+// Match whether decoded name matches expected name.
+//
+// Namespace is checked only if expected name has it, so unqualified names
+// match elements from any namespace.
+func Match(name xml.Name, expected Name) bool {
+	return name.Local == expected.Local && (expected.Space == "" || name.Space == expected.Space)
+}
+
+// StartElement is a start of XML element.
+type StartElement = xml.StartElement
+
+// Attr is an XML attribute.
+type Attr = xml.Attr
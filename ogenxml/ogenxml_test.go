@@ -0,0 +1,180 @@
+package ogenxml
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder(t *testing.T) {
+	const (
+		pets  = "http://example.com/pets"
+		notes = "http://example.com/notes"
+	)
+
+	tests := []struct {
+		name   string
+		encode func(e *Encoder)
+		want   string
+	}{
+		{
+			"Empty",
+			func(e *Encoder) {
+				e.ElemStart(Name{Local: "root"})
+				e.ElemEnd()
+			},
+			`<root/>`,
+		},
+		{
+			"Escape",
+			func(e *Encoder) {
+				e.ElemStart(Name{Local: "root"})
+				e.Attr(Name{Local: "a"}, `"<&>"`)
+				e.TextElem(Name{Local: "text"}, `<&>`)
+				e.TextElem(Name{Local: "empty"}, ``)
+				e.ElemEnd()
+			},
+			`<root a="&#34;&lt;&amp;&gt;&#34;"><text>&lt;&amp;&gt;</text><empty/></root>`,
+		},
+		{
+			"Prefix",
+			func(e *Encoder) {
+				e.ElemStart(Name{Space: pets, Prefix: "p", Local: "pet"})
+				e.Attr(Name{Space: notes, Prefix: "n", Local: "lang"}, "en")
+				e.TextElem(Name{Space: pets, Prefix: "p", Local: "name"}, "Tom")
+				e.TextElem(Name{Space: notes, Prefix: "n", Local: "note"}, "cat")
+				e.ElemEnd()
+			},
+			`<p:pet xmlns:p="http://example.com/pets" xmlns:n="http://example.com/notes" n:lang="en">` +
+				`<p:name>Tom</p:name><n:note>cat</n:note></p:pet>`,
+		},
+		{
+			"DefaultNamespace",
+			func(e *Encoder) {
+				e.ElemStart(Name{Space: pets, Local: "pets"})
+				e.TextElem(Name{Space: pets, Local: "pet"}, "Tom")
+				e.TextElem(Name{Space: notes, Local: "note"}, "cat")
+				e.TextElem(Name{Local: "plain"}, "")
+				e.ElemEnd()
+			},
+			`<pets xmlns="http://example.com/pets"><pet>Tom</pet>` +
+				`<note xmlns="http://example.com/notes">cat</note><plain/></pets>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := require.New(t)
+
+			var sb strings.Builder
+			e := NewEncoder(&sb)
+			tt.encode(e)
+			a.NoError(e.Close())
+			a.Equal(xml.Header+tt.want, sb.String())
+
+			// Ensure that output is valid XML.
+			d := xml.NewDecoder(strings.NewReader(sb.String()))
+			for {
+				_, err := d.Token()
+				if err != nil {
+					a.Equal("EOF", err.Error())
+					break
+				}
+			}
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, encode := range []func(e *Encoder){
+			func(e *Encoder) {
+				e.ElemStart(Name{Local: "root"})
+			},
+			func(e *Encoder) {
+				e.ElemEnd()
+			},
+			func(e *Encoder) {
+				e.Text("text")
+			},
+			func(e *Encoder) {
+				e.ElemStart(Name{Local: "root"})
+				e.Text("text")
+				e.Attr(Name{Local: "a"}, "b")
+				e.ElemEnd()
+			},
+		} {
+			e := NewEncoder(new(strings.Builder))
+			encode(e)
+			require.Error(t, e.Close())
+		}
+	})
+}
+
+func TestDecoder(t *testing.T) {
+	a := require.New(t)
+
+	d := DecodeBytes([]byte(`<?xml version="1.0"?>
+<!-- comment -->
+<p:pet xmlns:p="http://example.com/pets" id="1">
+	<name>Tom &amp; Jerry</name>
+	<p:tags><tag>a</tag><skip><nested/></skip><tag>b</tag></p:tags>
+</p:pet>
+`))
+	start, err := d.Root()
+	a.NoError(err)
+	a.True(Match(start.Name, Name{Space: "http://example.com/pets", Local: "pet"}))
+	a.False(Match(start.Name, Name{Space: "http://example.com/other", Local: "pet"}))
+
+	var (
+		name string
+		tags []string
+	)
+	a.NoError(d.Elements(func(d *Decoder, start StartElement) error {
+		switch {
+		case Match(start.Name, Name{Local: "name"}):
+			v, err := d.Text()
+			name = v
+			return err
+		case Match(start.Name, Name{Space: "http://example.com/pets", Local: "tags"}):
+			return d.Elements(func(d *Decoder, start StartElement) error {
+				if !Match(start.Name, Name{Local: "tag"}) {
+					return d.Skip()
+				}
+				v, err := d.Text()
+				tags = append(tags, v)
+				return err
+			})
+		default:
+			return d.Skip()
+		}
+	}))
+	a.NoError(d.End())
+	a.Equal("Tom & Jerry", name)
+	a.Equal([]string{"a", "b"}, tags)
+
+	for _, input := range []string{
+		``,
+		`text`,
+		`<a>`,
+		`<a/><b/>`,
+		`<a/>text`,
+	} {
+		d := DecodeBytes([]byte(input))
+		err := func() error {
+			if _, err := d.Root(); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			return d.End()
+		}()
+		a.Error(err, input)
+	}
+
+	d = DecodeBytes([]byte(`<a><b/></a>`))
+	_, err = d.Root()
+	a.NoError(err)
+	_, err = d.Text()
+	a.Error(err)
+}
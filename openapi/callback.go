@@ -0,0 +1,25 @@
+package openapi
+
+import "github.com/ogen-go/ogen/location"
+
+// Callback is an OpenAPI Callback.
+type Callback struct {
+	// Name of the callback.
+	Name string
+	// Ref is a reference to the callback component, if any.
+	Ref Ref
+	// Paths of the callback sorted by expression.
+	Paths []*CallbackPath
+
+	location.Pointer `json:"-" yaml:"-"`
+}
+
+// CallbackPath is a callback Path Item with its URL expression.
+type CallbackPath struct {
+	// Expression is a parsed callback URL expression.
+	Expression Expression
+	// Operations of the callback's Path Item.
+	Operations []*Operation
+
+	location.Pointer `json:"-" yaml:"-"`
+}
@@ -0,0 +1,188 @@
+package openapi
+
+import (
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// ExpressionSource defines source of runtime expression value.
+type ExpressionSource string
+
+const (
+	// ExpressionURL is "$url" expression.
+	ExpressionURL ExpressionSource = "url"
+	// ExpressionMethod is "$method" expression.
+	ExpressionMethod ExpressionSource = "method"
+	// ExpressionStatusCode is "$statusCode" expression.
+	ExpressionStatusCode ExpressionSource = "statusCode"
+	// ExpressionRequest is "$request" expression.
+	ExpressionRequest ExpressionSource = "request"
+	// ExpressionResponse is "$response" expression.
+	ExpressionResponse ExpressionSource = "response"
+)
+
+// RuntimeExpression is an OpenAPI runtime expression.
+//
+// See https://spec.openapis.org/oas/v3.1.0#runtime-expressions.
+type RuntimeExpression struct {
+	Source ExpressionSource
+	// In is a location of referenced parameter.
+	//
+	// Empty, if expression does not reference parameter.
+	In ParameterLocation
+	// Name is a name of referenced parameter.
+	Name string
+	// Body whether expression references request or response body.
+	Body bool
+	// Pointer is a JSON Pointer to the referenced body value.
+	//
+	// Empty, if expression references the whole body.
+	Pointer string
+}
+
+// String implements fmt.Stringer.
+func (e RuntimeExpression) String() string {
+	var sb strings.Builder
+	sb.WriteByte('$')
+	sb.WriteString(string(e.Source))
+	switch {
+	case e.Body:
+		sb.WriteString(".body")
+		if e.Pointer != "" {
+			sb.WriteByte('#')
+			sb.WriteString(e.Pointer)
+		}
+	case e.In != "":
+		sb.WriteByte('.')
+		sb.WriteString(string(e.In))
+		sb.WriteByte('.')
+		sb.WriteString(e.Name)
+	}
+	return sb.String()
+}
+
+// ParseRuntimeExpression parses runtime expression.
+func ParseRuntimeExpression(s string) (e RuntimeExpression, _ error) {
+	rest, ok := strings.CutPrefix(s, "$")
+	if !ok {
+		return e, errors.Errorf("expression %q must start with %q", s, "$")
+	}
+
+	source, ref, hasRef := strings.Cut(rest, ".")
+	e.Source = ExpressionSource(source)
+	switch e.Source {
+	case ExpressionURL, ExpressionMethod, ExpressionStatusCode:
+		if hasRef {
+			return e, errors.Errorf("unexpected %q after %q", ref, "$"+source)
+		}
+		return e, nil
+	case ExpressionRequest, ExpressionResponse:
+		if !hasRef {
+			return e, errors.Errorf("%q: source is required", s)
+		}
+	default:
+		return e, errors.Errorf("unknown expression %q", "$"+source)
+	}
+
+	if body, ok := strings.CutPrefix(ref, "body"); ok && (body == "" || body[0] == '#') {
+		e.Body = true
+		if ptr, ok := strings.CutPrefix(body, "#"); ok {
+			if ptr != "" && ptr[0] != '/' {
+				return e, errors.Errorf("invalid JSON pointer %q", ptr)
+			}
+			e.Pointer = ptr
+		}
+		return e, nil
+	}
+
+	in, name, _ := strings.Cut(ref, ".")
+	e.In, e.Name = ParameterLocation(in), name
+	switch e.In {
+	case LocationHeader:
+		if name == "" || strings.IndexFunc(name, func(r rune) bool { return !isTokenChar(r) }) >= 0 {
+			return e, errors.Errorf("invalid header name %q", name)
+		}
+	case LocationQuery, LocationPath:
+		if name == "" {
+			return e, errors.Errorf("%q: parameter name is required", s)
+		}
+		if e.Source == ExpressionResponse {
+			return e, errors.Errorf("%q: response has no %s parameters", s, in)
+		}
+	default:
+		return e, errors.Errorf("unknown source %q", in)
+	}
+	return e, nil
+}
+
+// isTokenChar whether r is a valid RFC 7230 token character.
+func isTokenChar(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	default:
+		return strings.ContainsRune("!#$%&'*+-.^_`|~", r)
+	}
+}
+
+// ExpressionPart is a part of Expression.
+type ExpressionPart struct {
+	// Raw is a literal part of expression.
+	Raw string
+	// Expr is a runtime expression, nil if part is literal.
+	Expr *RuntimeExpression
+}
+
+// Expression is a callback URL expression.
+//
+// It is either a single runtime expression or a template
+// with runtime expressions embedded using curly braces.
+type Expression struct {
+	Raw   string
+	Parts []ExpressionPart
+}
+
+// String implements fmt.Stringer.
+func (e Expression) String() string {
+	return e.Raw
+}
+
+// ParseExpression parses callback URL expression.
+func ParseExpression(s string) (e Expression, _ error) {
+	e.Raw = s
+	if strings.HasPrefix(s, "$") {
+		expr, err := ParseRuntimeExpression(s)
+		if err != nil {
+			return e, err
+		}
+		e.Parts = []ExpressionPart{{Expr: &expr}}
+		return e, nil
+	}
+
+	for s != "" {
+		start := strings.IndexByte(s, '{')
+		if start < 0 {
+			e.Parts = append(e.Parts, ExpressionPart{Raw: s})
+			break
+		}
+		if start > 0 {
+			e.Parts = append(e.Parts, ExpressionPart{Raw: s[:start]})
+		}
+
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			return e, errors.Errorf("unclosed %q at %d", "{", len(e.Raw)-len(s)+start)
+		}
+		expr, err := ParseRuntimeExpression(s[start+1 : start+end])
+		if err != nil {
+			return e, err
+		}
+		e.Parts = append(e.Parts, ExpressionPart{Expr: &expr})
+		s = s[start+end+1:]
+	}
+	if len(e.Parts) == 0 {
+		return e, errors.New("expression is empty")
+	}
+	return e, nil
+}
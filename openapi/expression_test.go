@@ -0,0 +1,102 @@
+package openapi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRuntimeExpression(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    RuntimeExpression
+		wantErr bool
+	}{
+		{"$url", RuntimeExpression{Source: ExpressionURL}, false},
+		{"$method", RuntimeExpression{Source: ExpressionMethod}, false},
+		{"$statusCode", RuntimeExpression{Source: ExpressionStatusCode}, false},
+		{"$request.path.eventType", RuntimeExpression{Source: ExpressionRequest, In: LocationPath, Name: "eventType"}, false},
+		{"$request.query.queryUrl", RuntimeExpression{Source: ExpressionRequest, In: LocationQuery, Name: "queryUrl"}, false},
+		{"$request.header.X-Callback", RuntimeExpression{Source: ExpressionRequest, In: LocationHeader, Name: "X-Callback"}, false},
+		{"$response.header.Location", RuntimeExpression{Source: ExpressionResponse, In: LocationHeader, Name: "Location"}, false},
+		{"$request.body", RuntimeExpression{Source: ExpressionRequest, Body: true}, false},
+		{"$request.body#/user/uuid", RuntimeExpression{Source: ExpressionRequest, Body: true, Pointer: "/user/uuid"}, false},
+		{"$response.body#/status", RuntimeExpression{Source: ExpressionResponse, Body: true, Pointer: "/status"}, false},
+
+		{"", RuntimeExpression{}, true},
+		{"url", RuntimeExpression{}, true},
+		{"$", RuntimeExpression{}, true},
+		{"$foo", RuntimeExpression{}, true},
+		{"$url.path", RuntimeExpression{}, true},
+		{"$request", RuntimeExpression{}, true},
+		{"$request.", RuntimeExpression{}, true},
+		{"$request.cookie.id", RuntimeExpression{}, true},
+		{"$request.query.", RuntimeExpression{}, true},
+		{"$request.header.X Callback", RuntimeExpression{}, true},
+		{"$request.body#user", RuntimeExpression{}, true},
+		{"$response.query.id", RuntimeExpression{}, true},
+	}
+	for i, tt := range tests {
+		tt := tt
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			got, err := ParseRuntimeExpression(tt.input)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.want, got)
+			a.Equal(tt.input, got.String())
+		})
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	var (
+		bodyURL = &RuntimeExpression{Source: ExpressionRequest, Body: true, Pointer: "/callbackUrl"}
+		bodyID  = &RuntimeExpression{Source: ExpressionRequest, Body: true, Pointer: "/id"}
+		queryID = &RuntimeExpression{Source: ExpressionRequest, In: LocationQuery, Name: "id"}
+	)
+	tests := []struct {
+		input   string
+		want    []ExpressionPart
+		wantErr bool
+	}{
+		{"$request.body#/callbackUrl", []ExpressionPart{{Expr: bodyURL}}, false},
+		{"{$request.body#/callbackUrl}", []ExpressionPart{{Expr: bodyURL}}, false},
+		{"{$request.body#/callbackUrl}/events", []ExpressionPart{
+			{Expr: bodyURL},
+			{Raw: "/events"},
+		}, false},
+		{"https://example.com/{$request.query.id}?id={$request.body#/id}", []ExpressionPart{
+			{Raw: "https://example.com/"},
+			{Expr: queryID},
+			{Raw: "?id="},
+			{Expr: bodyID},
+		}, false},
+		{"https://example.com/events", []ExpressionPart{{Raw: "https://example.com/events"}}, false},
+
+		{"", nil, true},
+		{"{$request.query.id", nil, true},
+		{"https://example.com/{id}", nil, true},
+		{"$request.query", nil, true},
+	}
+	for i, tt := range tests {
+		tt := tt
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			a := require.New(t)
+
+			got, err := ParseExpression(tt.input)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.input, got.String())
+			a.Equal(tt.want, got.Parts)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package openapi
+
+import "github.com/ogen-go/ogen/location"
+
+// Link is an OpenAPI Link.
+type Link struct {
+	// Name of the link.
+	Name string
+	// Ref is a reference to the link component, if any.
+	Ref         Ref
+	Description string
+	// OperationRef is a reference to the linked operation, if any.
+	OperationRef string
+	// OperationID is an ID of the linked operation, if any.
+	OperationID string
+	// Operation is the linked operation.
+	Operation *Operation
+	// Parameters to pass to the linked operation sorted by name.
+	Parameters []*LinkParameter
+	// RequestBody to pass to the linked operation, if any.
+	RequestBody *LinkValue
+
+	location.Pointer `json:"-" yaml:"-"`
+}
+
+// LinkParameter is a parameter to pass to the linked operation.
+type LinkParameter struct {
+	// Name of the parameter.
+	Name string
+	// In is a location of the parameter.
+	//
+	// Empty, if the name is not qualified with location, e.g. "path.id".
+	In ParameterLocation
+	// Value of the parameter.
+	Value LinkValue
+
+	location.Pointer `json:"-" yaml:"-"`
+}
+
+// LinkValue is a constant value or a runtime expression.
+type LinkValue struct {
+	// Expression is a parsed runtime expression.
+	//
+	// Nil, if value is a constant.
+	Expression *Expression
+	// Value is a parsed constant value.
+	Value any
+}
+
+// IsConstant whether value is a constant.
+func (v LinkValue) IsConstant() bool {
+	return v.Expression == nil
+}
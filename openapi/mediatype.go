@@ -27,10 +27,11 @@ func (s SSEEventShape) Enabled() bool { return s != SSEEventShapeNone }
 
 // MediaType is Media Type Object.
 type MediaType struct {
-	Schema   *jsonschema.Schema
-	Example  json.RawMessage
-	Examples map[string]*Example
-	Encoding map[string]*Encoding
+	Schema     *jsonschema.Schema
+	ItemSchema *jsonschema.Schema // only for sequential media types
+	Example    json.RawMessage
+	Examples   map[string]*Example
+	Encoding   map[string]*Encoding
 
 	XOgenJSONStreaming bool
 	XOgenRawResponse   bool
@@ -27,7 +27,12 @@ type Operation struct {
 	// Operation responses.
 	Responses Responses
 
+	// Operation callbacks sorted by name.
+	Callbacks []*Callback
+
 	XOgenOperationGroup string // Extension field for operation grouping.
+	XOgenMaxBodySize    int64  // Extension field for request body size limit.
+	XOgenRetry          *bool  // Extension field for client retries, nil if not set.
 
 	location.Pointer `json:"-" yaml:"-"`
 }
@@ -51,7 +56,8 @@ type Response struct {
 	Description string
 	Headers     map[string]*Header
 	Content     map[string]*MediaType
-	// Links map[string]*Link
+	// Response links sorted by name.
+	Links []*Link
 
 	location.Pointer `json:"-" yaml:"-"`
 }
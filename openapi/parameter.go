@@ -17,6 +17,10 @@ const (
 	LocationPath ParameterLocation = "path"
 	// LocationCookie is "cookie" parameter location.
 	LocationCookie ParameterLocation = "cookie"
+	// LocationQueryString is "querystring" parameter location.
+	//
+	// The parameter describes the whole query string. Added in OpenAPI 3.2.
+	LocationQueryString ParameterLocation = "querystring"
 )
 
 // Query whether parameter location is query.
@@ -31,6 +35,9 @@ func (l ParameterLocation) Path() bool { return l == LocationPath }
 // Cookie whether parameter location is cookie.
 func (l ParameterLocation) Cookie() bool { return l == LocationCookie }
 
+// QueryString whether parameter location is querystring.
+func (l ParameterLocation) QueryString() bool { return l == LocationQueryString }
+
 // String implements fmt.Stringer.
 func (l ParameterLocation) String() string { return string(l) }
 
@@ -3,6 +3,7 @@ package parser
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -28,6 +29,7 @@ type localToRemote struct {
 }
 
 type expander struct {
+	version       openapi.Version
 	components    *ogen.Components
 	localToRemote map[string]localToRemote
 }
@@ -36,6 +38,7 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 	spec = new(ogen.Spec)
 	spec.Init()
 	e.components = spec.Components
+	e.version = api.Version
 
 	spec.OpenAPI = api.Version.String()
 
@@ -43,7 +46,10 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 	for i, tag := range api.Tags {
 		tags[i] = ogen.Tag{
 			Name:        tag.Name,
+			Summary:     tag.Summary,
 			Description: tag.Description,
+			Parent:      tag.Parent,
+			Kind:        tag.Kind,
 		}
 	}
 	spec.Tags = tags
@@ -60,46 +66,6 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 		spec.Servers = expanded
 	}
 
-	setOperation := func(pi *ogen.PathItem, method string, op *ogen.Operation) error {
-		var ptr **ogen.Operation
-		switch m := strings.ToLower(method); m {
-		case "get":
-			ptr = &pi.Get
-		case "put":
-			ptr = &pi.Put
-		case "post":
-			ptr = &pi.Post
-		case "delete":
-			ptr = &pi.Delete
-		case "options":
-			ptr = &pi.Options
-		case "head":
-			ptr = &pi.Head
-		case "patch":
-			ptr = &pi.Patch
-		case "trace":
-			ptr = &pi.Trace
-		case "query":
-			ptr = &pi.Query
-		default:
-			if pi.AdditionalOperations != nil {
-				if _, ok := pi.AdditionalOperations[m]; ok {
-					return errors.Errorf("path item already contains %q operation", method)
-				}
-				pi.AdditionalOperations[m] = op
-				return nil
-			}
-			return errors.Errorf("unexpected method %q", method)
-		}
-
-		if existing := *ptr; existing != nil {
-			return errors.Errorf("path item already contains %q operation", method)
-		}
-		*ptr = op
-
-		return nil
-	}
-
 	for _, op := range api.Operations {
 		path := op.Path.String()
 
@@ -110,10 +76,7 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 
 		pi := spec.Paths[path]
 		if pi == nil {
-			pi = &ogen.PathItem{}
-			if api.Version.Minor >= 2 {
-				pi.AdditionalOperations = make(map[string]*ogen.Operation)
-			}
+			pi = e.PathItem()
 			if spec.Paths == nil {
 				spec.Paths = ogen.Paths{}
 			}
@@ -134,7 +97,7 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 
 			pi := spec.Webhooks[wh.Name]
 			if pi == nil {
-				pi = &ogen.PathItem{}
+				pi = e.PathItem()
 
 				if spec.Webhooks == nil {
 					spec.Webhooks = map[string]*ogen.PathItem{}
@@ -151,6 +114,55 @@ func (e *expander) Spec(api *openapi.API) (spec *ogen.Spec, err error) {
 	return spec, nil
 }
 
+// PathItem creates new empty Path Item.
+func (e *expander) PathItem() *ogen.PathItem {
+	pi := &ogen.PathItem{}
+	if e.version.Minor >= 2 {
+		pi.AdditionalOperations = make(map[string]*ogen.Operation)
+	}
+	return pi
+}
+
+func setOperation(pi *ogen.PathItem, method string, op *ogen.Operation) error {
+	var ptr **ogen.Operation
+	switch m := strings.ToLower(method); m {
+	case "get":
+		ptr = &pi.Get
+	case "put":
+		ptr = &pi.Put
+	case "post":
+		ptr = &pi.Post
+	case "delete":
+		ptr = &pi.Delete
+	case "options":
+		ptr = &pi.Options
+	case "head":
+		ptr = &pi.Head
+	case "patch":
+		ptr = &pi.Patch
+	case "trace":
+		ptr = &pi.Trace
+	case "query":
+		ptr = &pi.Query
+	default:
+		if pi.AdditionalOperations != nil {
+			if _, ok := pi.AdditionalOperations[m]; ok {
+				return errors.Errorf("path item already contains %q operation", method)
+			}
+			pi.AdditionalOperations[m] = op
+			return nil
+		}
+		return errors.Errorf("unexpected method %q", method)
+	}
+
+	if existing := *ptr; existing != nil {
+		return errors.Errorf("path item already contains %q operation", method)
+	}
+	*ptr = op
+
+	return nil
+}
+
 func (e *expander) Server(s openapi.Server) (expanded ogen.Server, err error) {
 	expanded.Description = s.Description
 
@@ -304,6 +316,63 @@ func (e *expander) Operation(op *openapi.Operation) (expanded *ogen.Operation, e
 		return nil, errors.Wrap(err, "expand responses")
 	}
 
+	expanded.Callbacks, err = e.Callbacks(op.Callbacks)
+	if err != nil {
+		return nil, errors.Wrap(err, "expand callbacks")
+	}
+
+	return expanded, nil
+}
+
+func (e *expander) Callbacks(callbacks []*openapi.Callback) (expanded map[string]*ogen.Callback, err error) {
+	if len(callbacks) == 0 {
+		return nil, nil
+	}
+
+	expanded = make(map[string]*ogen.Callback, len(callbacks))
+	for _, cb := range callbacks {
+		expanded[cb.Name], err = e.Callback(cb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "expand callback %q", cb.Name)
+		}
+	}
+	return expanded, nil
+}
+
+func (e *expander) Callback(cb *openapi.Callback) (expanded *ogen.Callback, err error) {
+	expanded = &ogen.Callback{}
+	if ref := cb.Ref; !ref.IsZero() {
+		localRef, name, err := e.generateComponentLocalRef("#/components/callbacks/", ref, cb.Pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		ref := &ogen.Callback{"$ref": {Ref: localRef}}
+		m := e.components.Callbacks
+		if _, ok := m[name]; !ok {
+			m[name] = expanded
+			defer func() {
+				expanded = ref
+			}()
+		} else {
+			return ref, nil
+		}
+	}
+
+	for _, p := range cb.Paths {
+		pi := e.PathItem()
+		for _, op := range p.Operations {
+			expandedOp, err := e.Operation(op)
+			if err != nil {
+				return nil, errors.Wrapf(err, "expand operation %s %s", op.HTTPMethod, p.Expression)
+			}
+			if err := setOperation(pi, op.HTTPMethod, expandedOp); err != nil {
+				return nil, err
+			}
+		}
+		(*expanded)[p.Expression.String()] = pi
+	}
+
 	return expanded, nil
 }
 
@@ -485,9 +554,88 @@ func (e *expander) Response(resp *openapi.Response) (expanded *ogen.Response, er
 		return nil, errors.Wrap(err, "expand headers")
 	}
 
+	expanded.Links, err = e.Links(resp.Links)
+	if err != nil {
+		return nil, errors.Wrap(err, "expand links")
+	}
+
 	return expanded, nil
 }
 
+func (e *expander) Links(links []*openapi.Link) (expanded map[string]*ogen.Link, err error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	expanded = make(map[string]*ogen.Link, len(links))
+	for _, l := range links {
+		expanded[l.Name], err = e.Link(l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "expand link %q", l.Name)
+		}
+	}
+
+	return expanded, nil
+}
+
+func (e *expander) Link(l *openapi.Link) (expanded *ogen.Link, err error) {
+	expanded = new(ogen.Link)
+	if ref := l.Ref; !ref.IsZero() {
+		localRef, name, err := e.generateComponentLocalRef("#/components/links/", ref, l.Pointer)
+		if err != nil {
+			return nil, err
+		}
+
+		ref := &ogen.Link{Ref: localRef}
+		m := e.components.Links
+		if _, ok := m[name]; !ok {
+			m[name] = expanded
+			defer func() {
+				expanded = ref
+			}()
+		} else {
+			return ref, nil
+		}
+	}
+
+	expanded.Description = l.Description
+	expanded.OperationRef = l.OperationRef
+	expanded.OperationID = l.OperationID
+
+	for _, p := range l.Parameters {
+		name := p.Name
+		if p.In != "" {
+			name = p.In.String() + "." + name
+		}
+
+		v, err := e.LinkValue(p.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "expand parameter %q", name)
+		}
+
+		if expanded.Parameters == nil {
+			expanded.Parameters = map[string]ogen.RawValue{}
+		}
+		expanded.Parameters[name] = v
+	}
+
+	if body := l.RequestBody; body != nil {
+		expanded.RequestBody, err = e.LinkValue(*body)
+		if err != nil {
+			return nil, errors.Wrap(err, "expand requestBody")
+		}
+	}
+
+	return expanded, nil
+}
+
+func (e *expander) LinkValue(v openapi.LinkValue) (ogen.RawValue, error) {
+	if expr := v.Expression; expr != nil {
+		return json.Marshal(expr.Raw)
+	}
+	return json.Marshal(v.Value)
+}
+
 func (e *expander) Headers(headers map[string]*openapi.Header) (expanded map[string]*ogen.Header, err error) {
 	expanded = make(map[string]*ogen.Header, len(headers))
 
@@ -542,6 +690,11 @@ func (e *expander) Media(media *openapi.MediaType) (expanded ogen.Media, err err
 		return expanded, errors.Wrap(err, "expand schema")
 	}
 
+	expanded.ItemSchema, err = e.Schema(media.ItemSchema, nil)
+	if err != nil {
+		return expanded, errors.Wrap(err, "expand itemSchema")
+	}
+
 	if encodings := media.Encoding; len(encodings) > 0 {
 		expanded.Encoding = make(map[string]ogen.Encoding, len(encodings))
 		for name, encoding := range encodings {
@@ -636,6 +789,43 @@ func (e *expander) Schema(schema *jsonschema.Schema, walked map[*jsonschema.Sche
 		return nil, errors.Wrap(err, "expand allOf")
 	}
 
+	for _, c := range []struct {
+		name   string
+		schema *jsonschema.Schema
+		to     **ogen.Schema
+	}{
+		{"not", schema.Not, &expanded.Not},
+		{"if", schema.If, &expanded.If},
+		{"then", schema.Then, &expanded.Then},
+		{"else", schema.Else, &expanded.Else},
+		{"contentSchema", schema.ContentSchema, &expanded.ContentSchema},
+	} {
+		if c.schema == nil {
+			continue
+		}
+		*c.to, err = e.Schema(c.schema, walked)
+		if err != nil {
+			return nil, errors.Wrapf(err, "expand %s", c.name)
+		}
+	}
+
+	if dr := schema.DependentRequired; len(dr) > 0 {
+		expanded.DependentRequired = make(map[string][]string, len(dr))
+		for prop, required := range dr {
+			expanded.DependentRequired[prop] = slices.Clone(required)
+		}
+	}
+
+	if ds := schema.DependentSchemas; len(ds) > 0 {
+		expanded.DependentSchemas = make(map[string]*ogen.Schema, len(ds))
+		for prop, dep := range ds {
+			expanded.DependentSchemas[prop], err = e.Schema(dep, walked)
+			if err != nil {
+				return nil, errors.Wrapf(err, "expand dependentSchemas %q", prop)
+			}
+		}
+	}
+
 	if enum := schema.Enum; len(enum) > 0 {
 		expanded.Enum = make(jsonschema.Enum, len(enum))
 		for i, e := range enum {
@@ -691,6 +881,26 @@ func (e *expander) Schema(schema *jsonschema.Schema, walked map[*jsonschema.Sche
 			expanded.AdditionalProperties = expandedAp
 		}
 
+		if up := schema.UnevaluatedProperties; up != nil {
+			expandedUp := &ogen.AdditionalProperties{}
+
+			if item := schema.UnevaluatedItem; item != nil {
+				s, err := e.Schema(item, walked)
+				if err != nil {
+					return nil, errors.Wrap(err, "expand unevaluatedProperties")
+				}
+				if s != nil {
+					expandedUp.Schema = *s
+				}
+			} else {
+				v := new(bool)
+				*v = *up
+				expandedUp.Bool = v
+			}
+
+			expanded.UnevaluatedProperties = expandedUp
+		}
+
 		if patternProps := schema.PatternProperties; len(patternProps) > 0 {
 			expanded.PatternProperties = make(ogen.PatternProperties, len(patternProps))
 			for i, prop := range patternProps {
@@ -715,6 +925,18 @@ func (e *expander) Schema(schema *jsonschema.Schema, walked map[*jsonschema.Sche
 		if err != nil {
 			return nil, errors.Wrap(err, "expand items")
 		}
+		if len(schema.Items) > 0 {
+			expanded.PrefixItems, err = e.Schemas(schema.Items, walked)
+			if err != nil {
+				return nil, errors.Wrap(err, "expand prefixItems")
+			}
+			if item == nil {
+				// Tuple without additional items.
+				noItems := false
+				expanded.Items = &ogen.Items{Bool: &noItems}
+				break
+			}
+		}
 		expanded.Items = &ogen.Items{
 			Item: item,
 		}
@@ -30,3 +30,56 @@ func TestExpand(t *testing.T) {
 	require.Empty(t, expandSpec.Info.Summary)
 	require.Len(t, expandSpec.Tags, 3)
 }
+
+func TestExpandCallbacks(t *testing.T) {
+	a := require.New(t)
+
+	f, err := os.ReadFile("_testdata/expand/callbacks.yaml")
+	a.NoError(err)
+
+	spec, err := ogen.Parse(f)
+	a.NoError(err)
+
+	api, err := parser.Parse(spec, parser.Settings{})
+	a.NoError(err)
+
+	expandSpec, err := parser.Expand(api)
+	a.NoError(err)
+
+	op := expandSpec.Paths["/subscribe"].Post
+	a.Len(op.Callbacks, 2)
+	a.Equal("#/components/callbacks/OnEvent", op.Callbacks["onEvent"].Ref())
+	a.Contains(*op.Callbacks["onStatus"], "{$request.query.statusUrl}/status")
+
+	component := expandSpec.Components.Callbacks["OnEvent"]
+	a.NotNil(component)
+	a.Equal("onEvent", (*component)["$request.body#/url"].Post.OperationID)
+}
+
+func TestExpandLinks(t *testing.T) {
+	a := require.New(t)
+
+	f, err := os.ReadFile("_testdata/expand/links.yaml")
+	a.NoError(err)
+
+	spec, err := ogen.Parse(f)
+	a.NoError(err)
+
+	api, err := parser.Parse(spec, parser.Settings{})
+	a.NoError(err)
+
+	expandSpec, err := parser.Expand(api)
+	a.NoError(err)
+
+	links := expandSpec.Paths["/users"].Post.Responses["201"].Links
+	a.Len(links, 2)
+	a.Equal("#/components/links/GetUser", links["GetUser"].Ref)
+	a.Equal("#/paths/~1users/get", links["ListUsers"].OperationRef)
+	a.JSONEq(`10`, string(links["ListUsers"].Parameters["query.limit"]))
+
+	component := expandSpec.Components.Links["GetUser"]
+	a.NotNil(component)
+	a.Equal("getUser", component.OperationID)
+	a.Equal("Get created user.", component.Description)
+	a.JSONEq(`"$response.body#/id"`, string(component.Parameters["id"]))
+}
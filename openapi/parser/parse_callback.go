@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/internal/xmaps"
+	"github.com/ogen-go/ogen/jsonpointer"
+	"github.com/ogen-go/ogen/location"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (p *parser) parseCallbacks(
+	callbacks map[string]*ogen.Callback,
+	locator location.Locator,
+	ctx *jsonpointer.ResolveCtx,
+) ([]*openapi.Callback, error) {
+	if len(callbacks) == 0 {
+		return nil, nil
+	}
+
+	r := make([]*openapi.Callback, 0, len(callbacks))
+	for _, name := range xmaps.SortedKeys(callbacks) {
+		locator := locator.Field(name)
+
+		cb, err := p.parseCallback(name, callbacks[name], locator, ctx)
+		if err != nil {
+			err := errors.Wrapf(err, "callback %q", name)
+			return nil, p.wrapLocation(p.file(ctx), locator, err)
+		}
+		r = append(r, cb)
+	}
+	return r, nil
+}
+
+func (p *parser) parseCallback(
+	name string,
+	cb *ogen.Callback,
+	locator location.Locator,
+	ctx *jsonpointer.ResolveCtx,
+) (*openapi.Callback, error) {
+	if cb == nil {
+		return nil, errors.New("callback object is empty or null")
+	}
+	c := &openapi.Callback{
+		Name:    name,
+		Pointer: locator.Pointer(p.file(ctx)),
+	}
+
+	if ref := cb.Ref(); ref != "" {
+		key, paths, err := p.resolveCallback(ref, ctx)
+		if err != nil {
+			return nil, p.wrapRef(p.file(ctx), locator, err)
+		}
+		c.Ref = key
+		c.Paths = paths
+		return c, nil
+	}
+
+	paths, err := p.parseCallbackPaths(cb, ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.Paths = paths
+	return c, nil
+}
+
+func (p *parser) parseCallbackPaths(cb *ogen.Callback, ctx *jsonpointer.ResolveCtx) ([]*openapi.CallbackPath, error) {
+	if cb == nil || len(*cb) == 0 {
+		return nil, errors.New("callback object is empty or null")
+	}
+
+	paths := make([]*openapi.CallbackPath, 0, len(*cb))
+	for _, key := range xmaps.SortedKeys(*cb) {
+		item := (*cb)[key]
+		if item == nil {
+			return nil, errors.Errorf("callback %q: pathItem object is empty or null", key)
+		}
+
+		expr, err := openapi.ParseExpression(key)
+		if err != nil {
+			err := errors.Wrapf(err, "parse expression %q", key)
+			return nil, p.wrapLocation(p.file(ctx), item.Common.Locator, err)
+		}
+
+		// Callback URL is computed at runtime, so there is no path to parse.
+		ops, err := p.parsePathItem(unparsedPath{path: "/"}, item, ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "callback %q", key)
+		}
+
+		paths = append(paths, &openapi.CallbackPath{
+			Expression: expr,
+			Operations: ops,
+			Pointer:    item.Common.Pointer(p.file(ctx)),
+		})
+	}
+	return paths, nil
+}
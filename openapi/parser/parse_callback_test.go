@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func TestParseCallbacks(t *testing.T) {
+	a := require.New(t)
+
+	root, err := ogen.Parse([]byte(`openapi: 3.1.0
+info:
+  title: title
+  version: v0.1.0
+paths:
+  /subscribe:
+    post:
+      operationId: subscribe
+      responses:
+        "200":
+          description: Ok
+      callbacks:
+        onEvent:
+          $ref: "#/components/callbacks/OnEvent"
+        onStatus:
+          "{$request.query.statusUrl}/status":
+            post:
+              operationId: onStatus
+              responses:
+                "200":
+                  description: Ok
+  /resubscribe:
+    post:
+      operationId: resubscribe
+      responses:
+        "200":
+          description: Ok
+      callbacks:
+        onEvent:
+          $ref: "#/components/callbacks/OnEvent"
+components:
+  callbacks:
+    OnEvent:
+      "$request.body#/url":
+        post:
+          operationId: onEvent
+          responses:
+            "200":
+              description: Ok
+`))
+	a.NoError(err)
+
+	spec, err := Parse(root, Settings{
+		RootURL: testRootURL,
+	})
+	a.NoError(err)
+	a.Len(spec.Operations, 2)
+
+	ops := map[string]*openapi.Operation{}
+	for _, op := range spec.Operations {
+		ops[op.OperationID] = op
+	}
+
+	subscribe := ops["subscribe"]
+	a.Len(subscribe.Callbacks, 2)
+	{
+		cb := subscribe.Callbacks[0]
+		a.Equal("onEvent", cb.Name)
+		a.Equal("#/components/callbacks/OnEvent", cb.Ref.Ptr)
+		a.Len(cb.Paths, 1)
+		a.Equal([]openapi.ExpressionPart{
+			{Expr: &openapi.RuntimeExpression{
+				Source:  openapi.ExpressionRequest,
+				Body:    true,
+				Pointer: "/url",
+			}},
+		}, cb.Paths[0].Expression.Parts)
+		a.Len(cb.Paths[0].Operations, 1)
+		a.Equal("onEvent", cb.Paths[0].Operations[0].OperationID)
+	}
+	{
+		cb := subscribe.Callbacks[1]
+		a.Equal("onStatus", cb.Name)
+		a.True(cb.Ref.IsZero())
+		a.Len(cb.Paths, 1)
+		a.Equal("{$request.query.statusUrl}/status", cb.Paths[0].Expression.String())
+		a.Len(cb.Paths[0].Expression.Parts, 2)
+		a.Equal("onStatus", cb.Paths[0].Operations[0].OperationID)
+	}
+
+	// Referenced callback must be parsed only once.
+	resubscribe := ops["resubscribe"]
+	a.Len(resubscribe.Callbacks, 1)
+	a.Equal(subscribe.Callbacks[0].Paths, resubscribe.Callbacks[0].Paths)
+	a.Same(subscribe.Callbacks[0].Paths[0], resubscribe.Callbacks[0].Paths[0])
+}
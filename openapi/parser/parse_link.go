@@ -0,0 +1,217 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/internal/xmaps"
+	"github.com/ogen-go/ogen/jsonpointer"
+	"github.com/ogen-go/ogen/jsonschema"
+	"github.com/ogen-go/ogen/location"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (p *parser) parseLinks(
+	links map[string]*ogen.Link,
+	locator location.Locator,
+	ctx *jsonpointer.ResolveCtx,
+) ([]*openapi.Link, error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	r := make([]*openapi.Link, 0, len(links))
+	for _, name := range xmaps.SortedKeys(links) {
+		link, err := p.parseLinkRef(name, links[name], ctx)
+		if err != nil {
+			err := errors.Wrapf(err, "link %q", name)
+			return nil, p.wrapLocation(p.file(ctx), locator.Field(name), err)
+		}
+		// Linked operation is resolved after all operations are parsed.
+		p.links = append(p.links, link)
+		r = append(r, link)
+	}
+	return r, nil
+}
+
+func (p *parser) parseLinkRef(name string, link *ogen.Link, ctx *jsonpointer.ResolveCtx) (*openapi.Link, error) {
+	if link == nil {
+		return nil, errors.New("link object is empty or null")
+	}
+
+	if ref := link.Ref; ref != "" {
+		resolved, err := p.resolveLink(ref, ctx)
+		if err != nil {
+			return nil, p.wrapRef(p.file(ctx), link.Common.Locator, err)
+		}
+		// Component may be used under different names.
+		l := *resolved
+		l.Name = name
+		return &l, nil
+	}
+
+	l, err := p.parseLink(link, ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.Name = name
+	return l, nil
+}
+
+func (p *parser) parseLink(link *ogen.Link, ctx *jsonpointer.ResolveCtx) (_ *openapi.Link, rerr error) {
+	if link == nil {
+		return nil, errors.New("link object is empty or null")
+	}
+	locator := link.Common.Locator
+	defer func() {
+		rerr = p.wrapLocation(p.file(ctx), locator, rerr)
+	}()
+
+	switch {
+	case link.OperationRef != "" && link.OperationID != "":
+		return nil, errors.New("operationRef and operationId are mutually exclusive")
+	case link.OperationRef == "" && link.OperationID == "":
+		return nil, errors.New("operationRef or operationId must be specified")
+	}
+
+	l := &openapi.Link{
+		Description:  link.Description,
+		OperationRef: link.OperationRef,
+		OperationID:  link.OperationID,
+		Pointer:      locator.Pointer(p.file(ctx)),
+	}
+
+	for _, name := range xmaps.SortedKeys(link.Parameters) {
+		locator := locator.Field("parameters").Field(name)
+
+		value, err := parseLinkValue(link.Parameters[name])
+		if err != nil {
+			err := errors.Wrapf(err, "parameter %q", name)
+			return nil, p.wrapLocation(p.file(ctx), locator, err)
+		}
+
+		param := &openapi.LinkParameter{
+			Name:    name,
+			Value:   value,
+			Pointer: locator.Pointer(p.file(ctx)),
+		}
+		// Parameter name may be qualified with location, e.g. "path.id".
+		if in, paramName, ok := strings.Cut(name, "."); ok {
+			switch loc := openapi.ParameterLocation(in); loc {
+			case openapi.LocationPath,
+				openapi.LocationQuery,
+				openapi.LocationHeader,
+				openapi.LocationCookie:
+				param.In, param.Name = loc, paramName
+			}
+		}
+		l.Parameters = append(l.Parameters, param)
+	}
+
+	if body := link.RequestBody; len(body) > 0 {
+		value, err := parseLinkValue(body)
+		if err != nil {
+			err := errors.Wrap(err, "requestBody")
+			return nil, p.wrapField("requestBody", p.file(ctx), locator, err)
+		}
+		l.RequestBody = &value
+	}
+
+	return l, nil
+}
+
+func parseLinkValue(raw ogen.RawValue) (v openapi.LinkValue, _ error) {
+	val, err := jsonschema.ParseJSONValue(json.RawMessage(raw))
+	if err != nil {
+		return v, err
+	}
+
+	if s, ok := val.(string); ok && (strings.HasPrefix(s, "$") || strings.Contains(s, "{$")) {
+		expr, err := openapi.ParseExpression(s)
+		if err != nil {
+			return v, errors.Wrapf(err, "parse expression %q", s)
+		}
+		v.Expression = &expr
+		return v, nil
+	}
+	v.Value = val
+	return v, nil
+}
+
+var pointerEscaper = strings.NewReplacer(
+	"~", "~0",
+	"/", "~1",
+)
+
+// resolveLinks sets linked operations of parsed links.
+func (p *parser) resolveLinks() error {
+	if len(p.links) == 0 {
+		return nil
+	}
+
+	var (
+		byID  = map[string]*openapi.Operation{}
+		byRef = map[string]*openapi.Operation{}
+	)
+	for _, op := range p.operations {
+		if id := op.OperationID; id != "" {
+			byID[id] = op
+		}
+		ref := fmt.Sprintf("#/paths/%s/%s",
+			pointerEscaper.Replace(op.Path.String()),
+			strings.ToLower(op.HTTPMethod),
+		)
+		byRef[ref] = op
+	}
+
+	for _, l := range p.links {
+		file := l.Pointer.File()
+
+		var op *openapi.Operation
+		if id := l.OperationID; id != "" {
+			found, ok := byID[id]
+			if !ok {
+				err := errors.Errorf("link %q: operation %q not found", l.Name, id)
+				return p.wrapLocation(file, l.Pointer.Locator.Field("operationId"), err)
+			}
+			op = found
+		} else {
+			found, ok := byRef[l.OperationRef]
+			if !ok {
+				if strings.HasPrefix(l.OperationRef, "#/") {
+					err := errors.Errorf("link %q: operation %q not found", l.Name, l.OperationRef)
+					return p.wrapLocation(file, l.Pointer.Locator.Field("operationRef"), err)
+				}
+				// Only local references to paths are supported.
+				continue
+			}
+			op = found
+		}
+
+		target := l.OperationID
+		if target == "" {
+			target = l.OperationRef
+		}
+		for _, param := range l.Parameters {
+			if !hasLinkParameter(op, param) {
+				err := errors.Errorf("link %q: operation %q has no parameter %q", l.Name, target, param.Name)
+				return p.wrapLocation(file, param.Pointer.Locator, err)
+			}
+		}
+		l.Operation = op
+	}
+	return nil
+}
+
+func hasLinkParameter(op *openapi.Operation, param *openapi.LinkParameter) bool {
+	for _, p := range op.Parameters {
+		if p.Name == param.Name && (param.In == "" || p.In == param.In) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func TestParseLinks(t *testing.T) {
+	a := require.New(t)
+
+	root, err := ogen.Parse([]byte(`openapi: 3.1.0
+info:
+  title: title
+  version: v0.1.0
+paths:
+  /users:
+    post:
+      operationId: createUser
+      responses:
+        "201":
+          description: Created
+          links:
+            GetUser:
+              $ref: "#/components/links/GetUser"
+            ListUsers:
+              operationRef: "#/paths/~1users/get"
+              parameters:
+                query.limit: 10
+                after: "{$response.header.X-Next}"
+            External:
+              operationRef: "https://example.com/openapi.yml#/paths/~1users/get"
+    get:
+      operationId: listUsers
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+        - name: after
+          in: query
+          schema:
+            type: string
+      responses:
+        "200":
+          description: Ok
+          links:
+            Self:
+              $ref: "#/components/links/GetUser"
+  /users/{id}:
+    get:
+      operationId: getUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: Ok
+components:
+  links:
+    GetUser:
+      operationId: getUser
+      parameters:
+        id: $response.body#/id
+`))
+	a.NoError(err)
+
+	spec, err := Parse(root, Settings{
+		RootURL: testRootURL,
+	})
+	a.NoError(err)
+
+	ops := map[string]*openapi.Operation{}
+	for _, op := range spec.Operations {
+		ops[op.OperationID] = op
+	}
+
+	links := ops["createUser"].Responses.StatusCode[201].Links
+	a.Len(links, 3)
+	{
+		l := links[0]
+		a.Equal("External", l.Name)
+		// External operations are not resolved.
+		a.Nil(l.Operation)
+	}
+	{
+		l := links[1]
+		a.Equal("GetUser", l.Name)
+		a.Equal("#/components/links/GetUser", l.Ref.Ptr)
+		a.Same(ops["getUser"], l.Operation)
+		a.Len(l.Parameters, 1)
+
+		p := l.Parameters[0]
+		a.Equal("id", p.Name)
+		a.Empty(p.In)
+		a.False(p.Value.IsConstant())
+		a.Equal([]openapi.ExpressionPart{
+			{Expr: &openapi.RuntimeExpression{
+				Source:  openapi.ExpressionResponse,
+				Body:    true,
+				Pointer: "/id",
+			}},
+		}, p.Value.Expression.Parts)
+	}
+	{
+		l := links[2]
+		a.Equal("ListUsers", l.Name)
+		a.Same(ops["listUsers"], l.Operation)
+		a.Len(l.Parameters, 2)
+
+		after := l.Parameters[0]
+		a.Equal("after", after.Name)
+		a.Equal("{$response.header.X-Next}", after.Value.Expression.String())
+
+		limit := l.Parameters[1]
+		a.Equal("limit", limit.Name)
+		a.Equal(openapi.LocationQuery, limit.In)
+		a.True(limit.Value.IsConstant())
+		a.Equal(int64(10), limit.Value.Value)
+	}
+
+	// Referenced link may be used under a different name.
+	self := ops["listUsers"].Responses.StatusCode[200].Links
+	a.Len(self, 1)
+	a.Equal("Self", self[0].Name)
+	a.Same(ops["getUser"], self[0].Operation)
+}
@@ -99,6 +99,17 @@ func (p *parser) parseMediaType(ct string, m ogen.Media, ctx *jsonpointer.Resolv
 		return nil, errors.Wrap(err, "schema")
 	}
 
+	var itemSchema *jsonschema.Schema
+	if m.ItemSchema != nil {
+		if err := p.requireMinorVersion("itemSchema", 2); err != nil {
+			return nil, p.wrapField("itemSchema", p.file(ctx), locator, err)
+		}
+		itemSchema, err = p.parseSchema(m.ItemSchema, ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "itemSchema")
+		}
+	}
+
 	encodings := make(map[string]*openapi.Encoding, len(m.Encoding))
 	if len(m.Encoding) > 0 {
 		switch ct {
@@ -226,6 +237,13 @@ func (p *parser) parseMediaType(ct string, m ogen.Media, ctx *jsonpointer.Resolv
 				err := errors.Errorf("unknown SSE event shape %q", value)
 				return nil, p.wrapField(extensionName, p.file(ctx), locator, err)
 			}
+			if sseShape == openapi.SSEEventShapeFullArray && itemSchema != nil {
+				err := errors.Errorf("SSE event shape %q cannot be used with itemSchema", sseShape)
+				return nil, p.wrapField(extensionName, p.file(ctx), locator, err)
+			}
+		} else if ct == "text/event-stream" && !rawResponse && itemSchema != nil {
+			// Item schema of the event stream describes the full event.
+			sseShape = openapi.SSEEventShapeFull
 		} else if ct == "text/event-stream" && !rawResponse && !isBinaryStreamSchema(s) {
 			// Do not auto-enable SSE for raw byte stream schemas: the
 			// generator lowers them to io.Reader, which was the only way
@@ -236,6 +254,7 @@ func (p *parser) parseMediaType(ct string, m ogen.Media, ctx *jsonpointer.Resolv
 
 	return &openapi.MediaType{
 		Schema:             s,
+		ItemSchema:         itemSchema,
 		Example:            json.RawMessage(m.Example),
 		Examples:           examples,
 		Encoding:           encodings,
@@ -46,6 +46,35 @@ func mergeParams(opParams, itemParams []*openapi.Parameter) []*openapi.Parameter
 	return opParams
 }
 
+// validateQueryString checks that operation has at most one querystring parameter
+// and does not mix it with query parameters.
+func (p *parser) validateQueryString(params []*openapi.Parameter) error {
+	var queryString, query *openapi.Parameter
+	for _, param := range params {
+		switch param.In {
+		case openapi.LocationQueryString:
+			if queryString != nil {
+				me := new(location.MultiError)
+				me.Report(queryString.File(), queryString.Locator, "operation MUST NOT have more than one querystring parameter")
+				me.Report(param.File(), param.Locator, "")
+				return me
+			}
+			queryString = param
+		case openapi.LocationQuery:
+			if query == nil {
+				query = param
+			}
+		}
+	}
+	if queryString != nil && query != nil {
+		me := new(location.MultiError)
+		me.Report(queryString.File(), queryString.Locator, "querystring parameter MUST NOT be used with query parameters")
+		me.Report(query.File(), query.Locator, "")
+		return me
+	}
+	return nil
+}
+
 func (p *parser) parseParams(
 	params []*ogen.Parameter,
 	locator location.Locator,
@@ -115,6 +144,23 @@ func (p *parser) validateParameter(
 		return p.wrapField("content", file, locator, err)
 	}
 
+	if locatedIn == openapi.LocationQueryString {
+		if err := p.requireMinorVersion("querystring parameter", 2); err != nil {
+			return p.wrapField("in", file, locator, err)
+		}
+		switch {
+		case param.Schema != nil:
+			err := errors.New("querystring parameter MUST use content property")
+			return p.wrapField("schema", file, locator, err)
+		case param.Style != "":
+			err := errors.New("querystring parameter MUST NOT use style")
+			return p.wrapField("style", file, locator, err)
+		case param.Explode != nil:
+			err := errors.New("querystring parameter MUST NOT use explode")
+			return p.wrapField("explode", file, locator, err)
+		}
+	}
+
 	// Path parameters are always required.
 	switch locatedIn {
 	case openapi.LocationPath:
@@ -157,6 +203,8 @@ func (p *parser) parseParameter(param *ogen.Parameter, ctx *jsonpointer.ResolveC
 		"header": openapi.LocationHeader,
 		"path":   openapi.LocationPath,
 		"cookie": openapi.LocationCookie,
+
+		"querystring": openapi.LocationQueryString,
 	}
 
 	locatedIn, exists := types[strings.ToLower(param.In)]
@@ -283,9 +331,9 @@ func (p *parser) validateParamStyle(param *openapi.Parameter, file location.File
 			{openapi.QueryStyleForm, true}:            primitive | array | object,
 			{openapi.QueryStyleForm, false}:           primitive | array | object,
 			{openapi.QueryStyleSpaceDelimited, true}:  array,
-			{openapi.QueryStyleSpaceDelimited, false}: array,
+			{openapi.QueryStyleSpaceDelimited, false}: array | object,
 			{openapi.QueryStylePipeDelimited, true}:   array,
-			{openapi.QueryStylePipeDelimited, false}:  array,
+			{openapi.QueryStylePipeDelimited, false}:  array | object,
 			{openapi.QueryStyleDeepObject, true}:      object,
 		},
 		openapi.LocationHeader: {
@@ -301,6 +349,11 @@ func (p *parser) validateParamStyle(param *openapi.Parameter, file location.File
 		return p.wrapField(field, file, param.Locator, err)
 	}
 
+	if param.In.QueryString() {
+		// Query string is serialized according to the content media type.
+		return nil
+	}
+
 	styles, ok := table[param.In]
 	if !ok {
 		return wrap("in", errors.Errorf(`invalid "in": %q`, param.In))
@@ -159,6 +159,8 @@ func TestParameterExplodeDefault(t *testing.T) {
 		{"SpaceDelimited", "spaceDelimited", nil, stringArraySchema(), false},
 		{"PipeDelimited", "pipeDelimited", nil, stringArraySchema(), false},
 		{"DeepObject", "deepObject", nil, object, true},
+		{"SpaceDelimitedObject", "spaceDelimited", nil, object, false},
+		{"PipeDelimitedObject", "pipeDelimited", nil, object, false},
 		{"SpaceDelimitedExplodeTrue", "spaceDelimited", explodePtr(true), stringArraySchema(), true},
 		{"PipeDelimitedExplodeTrue", "pipeDelimited", explodePtr(true), stringArraySchema(), true},
 		{"FormExplodeFalse", "form", explodePtr(false), stringArraySchema(), false},
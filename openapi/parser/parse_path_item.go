@@ -26,6 +26,8 @@ type (
 
 const (
 	xOgenOperationGroup = "x-ogen-operation-group"
+	xOgenMaxBodySize    = "x-ogen-max-body-size"
+	xOgenRetry          = "x-ogen-retry"
 )
 
 func (up unparsedPath) String() string {
@@ -154,6 +156,26 @@ func (p *parser) parseOp(
 		return nil, errors.Wrap(err, xOgenOperationGroup)
 	}
 
+	if ex, ok := spec.Common.Extensions[xOgenMaxBodySize]; ok {
+		if err := ex.Decode(&op.XOgenMaxBodySize); err != nil {
+			err := errors.Wrap(err, "unmarshal value")
+			return nil, p.wrapField(xOgenMaxBodySize, p.file(ctx), locator, err)
+		}
+		if op.XOgenMaxBodySize <= 0 {
+			err := errors.Errorf("body size limit must be positive, got %d", op.XOgenMaxBodySize)
+			return nil, p.wrapField(xOgenMaxBodySize, p.file(ctx), locator, err)
+		}
+	}
+
+	if ex, ok := spec.Common.Extensions[xOgenRetry]; ok {
+		var retry bool
+		if err := ex.Decode(&retry); err != nil {
+			err := errors.Wrap(err, "unmarshal value")
+			return nil, p.wrapField(xOgenRetry, p.file(ctx), locator, err)
+		}
+		op.XOgenRetry = &retry
+	}
+
 	opParams, err := p.parseParams(spec.Parameters, locator.Field("parameters"), ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "parameters")
@@ -161,6 +183,9 @@ func (p *parser) parseOp(
 
 	// Merge operation parameters with pathItem parameters.
 	op.Parameters = mergeParams(opParams, itemParams)
+	if err := p.validateQueryString(op.Parameters); err != nil {
+		return nil, errors.Wrap(err, "parameters")
+	}
 
 	op.Path, err = parsePath(up.path, op.Parameters)
 	if err != nil {
@@ -190,6 +215,15 @@ func (p *parser) parseOp(
 		}
 	}
 
+	{
+		locator := locator.Field("callbacks")
+		op.Callbacks, err = p.parseCallbacks(spec.Callbacks, locator, ctx)
+		if err != nil {
+			err := errors.Wrap(err, "callbacks")
+			return nil, p.wrapLocation(p.file(ctx), locator, err)
+		}
+	}
+
 	parseSecurity := func(spec ogen.SecurityRequirements, locator location.Locator) (err error) {
 		op.Security, err = p.parseSecurityRequirements(spec, locator, ctx)
 		if err != nil {
@@ -321,3 +321,86 @@ func TestDuplicatePathsSameMethod(t *testing.T) {
 	a.Error(err)
 	a.Contains(err.Error(), "duplicate path")
 }
+
+func TestXOgenMaxBodySize(t *testing.T) {
+	root := &ogen.Spec{
+		OpenAPI: "3.0.3",
+		Paths: map[string]*ogen.PathItem{
+			"/users": {
+				Post: &ogen.Operation{
+					Common:      extensionValue(xOgenMaxBodySize, "1048576"),
+					OperationID: "userCreate",
+					Responses: map[string]*ogen.Response{
+						"200": {},
+					},
+				},
+				Get: &ogen.Operation{
+					OperationID: "userList",
+					Responses: map[string]*ogen.Response{
+						"200": {},
+					},
+				},
+			},
+		},
+	}
+
+	spec, err := Parse(root, Settings{
+		RootURL: testRootURL,
+	})
+	require.NoError(t, err)
+
+	limits := map[string]int64{}
+	for _, op := range spec.Operations {
+		limits[op.OperationID] = op.XOgenMaxBodySize
+	}
+	require.Equal(t, map[string]int64{
+		"userList":   0,
+		"userCreate": 1 << 20,
+	}, limits)
+}
+
+func TestXOgenRetry(t *testing.T) {
+	root := &ogen.Spec{
+		OpenAPI: "3.0.3",
+		Paths: map[string]*ogen.PathItem{
+			"/users": {
+				Get: &ogen.Operation{
+					Common:      extensionValue(xOgenRetry, "false"),
+					OperationID: "userList",
+					Responses: map[string]*ogen.Response{
+						"200": {},
+					},
+				},
+				Put: &ogen.Operation{
+					Common:      extensionValue(xOgenRetry, "true"),
+					OperationID: "userUpdate",
+					Responses: map[string]*ogen.Response{
+						"200": {},
+					},
+				},
+				Post: &ogen.Operation{
+					OperationID: "userCreate",
+					Responses: map[string]*ogen.Response{
+						"200": {},
+					},
+				},
+			},
+		},
+	}
+
+	spec, err := Parse(root, Settings{
+		RootURL: testRootURL,
+	})
+	require.NoError(t, err)
+
+	retry := map[string]*bool{}
+	for _, op := range spec.Operations {
+		retry[op.OperationID] = op.XOgenRetry
+	}
+	no, yes := false, true
+	require.Equal(t, map[string]*bool{
+		"userList":   &no,
+		"userUpdate": &yes,
+		"userCreate": nil,
+	}, retry)
+}
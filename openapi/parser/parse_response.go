@@ -64,10 +64,17 @@ func (p *parser) parseResponse(resp *ogen.Response, ctx *jsonpointer.ResolveCtx)
 		return nil, p.wrapField("headers", p.file(ctx), locator, err)
 	}
 
+	links, err := p.parseLinks(resp.Links, locator.Field("links"), ctx)
+	if err != nil {
+		err := errors.Wrap(err, "links")
+		return nil, p.wrapField("links", p.file(ctx), locator, err)
+	}
+
 	return &openapi.Response{
 		Description: resp.Description,
 		Headers:     headers,
 		Content:     content,
+		Links:       links,
 		Pointer:     locator.Pointer(p.file(ctx)),
 	}, nil
 }
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"github.com/go-faster/errors"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/openapi"
+)
+
+func (p *parser) parseTags(tags []ogen.Tag) ([]openapi.Tag, error) {
+	locator := p.rootLoc.Field("tags")
+
+	var (
+		result = make([]openapi.Tag, len(tags))
+		index  = make(map[string]int, len(tags))
+	)
+	for i, tag := range tags {
+		locator := locator.Index(i)
+		for _, field := range [...]struct {
+			name  string
+			value string
+		}{
+			{"summary", tag.Summary},
+			{"parent", tag.Parent},
+			{"kind", tag.Kind},
+		} {
+			if field.value == "" {
+				continue
+			}
+			if err := p.requireMinorVersion("tag "+field.name, 2); err != nil {
+				return nil, p.wrapField(field.name, p.rootFile, locator, err)
+			}
+		}
+
+		if _, ok := index[tag.Name]; !ok {
+			index[tag.Name] = i
+		}
+		result[i] = openapi.Tag{
+			Name:        tag.Name,
+			Summary:     tag.Summary,
+			Description: tag.Description,
+			Parent:      tag.Parent,
+			Kind:        tag.Kind,
+		}
+	}
+
+	for i, tag := range result {
+		if tag.Parent == "" {
+			continue
+		}
+		locator := locator.Index(i)
+
+		if _, ok := index[tag.Parent]; !ok {
+			err := errors.Errorf("parent tag %q not found", tag.Parent)
+			return nil, p.wrapField("parent", p.rootFile, locator, err)
+		}
+
+		visited := map[string]struct{}{
+			tag.Name: {},
+		}
+		for parent := tag.Parent; parent != ""; {
+			if _, ok := visited[parent]; ok {
+				err := errors.Errorf("circular tag hierarchy: tag %q is an ancestor of itself", tag.Name)
+				return nil, p.wrapField("parent", p.rootFile, locator, err)
+			}
+			visited[parent] = struct{}{}
+
+			idx, ok := index[parent]
+			if !ok {
+				// Reported by the tag referencing the missing parent.
+				break
+			}
+			parent = result[idx].Parent
+		}
+	}
+
+	return result, nil
+}
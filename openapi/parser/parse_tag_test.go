@@ -0,0 +1,34 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/openapi"
+	"github.com/ogen-go/ogen/openapi/parser"
+)
+
+func TestParseTags(t *testing.T) {
+	a := require.New(t)
+
+	api, err := parser.Parse(&ogen.Spec{
+		OpenAPI: "3.2.0",
+		Info: ogen.Info{
+			Title:   "test",
+			Version: "0.0.0",
+		},
+		Tags: []ogen.Tag{
+			{Name: "billing", Summary: "Billing", Kind: openapi.TagKindNav},
+			{Name: "invoices", Description: "Invoices.", Parent: "billing"},
+			{Name: "beta", Kind: openapi.TagKindBadge},
+		},
+	}, parser.Settings{})
+	a.NoError(err)
+	a.Equal([]openapi.Tag{
+		{Name: "billing", Summary: "Billing", Kind: "nav"},
+		{Name: "invoices", Description: "Invoices.", Parent: "billing"},
+		{Name: "beta", Kind: "badge"},
+	}, api.Tags)
+}
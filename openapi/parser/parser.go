@@ -39,7 +39,11 @@ type parser struct {
 		examples        map[refKey]*openapi.Example
 		securitySchemes map[refKey]*ogen.SecurityScheme
 		pathItems       map[refKey]pathItem
+		callbacks       map[refKey][]*openapi.CallbackPath
+		links           map[refKey]*openapi.Link
 	}
+	// links contains parsed links to resolve linked operations.
+	links []*openapi.Link
 	// securitySchemes contains security schemes defined in the root spec.
 	securitySchemes map[string]*ogen.SecurityScheme
 	// operationIDs holds operation IDs of already parsed operations.
@@ -70,6 +74,13 @@ func Parse(spec *ogen.Spec, s Settings) (_ *openapi.API, rerr error) {
 	spec.Init()
 
 	s.setDefaults()
+	if isSwagger2(spec) {
+		converted, err := convertSwagger2(spec, s.File)
+		if err != nil {
+			return nil, errors.Wrap(err, "convert Swagger 2.0")
+		}
+		spec = converted
+	}
 	p := &parser{
 		spec: spec,
 		refs: struct {
@@ -80,6 +91,8 @@ func Parse(spec *ogen.Spec, s Settings) (_ *openapi.API, rerr error) {
 			examples        map[refKey]*openapi.Example
 			securitySchemes map[refKey]*ogen.SecurityScheme
 			pathItems       map[refKey]pathItem
+			callbacks       map[refKey][]*openapi.CallbackPath
+			links           map[refKey]*openapi.Link
 		}{
 			requestBodies:   map[refKey]*openapi.RequestBody{},
 			responses:       map[refKey]*openapi.Response{},
@@ -88,6 +101,8 @@ func Parse(spec *ogen.Spec, s Settings) (_ *openapi.API, rerr error) {
 			examples:        map[refKey]*openapi.Example{},
 			securitySchemes: map[refKey]*ogen.SecurityScheme{},
 			pathItems:       map[refKey]pathItem{},
+			callbacks:       map[refKey][]*openapi.CallbackPath{},
+			links:           map[refKey]*openapi.Link{},
 		},
 		securitySchemes: maps.Clone(spec.Components.SecuritySchemes),
 		operationIDs:    map[string]location.Pointer{},
@@ -136,6 +151,10 @@ func Parse(spec *ogen.Spec, s Settings) (_ *openapi.API, rerr error) {
 		return nil, errors.Wrap(err, "parse path items")
 	}
 
+	if err := p.resolveLinks(); err != nil {
+		return nil, errors.Wrap(err, "resolve links")
+	}
+
 	servers, err := p.parseServers(p.spec.Servers, p.resolveCtx())
 	if err != nil {
 		return nil, errors.Wrap(err, "parse servers")
@@ -146,12 +165,9 @@ func Parse(spec *ogen.Spec, s Settings) (_ *openapi.API, rerr error) {
 		return nil, errors.Wrap(err, "parse webhooks")
 	}
 
-	tags := make([]openapi.Tag, len(spec.Tags))
-	for i, tag := range spec.Tags {
-		tags[i] = openapi.Tag{
-			Name:        tag.Name,
-			Description: tag.Description,
-		}
+	tags, err := p.parseTags(spec.Tags)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse tags")
 	}
 
 	return &openapi.API{
@@ -254,3 +254,34 @@ func (p *parser) resolvePathItem(
 	}
 	return c, nil
 }
+
+func (p *parser) resolveCallback(ref string, ctx *jsonpointer.ResolveCtx) (refKey, []*openapi.CallbackPath, error) {
+	const prefix = "#/components/callbacks/"
+	key, c, _, err := resolveComponent(p, componentResolve[*ogen.Callback, []*openapi.CallbackPath]{
+		prefix:     prefix,
+		components: p.spec.Components.Callbacks,
+		cache:      p.refs.callbacks,
+		parse:      p.parseCallbackPaths,
+	}, ref, ctx)
+	if err != nil {
+		return key, nil, err
+	}
+	return key, c, nil
+}
+
+func (p *parser) resolveLink(ref string, ctx *jsonpointer.ResolveCtx) (*openapi.Link, error) {
+	const prefix = "#/components/links/"
+	key, c, cached, err := resolveComponent(p, componentResolve[*ogen.Link, *openapi.Link]{
+		prefix:     prefix,
+		components: p.spec.Components.Links,
+		cache:      p.refs.links,
+		parse:      p.parseLink,
+	}, ref, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !cached && c.Ref.IsZero() {
+		c.Ref = key
+	}
+	return c, nil
+}
@@ -0,0 +1,940 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/yaml"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/location"
+)
+
+// swagger2Version is the OpenAPI version Swagger 2.0 documents are converted to.
+const swagger2Version = "3.0.3"
+
+// isSwagger2 whether spec is a Swagger 2.0 document.
+func isSwagger2(spec *ogen.Spec) bool {
+	return spec.OpenAPI == "" && strings.HasPrefix(spec.Swagger, "2.")
+}
+
+// convertSwagger2 converts Swagger 2.0 document to OpenAPI 3.0.
+//
+// Conversion is done on the raw YAML tree, reusing nodes of the original
+// document, so locations of the converted spec point to the original file.
+func convertSwagger2(spec *ogen.Spec, file location.File) (*ogen.Spec, error) {
+	root := spec.Raw
+	if root == nil {
+		return nil, errors.New("raw document is required to convert Swagger 2.0")
+	}
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+
+	c := &swagger2Converter{
+		file: file,
+	}
+	node, err := c.convert(root)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := new(ogen.Spec)
+	if err := converted.UnmarshalYAML(node); err != nil {
+		return nil, errors.Wrap(err, "decode converted spec")
+	}
+	converted.Init()
+	return converted, nil
+}
+
+type swagger2Converter struct {
+	file location.File
+
+	// consumes and produces are global MIME types.
+	consumes []string
+	produces []string
+	// parameters are global parameters, by name.
+	parameters map[string]*yaml.Node
+}
+
+func (c *swagger2Converter) errorf(n *yaml.Node, format string, args ...any) error {
+	var pos location.Position
+	pos.FromNode(n)
+	return &LocationError{
+		File: c.file,
+		Pos:  pos,
+		Err:  errors.Errorf(format, args...),
+	}
+}
+
+func (c *swagger2Converter) convert(root *yaml.Node) (*yaml.Node, error) {
+	if root.Kind != yaml.MappingNode {
+		return nil, c.errorf(root, "expected object")
+	}
+
+	if v := yamlField(root, "swagger"); v == nil || v.Value != "2.0" {
+		return nil, c.errorf(root, "unsupported version: %s", yamlString(root, "swagger"))
+	}
+	c.consumes = yamlStrings(yamlField(root, "consumes"))
+	c.produces = yamlStrings(yamlField(root, "produces"))
+	c.parameters = map[string]*yaml.Node{}
+	for key, value := range yamlEntries(yamlField(root, "parameters")) {
+		c.parameters[key.Value] = value
+	}
+
+	var (
+		out        = yamlMap(root)
+		components = yamlMap(root)
+	)
+	for key, value := range yamlEntries(root) {
+		switch key.Value {
+		case "swagger":
+			yamlSet(out, yamlRenamed(key, "openapi"), yamlStr(swagger2Version, value))
+		case "info", "tags", "externalDocs", "security":
+			yamlSet(out, key, value)
+		case "host", "basePath", "schemes", "consumes", "produces":
+			// Handled separately.
+		case "paths":
+			paths, err := c.convertPaths(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "paths")
+			}
+			yamlSet(out, key, paths)
+		case "definitions":
+			schemas := yamlMap(value)
+			for name, schema := range yamlEntries(value) {
+				yamlSet(schemas, name, c.convertSchema(schema))
+			}
+			yamlSet(components, yamlRenamed(key, "schemas"), schemas)
+		case "parameters":
+			params := yamlMap(value)
+			for name, param := range yamlEntries(value) {
+				if isBodyParameter(param) {
+					// Body and form parameters are inlined into request bodies.
+					continue
+				}
+				p, err := c.convertParameter(param)
+				if err != nil {
+					return nil, errors.Wrapf(err, "parameter %q", name.Value)
+				}
+				yamlSet(params, name, p)
+			}
+			yamlSet(components, key, params)
+		case "responses":
+			responses := yamlMap(value)
+			for name, resp := range yamlEntries(value) {
+				r, err := c.convertResponse(resp, c.produces)
+				if err != nil {
+					return nil, errors.Wrapf(err, "response %q", name.Value)
+				}
+				yamlSet(responses, name, r)
+			}
+			yamlSet(components, key, responses)
+		case "securityDefinitions":
+			schemes := yamlMap(value)
+			for name, scheme := range yamlEntries(value) {
+				s, err := c.convertSecurityScheme(scheme)
+				if err != nil {
+					return nil, errors.Wrapf(err, "security definition %q", name.Value)
+				}
+				yamlSet(schemes, name, s)
+			}
+			yamlSet(components, yamlRenamed(key, "securitySchemes"), schemes)
+		default:
+			if strings.HasPrefix(key.Value, "x-") {
+				yamlSet(out, key, value)
+			}
+		}
+	}
+	if servers := c.convertServers(root); servers != nil {
+		yamlSet(out, yamlStr("servers", servers), servers)
+	}
+	if len(components.Content) > 0 {
+		yamlSet(out, yamlStr("components", root), components)
+	}
+	return out, nil
+}
+
+func (c *swagger2Converter) convertServers(root *yaml.Node) *yaml.Node {
+	var (
+		host     = yamlField(root, "host")
+		basePath = yamlField(root, "basePath")
+		schemes  = yamlStrings(yamlField(root, "schemes"))
+	)
+	pos := host
+	if pos == nil {
+		pos = basePath
+	}
+	if pos == nil {
+		return nil
+	}
+
+	// basePath is optional.
+	var path string
+	if basePath != nil {
+		path = basePath.Value
+	}
+
+	var urls []string
+	switch {
+	case host == nil:
+		urls = append(urls, path)
+	case len(schemes) == 0:
+		urls = append(urls, "https://"+host.Value+path)
+	default:
+		for _, scheme := range schemes {
+			urls = append(urls, scheme+"://"+host.Value+path)
+		}
+	}
+
+	servers := yamlSeq(pos)
+	for _, u := range urls {
+		server := yamlMap(pos)
+		yamlSet(server, yamlStr("url", pos), yamlStr(u, pos))
+		servers.Content = append(servers.Content, server)
+	}
+	return servers
+}
+
+var swagger2Methods = map[string]struct{}{
+	"get":     {},
+	"put":     {},
+	"post":    {},
+	"delete":  {},
+	"options": {},
+	"head":    {},
+	"patch":   {},
+}
+
+func (c *swagger2Converter) convertPaths(paths *yaml.Node) (*yaml.Node, error) {
+	out := yamlMap(paths)
+	for path, item := range yamlEntries(paths) {
+		if strings.HasPrefix(path.Value, "x-") {
+			yamlSet(out, path, item)
+			continue
+		}
+		converted, err := c.convertPathItem(item)
+		if err != nil {
+			return nil, errors.Wrapf(err, "path %q", path.Value)
+		}
+		yamlSet(out, path, converted)
+	}
+	return out, nil
+}
+
+func (c *swagger2Converter) convertPathItem(item *yaml.Node) (*yaml.Node, error) {
+	out := yamlMap(item)
+
+	var bodyParams []*yaml.Node
+	if params := yamlField(item, "parameters"); params != nil {
+		regular, body, err := c.convertParameters(params)
+		if err != nil {
+			return nil, errors.Wrap(err, "parameters")
+		}
+		bodyParams = body
+		if len(regular.Content) > 0 {
+			yamlSet(out, yamlKey(item, "parameters"), regular)
+		}
+	}
+
+	for key, value := range yamlEntries(item) {
+		switch _, isMethod := swagger2Methods[key.Value]; {
+		case isMethod:
+			op, err := c.convertOperation(value, bodyParams)
+			if err != nil {
+				return nil, errors.Wrap(err, key.Value)
+			}
+			yamlSet(out, key, op)
+		case key.Value == "$ref":
+			yamlSet(out, key, yamlStr(swagger2Ref(value.Value), value))
+		case strings.HasPrefix(key.Value, "x-"):
+			yamlSet(out, key, value)
+		}
+	}
+	return out, nil
+}
+
+func (c *swagger2Converter) convertOperation(op *yaml.Node, pathBodyParams []*yaml.Node) (*yaml.Node, error) {
+	out := yamlMap(op)
+
+	consumes := c.consumes
+	if v := yamlField(op, "consumes"); v != nil {
+		consumes = yamlStrings(v)
+	}
+	produces := c.produces
+	if v := yamlField(op, "produces"); v != nil {
+		produces = yamlStrings(v)
+	}
+
+	bodyParams := pathBodyParams
+	for key, value := range yamlEntries(op) {
+		switch key.Value {
+		case "tags", "summary", "description", "externalDocs", "operationId", "deprecated", "security":
+			yamlSet(out, key, value)
+		case "parameters":
+			regular, body, err := c.convertParameters(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "parameters")
+			}
+			bodyParams = mergeBodyParameters(bodyParams, body)
+			if len(regular.Content) > 0 {
+				yamlSet(out, key, regular)
+			}
+		case "responses":
+			responses := yamlMap(value)
+			for code, resp := range yamlEntries(value) {
+				if strings.HasPrefix(code.Value, "x-") {
+					yamlSet(responses, code, resp)
+					continue
+				}
+				r, err := c.convertResponse(resp, produces)
+				if err != nil {
+					return nil, errors.Wrapf(err, "response %q", code.Value)
+				}
+				yamlSet(responses, code, r)
+			}
+			yamlSet(out, key, responses)
+		default:
+			if strings.HasPrefix(key.Value, "x-") {
+				yamlSet(out, key, value)
+			}
+		}
+	}
+
+	if len(bodyParams) > 0 {
+		body, err := c.convertRequestBody(bodyParams, consumes)
+		if err != nil {
+			return nil, errors.Wrap(err, "request body")
+		}
+		yamlSet(out, yamlStr("requestBody", body), body)
+	}
+	return out, nil
+}
+
+// convertParameters converts list of parameters.
+//
+// Body and form parameters are returned separately, resolved.
+func (c *swagger2Converter) convertParameters(params *yaml.Node) (regular *yaml.Node, body []*yaml.Node, _ error) {
+	regular = yamlSeq(params)
+	for i, param := range yamlItems(params) {
+		resolved, err := c.resolveParameter(param)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "[%d]", i)
+		}
+		if isBodyParameter(resolved) {
+			body = append(body, resolved)
+			continue
+		}
+		p, err := c.convertParameter(param)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "[%d]", i)
+		}
+		regular.Content = append(regular.Content, p)
+	}
+	return regular, body, nil
+}
+
+// resolveParameter resolves reference to the global parameter.
+func (c *swagger2Converter) resolveParameter(param *yaml.Node) (*yaml.Node, error) {
+	ref := yamlField(param, "$ref")
+	if ref == nil {
+		return param, nil
+	}
+	name, ok := strings.CutPrefix(ref.Value, "#/parameters/")
+	if !ok {
+		// External reference, keep as is.
+		return param, nil
+	}
+	resolved, ok := c.parameters[unescapeRefToken(name)]
+	if !ok {
+		return nil, c.errorf(ref, "parameter %q not found", name)
+	}
+	return resolved, nil
+}
+
+func isBodyParameter(param *yaml.Node) bool {
+	switch yamlString(param, "in") {
+	case "body", "formData":
+		return true
+	default:
+		return false
+	}
+}
+
+// mergeBodyParameters merges path-level body parameters with operation-level ones.
+//
+// Operation-level parameters override path-level parameters with the same name and location.
+func mergeBodyParameters(path, op []*yaml.Node) []*yaml.Node {
+	key := func(p *yaml.Node) string {
+		in := yamlString(p, "in")
+		if in == "body" {
+			// There can be only one body parameter.
+			return in
+		}
+		return in + ":" + yamlString(p, "name")
+	}
+
+	overridden := map[string]struct{}{}
+	for _, p := range op {
+		overridden[key(p)] = struct{}{}
+	}
+	var r []*yaml.Node
+	for _, p := range path {
+		if _, ok := overridden[key(p)]; !ok {
+			r = append(r, p)
+		}
+	}
+	return append(r, op...)
+}
+
+// swagger2SchemaKeys are keywords of non-body parameters, headers and items
+// which form the schema of the value.
+var swagger2SchemaKeys = map[string]struct{}{
+	"type":             {},
+	"format":           {},
+	"items":            {},
+	"default":          {},
+	"maximum":          {},
+	"exclusiveMaximum": {},
+	"minimum":          {},
+	"exclusiveMinimum": {},
+	"maxLength":        {},
+	"minLength":        {},
+	"pattern":          {},
+	"maxItems":         {},
+	"minItems":         {},
+	"uniqueItems":      {},
+	"enum":             {},
+	"multipleOf":       {},
+}
+
+// convertItemsSchema converts type keywords of parameter, header or items object to the schema.
+func (c *swagger2Converter) convertItemsSchema(n *yaml.Node) *yaml.Node {
+	schema := yamlMap(n)
+	for key, value := range yamlEntries(n) {
+		if _, ok := swagger2SchemaKeys[key.Value]; !ok {
+			continue
+		}
+		switch key.Value {
+		case "items":
+			value = c.convertItemsSchema(value)
+		case "type":
+			if value.Value == "file" {
+				yamlSet(schema, key, yamlStr("string", value))
+				yamlSet(schema, yamlRenamed(key, "format"), yamlStr("binary", value))
+				continue
+			}
+		}
+		yamlSet(schema, key, value)
+	}
+	return schema
+}
+
+func (c *swagger2Converter) convertParameter(param *yaml.Node) (*yaml.Node, error) {
+	if ref := yamlField(param, "$ref"); ref != nil {
+		out := yamlMap(param)
+		yamlSet(out, yamlKey(param, "$ref"), yamlStr(swagger2Ref(ref.Value), ref))
+		return out, nil
+	}
+
+	out := yamlMap(param)
+	for key, value := range yamlEntries(param) {
+		switch key.Value {
+		case "name", "in", "description", "required", "allowEmptyValue":
+			yamlSet(out, key, value)
+		default:
+			if strings.HasPrefix(key.Value, "x-") {
+				yamlSet(out, key, value)
+			}
+		}
+	}
+	yamlSet(out, yamlStr("schema", param), c.convertItemsSchema(param))
+
+	if yamlString(param, "type") != "array" {
+		return out, nil
+	}
+	format := yamlField(param, "collectionFormat")
+	style, explode, err := c.collectionStyle(yamlString(param, "in"), format)
+	if err != nil {
+		return nil, err
+	}
+	pos := param
+	if format != nil {
+		pos = format
+	}
+	yamlSet(out, yamlStr("style", pos), yamlStr(style, pos))
+	yamlSet(out, yamlStr("explode", pos), yamlBool(explode, pos))
+	return out, nil
+}
+
+// collectionStyle returns style and explode of the array parameter by the collectionFormat.
+func (c *swagger2Converter) collectionStyle(in string, format *yaml.Node) (style string, explode bool, _ error) {
+	collectionFormat := "csv"
+	if format != nil {
+		collectionFormat = format.Value
+	}
+	switch collectionFormat {
+	case "csv":
+		if in == "query" || in == "formData" {
+			return "form", false, nil
+		}
+		return "simple", false, nil
+	case "ssv":
+		return "spaceDelimited", false, nil
+	case "pipes":
+		return "pipeDelimited", false, nil
+	case "multi":
+		return "form", true, nil
+	default:
+		return "", false, c.errorf(format, "unsupported collectionFormat %q", collectionFormat)
+	}
+}
+
+var swagger2FormTypes = []string{
+	"application/x-www-form-urlencoded",
+	"multipart/form-data",
+}
+
+func (c *swagger2Converter) convertRequestBody(params []*yaml.Node, consumes []string) (*yaml.Node, error) {
+	var (
+		body *yaml.Node
+		form []*yaml.Node
+	)
+	for _, p := range params {
+		if yamlString(p, "in") == "body" {
+			body = p
+		} else {
+			form = append(form, p)
+		}
+	}
+	if body != nil && len(form) > 0 {
+		return nil, c.errorf(body, "body and formData parameters cannot be used together")
+	}
+
+	if body != nil {
+		if len(consumes) == 0 {
+			consumes = []string{"application/json"}
+		}
+		out := yamlMap(body)
+		for key, value := range yamlEntries(body) {
+			switch key.Value {
+			case "description", "required":
+				yamlSet(out, key, value)
+			default:
+				if strings.HasPrefix(key.Value, "x-") {
+					yamlSet(out, key, value)
+				}
+			}
+		}
+		schema := yamlField(body, "schema")
+		if schema == nil {
+			return nil, c.errorf(body, "body parameter schema is required")
+		}
+		content := yamlMap(body)
+		for _, ct := range consumes {
+			media := yamlMap(schema)
+			yamlSet(media, yamlKey(body, "schema"), c.convertSchema(schema))
+			yamlSet(content, yamlStr(ct, body), media)
+		}
+		yamlSet(out, yamlStr("content", body), content)
+		return out, nil
+	}
+
+	var formTypes []string
+	for _, ct := range consumes {
+		for _, ft := range swagger2FormTypes {
+			if strings.EqualFold(ct, ft) {
+				formTypes = append(formTypes, ft)
+			}
+		}
+	}
+	if len(formTypes) == 0 {
+		formTypes = []string{"application/x-www-form-urlencoded"}
+		for _, p := range form {
+			if yamlString(p, "type") == "file" {
+				formTypes = []string{"multipart/form-data"}
+				break
+			}
+		}
+	}
+
+	var (
+		pos        = form[0]
+		schema     = yamlMap(pos)
+		properties = yamlMap(pos)
+		required   = yamlSeq(pos)
+		encoding   = yamlMap(pos)
+		isRequired bool
+	)
+	for _, p := range form {
+		name := yamlField(p, "name")
+		prop := c.convertItemsSchema(p)
+		if d := yamlField(p, "description"); d != nil {
+			yamlSet(prop, yamlKey(p, "description"), d)
+		}
+		yamlSet(properties, name, prop)
+
+		if yamlString(p, "required") == "true" {
+			required.Content = append(required.Content, name)
+			isRequired = true
+		}
+		if yamlString(p, "type") == "array" {
+			format := yamlField(p, "collectionFormat")
+			style, explode, err := c.collectionStyle("formData", format)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parameter %q", name.Value)
+			}
+			enc := yamlMap(p)
+			yamlSet(enc, yamlStr("style", p), yamlStr(style, p))
+			yamlSet(enc, yamlStr("explode", p), yamlBool(explode, p))
+			yamlSet(encoding, name, enc)
+		}
+	}
+	yamlSet(schema, yamlStr("type", pos), yamlStr("object", pos))
+	yamlSet(schema, yamlStr("properties", pos), properties)
+	if len(required.Content) > 0 {
+		yamlSet(schema, yamlStr("required", pos), required)
+	}
+
+	out := yamlMap(pos)
+	if isRequired {
+		yamlSet(out, yamlStr("required", pos), yamlBool(true, pos))
+	}
+	content := yamlMap(pos)
+	for _, ct := range formTypes {
+		media := yamlMap(pos)
+		yamlSet(media, yamlStr("schema", pos), schema)
+		if ct == "application/x-www-form-urlencoded" && len(encoding.Content) > 0 {
+			yamlSet(media, yamlStr("encoding", pos), encoding)
+		}
+		yamlSet(content, yamlStr(ct, pos), media)
+	}
+	yamlSet(out, yamlStr("content", pos), content)
+	return out, nil
+}
+
+func (c *swagger2Converter) convertResponse(resp *yaml.Node, produces []string) (*yaml.Node, error) {
+	out := yamlMap(resp)
+	if ref := yamlField(resp, "$ref"); ref != nil {
+		yamlSet(out, yamlKey(resp, "$ref"), yamlStr(swagger2Ref(ref.Value), ref))
+		return out, nil
+	}
+	if len(produces) == 0 {
+		produces = []string{"application/json"}
+	}
+
+	for key, value := range yamlEntries(resp) {
+		switch key.Value {
+		case "description":
+			yamlSet(out, key, value)
+		case "headers":
+			headers := yamlMap(value)
+			for name, h := range yamlEntries(value) {
+				header, err := c.convertHeader(h)
+				if err != nil {
+					return nil, errors.Wrapf(err, "header %q", name.Value)
+				}
+				yamlSet(headers, name, header)
+			}
+			yamlSet(out, key, headers)
+		case "schema":
+			examples := yamlField(resp, "examples")
+			content := yamlMap(value)
+			for _, ct := range produces {
+				media := yamlMap(value)
+				yamlSet(media, key, c.convertSchema(value))
+				if ex := yamlField(examples, ct); ex != nil {
+					yamlSet(media, yamlKey(examples, ct), ex)
+				}
+				yamlSet(content, yamlStr(ct, value), media)
+			}
+			yamlSet(out, yamlRenamed(key, "content"), content)
+		default:
+			if strings.HasPrefix(key.Value, "x-") {
+				yamlSet(out, key, value)
+			}
+		}
+	}
+	if yamlField(out, "description") == nil {
+		// Description is required by OpenAPI 3.
+		yamlSet(out, yamlStr("description", resp), yamlStr("", resp))
+	}
+	return out, nil
+}
+
+func (c *swagger2Converter) convertHeader(h *yaml.Node) (*yaml.Node, error) {
+	out := yamlMap(h)
+	for key, value := range yamlEntries(h) {
+		if key.Value == "description" || strings.HasPrefix(key.Value, "x-") {
+			yamlSet(out, key, value)
+		}
+	}
+	yamlSet(out, yamlStr("schema", h), c.convertItemsSchema(h))
+	if yamlString(h, "type") == "array" {
+		format := yamlField(h, "collectionFormat")
+		if style, _, err := c.collectionStyle("header", format); err != nil {
+			return nil, err
+		} else if style != "simple" {
+			return nil, c.errorf(format, "unsupported header collectionFormat %q", format.Value)
+		}
+	}
+	return out, nil
+}
+
+func (c *swagger2Converter) convertSecurityScheme(scheme *yaml.Node) (*yaml.Node, error) {
+	out := yamlMap(scheme)
+	for key, value := range yamlEntries(scheme) {
+		if key.Value == "description" || strings.HasPrefix(key.Value, "x-") {
+			yamlSet(out, key, value)
+		}
+	}
+
+	typ := yamlField(scheme, "type")
+	switch yamlString(scheme, "type") {
+	case "basic":
+		yamlSet(out, yamlKey(scheme, "type"), yamlStr("http", typ))
+		yamlSet(out, yamlStr("scheme", typ), yamlStr("basic", typ))
+	case "apiKey":
+		for key, value := range yamlEntries(scheme) {
+			switch key.Value {
+			case "type", "name", "in":
+				yamlSet(out, key, value)
+			}
+		}
+	case "oauth2":
+		yamlSet(out, yamlKey(scheme, "type"), typ)
+
+		flow := yamlField(scheme, "flow")
+		if flow == nil {
+			return nil, c.errorf(scheme, "oauth2 flow is required")
+		}
+		var name string
+		switch flow.Value {
+		case "implicit":
+			name = "implicit"
+		case "password":
+			name = "password"
+		case "application":
+			name = "clientCredentials"
+		case "accessCode":
+			name = "authorizationCode"
+		default:
+			return nil, c.errorf(flow, "unknown oauth2 flow %q", flow.Value)
+		}
+
+		f := yamlMap(flow)
+		for key, value := range yamlEntries(scheme) {
+			switch key.Value {
+			case "authorizationUrl", "tokenUrl", "scopes":
+				yamlSet(f, key, value)
+			}
+		}
+		if yamlField(f, "scopes") == nil {
+			yamlSet(f, yamlStr("scopes", flow), yamlMap(flow))
+		}
+		flows := yamlMap(flow)
+		yamlSet(flows, yamlStr(name, flow), f)
+		yamlSet(out, yamlRenamed(yamlKey(scheme, "flow"), "flows"), flows)
+	default:
+		if typ == nil {
+			return nil, c.errorf(scheme, "type is required")
+		}
+		return nil, c.errorf(typ, "unknown security type %q", typ.Value)
+	}
+	return out, nil
+}
+
+// convertSchema converts Swagger 2.0 Schema Object.
+func (c *swagger2Converter) convertSchema(schema *yaml.Node) *yaml.Node {
+	schema = yamlDeref(schema)
+	if schema == nil || schema.Kind != yaml.MappingNode {
+		return schema
+	}
+
+	out := yamlMap(schema)
+	for key, value := range yamlEntries(schema) {
+		switch key.Value {
+		case "$ref":
+			value = yamlStr(swagger2Ref(value.Value), value)
+		case "x-nullable":
+			key = yamlRenamed(key, "nullable")
+		case "type":
+			if value.Value == "file" {
+				yamlSet(out, key, yamlStr("string", value))
+				if yamlField(schema, "format") == nil {
+					yamlSet(out, yamlRenamed(key, "format"), yamlStr("binary", value))
+				}
+				continue
+			}
+		case "discriminator":
+			if value.Kind == yaml.ScalarNode {
+				d := yamlMap(value)
+				yamlSet(d, yamlStr("propertyName", value), value)
+				value = d
+			}
+		case "properties", "patternProperties":
+			props := yamlMap(value)
+			for name, prop := range yamlEntries(value) {
+				yamlSet(props, name, c.convertSchema(prop))
+			}
+			value = props
+		case "items", "additionalProperties", "not":
+			if value.Kind == yaml.SequenceNode {
+				value = c.convertSchemas(value)
+			} else {
+				value = c.convertSchema(value)
+			}
+		case "allOf", "anyOf", "oneOf":
+			value = c.convertSchemas(value)
+		}
+		yamlSet(out, key, value)
+	}
+	return out
+}
+
+func (c *swagger2Converter) convertSchemas(schemas *yaml.Node) *yaml.Node {
+	out := yamlSeq(schemas)
+	for _, s := range yamlItems(schemas) {
+		out.Content = append(out.Content, c.convertSchema(s))
+	}
+	return out
+}
+
+// swagger2Ref rewrites local reference to the Swagger 2.0 component.
+func swagger2Ref(ref string) string {
+	for _, r := range [...]struct {
+		from, to string
+	}{
+		{"#/definitions/", "#/components/schemas/"},
+		{"#/parameters/", "#/components/parameters/"},
+		{"#/responses/", "#/components/responses/"},
+	} {
+		if name, ok := strings.CutPrefix(ref, r.from); ok {
+			return r.to + name
+		}
+	}
+	return ref
+}
+
+func unescapeRefToken(s string) string {
+	s = strings.ReplaceAll(s, "~1", "/")
+	return strings.ReplaceAll(s, "~0", "~")
+}
+
+// YAML tree helpers.
+//
+// Created nodes copy the position of the given node, so converted spec keeps
+// locations of the original document.
+
+func yamlDeref(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
+
+func yamlEntries(n *yaml.Node) func(yield func(key, value *yaml.Node) bool) {
+	return func(yield func(key, value *yaml.Node) bool) {
+		n = yamlDeref(n)
+		if n == nil || n.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if !yield(n.Content[i], yamlDeref(n.Content[i+1])) {
+				return
+			}
+		}
+	}
+}
+
+func yamlItems(n *yaml.Node) []*yaml.Node {
+	n = yamlDeref(n)
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	items := make([]*yaml.Node, len(n.Content))
+	for i, item := range n.Content {
+		items[i] = yamlDeref(item)
+	}
+	return items
+}
+
+// yamlKey returns key node of the field.
+func yamlKey(n *yaml.Node, key string) *yaml.Node {
+	for k := range yamlEntries(n) {
+		if k.Value == key {
+			return k
+		}
+	}
+	return yamlStr(key, n)
+}
+
+// yamlField returns value node of the field.
+func yamlField(n *yaml.Node, key string) *yaml.Node {
+	for k, v := range yamlEntries(n) {
+		if k.Value == key {
+			return v
+		}
+	}
+	return nil
+}
+
+// yamlString returns scalar value of the field.
+func yamlString(n *yaml.Node, key string) string {
+	if v := yamlField(n, key); v != nil {
+		return v.Value
+	}
+	return ""
+}
+
+func yamlStrings(n *yaml.Node) (r []string) {
+	for _, item := range yamlItems(n) {
+		r = append(r, item.Value)
+	}
+	return r
+}
+
+func yamlSet(m, key, value *yaml.Node) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key.Value {
+			m.Content[i+1] = value
+			return
+		}
+	}
+	m.Content = append(m.Content, key, value)
+}
+
+func yamlMap(pos *yaml.Node) *yaml.Node {
+	return yamlNode(yaml.MappingNode, "!!map", "", pos)
+}
+
+func yamlSeq(pos *yaml.Node) *yaml.Node {
+	return yamlNode(yaml.SequenceNode, "!!seq", "", pos)
+}
+
+func yamlStr(v string, pos *yaml.Node) *yaml.Node {
+	return yamlNode(yaml.ScalarNode, "!!str", v, pos)
+}
+
+func yamlBool(v bool, pos *yaml.Node) *yaml.Node {
+	return yamlNode(yaml.ScalarNode, "!!bool", strconv.FormatBool(v), pos)
+}
+
+// yamlRenamed returns copy of key node with a new name.
+func yamlRenamed(key *yaml.Node, name string) *yaml.Node {
+	return yamlStr(name, key)
+}
+
+func yamlNode(kind yaml.Kind, tag, value string, pos *yaml.Node) *yaml.Node {
+	n := &yaml.Node{
+		Kind:  kind,
+		Tag:   tag,
+		Value: value,
+	}
+	if pos != nil {
+		n.Line = pos.Line
+		n.Column = pos.Column
+	}
+	return n
+}
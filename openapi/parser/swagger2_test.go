@@ -0,0 +1,195 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/location"
+	"github.com/ogen-go/ogen/openapi"
+	"github.com/ogen-go/ogen/openapi/parser"
+)
+
+func TestSwagger2(t *testing.T) {
+	a := require.New(t)
+
+	data := []byte(`swagger: "2.0"
+info:
+  title: test
+  version: 1.0.0
+host: api.example.com
+basePath: /v1
+schemes: [https]
+consumes: [application/json, application/xml]
+securityDefinitions:
+  oauth:
+    type: oauth2
+    flow: application
+    tokenUrl: https://example.com/token
+    scopes:
+      read: Read access
+paths:
+  /users:
+    get:
+      operationId: listUsers
+      parameters:
+        - name: ids
+          in: query
+          type: array
+          items:
+            type: integer
+        - name: tags
+          in: query
+          type: array
+          collectionFormat: pipes
+          items:
+            type: string
+      responses:
+        "200":
+          description: OK
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/User"
+    post:
+      operationId: createUser
+      security:
+        - oauth: [read]
+      parameters:
+        - name: user
+          in: body
+          required: true
+          schema:
+            $ref: "#/definitions/User"
+      responses:
+        "204":
+          description: Created
+definitions:
+  User:
+    type: object
+    properties:
+      name:
+        type: string
+        x-nullable: true
+`)
+	spec, err := ogen.Parse(data)
+	a.NoError(err)
+
+	api, err := parser.Parse(spec, parser.Settings{
+		File: location.NewFile("swagger.yml", "swagger.yml", data),
+	})
+	a.NoError(err)
+	a.Equal(openapi.Version{Major: 3, Minor: 0, Patch: 3}, api.Version)
+
+	a.Len(api.Servers, 1)
+	a.Equal("https://api.example.com/v1", api.Servers[0].Template[0].Raw)
+
+	user := api.Components.Schemas["User"]
+	a.NotNil(user)
+	a.True(user.Properties[0].Schema.Nullable)
+
+	ops := map[string]*openapi.Operation{}
+	for _, op := range api.Operations {
+		ops[op.OperationID] = op
+	}
+
+	list := ops["listUsers"]
+	a.NotNil(list)
+	a.Len(list.Parameters, 2)
+	ids, tags := list.Parameters[0], list.Parameters[1]
+	a.Equal(openapi.QueryStyleForm, ids.Style)
+	a.False(ids.Explode)
+	a.Equal(openapi.QueryStylePipeDelimited, tags.Style)
+	a.False(tags.Explode)
+	a.Equal(user, list.Responses.StatusCode[200].Content["application/json"].Schema.Item)
+
+	create := ops["createUser"]
+	a.NotNil(create)
+	a.Empty(create.Parameters)
+	a.NotNil(create.RequestBody)
+	a.True(create.RequestBody.Required)
+	a.Len(create.RequestBody.Content, 2)
+	a.Equal(user, create.RequestBody.Content["application/json"].Schema)
+	a.Equal(user, create.RequestBody.Content["application/xml"].Schema)
+
+	a.Len(create.Security, 1)
+	scheme := create.Security[0].Schemes[0]
+	a.Equal("oauth", scheme.Name)
+	a.Equal([]string{"read"}, scheme.Scopes)
+	a.Equal("oauth2", scheme.Security.Type)
+	a.NotNil(scheme.Security.Flows.ClientCredentials)
+	a.Equal("https://example.com/token", scheme.Security.Flows.ClientCredentials.TokenURL)
+}
+
+func TestSwagger2ErrorLocation(t *testing.T) {
+	a := require.New(t)
+
+	data := []byte(`swagger: "2.0"
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /users:
+    get:
+      parameters:
+        - name: ids
+          in: query
+          type: array
+          collectionFormat: tsv
+          items:
+            type: string
+      responses:
+        "200":
+          description: OK
+`)
+	spec, err := ogen.Parse(data)
+	a.NoError(err)
+
+	_, err = parser.Parse(spec, parser.Settings{
+		File: location.NewFile("swagger.yml", "swagger.yml", data),
+	})
+	a.Error(err)
+
+	var locErr *location.Error
+	a.ErrorAs(err, &locErr)
+	// Error points to the original Swagger 2.0 document.
+	a.Equal(12, locErr.Pos.Line)
+	a.Equal("swagger.yml", locErr.File.Name)
+}
+
+func TestSwagger2Servers(t *testing.T) {
+	for i, tt := range []struct {
+		Header string
+		Want   []string
+	}{
+		{"host: api.example.com\nbasePath: /v1\n", []string{"https://api.example.com/v1"}},
+		// basePath is optional.
+		{"host: api.example.com\n", []string{"https://api.example.com"}},
+		{"host: api.example.com\nschemes: [http, https]\n", []string{"http://api.example.com", "https://api.example.com"}},
+		{"basePath: /v1\n", []string{"/v1"}},
+		{"", nil},
+	} {
+		a := require.New(t)
+
+		data := []byte(`swagger: "2.0"
+info:
+  title: test
+  version: 1.0.0
+` + tt.Header + `paths: {}
+`)
+		spec, err := ogen.Parse(data)
+		a.NoError(err, "test %d", i+1)
+
+		api, err := parser.Parse(spec, parser.Settings{
+			File: location.NewFile("swagger.yml", "swagger.yml", data),
+		})
+		a.NoError(err, "test %d", i+1)
+
+		var got []string
+		for _, s := range api.Servers {
+			got = append(got, s.Template[0].Raw)
+		}
+		a.Equal(tt.Want, got, "test %d", i+1)
+	}
+}
@@ -1,7 +1,21 @@
 package openapi
 
+// Tag kinds registered by OpenAPI.
+const (
+	// TagKindNav is a tag used for navigation.
+	TagKindNav = "nav"
+	// TagKindBadge is a tag used for visible badges.
+	TagKindBadge = "badge"
+	// TagKindAudience is a tag used for different groups of API consumers.
+	TagKindAudience = "audience"
+)
+
 // Tag represents parsed OpenAPI tags.
 type Tag struct {
 	Name        string
+	Summary     string
 	Description string
+	// Parent is the name of the parent tag, if any.
+	Parent string
+	Kind   string
 }
@@ -4,10 +4,23 @@ import (
 	"go.opentelemetry.io/otel/metric"
 )
 
+var (
+	// DurationBuckets are suggested histogram bucket boundaries of durations, milliseconds.
+	//
+	// They are not applied to ClientDuration and ServerDuration histograms to keep
+	// existing boundaries, use a metric view to opt in.
+	DurationBuckets = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+	// BodySizeBuckets are histogram bucket boundaries of body sizes, bytes.
+	BodySizeBuckets = []float64{0, 64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20, 16 << 20}
+)
+
 const (
-	ClientRequestCount = "ogen.client.request_count" // Outgoing request count total
-	ClientErrorsCount  = "ogen.client.errors_count"  // Outgoing errors total
-	ClientDuration     = "ogen.client.duration"      // Outgoing end to end duration, milliseconds
+	ClientRequestCount     = "ogen.client.request_count"      // Outgoing request count total
+	ClientErrorsCount      = "ogen.client.errors_count"       // Outgoing errors total
+	ClientDuration         = "ogen.client.duration"           // Outgoing end to end duration, milliseconds
+	ClientActiveRequests   = "ogen.client.active_requests"    // Outgoing requests in flight
+	ClientRequestBodySize  = "ogen.client.request_body_size"  // Outgoing request body size, bytes
+	ClientResponseBodySize = "ogen.client.response_body_size" // Incoming response body size, bytes
 )
 
 func ClientRequestCountCounter(meter metric.Meter) (metric.Int64Counter, error) {
@@ -34,10 +47,39 @@ func ClientDurationHistogram(meter metric.Meter) (metric.Float64Histogram, error
 	)
 }
 
+func ClientActiveRequestsCounter(meter metric.Meter) (metric.Int64UpDownCounter, error) {
+	return meter.Int64UpDownCounter(
+		ClientActiveRequests,
+		metric.WithDescription("Outgoing requests in flight"),
+		metric.WithUnit("{request}"),
+	)
+}
+
+func ClientRequestBodySizeHistogram(meter metric.Meter) (metric.Int64Histogram, error) {
+	return meter.Int64Histogram(
+		ClientRequestBodySize,
+		metric.WithDescription("Outgoing request body size"),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(BodySizeBuckets...),
+	)
+}
+
+func ClientResponseBodySizeHistogram(meter metric.Meter) (metric.Int64Histogram, error) {
+	return meter.Int64Histogram(
+		ClientResponseBodySize,
+		metric.WithDescription("Incoming response body size"),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(BodySizeBuckets...),
+	)
+}
+
 const (
-	ServerRequestCount = "ogen.server.request_count" // Incoming request count total
-	ServerErrorsCount  = "ogen.server.errors_count"  // Incoming errors total
-	ServerDuration     = "ogen.server.duration"      // Incoming end to end duration, milliseconds
+	ServerRequestCount     = "ogen.server.request_count"      // Incoming request count total
+	ServerErrorsCount      = "ogen.server.errors_count"       // Incoming errors total
+	ServerDuration         = "ogen.server.duration"           // Incoming end to end duration, milliseconds
+	ServerActiveRequests   = "ogen.server.active_requests"    // Incoming requests in flight
+	ServerRequestBodySize  = "ogen.server.request_body_size"  // Incoming request body size, bytes
+	ServerResponseBodySize = "ogen.server.response_body_size" // Outgoing response body size, bytes
 )
 
 func ServerRequestCountCounter(meter metric.Meter) (metric.Int64Counter, error) {
@@ -63,3 +105,29 @@ func ServerDurationHistogram(meter metric.Meter) (metric.Float64Histogram, error
 		metric.WithUnit("ms"),
 	)
 }
+
+func ServerActiveRequestsCounter(meter metric.Meter) (metric.Int64UpDownCounter, error) {
+	return meter.Int64UpDownCounter(
+		ServerActiveRequests,
+		metric.WithDescription("Incoming requests in flight"),
+		metric.WithUnit("{request}"),
+	)
+}
+
+func ServerRequestBodySizeHistogram(meter metric.Meter) (metric.Int64Histogram, error) {
+	return meter.Int64Histogram(
+		ServerRequestBodySize,
+		metric.WithDescription("Incoming request body size"),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(BodySizeBuckets...),
+	)
+}
+
+func ServerResponseBodySizeHistogram(meter metric.Meter) (metric.Int64Histogram, error) {
+	return meter.Int64Histogram(
+		ServerResponseBodySize,
+		metric.WithDescription("Outgoing response body size"),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(BodySizeBuckets...),
+	)
+}
@@ -0,0 +1,160 @@
+// Package metrictest provides an in-process OpenTelemetry metrics reader
+// for asserting on metrics recorded by generated servers and clients.
+package metrictest
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Reader collects metrics in-process using a manual reader.
+type Reader struct {
+	reader   *sdkmetric.ManualReader
+	provider *sdkmetric.MeterProvider
+}
+
+// NewReader creates new Reader.
+//
+// The underlying meter provider is shut down on test cleanup.
+func NewReader(t testing.TB) *Reader {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+	)
+	t.Cleanup(func() {
+		if err := provider.Shutdown(context.Background()); err != nil {
+			t.Errorf("shutdown meter provider: %v", err)
+		}
+	})
+
+	return &Reader{
+		reader:   reader,
+		provider: provider,
+	}
+}
+
+// MeterProvider returns meter provider to pass to the generated server or client.
+func (r *Reader) MeterProvider() metric.MeterProvider {
+	return r.provider
+}
+
+// Collect collects recorded metrics.
+func (r *Reader) Collect(t testing.TB) Metrics {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := r.reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+
+	var m Metrics
+	for _, sm := range rm.ScopeMetrics {
+		m = append(m, sm.Metrics...)
+	}
+	return m
+}
+
+// Metrics is a collected set of metrics.
+//
+// Lookup methods match data points which have all given attributes,
+// other attributes of the data point are ignored.
+type Metrics []metricdata.Metrics
+
+// Has returns true if metric with given name was recorded.
+func (m Metrics) Has(name string) bool {
+	for _, e := range m {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Sum returns the total value of matching counter or up-down counter data points.
+func (m Metrics) Sum(name string, attrs ...attribute.KeyValue) (total int64) {
+	for _, e := range m {
+		if e.Name != name {
+			continue
+		}
+		switch data := e.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += dp.Value
+				}
+			}
+		case metricdata.Sum[float64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += int64(dp.Value)
+				}
+			}
+		}
+	}
+	return total
+}
+
+// HistogramCount returns the number of recorded values of matching histogram data points.
+func (m Metrics) HistogramCount(name string, attrs ...attribute.KeyValue) (total uint64) {
+	for _, e := range m {
+		if e.Name != name {
+			continue
+		}
+		switch data := e.Data.(type) {
+		case metricdata.Histogram[int64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += dp.Count
+				}
+			}
+		case metricdata.Histogram[float64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += dp.Count
+				}
+			}
+		}
+	}
+	return total
+}
+
+// HistogramSum returns the sum of recorded values of matching histogram data points.
+func (m Metrics) HistogramSum(name string, attrs ...attribute.KeyValue) (total float64) {
+	for _, e := range m {
+		if e.Name != name {
+			continue
+		}
+		switch data := e.Data.(type) {
+		case metricdata.Histogram[int64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += float64(dp.Sum)
+				}
+			}
+		case metricdata.Histogram[float64]:
+			for _, dp := range data.DataPoints {
+				if matches(dp.Attributes, attrs) {
+					total += dp.Sum
+				}
+			}
+		}
+	}
+	return total
+}
+
+func matches(set attribute.Set, attrs []attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		v, ok := set.Value(attr.Key)
+		if !ok || v != attr.Value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package metrictest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/ogen-go/ogen/otelogen"
+)
+
+func TestReader(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+
+	r := NewReader(t)
+	meter := r.MeterProvider().Meter(otelogen.Name)
+
+	requests, err := otelogen.ServerRequestCountCounter(meter)
+	a.NoError(err)
+	active, err := otelogen.ServerActiveRequestsCounter(meter)
+	a.NoError(err)
+	bodySize, err := otelogen.ServerRequestBodySizeHistogram(meter)
+	a.NoError(err)
+	duration, err := otelogen.ServerDurationHistogram(meter)
+	a.NoError(err)
+
+	var (
+		foo = metric.WithAttributes(attribute.String("op", "foo"), attribute.Int("code", 200))
+		bar = metric.WithAttributes(attribute.String("op", "bar"), attribute.Int("code", 500))
+	)
+	requests.Add(ctx, 1, foo)
+	requests.Add(ctx, 2, bar)
+	active.Add(ctx, 1, foo)
+	active.Add(ctx, 1, bar)
+	active.Add(ctx, -1, bar)
+	bodySize.Record(ctx, 10, foo)
+	bodySize.Record(ctx, 32, foo)
+	duration.Record(ctx, 1.5, bar)
+
+	m := r.Collect(t)
+	a.True(m.Has(otelogen.ServerRequestCount))
+	a.False(m.Has(otelogen.ServerErrorsCount))
+
+	a.Equal(int64(3), m.Sum(otelogen.ServerRequestCount))
+	a.Equal(int64(1), m.Sum(otelogen.ServerRequestCount, attribute.String("op", "foo")))
+	a.Equal(int64(2), m.Sum(otelogen.ServerRequestCount, attribute.Int("code", 500)))
+	a.Zero(m.Sum(otelogen.ServerRequestCount, attribute.String("op", "foo"), attribute.Int("code", 500)))
+	a.Zero(m.Sum(otelogen.ServerRequestCount, attribute.String("op", "baz")))
+
+	a.Equal(int64(1), m.Sum(otelogen.ServerActiveRequests, attribute.String("op", "foo")))
+	a.Zero(m.Sum(otelogen.ServerActiveRequests, attribute.String("op", "bar")))
+
+	a.Equal(uint64(2), m.HistogramCount(otelogen.ServerRequestBodySize, attribute.String("op", "foo")))
+	a.Equal(42.0, m.HistogramSum(otelogen.ServerRequestBodySize, attribute.String("op", "foo")))
+	a.Equal(uint64(1), m.HistogramCount(otelogen.ServerDuration))
+	a.Equal(1.5, m.HistogramSum(otelogen.ServerDuration, attribute.String("op", "bar")))
+}
@@ -1,7 +1,12 @@
 package otelogen
 
 import (
+	"strings"
+
 	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+
+	"github.com/ogen-go/ogen/ogenerrors"
 )
 
 const (
@@ -9,6 +14,14 @@ const (
 	OperationIDKey = attribute.Key("oas.operation")
 	// WebhookNameKey by OpenAPI specification.
 	WebhookNameKey = attribute.Key("oas.webhook.name")
+	// CallbackNameKey by OpenAPI specification.
+	CallbackNameKey = attribute.Key("oas.callback.name")
+	// SecuritySchemeKey is the name of failed security scheme.
+	SecuritySchemeKey = attribute.Key("oas.security.scheme")
+	// SSELastEventIDKey is the Last-Event-ID sent on SSE reconnect.
+	SSELastEventIDKey = attribute.Key("sse.last_event_id")
+	// SSEReconnectAttemptKey is the number of SSE reconnect attempt, starting from 1.
+	SSEReconnectAttemptKey = attribute.Key("sse.reconnect.attempt")
 )
 
 // OperationID attribute.
@@ -26,3 +39,52 @@ func WebhookName(v string) attribute.KeyValue {
 		Value: attribute.StringValue(v),
 	}
 }
+
+// CallbackName attribute.
+func CallbackName(v string) attribute.KeyValue {
+	return attribute.KeyValue{
+		Key:   CallbackNameKey,
+		Value: attribute.StringValue(v),
+	}
+}
+
+// SecurityScheme attribute.
+func SecurityScheme(v string) attribute.KeyValue {
+	return attribute.KeyValue{
+		Key:   SecuritySchemeKey,
+		Value: attribute.StringValue(v),
+	}
+}
+
+// SSELastEventID attribute.
+func SSELastEventID(v string) attribute.KeyValue {
+	return attribute.KeyValue{
+		Key:   SSELastEventIDKey,
+		Value: attribute.StringValue(v),
+	}
+}
+
+// SSEReconnectAttempt attribute.
+func SSEReconnectAttempt(v int) attribute.KeyValue {
+	return attribute.KeyValue{
+		Key:   SSEReconnectAttemptKey,
+		Value: attribute.IntValue(v),
+	}
+}
+
+// ErrorAttributes returns attributes of the error at given stage.
+//
+// Error type is reported using "error.type" attribute, see ogenerrors.ErrorType.
+// Errors at "Validate" stage are always reported as validation errors.
+// "Security:<Name>" stage also adds the name of the failed security scheme.
+func ErrorAttributes(stage string, err error) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 2)
+	typ := ogenerrors.ErrorType(err)
+	if stage == "Validate" {
+		typ = ogenerrors.ErrorTypeValidation
+	} else if scheme, ok := strings.CutPrefix(stage, "Security:"); ok {
+		typ = ogenerrors.ErrorTypeSecurity
+		attrs = append(attrs, SecurityScheme(scheme))
+	}
+	return append(attrs, semconv.ErrorTypeKey.String(typ))
+}
@@ -40,6 +40,10 @@ type Schema struct {
 	// and not a standard JSON Schema. Consistent with JSON Schema, additionalProperties defaults to true.
 	AdditionalProperties *AdditionalProperties `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
 
+	// Applies to properties not evaluated by the schema itself and its allOf, oneOf,
+	// anyOf and conditional subschemas. Value can be boolean or object.
+	UnevaluatedProperties *AdditionalProperties `json:"unevaluatedProperties,omitempty" yaml:"unevaluatedProperties,omitempty"`
+
 	// The value of "patternProperties" MUST be an object. Each property
 	// name of this object SHOULD be a valid regular expression, according
 	// to the ECMA-262 regular expression dialect. Each property value of
@@ -56,6 +60,10 @@ type Schema struct {
 	// MUST be present if the Type is "array".
 	Items *Items `json:"items,omitempty" yaml:"items,omitempty"`
 
+	// Validates items of the array by position. Items after prefix
+	// are validated by Items.
+	PrefixItems []*Schema `json:"prefixItems,omitempty" yaml:"prefixItems,omitempty"`
+
 	// A true value adds "null" to the allowed type specified by the type keyword,
 	// only if type is explicitly defined within the same Schema Object.
 	// Other Schema Object constraints retain their defined behavior,
@@ -76,6 +84,23 @@ type Schema struct {
 	// AnyOf validates the value against any (one or more) of the subschemas
 	AnyOf []*Schema `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
 
+	// Not validates the value, if it is NOT valid against the subschema.
+	Not *Schema `json:"not,omitempty" yaml:"not,omitempty"`
+
+	// If the instance is valid against If, it also MUST be valid against Then.
+	// Otherwise, it MUST be valid against Else.
+	If   *Schema `json:"if,omitempty" yaml:"if,omitempty"`
+	Then *Schema `json:"then,omitempty" yaml:"then,omitempty"`
+	Else *Schema `json:"else,omitempty" yaml:"else,omitempty"`
+
+	// Maps property name to the list of properties that are required
+	// if the property is present.
+	DependentRequired map[string][]string `json:"dependentRequired,omitempty" yaml:"dependentRequired,omitempty"`
+
+	// Maps property name to the schema that the instance MUST be valid against
+	// if the property is present.
+	DependentSchemas map[string]*Schema `json:"dependentSchemas,omitempty" yaml:"dependentSchemas,omitempty"`
+
 	// Discriminator for subschemas.
 	Discriminator *Discriminator `json:"discriminator,omitempty" yaml:"discriminator,omitempty"`
 
@@ -249,6 +274,13 @@ type Schema struct {
 	// described is not a string.
 	ContentMediaType string `json:"contentMediaType,omitempty" yaml:"contentMediaType,omitempty"`
 
+	// If the instance is a string, and if "contentMediaType" is present,
+	// this property contains a schema which describes the structure of
+	// the string.
+	//
+	// The value of this property MUST be a valid JSON schema.
+	ContentSchema *Schema `json:"contentSchema,omitempty" yaml:"contentSchema,omitempty"`
+
 	Common jsonschema.OpenAPICommon `json:"-" yaml:",inline"`
 }
 
@@ -545,12 +577,17 @@ func (p *PatternProperties) UnmarshalJSON(data []byte) error {
 
 // Items is unparsed JSON Schema items validator description.
 type Items struct {
+	// Bool is set, if items is a boolean schema.
+	Bool  *bool
 	Item  *Schema
 	Items []*Schema
 }
 
 // MarshalYAML implements yaml.Marshaler.
 func (p Items) MarshalYAML() (any, error) {
+	if p.Bool != nil {
+		return *p.Bool, nil
+	}
 	if p.Item != nil {
 		return p.Item, nil
 	}
@@ -560,6 +597,8 @@ func (p Items) MarshalYAML() (any, error) {
 // UnmarshalYAML implements yaml.Unmarshaler.
 func (p *Items) UnmarshalYAML(node *yaml.Node) error {
 	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Decode(&p.Bool)
 	case yaml.MappingNode:
 		return node.Decode(&p.Item)
 	case yaml.SequenceNode:
@@ -571,6 +610,9 @@ func (p *Items) UnmarshalYAML(node *yaml.Node) error {
 
 // MarshalJSON implements json.Marshaler.
 func (p Items) MarshalJSON() ([]byte, error) {
+	if p.Bool != nil {
+		return json.Marshal(p.Bool)
+	}
 	if p.Item != nil {
 		return json.Marshal(p.Item)
 	}
@@ -579,7 +621,15 @@ func (p Items) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (p *Items) UnmarshalJSON(data []byte) error {
-	switch tt := jx.DecodeBytes(data).Next(); tt {
+	d := jx.DecodeBytes(data)
+	switch tt := d.Next(); tt {
+	case jx.Bool:
+		val, err := d.Bool()
+		if err != nil {
+			return err
+		}
+		p.Bool = &val
+		return nil
 	case jx.Object:
 		s := Schema{}
 		if err := json.Unmarshal(data, &s); err != nil {
@@ -17,45 +17,64 @@ func (s *Schema) ToJSONSchema() *jsonschema.RawSchema {
 		}
 		return result
 	}
+	convertMap := func(schemas map[string]*Schema) map[string]*jsonschema.RawSchema {
+		if schemas == nil {
+			return nil
+		}
+		result := make(map[string]*jsonschema.RawSchema, len(schemas))
+		for k, s := range schemas {
+			result[k] = s.ToJSONSchema()
+		}
+		return result
+	}
 
 	return &jsonschema.RawSchema{
-		Ref:                  s.Ref,
-		Summary:              s.Summary,
-		Description:          s.Description,
-		Type:                 s.Type,
-		Format:               s.Format,
-		Properties:           s.Properties.ToJSONSchema(),
-		AdditionalProperties: s.AdditionalProperties.ToJSONSchema(),
-		PatternProperties:    s.PatternProperties.ToJSONSchema(),
-		Required:             s.Required,
-		Items:                s.Items.ToJSONSchema(),
-		Nullable:             s.Nullable,
-		AllOf:                convertMany(s.AllOf),
-		OneOf:                convertMany(s.OneOf),
-		AnyOf:                convertMany(s.AnyOf),
-		Enum:                 s.Enum,
-		Const:                s.Const,
-		MultipleOf:           s.MultipleOf,
-		Maximum:              s.Maximum,
-		ExclusiveMaximum:     s.ExclusiveMaximum,
-		Minimum:              s.Minimum,
-		ExclusiveMinimum:     s.ExclusiveMinimum,
-		MaxLength:            s.MaxLength,
-		MinLength:            s.MinLength,
-		Pattern:              s.Pattern,
-		MaxItems:             s.MaxItems,
-		MinItems:             s.MinItems,
-		UniqueItems:          s.UniqueItems,
-		MaxProperties:        s.MaxProperties,
-		MinProperties:        s.MinProperties,
-		Default:              s.Default,
-		Deprecated:           s.Deprecated,
-		ContentEncoding:      s.ContentEncoding,
-		ContentMediaType:     s.ContentMediaType,
-		Discriminator:        s.Discriminator.ToJSONSchema(),
-		XML:                  s.XML.ToJSONSchema(),
-		Example:              s.Example,
-		Common:               s.Common,
+		Ref:                   s.Ref,
+		Summary:               s.Summary,
+		Description:           s.Description,
+		Type:                  s.Type,
+		Format:                s.Format,
+		Properties:            s.Properties.ToJSONSchema(),
+		AdditionalProperties:  s.AdditionalProperties.ToJSONSchema(),
+		UnevaluatedProperties: s.UnevaluatedProperties.ToJSONSchema(),
+		PatternProperties:     s.PatternProperties.ToJSONSchema(),
+		Required:              s.Required,
+		Items:                 s.Items.ToJSONSchema(),
+		PrefixItems:           convertMany(s.PrefixItems),
+		Nullable:              s.Nullable,
+		AllOf:                 convertMany(s.AllOf),
+		OneOf:                 convertMany(s.OneOf),
+		AnyOf:                 convertMany(s.AnyOf),
+		Not:                   s.Not.ToJSONSchema(),
+		If:                    s.If.ToJSONSchema(),
+		Then:                  s.Then.ToJSONSchema(),
+		Else:                  s.Else.ToJSONSchema(),
+		DependentRequired:     s.DependentRequired,
+		DependentSchemas:      convertMap(s.DependentSchemas),
+		Enum:                  s.Enum,
+		Const:                 s.Const,
+		MultipleOf:            s.MultipleOf,
+		Maximum:               s.Maximum,
+		ExclusiveMaximum:      s.ExclusiveMaximum,
+		Minimum:               s.Minimum,
+		ExclusiveMinimum:      s.ExclusiveMinimum,
+		MaxLength:             s.MaxLength,
+		MinLength:             s.MinLength,
+		Pattern:               s.Pattern,
+		MaxItems:              s.MaxItems,
+		MinItems:              s.MinItems,
+		UniqueItems:           s.UniqueItems,
+		MaxProperties:         s.MaxProperties,
+		MinProperties:         s.MinProperties,
+		Default:               s.Default,
+		Deprecated:            s.Deprecated,
+		ContentEncoding:       s.ContentEncoding,
+		ContentMediaType:      s.ContentMediaType,
+		ContentSchema:         s.ContentSchema.ToJSONSchema(),
+		Discriminator:         s.Discriminator.ToJSONSchema(),
+		XML:                   s.XML.ToJSONSchema(),
+		Example:               s.Example,
+		Common:                s.Common,
 	}
 }
 
@@ -110,6 +129,12 @@ func (p *Items) ToJSONSchema() *jsonschema.RawItems {
 		return nil
 	}
 
+	if b := p.Bool; b != nil {
+		val := *b
+		return &jsonschema.RawItems{
+			Bool: &val,
+		}
+	}
 	if item := p.Item; item != nil {
 		return &jsonschema.RawItems{
 			Item: item.ToJSONSchema(),
@@ -42,7 +42,7 @@ type Spec struct {
 	// REQUIRED. This string MUST be the version number of the OpenAPI Specification
 	// that the OpenAPI document uses.
 	OpenAPI string `json:"openapi" yaml:"openapi"`
-	// Added just to detect v2 openAPI specifications and to pretty print version error.
+	// Swagger version of v2 specifications, which are converted to OpenAPI 3.0 by the parser.
 	Swagger string `json:"swagger,omitempty" yaml:"swagger,omitempty"`
 	// REQUIRED. Provides metadata about the API.
 	//
@@ -484,7 +484,7 @@ type Parameter struct {
 
 	// REQUIRED. The name of the parameter. Parameter names are case sensitive.
 	Name string `json:"name,omitempty" yaml:"name,omitempty"`
-	// REQUIRED. The location of the parameter. Possible values are "query", "header", "path" or "cookie".
+	// REQUIRED. The location of the parameter. Possible values are "query", "querystring", "header", "path" or "cookie".
 	In string `json:"in,omitempty" yaml:"in,omitempty"`
 	// A brief description of the parameter. This could contain examples of use.
 	// CommonMark syntax MAY be used for rich text representation.
@@ -562,6 +562,11 @@ type RequestBody struct {
 type Media struct {
 	// The schema defining the content of the request, response, or parameter.
 	Schema *Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
+	// The schema defining each item of a sequential media type, like JSON Lines
+	// or Server-Sent Events.
+	//
+	// Added in OpenAPI 3.2.
+	ItemSchema *Schema `json:"itemSchema,omitempty" yaml:"itemSchema,omitempty"`
 	// Example of the media type.
 	Example ExampleValue `json:"example,omitempty" yaml:"example,omitempty"`
 	// Examples of the media type.
@@ -665,9 +670,74 @@ type Response struct {
 // To describe incoming requests from the API provider independent from another
 // API call, use the `webhooks` field.
 //
+// Reference Object is stored as a single "$ref" key with Path Item containing only Ref,
+// use Ref method to get it.
+//
 // See https://spec.openapis.org/oas/v3.1.0#callback-object.
 type Callback map[string]*PathItem
 
+// Ref returns reference, if Callback is a Reference Object.
+func (c Callback) Ref() string {
+	if item, ok := c["$ref"]; ok && item != nil {
+		return item.Ref
+	}
+	return ""
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (c Callback) MarshalYAML() (any, error) {
+	if ref := c.Ref(); ref != "" {
+		return map[string]string{"$ref": ref}, nil
+	}
+	return map[string]*PathItem(c), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (c *Callback) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			if key.Value == "$ref" && value.Kind == yaml.ScalarNode {
+				*c = Callback{"$ref": {Ref: value.Value}}
+				return nil
+			}
+		}
+	}
+
+	var m map[string]*PathItem
+	if err := n.Decode(&m); err != nil {
+		return err
+	}
+	*c = m
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Callback) MarshalJSON() ([]byte, error) {
+	if ref := c.Ref(); ref != "" {
+		return json.Marshal(map[string]string{"$ref": ref})
+	}
+	return json.Marshal(map[string]*PathItem(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Callback) UnmarshalJSON(data []byte) error {
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if err := json.Unmarshal(data, &ref); err == nil && ref.Ref != "" {
+		*c = Callback{"$ref": {Ref: ref.Ref}}
+		return nil
+	}
+
+	var m map[string]*PathItem
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	*c = m
+	return nil
+}
+
 // Example object.
 //
 // See https://spec.openapis.org/oas/v3.1.0#example-object.
@@ -707,6 +777,12 @@ type Link struct {
 	// The key is the parameter name to be used, whereas the value can be a constant or an expression to be
 	// evaluated and passed to the linked operation.
 	Parameters map[string]RawValue `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	// A literal value or {expression} to use as a request body when calling the target operation.
+	RequestBody RawValue `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	// A description of the link. CommonMark syntax MAY be used for rich text representation.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// A server object to be used by the target operation.
+	Server *Server `json:"server,omitempty" yaml:"server,omitempty"`
 
 	Common OpenAPICommon `json:"-" yaml:",inline"`
 }
@@ -728,10 +804,20 @@ type Header = Parameter
 type Tag struct {
 	// REQUIRED. The name of the tag.
 	Name string `json:"name" yaml:"name"`
+	// A short summary of the tag, used for display purposes. Added in OpenAPI 3.2.
+	Summary string `json:"summary,omitempty" yaml:"summary,omitempty"`
 	// A description for the tag. CommonMark syntax MAY be used for rich text representation.
 	Description string `json:"description,omitempty" yaml:"description,omitempty"`
 	// Additional external documentation for this tag.
 	ExternalDocs *ExternalDocumentation `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	// The name of a tag that this tag is nested under. Added in OpenAPI 3.2.
+	//
+	// The named tag MUST exist in the API description,
+	// and circular references between parent and child tags MUST NOT be used.
+	Parent string `json:"parent,omitempty" yaml:"parent,omitempty"`
+	// A machine-readable string to categorize what sort of tag it is,
+	// like "nav", "badge" or "audience". Added in OpenAPI 3.2.
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
 
 	// Specification extensions.
 	Extensions Extensions `json:"-" yaml:",inline"`
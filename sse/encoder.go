@@ -0,0 +1,84 @@
+package sse
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// Encoder writes an SSE stream as defined by the HTML Standard.
+type Encoder struct {
+	w *bufio.Writer
+}
+
+// NewEncoder creates an encoder for an SSE event stream.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a single event frame.
+//
+// Empty ID and Type fields are omitted. Data is split into multiple data
+// fields on line endings, so that a decoder reassembles the same value.
+// Encode returns an error if ID or Type contain line endings, since they
+// cannot be represented in the stream.
+func (e *Encoder) Encode(event Event) error {
+	if strings.ContainsAny(event.ID, "\r\n\x00") {
+		return errors.New("sse: id must not contain line endings or NULL")
+	}
+	if strings.ContainsAny(event.Type, "\r\n") {
+		return errors.New("sse: event type must not contain line endings")
+	}
+
+	if event.ID != "" {
+		e.writeField(fieldID, event.ID)
+	}
+	if event.Type != "" && event.Type != DefaultEventType {
+		e.writeField(fieldEvent, event.Type)
+	}
+	data := strings.ReplaceAll(event.Data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\r", "\n")
+	for line := range strings.SplitSeq(data, "\n") {
+		e.writeField(fieldData, line)
+	}
+	if event.Retry != nil && *event.Retry >= 0 {
+		e.writeField(fieldRetry, strconv.FormatInt(int64(*event.Retry/time.Millisecond), 10))
+	}
+	_ = e.w.WriteByte('\n')
+	return e.w.Flush()
+}
+
+// Comment writes a comment line.
+//
+// Comments are ignored by decoders and commonly used as keep-alive frames.
+func (e *Encoder) Comment(text string) error {
+	for line := range strings.Lines(text) {
+		line = strings.TrimRight(line, "\r\n")
+		_ = e.w.WriteByte(':')
+		if line != "" {
+			_ = e.w.WriteByte(' ')
+			_, _ = e.w.WriteString(line)
+		}
+		_ = e.w.WriteByte('\n')
+	}
+	if text == "" {
+		_ = e.w.WriteByte(':')
+		_ = e.w.WriteByte('\n')
+	}
+	_ = e.w.WriteByte('\n')
+	return e.w.Flush()
+}
+
+func (e *Encoder) writeField(name []byte, value string) {
+	_, _ = e.w.Write(name)
+	_ = e.w.WriteByte(':')
+	if value != "" {
+		_ = e.w.WriteByte(' ')
+		_, _ = e.w.WriteString(value)
+	}
+	_ = e.w.WriteByte('\n')
+}
@@ -0,0 +1,104 @@
+package sse
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncoder_Encode(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  Event
+		output string
+	}{
+		{
+			name:   "data only",
+			event:  Event{Data: "ok"},
+			output: "data: ok\n\n",
+		},
+		{
+			name:   "default type omitted",
+			event:  Event{Type: DefaultEventType, Data: "ok"},
+			output: "data: ok\n\n",
+		},
+		{
+			name: "all fields",
+			event: Event{
+				ID:    "10",
+				Type:  "update",
+				Data:  "one\ntwo",
+				Retry: newDuration(1500 * time.Millisecond),
+			},
+			output: "id: 10\nevent: update\ndata: one\ndata: two\nretry: 1500\n\n",
+		},
+		{
+			name:   "empty data",
+			event:  Event{},
+			output: "data:\n\n",
+		},
+		{
+			name:   "crlf data",
+			event:  Event{Data: "a\r\nb\rc"},
+			output: "data: a\ndata: b\ndata: c\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := NewEncoder(&buf).Encode(tt.event); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if got := buf.String(); got != tt.output {
+				t.Fatalf("Encode() = %q, want %q", got, tt.output)
+			}
+		})
+	}
+}
+
+func TestEncoder_EncodeInvalid(t *testing.T) {
+	for _, event := range []Event{
+		{ID: "a\nb"},
+		{ID: "a\x00b"},
+		{Type: "a\rb"},
+	} {
+		if err := NewEncoder(io.Discard).Encode(event); err == nil {
+			t.Fatalf("Encode(%#v) expected error", event)
+		}
+	}
+}
+
+func TestEncoder_RoundTrip(t *testing.T) {
+	events := []Event{
+		{ID: "1", Type: "update", Data: `{"a":1}`},
+		{Type: DefaultEventType, Data: " leading space"},
+		{ID: "3", Type: DefaultEventType, Data: "multi\nline\n", Retry: newDuration(time.Second)},
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Comment("keep-alive"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dec := NewDecoder(&buf, 0, 0, "", nil)
+	for i, want := range events {
+		got, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("Decode() #%d error = %v", i, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Decode() #%d = %#v, want %#v", i, got, want)
+		}
+	}
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("Decode() error = %v, want EOF", err)
+	}
+}
@@ -0,0 +1,108 @@
+package sse
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/go-faster/errors"
+)
+
+// DefaultKeepAlive is the default interval between keep-alive comments sent
+// by the server when there are no events to write.
+const DefaultKeepAlive = 15 * time.Second
+
+// WriteStream writes events to w until the sequence ends, ctx is done or a
+// write fails.
+//
+// Every event is flushed to the client as soon as it is written. If keepAlive
+// is positive, a comment is written whenever no event was sent for that long.
+// Cancellation of ctx, which usually means that the client has disconnected,
+// is not reported as an error. The error yielded by events stops the stream
+// and is returned as is.
+//
+// The sequence is consumed in a separate goroutine, so it should respect ctx
+// to stop promptly after the client disconnects.
+func WriteStream(ctx context.Context, w http.ResponseWriter, events iter.Seq2[Event, error], keepAlive time.Duration) error {
+	var (
+		rc  = http.NewResponseController(w)
+		enc = NewEncoder(w)
+	)
+	flush := func() error {
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}
+	// Send headers immediately, the first event may take a while.
+	if err := flush(); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+	if events == nil {
+		return nil
+	}
+
+	type result struct {
+		event Event
+		err   error
+	}
+	var (
+		results = make(chan result)
+		done    = make(chan struct{})
+	)
+	defer close(done)
+	go func() {
+		defer close(results)
+		for event, err := range events {
+			select {
+			case results <- result{event: event, err: err}:
+			case <-done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	var (
+		timer       *time.Timer
+		keepAliveCh <-chan time.Time
+	)
+	if keepAlive > 0 {
+		timer = time.NewTimer(keepAlive)
+		defer timer.Stop()
+		keepAliveCh = timer.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAliveCh:
+			if err := enc.Comment("keep-alive"); err != nil {
+				return errors.Wrap(err, "write keep-alive")
+			}
+			if err := flush(); err != nil {
+				return errors.Wrap(err, "flush")
+			}
+			timer.Reset(keepAlive)
+		case r, ok := <-results:
+			if !ok {
+				return nil
+			}
+			if r.err != nil {
+				return r.err
+			}
+			if err := enc.Encode(r.event); err != nil {
+				return errors.Wrap(err, "write event")
+			}
+			if err := flush(); err != nil {
+				return errors.Wrap(err, "flush")
+			}
+			if timer != nil {
+				timer.Reset(keepAlive)
+			}
+		}
+	}
+}
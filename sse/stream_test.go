@@ -0,0 +1,97 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func seqOf(events ...Event) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		for _, event := range events {
+			if !yield(event, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestWriteStream(t *testing.T) {
+	t.Run("Events", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		err := WriteStream(context.Background(), rec, seqOf(
+			Event{Data: "one"},
+			Event{ID: "2", Data: "two"},
+		), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rec.Flushed {
+			t.Fatal("expected flush")
+		}
+		const want = "data: one\n\nid: 2\ndata: two\n\n"
+		if got := rec.Body.String(); got != want {
+			t.Fatalf("body = %q, want %q", got, want)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		testErr := errors.New("test")
+		rec := httptest.NewRecorder()
+		err := WriteStream(context.Background(), rec, func(yield func(Event, error) bool) {
+			if !yield(Event{Data: "one"}, nil) {
+				return
+			}
+			yield(Event{}, testErr)
+		}, 0)
+		if !errors.Is(err, testErr) {
+			t.Fatalf("error = %v, want %v", err, testErr)
+		}
+		if got := rec.Body.String(); got != "data: one\n\n" {
+			t.Fatalf("body = %q", got)
+		}
+	})
+	t.Run("KeepAlive", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rec := httptest.NewRecorder()
+		err := WriteStream(ctx, rec, func(yield func(Event, error) bool) {
+			time.Sleep(50 * time.Millisecond)
+			yield(Event{Data: "late"}, nil)
+		}, 10*time.Millisecond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := rec.Body.String()
+		if !strings.HasPrefix(body, ": keep-alive\n\n") || !strings.HasSuffix(body, "data: late\n\n") {
+			t.Fatalf("unexpected body %q", body)
+		}
+	})
+	t.Run("Disconnect", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan struct{})
+
+		rec := httptest.NewRecorder()
+		err := WriteStream(ctx, rec, func(yield func(Event, error) bool) {
+			defer close(stopped)
+			for {
+				if !yield(Event{Data: "tick"}, nil) {
+					return
+				}
+				cancel()
+			}
+		}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("sequence was not stopped")
+		}
+	})
+}
@@ -21,6 +21,9 @@ func (e *cookieParamEncoder) setCookie(val string) {
 }
 
 func (e *cookieParamEncoder) serialize() error {
+	if err := e.checkFlat(); err != nil {
+		return err
+	}
 	switch e.typ {
 	case typeNotSet:
 		return nil
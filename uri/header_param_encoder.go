@@ -13,6 +13,9 @@ type headerParamEncoder struct {
 }
 
 func (e *headerParamEncoder) serialize() error {
+	if err := e.checkFlat(); err != nil {
+		return err
+	}
 	switch e.typ {
 	case typeNotSet:
 		return nil
@@ -7,8 +7,13 @@ package uri
 // to represent type with any nesting level.
 // This was done for simplicity of templates.
 //
-// The actual encoders/decoders (PathEncoder, PathDecoder, QueryEncoder, etc)
-// does not support nested types and panic if you try to encode/decode them.
+// Query parameters of deepObject and exploded form styles support nesting
+// using bracket notation, like "filter[price][gte]=10&filter[tags][]=a".
+// Items of arrays of objects and arrays are encoded as fields named by
+// item index, like "filter[items][0][name]=a".
+//
+// Other encoders/decoders (PathEncoder, PathDecoder, etc) do not support
+// nested types and fail or panic if you try to encode/decode them.
 //
 // To prevent these panics, gen checks that parameter type is satisfying
 // for OpenAPI constraints (internal/gen/gen_parameters.go:isParamAllowed).
@@ -51,6 +51,9 @@ func (e *PathEncoder) Result() (r string, _ error) {
 	if err := e.checkParam(); err != nil {
 		return "", err
 	}
+	if err := e.checkFlat(); err != nil {
+		return "", err
+	}
 	switch e.typ {
 	case typeNotSet:
 		panic("encoder was not called, no value")
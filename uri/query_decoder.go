@@ -47,7 +47,7 @@ func (d *QueryDecoder) HasParam(cfg QueryParameterDecodingConfig) error {
 					qparam = cfg.Name + "[" + field.Name + "]"
 				}
 
-				if _, ok := d.values[qparam]; ok {
+				if d.hasKey(qparam) {
 					found = true
 					continue
 				}
@@ -84,12 +84,34 @@ func (d *QueryDecoder) HasParam(cfg QueryParameterDecodingConfig) error {
 		return errors.Errorf("query parameter %q not set", cfg.Name)
 	}
 
+	if cfg.Style == QueryStyleForm && cfg.Explode {
+		// Exploded array may use bracket notation, like "tags[]=a".
+		if !d.hasKey(cfg.Name) {
+			return errors.Errorf("query parameter %q not set", cfg.Name)
+		}
+		return nil
+	}
+
 	if _, ok := d.values[cfg.Name]; !ok {
 		return errors.Errorf("query parameter %q not set", cfg.Name)
 	}
 	return nil
 }
 
+// hasKey whether the key or its nested keys, like "key[field]", are set.
+func (d *QueryDecoder) hasKey(key string) bool {
+	if _, ok := d.values[key]; ok {
+		return true
+	}
+	prefix := key + "["
+	for k := range d.values {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *QueryDecoder) DecodeParam(cfg QueryParameterDecodingConfig, f func(Decoder) error) error {
 	p := &queryParamDecoder{
 		values:       d.values,
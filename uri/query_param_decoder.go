@@ -2,6 +2,7 @@ package uri
 
 import (
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/go-faster/errors"
@@ -39,6 +40,15 @@ func (d *queryParamDecoder) DecodeValue() (string, error) {
 }
 
 func (d *queryParamDecoder) DecodeArray(f func(d Decoder) error) error {
+	if d.style == QueryStyleForm && d.explode {
+		root := parseQueryTree(d.values, d.paramName)
+		n, ok := root.children[d.paramName]
+		if !ok {
+			return errors.Errorf("query parameter %q not set", d.paramName)
+		}
+		return (&queryNodeDecoder{name: d.paramName, node: n}).DecodeArray(f)
+	}
+
 	values, ok := d.values[d.paramName]
 	if !ok {
 		return errors.Errorf("query parameter %q not set", d.paramName)
@@ -46,15 +56,6 @@ func (d *queryParamDecoder) DecodeArray(f func(d Decoder) error) error {
 
 	switch d.style {
 	case QueryStyleForm:
-		if d.explode {
-			for _, item := range values {
-				if err := f(&constval{item}); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-
 		if len(values) != 1 {
 			return errors.New("invalid value")
 		}
@@ -130,24 +131,24 @@ func (d *queryParamDecoder) DecodeArray(f func(d Decoder) error) error {
 }
 
 func (d *queryParamDecoder) DecodeFields(f func(name string, d Decoder) error) error {
-	adapter := func(name, value string) error { return f(name, &constval{value}) }
 	switch d.style {
 	case QueryStyleForm:
 		if d.explode {
+			names := make([]string, len(d.objectFields))
+			for i, field := range d.objectFields {
+				names[i] = field.Name
+			}
+			root := parseQueryTree(d.values, names...)
 			for _, field := range d.objectFields {
-				values, ok := d.values[field.Name]
-				if !ok || len(values) == 0 {
+				n, ok := root.children[field.Name]
+				if !ok {
 					if !field.Required {
 						continue
 					}
 					return errors.Errorf("query parameter %q field %q not set", d.paramName, field.Name)
 				}
 
-				if len(values) > 1 {
-					return errors.Errorf("query parameter %q field %q multiple values", d.paramName, field.Name)
-				}
-
-				if err := adapter(field.Name, values[0]); err != nil {
+				if err := f(field.Name, &queryNodeDecoder{name: field.Name, node: n}); err != nil {
 					return err
 				}
 			}
@@ -155,97 +156,84 @@ func (d *queryParamDecoder) DecodeFields(f func(name string, d Decoder) error) e
 			return nil
 		}
 
-		values, ok := d.values[d.paramName]
-		if !ok {
-			return errors.Errorf("query parameter %q not set", d.paramName)
-		}
-
-		if len(values) > 1 {
-			return errors.Errorf("query parameter %q multiple values", d.paramName)
-		}
-
-		cur := &cursor{src: values[0]}
-		return decodeObject(cur, ',', ',', adapter)
+		return d.decodeDelimitedObject(',', f)
 
 	case QueryStyleSpaceDelimited:
-		panic("object cannot have spaceDelimited style")
+		if d.explode {
+			return errors.Errorf("style %q cannot be exploded for objects", d.style)
+		}
+		return d.decodeDelimitedObject(' ', f)
 
 	case QueryStylePipeDelimited:
-		panic("object cannot have pipeDelimited style")
+		if d.explode {
+			return errors.Errorf("style %q cannot be exploded for objects", d.style)
+		}
+		return d.decodeDelimitedObject('|', f)
 
 	case QueryStyleDeepObject:
 		if !d.explode {
 			panic("invalid deepObject style configuration")
 		}
 
-		// Maintain a map of seen keys to avoid additional processing.
-		seenQueryParams := map[string]bool{}
+		root := parseQueryTree(d.values, d.paramName)
+		n := root.child(d.paramName)
+		// Value without brackets is not a part of the object.
+		n.values = nil
 
 		for _, field := range d.objectFields {
-			qparam := d.paramName + "[" + field.Name + "]"
-			seenQueryParams[qparam] = true
-			values, ok := d.values[qparam]
-			if !ok || len(values) == 0 {
-				if !field.Required {
-					continue
-				}
+			if _, ok := n.children[field.Name]; !ok && field.Required {
+				qparam := d.paramName + "[" + field.Name + "]"
 				return errors.Errorf("query parameter %q field %q not set", d.paramName, qparam)
 			}
-
-			if len(values) > 1 {
-				return errors.Errorf("query parameter %q field %q multiple values", d.paramName, qparam)
-			}
-
-			if err := adapter(field.Name, values[0]); err != nil {
-				return err
-			}
 		}
 
-		// With PatternProperties/AdditionalProperties, we do not know the names of these properties.
-		// Therefore, we just iterate over all query parameters that start with the prefix.
-		for k, values := range d.values {
-			qparam := k
-
-			if !strings.HasPrefix(qparam, d.paramName) {
-				// This query parameter does not start with the prefix, so we skip it.
-				continue
-			}
-
-			// To construct the field name, we need to remove the prefix while also removing the brackets.
-			// For example:
-			//   ?paramName[fieldName]=value => fieldName=value
-			//   ?paramName[fieldName][subField1]=value => fieldName[subField1]=value
-			//   ?paramName[fieldName][subField1][subSubField1]=value => fieldName[subField1][subSubField1]=value
-			fieldName := strings.TrimPrefix(qparam, d.paramName)
-			firstOpenBracket := strings.Index(fieldName, "[")
-			firstCloseBracket := strings.Index(fieldName, "]")
-			if firstOpenBracket != -1 && firstCloseBracket != -1 {
-				// Remove the first open and close brackets to get the field name.
-				fieldName = fieldName[firstOpenBracket+1:firstCloseBracket] + fieldName[firstCloseBracket+1:]
+		for _, k := range n.keys {
+			c := n.children[k]
+			if k == "" {
+				return errors.Errorf("query parameter %q: unexpected array, object expected", d.paramName)
 			}
+			qparam := d.paramName + "[" + k + "]"
 
-			if _, ok := seenQueryParams[qparam]; ok {
-				// This value was well-formed as part of the predefined d.objectFields, so we do not need to
-				// process it again.
+			declared := slices.ContainsFunc(d.objectFields, func(field QueryParameterObjectField) bool {
+				return field.Name == k
+			})
+			if len(c.children) == 0 || (declared && len(c.values) == 0) {
+				if err := f(k, &queryNodeDecoder{name: qparam, node: c}); err != nil {
+					return err
+				}
 				continue
 			}
 
-			if len(values) == 0 {
-				return errors.Errorf("query parameter %q field %q not set", d.paramName, qparam)
-			}
-
-			if len(values) > 1 {
-				return errors.Errorf("query parameter %q field %q multiple values", d.paramName, qparam)
-			}
-
-			if err := adapter(fieldName, values[0]); err != nil {
+			// Nested keys of fields that are not declared as objects are names of
+			// additionalProperties and patternProperties, like "role[subRole]".
+			if err := c.walk("", func(path string, leaf *queryNode) error {
+				return f(k+path, &queryNodeDecoder{
+					name: qparam + path,
+					node: &queryNode{values: leaf.values},
+				})
+			}); err != nil {
 				return err
 			}
 		}
-
 		return nil
 
 	default:
 		panic("unreachable")
 	}
 }
+
+func (d *queryParamDecoder) decodeDelimitedObject(sep byte, f func(name string, d Decoder) error) error {
+	values, ok := d.values[d.paramName]
+	if !ok {
+		return errors.Errorf("query parameter %q not set", d.paramName)
+	}
+
+	if len(values) > 1 {
+		return errors.Errorf("query parameter %q multiple values", d.paramName)
+	}
+
+	cur := &cursor{src: values[0]}
+	return decodeObject(cur, sep, sep, func(name, value string) error {
+		return f(name, &constval{value})
+	})
+}
@@ -175,6 +175,26 @@ func TestQueryParamDecoder(t *testing.T) {
 					{"firstName", "Alex"},
 				},
 			},
+			{
+				Param:   "id",
+				Input:   "id=role+admin+firstName+Alex",
+				Style:   QueryStyleSpaceDelimited,
+				Explode: false,
+				Expect: []Field{
+					{"role", "admin"},
+					{"firstName", "Alex"},
+				},
+			},
+			{
+				Param:   "id",
+				Input:   "id=role%7Cadmin%7CfirstName%7CAlex",
+				Style:   QueryStylePipeDelimited,
+				Explode: false,
+				Expect: []Field{
+					{"role", "admin"},
+					{"firstName", "Alex"},
+				},
+			},
 			{
 				Param:   "id",
 				Input:   "id%5BfirstName%5D=Alex&id%5Brole%5D=admin",
@@ -199,6 +219,19 @@ func TestQueryParamDecoder(t *testing.T) {
 					{"role[subRole][group]", "superuser"},
 				},
 			},
+			{
+				Param:   "id",
+				Input:   "id%5BfirstName%5D=Alex&id%5Brole%5D=admin&id%5Bmeta%5D%5Bkey%5D=value&idx%5Bgroup%5D=superuser",
+				Style:   QueryStyleDeepObject,
+				Explode: true,
+				Expect: []Field{
+					{"firstName", "Alex"},
+					{"role", "admin"},
+				},
+				NonEnumeratedFields: []Field{
+					{"meta[key]", "value"},
+				},
+			},
 		}
 
 		for i, test := range tests {
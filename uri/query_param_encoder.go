@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+
+	"github.com/go-faster/errors"
 )
 
 type QueryStyle string
@@ -58,47 +60,24 @@ func (e *queryParamEncoder) encodeArray() error {
 	case QueryStyleForm:
 		if e.explode {
 			e.values[e.paramName] = e.items
+			e.encodeNested(e.paramName)
 			return nil
 		}
-
-		const sep = ","
-		for _, v := range e.items {
-			if err := checkNotContains(v, sep); err != nil {
-				return err
-			}
-		}
-		e.values[e.paramName] = []string{strings.Join(e.items, sep)}
-		return nil
+		return e.encodeDelimitedArray(",")
 
 	case QueryStyleSpaceDelimited:
 		if e.explode {
 			e.values[e.paramName] = e.items
-			return nil
+			return e.checkFlat()
 		}
-
-		const sep = " "
-		for _, v := range e.items {
-			if err := checkNotContains(v, sep); err != nil {
-				return err
-			}
-		}
-		e.values[e.paramName] = []string{strings.Join(e.items, sep)}
-		return nil
+		return e.encodeDelimitedArray(" ")
 
 	case QueryStylePipeDelimited:
 		if e.explode {
 			e.values[e.paramName] = e.items
-			return nil
+			return e.checkFlat()
 		}
-
-		const sep = "|"
-		for _, v := range e.items {
-			if err := checkNotContains(v, sep); err != nil {
-				return err
-			}
-		}
-		e.values[e.paramName] = []string{strings.Join(e.items, sep)}
-		return nil
+		return e.encodeDelimitedArray("|")
 
 	case QueryStyleDeepObject:
 		panic(fmt.Sprintf("style %q cannot be used for arrays", e.style))
@@ -108,6 +87,19 @@ func (e *queryParamEncoder) encodeArray() error {
 	}
 }
 
+func (e *queryParamEncoder) encodeDelimitedArray(sep string) error {
+	if err := e.checkFlat(); err != nil {
+		return err
+	}
+	for _, v := range e.items {
+		if err := checkNotContains(v, sep); err != nil {
+			return err
+		}
+	}
+	e.values[e.paramName] = []string{strings.Join(e.items, sep)}
+	return nil
+}
+
 func (e *queryParamEncoder) encodeObject() error {
 	switch e.style {
 	case QueryStyleForm:
@@ -115,37 +107,22 @@ func (e *queryParamEncoder) encodeObject() error {
 			for _, f := range e.fields {
 				e.values[f.Name] = []string{f.Value}
 			}
+			e.encodeNested("")
 			return nil
 		}
-
-		const (
-			kvSep    = ","
-			fieldSep = ","
-		)
-		var out strings.Builder
-
-		for i, f := range e.fields {
-			if err := checkNotContains(f.Name, kvSep); err != nil {
-				return err
-			}
-			if err := checkNotContains(f.Value, fieldSep); err != nil {
-				return err
-			}
-
-			out.WriteString(f.Name + fieldSep + f.Value)
-			if i != len(e.fields)-1 {
-				out.WriteString(kvSep)
-			}
-		}
-
-		e.values[e.paramName] = []string{out.String()}
-		return nil
+		return e.encodeDelimitedObject(",")
 
 	case QueryStyleSpaceDelimited:
-		panic("object cannot have spaceDelimited style")
+		if e.explode {
+			return errors.Errorf("style %q cannot be exploded for objects", e.style)
+		}
+		return e.encodeDelimitedObject(" ")
 
 	case QueryStylePipeDelimited:
-		panic("object cannot have pipeDelimited style")
+		if e.explode {
+			return errors.Errorf("style %q cannot be exploded for objects", e.style)
+		}
+		return e.encodeDelimitedObject("|")
 
 	case QueryStyleDeepObject:
 		if !e.explode {
@@ -155,10 +132,54 @@ func (e *queryParamEncoder) encodeObject() error {
 		for _, f := range e.fields {
 			e.values[e.paramName+"["+f.Name+"]"] = []string{f.Value}
 		}
-
+		e.encodeNested(e.paramName)
 		return nil
 
 	default:
 		panic("unreachable")
 	}
 }
+
+func (e *queryParamEncoder) encodeDelimitedObject(sep string) error {
+	if err := e.checkFlat(); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	for i, f := range e.fields {
+		if err := checkNotContains(f.Name, sep); err != nil {
+			return err
+		}
+		if err := checkNotContains(f.Value, sep); err != nil {
+			return err
+		}
+
+		out.WriteString(f.Name + sep + f.Value)
+		if i != len(e.fields)-1 {
+			out.WriteString(sep)
+		}
+	}
+
+	e.values[e.paramName] = []string{out.String()}
+	return nil
+}
+
+// encodeNested encodes nested values using bracket notation, like "filter[price][gte]=10".
+//
+// Items of primitive arrays use empty brackets, like "filter[tags][]=a", items of
+// arrays of objects use indices, like "filter[items][0][name]=a".
+//
+// If prefix is empty, first key of the path is used as parameter name.
+func (e *queryParamEncoder) encodeNested(prefix string) {
+	for _, v := range e.nested {
+		path := v.path
+		key := prefix
+		if key == "" {
+			key, path = path[0], path[1:]
+		}
+		for _, k := range path {
+			key += "[" + k + "]"
+		}
+		e.values.Add(key, v.value)
+	}
+}
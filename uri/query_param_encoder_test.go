@@ -173,6 +173,26 @@ func TestQueryParamEncoder(t *testing.T) {
 				Explode: true,
 				Expect:  "id%5BfirstName%5D=Alex&id%5Brole%5D=admin",
 			},
+			{
+				Param: "id",
+				Input: []Field{
+					{"role", "admin"},
+					{"firstName", "Alex"},
+				},
+				Style:   QueryStyleSpaceDelimited,
+				Explode: false,
+				Expect:  "id=role+admin+firstName+Alex",
+			},
+			{
+				Param: "id",
+				Input: []Field{
+					{"role", "admin"},
+					{"firstName", "Alex"},
+				},
+				Style:   QueryStylePipeDelimited,
+				Explode: false,
+				Expect:  "id=role%7Cadmin%7CfirstName%7CAlex",
+			},
 		}
 
 		for i, test := range tests {
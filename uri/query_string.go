@@ -0,0 +1,124 @@
+package uri
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// QueryStringDecoder decodes the whole query string as a single parameter.
+//
+// See OpenAPI 3.2 "querystring" parameter location.
+type QueryStringDecoder struct {
+	raw    string
+	values url.Values
+}
+
+// NewQueryStringDecoder creates new QueryStringDecoder of the URL query.
+func NewQueryStringDecoder(u *url.URL) *QueryStringDecoder {
+	return &QueryStringDecoder{
+		raw:    u.RawQuery,
+		values: u.Query(),
+	}
+}
+
+type QueryStringDecodingConfig struct {
+	// Form whether query string is application/x-www-form-urlencoded.
+	//
+	// Otherwise, the whole query string is percent-decoded and passed as a single value.
+	Form   bool
+	Fields []QueryParameterObjectField // Only for object param.
+}
+
+// HasParam returns an error, if query string is empty.
+func (d *QueryStringDecoder) HasParam(QueryStringDecodingConfig) error {
+	if d.raw == "" {
+		return errors.New("query string not set")
+	}
+	return nil
+}
+
+func (d *QueryStringDecoder) DecodeParam(cfg QueryStringDecodingConfig, f func(Decoder) error) error {
+	if cfg.Form {
+		return f(&queryParamDecoder{
+			values:       d.values,
+			objectFields: cfg.Fields,
+
+			style:   QueryStyleForm,
+			explode: true,
+		})
+	}
+
+	// Query string is percent-encoded per RFC 3986, so "+" is not a space.
+	v, err := url.PathUnescape(d.raw)
+	if err != nil {
+		return errors.Wrap(err, "unescape query string")
+	}
+	return f(constval{v})
+}
+
+// QueryStringEncoder encodes a single parameter as the whole query string.
+//
+// See OpenAPI 3.2 "querystring" parameter location.
+type QueryStringEncoder struct {
+	raw string
+}
+
+// NewQueryStringEncoder creates new QueryStringEncoder.
+func NewQueryStringEncoder() *QueryStringEncoder {
+	return &QueryStringEncoder{}
+}
+
+type QueryStringEncodingConfig struct {
+	// Form whether query string is application/x-www-form-urlencoded.
+	//
+	// Otherwise, the value is percent-encoded as the whole query string.
+	Form bool
+}
+
+func (e *QueryStringEncoder) EncodeParam(cfg QueryStringEncodingConfig, f func(Encoder) error) error {
+	if cfg.Form {
+		values := make(url.Values)
+		p := &queryParamEncoder{
+			receiver: newReceiver(),
+			values:   values,
+
+			style:   QueryStyleForm,
+			explode: true,
+		}
+		if err := f(p); err != nil {
+			return err
+		}
+		if err := p.serialize(); err != nil {
+			return err
+		}
+		e.raw = values.Encode()
+		return nil
+	}
+
+	r := newReceiver()
+	if err := f(r); err != nil {
+		return err
+	}
+	switch r.typ {
+	case typeNotSet:
+		e.raw = ""
+	case typeValue:
+		e.raw = escapeQueryString(r.val)
+	default:
+		return errors.Errorf("query string cannot be encoded as %s", r.typ)
+	}
+	return nil
+}
+
+// escapeQueryString percent-encodes all characters except unreserved ones, per RFC 3986.
+func escapeQueryString(s string) string {
+	// QueryEscape escapes "+" and encodes space as "+".
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// Result returns the encoded query string.
+func (e *QueryStringEncoder) Result() string {
+	return e.raw
+}
@@ -0,0 +1,149 @@
+package uri
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryStringDecoder(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		a := require.New(t)
+
+		u, err := url.Parse(`/?%7B%22a%22%3A%22b%20c+d%22%7D`)
+		a.NoError(err)
+
+		d := NewQueryStringDecoder(u)
+		cfg := QueryStringDecodingConfig{}
+		a.NoError(d.HasParam(cfg))
+
+		var result string
+		a.NoError(d.DecodeParam(cfg, func(d Decoder) error {
+			result, err = d.DecodeValue()
+			return err
+		}))
+		a.Equal(`{"a":"b c+d"}`, result)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		a := require.New(t)
+
+		d := NewQueryStringDecoder(&url.URL{Path: "/"})
+		a.Error(d.HasParam(QueryStringDecodingConfig{}))
+		a.Error(d.HasParam(QueryStringDecodingConfig{Form: true}))
+	})
+	t.Run("Form", func(t *testing.T) {
+		a := require.New(t)
+
+		u, err := url.Parse(`/?name=foo&tags=a&tags=b&filter[min]=1`)
+		a.NoError(err)
+
+		d := NewQueryStringDecoder(u)
+		cfg := QueryStringDecodingConfig{
+			Form: true,
+			Fields: []QueryParameterObjectField{
+				{Name: "name", Required: true},
+				{Name: "tags"},
+				{Name: "filter"},
+				{Name: "missing"},
+			},
+		}
+		a.NoError(d.HasParam(cfg))
+
+		result := map[string][]string{}
+		a.NoError(d.DecodeParam(cfg, func(d Decoder) error {
+			return d.DecodeFields(func(name string, d Decoder) error {
+				switch name {
+				case "tags":
+					return d.DecodeArray(func(d Decoder) error {
+						v, err := d.DecodeValue()
+						result[name] = append(result[name], v)
+						return err
+					})
+				case "filter":
+					return d.DecodeFields(func(field string, d Decoder) error {
+						v, err := d.DecodeValue()
+						result[name+"."+field] = append(result[name+"."+field], v)
+						return err
+					})
+				default:
+					v, err := d.DecodeValue()
+					result[name] = append(result[name], v)
+					return err
+				}
+			})
+		}))
+		a.Equal(map[string][]string{
+			"name":       {"foo"},
+			"tags":       {"a", "b"},
+			"filter.min": {"1"},
+		}, result)
+	})
+	t.Run("FormRequiredField", func(t *testing.T) {
+		a := require.New(t)
+
+		u, err := url.Parse(`/?tags=a`)
+		a.NoError(err)
+
+		cfg := QueryStringDecodingConfig{
+			Form:   true,
+			Fields: []QueryParameterObjectField{{Name: "name", Required: true}},
+		}
+		a.Error(NewQueryStringDecoder(u).DecodeParam(cfg, func(d Decoder) error {
+			return d.DecodeFields(func(string, Decoder) error { return nil })
+		}))
+	})
+}
+
+func TestQueryStringEncoder(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		a := require.New(t)
+
+		e := NewQueryStringEncoder()
+		a.NoError(e.EncodeParam(QueryStringEncodingConfig{}, func(e Encoder) error {
+			return e.EncodeValue(`{"a":"b c+d"}`)
+		}))
+		a.Equal(`%7B%22a%22%3A%22b%20c%2Bd%22%7D`, e.Result())
+
+		u, err := url.Parse("/?" + e.Result())
+		a.NoError(err)
+		var decoded string
+		a.NoError(NewQueryStringDecoder(u).DecodeParam(QueryStringDecodingConfig{}, func(d Decoder) error {
+			decoded, err = d.DecodeValue()
+			return err
+		}))
+		a.Equal(`{"a":"b c+d"}`, decoded)
+	})
+	t.Run("Form", func(t *testing.T) {
+		a := require.New(t)
+
+		e := NewQueryStringEncoder()
+		a.NoError(e.EncodeParam(QueryStringEncodingConfig{Form: true}, func(e Encoder) error {
+			if err := e.EncodeField("name", func(e Encoder) error {
+				return e.EncodeValue("foo bar")
+			}); err != nil {
+				return err
+			}
+			return e.EncodeField("tags", func(e Encoder) error {
+				return e.EncodeArray(func(e Encoder) error {
+					if err := e.EncodeValue("a"); err != nil {
+						return err
+					}
+					return e.EncodeValue("b")
+				})
+			})
+		}))
+		// Arrays of the object use bracket notation, like for exploded form query parameters.
+		a.Equal(`name=foo+bar&tags%5B%5D=a&tags%5B%5D=b`, e.Result())
+	})
+	t.Run("Array", func(t *testing.T) {
+		a := require.New(t)
+
+		e := NewQueryStringEncoder()
+		a.Error(e.EncodeParam(QueryStringEncodingConfig{}, func(e Encoder) error {
+			return e.EncodeArray(func(e Encoder) error {
+				return e.EncodeValue("a")
+			})
+		}))
+	})
+}
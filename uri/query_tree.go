@@ -0,0 +1,198 @@
+package uri
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// queryNode is a value of the query parameter using bracket notation,
+// like "filter[price][gte]=10" or "filter[tags][]=a".
+type queryNode struct {
+	values   []string
+	keys     []string // keys of children in order of appearance
+	children map[string]*queryNode
+}
+
+// splitQueryKey splits the query key into the name and the path in brackets.
+//
+// For example, "filter[price][gte]" is split into "filter" and ["price", "gte"].
+// Key with malformed brackets is returned as is.
+func splitQueryKey(key string) (name string, path []string) {
+	idx := strings.IndexByte(key, '[')
+	if idx <= 0 || !strings.HasSuffix(key, "]") {
+		return key, nil
+	}
+	name, rest := key[:idx], key[idx:]
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return key, nil
+		}
+		path = append(path, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return name, path
+}
+
+// parseQueryTree collects values of keys of given parameter names into the tree.
+//
+// Every name is the key of the child node of the root. The exact key is matched
+// first, since the name may contain brackets itself, like "page[size]".
+// Otherwise, the key is split and matched by the name and the path prefix,
+// so "filter[ids][]" is collected into the "filter[ids]" node.
+func parseQueryTree(values url.Values, names ...string) *queryNode {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	// Sort keys to get stable order of fields.
+	slices.Sort(keys)
+
+	root := &queryNode{}
+	for _, k := range keys {
+		if slices.Contains(names, k) {
+			n := root.child(k)
+			n.values = append(n.values, values[k]...)
+			continue
+		}
+
+		name, path := splitQueryKey(k)
+		for _, declared := range names {
+			declaredName, declaredPath := splitQueryKey(declared)
+			if name != declaredName ||
+				len(path) <= len(declaredPath) ||
+				!slices.Equal(path[:len(declaredPath)], declaredPath) {
+				continue
+			}
+
+			n := root.child(declared)
+			for _, p := range path[len(declaredPath):] {
+				n = n.child(p)
+			}
+			n.values = append(n.values, values[k]...)
+			break
+		}
+	}
+	return root
+}
+
+func (n *queryNode) child(key string) *queryNode {
+	if c, ok := n.children[key]; ok {
+		return c
+	}
+	if n.children == nil {
+		n.children = map[string]*queryNode{}
+	}
+	c := &queryNode{}
+	n.children[key] = c
+	n.keys = append(n.keys, key)
+	return c
+}
+
+// walk calls f for the node and every descendant having values.
+//
+// The path of the node is given in bracket notation, like "[a][b]".
+func (n *queryNode) walk(path string, f func(path string, n *queryNode) error) error {
+	if len(n.values) > 0 {
+		if err := f(path, n); err != nil {
+			return err
+		}
+	}
+	for _, k := range n.keys {
+		if err := n.children[k].walk(path+"["+k+"]", f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ Decoder = (*queryNodeDecoder)(nil)
+
+// queryNodeDecoder decodes values of the queryNode.
+type queryNodeDecoder struct {
+	name string
+	node *queryNode
+}
+
+func (d *queryNodeDecoder) DecodeValue() (string, error) {
+	n := d.node
+	switch {
+	case len(n.children) > 0:
+		return "", errors.Errorf("query parameter %q: unexpected nested value", d.name)
+	case len(n.values) != 1:
+		return "", errors.Errorf("query parameter %q multiple values", d.name)
+	}
+	return n.values[0], nil
+}
+
+// DecodeArray decodes items of the array.
+//
+// Repeated keys ("tags=a&tags=b"), empty brackets ("tags[]=a&tags[]=b") and
+// indices ("tags[0]=a&tags[1]=b") are supported.
+func (d *queryNodeDecoder) DecodeArray(f func(d Decoder) error) error {
+	n := d.node
+	for _, v := range n.values {
+		if err := f(&constval{v}); err != nil {
+			return err
+		}
+	}
+
+	type indexed struct {
+		idx  int
+		node *queryNode
+	}
+	var items []indexed
+	for _, k := range n.keys {
+		c := n.children[k]
+		if k == "" {
+			if len(c.children) > 0 {
+				return errors.Errorf("query parameter %q: items of nested arrays must be indexed", d.name)
+			}
+			for _, v := range c.values {
+				if err := f(&constval{v}); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		idx, err := strconv.Atoi(k)
+		if err != nil || idx < 0 {
+			return errors.Errorf("query parameter %q: invalid array index %q", d.name, k)
+		}
+		items = append(items, indexed{idx: idx, node: c})
+	}
+	slices.SortFunc(items, func(a, b indexed) int { return a.idx - b.idx })
+
+	for _, item := range items {
+		if err := f(&queryNodeDecoder{
+			name: d.name + "[" + strconv.Itoa(item.idx) + "]",
+			node: item.node,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *queryNodeDecoder) DecodeFields(f func(name string, d Decoder) error) error {
+	n := d.node
+	if len(n.values) > 0 {
+		return errors.Errorf("query parameter %q: unexpected value, object expected", d.name)
+	}
+	for _, k := range n.keys {
+		if k == "" {
+			return errors.Errorf("query parameter %q: unexpected array, object expected", d.name)
+		}
+		if err := f(k, &queryNodeDecoder{
+			name: d.name + "[" + k + "]",
+			node: n.children[k],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package uri
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitQueryKey(t *testing.T) {
+	tests := []struct {
+		Key  string
+		Name string
+		Path []string
+	}{
+		{"filter", "filter", nil},
+		{"filter[price]", "filter", []string{"price"}},
+		{"filter[price][gte]", "filter", []string{"price", "gte"}},
+		{"filter[tags][]", "filter", []string{"tags", ""}},
+		{"filter[items][0][name]", "filter", []string{"items", "0", "name"}},
+		// Malformed brackets.
+		{"filter[price", "filter[price", nil},
+		{"filter[price]x", "filter[price]x", nil},
+		{"filter[a]x[b]", "filter[a]x[b]", nil},
+		{"[price]", "[price]", nil},
+	}
+	for i, tt := range tests {
+		name, path := splitQueryKey(tt.Key)
+		require.Equal(t, tt.Name, name, fmt.Sprintf("Test %d", i+1))
+		require.Equal(t, tt.Path, path, fmt.Sprintf("Test %d", i+1))
+	}
+}
+
+type nestedFilter struct {
+	Gte   string
+	Tags  []string
+	Items []string // names of items
+}
+
+func (f nestedFilter) EncodeURI(e Encoder) error {
+	if err := e.EncodeField("price", func(e Encoder) error {
+		return e.EncodeField("gte", func(e Encoder) error {
+			return e.EncodeValue(f.Gte)
+		})
+	}); err != nil {
+		return err
+	}
+	if err := e.EncodeField("tags", func(e Encoder) error {
+		return e.EncodeArray(func(e Encoder) error {
+			for _, tag := range f.Tags {
+				if err := e.EncodeValue(tag); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	return e.EncodeField("items", func(e Encoder) error {
+		return e.EncodeArray(func(e Encoder) error {
+			for i, name := range f.Items {
+				if err := e.EncodeField(fmt.Sprint(i), func(e Encoder) error {
+					return e.EncodeField("name", func(e Encoder) error {
+						return e.EncodeValue(name)
+					})
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (f *nestedFilter) DecodeURI(d Decoder) error {
+	return d.DecodeFields(func(name string, d Decoder) error {
+		switch name {
+		case "price":
+			return d.DecodeFields(func(name string, d Decoder) error {
+				if name != "gte" {
+					return fmt.Errorf("unexpected field %q", name)
+				}
+				v, err := d.DecodeValue()
+				f.Gte = v
+				return err
+			})
+		case "tags":
+			return d.DecodeArray(func(d Decoder) error {
+				v, err := d.DecodeValue()
+				f.Tags = append(f.Tags, v)
+				return err
+			})
+		case "items":
+			return d.DecodeArray(func(d Decoder) error {
+				return d.DecodeFields(func(name string, d Decoder) error {
+					v, err := d.DecodeValue()
+					f.Items = append(f.Items, v)
+					return err
+				})
+			})
+		default:
+			return fmt.Errorf("unexpected field %q", name)
+		}
+	})
+}
+
+func TestQueryNested(t *testing.T) {
+	filter := nestedFilter{
+		Gte:   "10",
+		Tags:  []string{"a", "b"},
+		Items: []string{"x", "y"},
+	}
+	cfg := QueryParameterDecodingConfig{
+		Name:    "filter",
+		Style:   QueryStyleDeepObject,
+		Explode: true,
+		Fields: []QueryParameterObjectField{
+			{Name: "price", Required: true},
+			{Name: "tags"},
+			{Name: "items"},
+		},
+	}
+
+	t.Run("Encode", func(t *testing.T) {
+		e := NewQueryEncoder()
+		require.NoError(t, e.EncodeParam(QueryParameterEncodingConfig{
+			Name:    cfg.Name,
+			Style:   cfg.Style,
+			Explode: cfg.Explode,
+		}, filter.EncodeURI))
+
+		q, err := url.QueryUnescape(e.Values().Encode())
+		require.NoError(t, err)
+		require.Equal(t,
+			"filter[items][0][name]=x&filter[items][1][name]=y&filter[price][gte]=10&filter[tags][]=a&filter[tags][]=b",
+			q,
+		)
+	})
+	t.Run("Decode", func(t *testing.T) {
+		for i, input := range []string{
+			"filter[price][gte]=10&filter[tags][]=a&filter[tags][]=b&filter[items][0][name]=x&filter[items][1][name]=y",
+			// Indexed items are sorted by index.
+			"filter[price][gte]=10&filter[tags][1]=b&filter[tags][0]=a&filter[items][1][name]=y&filter[items][0][name]=x",
+			// Repeated keys.
+			"filter[price][gte]=10&filter[tags]=a&filter[tags]=b&filter[items][0][name]=x&filter[items][1][name]=y",
+		} {
+			values, err := url.ParseQuery(input)
+			require.NoError(t, err)
+
+			d := NewQueryDecoder(values)
+			require.NoError(t, d.HasParam(cfg), fmt.Sprintf("Test %d", i+1))
+
+			var got nestedFilter
+			require.NoError(t, d.DecodeParam(cfg, got.DecodeURI), fmt.Sprintf("Test %d", i+1))
+			require.Equal(t, filter, got, fmt.Sprintf("Test %d", i+1))
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for i, input := range []string{
+			// Missing required field.
+			"filter[tags][]=a",
+			// Value instead of object.
+			"filter[price]=10",
+			// Object instead of value.
+			"filter[price][gte][eq]=10",
+			// Invalid index.
+			"filter[price][gte]=10&filter[tags][x]=a",
+		} {
+			values, err := url.ParseQuery(input)
+			require.NoError(t, err)
+
+			var got nestedFilter
+			err = NewQueryDecoder(values).DecodeParam(cfg, got.DecodeURI)
+			require.Error(t, err, fmt.Sprintf("Test %d", i+1))
+		}
+	})
+}
+
+func TestQueryBracketArray(t *testing.T) {
+	cfg := QueryParameterDecodingConfig{
+		Name:    "tags",
+		Style:   QueryStyleForm,
+		Explode: true,
+	}
+	for i, input := range []string{
+		"tags=a&tags=b",
+		"tags[]=a&tags[]=b",
+		"tags[0]=a&tags[1]=b",
+	} {
+		values, err := url.ParseQuery(input)
+		require.NoError(t, err)
+
+		d := NewQueryDecoder(values)
+		require.NoError(t, d.HasParam(cfg), fmt.Sprintf("Test %d", i+1))
+
+		var got []string
+		require.NoError(t, d.DecodeParam(cfg, func(d Decoder) error {
+			return d.DecodeArray(func(d Decoder) error {
+				v, err := d.DecodeValue()
+				got = append(got, v)
+				return err
+			})
+		}), fmt.Sprintf("Test %d", i+1))
+		require.Equal(t, []string{"a", "b"}, got, fmt.Sprintf("Test %d", i+1))
+	}
+}
+
+func TestQueryBracketName(t *testing.T) {
+	t.Run("Array", func(t *testing.T) {
+		cfg := QueryParameterDecodingConfig{
+			Name:    "filter[ids]",
+			Style:   QueryStyleForm,
+			Explode: true,
+		}
+		for i, input := range []string{
+			"filter[ids]=1&filter[ids]=2",
+			"filter[ids][]=1&filter[ids][]=2",
+			"filter[ids]=1&filter[ids]=2&filter[name]=foo&filter=bar",
+		} {
+			values, err := url.ParseQuery(input)
+			require.NoError(t, err)
+
+			d := NewQueryDecoder(values)
+			require.NoError(t, d.HasParam(cfg), fmt.Sprintf("Test %d", i+1))
+
+			var got []string
+			require.NoError(t, d.DecodeParam(cfg, func(d Decoder) error {
+				return d.DecodeArray(func(d Decoder) error {
+					v, err := d.DecodeValue()
+					got = append(got, v)
+					return err
+				})
+			}), fmt.Sprintf("Test %d", i+1))
+			require.Equal(t, []string{"1", "2"}, got, fmt.Sprintf("Test %d", i+1))
+		}
+	})
+	t.Run("Fields", func(t *testing.T) {
+		cfg := QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   QueryStyleForm,
+			Explode: true,
+			Fields: []QueryParameterObjectField{
+				{Name: "page[size]", Required: true},
+				{Name: "page[number]"},
+			},
+		}
+		values, err := url.ParseQuery("page[size]=10&page[number]=2")
+		require.NoError(t, err)
+
+		d := NewQueryDecoder(values)
+		require.NoError(t, d.HasParam(cfg))
+
+		got := map[string]string{}
+		require.NoError(t, d.DecodeParam(cfg, func(d Decoder) error {
+			return d.DecodeFields(func(name string, d Decoder) error {
+				v, err := d.DecodeValue()
+				got[name] = v
+				return err
+			})
+		}))
+		require.Equal(t, map[string]string{
+			"page[size]":   "10",
+			"page[number]": "2",
+		}, got)
+	})
+}
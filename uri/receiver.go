@@ -32,6 +32,52 @@ type receiver struct {
 	val    string   // value type
 	items  []string // array type
 	fields []Field  // object type
+	// nested are values of nested arrays and objects, only query parameters support them.
+	nested []nestedValue
+}
+
+// nestedValue is a primitive value of the nested array or object.
+type nestedValue struct {
+	// path is a list of keys of the value, empty key denotes an array item.
+	path  []string
+	value string
+}
+
+// leaves returns all primitive values of the receiver.
+func (s *receiver) leaves() (r []nestedValue) {
+	switch s.typ {
+	case typeValue:
+		r = append(r, nestedValue{value: s.val})
+	case typeArray:
+		for _, item := range s.items {
+			r = append(r, nestedValue{path: []string{""}, value: item})
+		}
+	case typeObject:
+		for _, f := range s.fields {
+			r = append(r, nestedValue{path: []string{f.Name}, value: f.Value})
+		}
+	}
+	return append(r, s.nested...)
+}
+
+// prefixed prepends key to paths of given values.
+func prefixed(key string, values []nestedValue) []nestedValue {
+	r := make([]nestedValue, len(values))
+	for i, v := range values {
+		r[i] = nestedValue{
+			path:  append([]string{key}, v.path...),
+			value: v.value,
+		}
+	}
+	return r
+}
+
+// checkFlat returns an error, if the receiver has nested values.
+func (s *receiver) checkFlat() error {
+	if len(s.nested) > 0 {
+		return errors.Errorf("nested %s not supported", s.typ)
+	}
+	return nil
 }
 
 func newReceiver() *receiver {
@@ -65,6 +111,7 @@ func (s *receiver) EncodeArray(f func(Encoder) error) error {
 	}
 
 	s.items = arr.items
+	s.nested = arr.nested
 	return nil
 }
 
@@ -74,25 +121,28 @@ func (s *receiver) EncodeField(field string, f func(Encoder) error) error {
 	}
 
 	s.typ = typeObject
-	vs := &valueReceiver{}
+	vs := newReceiver()
 	if err := f(vs); err != nil {
 		return err
 	}
 
-	if !vs.set {
-		return nil
+	switch vs.typ {
+	case typeNotSet:
+	case typeValue:
+		s.fields = append(s.fields, Field{
+			Name:  field,
+			Value: vs.val,
+		})
+	default:
+		s.nested = append(s.nested, prefixed(field, vs.leaves())...)
 	}
-
-	s.fields = append(s.fields, Field{
-		Name:  field,
-		Value: vs.value,
-	})
 	return nil
 }
 
 type arrayReceiver struct {
-	set   bool
-	items []string
+	set    bool
+	items  []string
+	nested []nestedValue
 }
 
 func (e *arrayReceiver) EncodeValue(v string) error {
@@ -102,31 +152,17 @@ func (e *arrayReceiver) EncodeValue(v string) error {
 }
 
 func (e *arrayReceiver) EncodeArray(_ func(Encoder) error) error {
-	panic("nested arrays not allowed in path parameters")
-}
-
-func (e *arrayReceiver) EncodeField(_ string, _ func(Encoder) error) error {
-	panic("nested objects not allowed in path parameters")
-}
-
-type valueReceiver struct {
-	set   bool
-	value string
+	panic("nested arrays must be encoded as indexed items")
 }
 
-func (e *valueReceiver) EncodeValue(v string) error {
-	if e.set {
-		panic("value already set")
+// EncodeField encodes an item of array of arrays or objects, name is the item index.
+func (e *arrayReceiver) EncodeField(name string, f func(Encoder) error) error {
+	item := newReceiver()
+	if err := f(item); err != nil {
+		return err
 	}
-	e.value = v
+
 	e.set = true
+	e.nested = append(e.nested, prefixed(name, item.leaves())...)
 	return nil
 }
-
-func (e *valueReceiver) EncodeArray(_ func(Encoder) error) error {
-	panic("nested arrays not allowed")
-}
-
-func (e *valueReceiver) EncodeField(_ string, _ func(Encoder) error) error {
-	panic("nested objects not allowed")
-}
@@ -31,6 +31,13 @@ func TestError_Error(t *testing.T) {
 	}
 }
 
+func TestDependentRequiredError(t *testing.T) {
+	a := require.New(t)
+	var err error = &DependentRequiredError{Dependency: "address"}
+	a.EqualError(err, `field required when "address" is present`)
+	a.ErrorIs(err, ErrFieldRequired)
+}
+
 func TestInvalidContentType(t *testing.T) {
 	a := require.New(t)
 	err := InvalidContentType("application/json")
@@ -15,6 +15,26 @@ import (
 // ErrFieldRequired reports that a field is required, but not found.
 var ErrFieldRequired = errors.New("field required")
 
+// ErrNotAllowed reports that a value is valid against the "not" subschema.
+var ErrNotAllowed = errors.New(`not allowed by "not" schema`)
+
+// DependentRequiredError reports that a field is required, because
+// other field is present.
+type DependentRequiredError struct {
+	// Dependency is the name of the present field.
+	Dependency string
+}
+
+// Error implements error.
+func (e *DependentRequiredError) Error() string {
+	return fmt.Sprintf("field required when %q is present", e.Dependency)
+}
+
+// Is reports whether target is ErrFieldRequired.
+func (e *DependentRequiredError) Is(target error) bool {
+	return target == ErrFieldRequired
+}
+
 // Error represents validation error.
 type Error struct {
 	Fields []FieldError
@@ -29,6 +49,11 @@ func (e *Error) Error() string {
 			b.WriteRune(',')
 		}
 		b.WriteRune(' ')
+		if f.Name == "" {
+			// Failure of the value itself.
+			b.WriteString(f.Error.Error())
+			continue
+		}
 		b.WriteString(f.Name)
 		b.WriteString(" (")
 		b.WriteString(f.Error.Error())
@@ -40,6 +65,10 @@ func (e *Error) Error() string {
 
 // FieldError is failed validation on field.
 type FieldError struct {
+	// Name is the name of the field.
+	//
+	// Array elements are named as "[i]". Empty name means that
+	// the validated value itself is invalid.
 	Name  string
 	Error error
 }
@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/go-faster/errors"
+)
+
+// Failure is a single validation failure.
+type Failure struct {
+	// Pointer is the JSON pointer (RFC 6901) to the invalid value, like "/items/3/price".
+	//
+	// Empty pointer refers to the validated value itself.
+	Pointer string
+	// Name is the name of the invalid field, as in FieldError.
+	Name string
+	// Err is the cause of the failure.
+	Err error
+}
+
+// Walk calls fn for every failure of e, including failures of nested
+// values, in order.
+//
+// Walk stops if fn returns false. Walk returns false if it was stopped.
+func (e *Error) Walk(fn func(f Failure) bool) bool {
+	return e.walk("", fn)
+}
+
+func (e *Error) walk(prefix string, fn func(f Failure) bool) bool {
+	for _, f := range e.Fields {
+		pointer := prefix
+		if f.Name != "" {
+			pointer += "/" + pointerToken(f.Name)
+		}
+
+		if nested := new(Error); errors.As(f.Error, &nested) {
+			if !nested.walk(pointer, fn) {
+				return false
+			}
+			continue
+		}
+		if !fn(Failure{
+			Pointer: pointer,
+			Name:    f.Name,
+			Err:     f.Error,
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// Failures returns all failures of e, including failures of nested values.
+func (e *Error) Failures() (r []Failure) {
+	e.Walk(func(f Failure) bool {
+		r = append(r, f)
+		return true
+	})
+	return r
+}
+
+// Failures returns all validation failures of err.
+//
+// Returns nil, if err is not caused by *Error.
+func Failures(err error) []Failure {
+	var e *Error
+	if !errors.As(err, &e) {
+		return nil
+	}
+	return e.Failures()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// pointerToken converts FieldError name to JSON pointer reference token.
+func pointerToken(name string) string {
+	// Array elements are named as "[i]".
+	if idx, ok := strings.CutPrefix(name, "["); ok {
+		if idx, ok := strings.CutSuffix(idx, "]"); ok {
+			return idx
+		}
+	}
+	return pointerEscaper.Replace(name)
+}
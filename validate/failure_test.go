@@ -0,0 +1,48 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/go-faster/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_Failures(t *testing.T) {
+	a := require.New(t)
+
+	tooLong := errors.New("too long")
+	err := errors.Wrap(&Error{Fields: []FieldError{
+		{Name: "name", Error: ErrFieldRequired},
+		{Name: "items", Error: &Error{Fields: []FieldError{
+			{Error: errors.New("array: too many items")},
+			{Name: "[3]", Error: errors.Wrap(&Error{Fields: []FieldError{
+				{Name: "price", Error: errors.New("negative")},
+				{Name: "a/b~c", Error: tooLong},
+			}}, "pointer")},
+		}}},
+	}}, "validate")
+
+	failures := Failures(err)
+	a.Len(failures, 4)
+	a.Equal(Failure{Pointer: "/name", Name: "name", Err: ErrFieldRequired}, failures[0])
+	a.Equal("/items", failures[1].Pointer)
+	a.Empty(failures[1].Name)
+	a.EqualError(failures[1].Err, "array: too many items")
+	a.Equal("/items/3/price", failures[2].Pointer)
+	a.Equal(Failure{Pointer: "/items/3/a~1b~0c", Name: "a/b~c", Err: tooLong}, failures[3])
+
+	var visited []string
+	var e *Error
+	a.True(errors.As(err, &e))
+	a.False(e.Walk(func(f Failure) bool {
+		visited = append(visited, f.Pointer)
+		return len(visited) < 2
+	}))
+	a.Equal([]string{"/name", "/items"}, visited)
+
+	a.Nil(Failures(errors.New("not a validation error")))
+	a.Equal(
+		"invalid: name (field required), items (invalid: array: too many items, [3] (pointer: invalid: price (negative), a/b~c (too long)))",
+		e.Error(),
+	)
+}